@@ -18,6 +18,12 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdQueryInterchainAccount(),
 		GetCmdParams(),
+		GetCmdScheduledTx(),
+		GetCmdScheduledTxs(),
+		GetCmdPendingChannelReopen(),
+		GetCmdPendingChannelReopens(),
+		GetCmdPacketResult(),
+		GetCmdPacketResults(),
 	)
 
 	return queryCmd
@@ -36,6 +42,9 @@ func NewTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		newRegisterInterchainAccountCmd(),
 		newSendTxCmd(),
+		newScheduleTxCmd(),
+		newCancelScheduledTxCmd(),
+		newTransferOwnershipCmd(),
 	)
 
 	return cmd
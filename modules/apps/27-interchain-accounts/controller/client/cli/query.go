@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -74,3 +75,247 @@ func GetCmdParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdScheduledTx returns the command handler for the controller submodule scheduled transaction querying.
+func GetCmdScheduledTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scheduled-tx [id]",
+		Short:   "Query a scheduled interchain account transaction",
+		Long:    "Query the controller submodule for the scheduled interchain account transaction with the given identifier",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts controller scheduled-tx 1", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScheduledTx(cmd.Context(), &types.QueryScheduledTxRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdScheduledTxs returns the command handler for the controller submodule scheduled transactions querying.
+func GetCmdScheduledTxs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scheduled-txs",
+		Short:   "Query the scheduled interchain account transactions",
+		Long:    "Query the controller submodule for all the scheduled interchain account transactions, or those of the owner given with the owner flag",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts controller scheduled-txs --owner cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryScheduledTxsRequest{
+				Owner:      owner,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ScheduledTxs(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagOwner, "", "Owner of the scheduled transactions")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled transactions")
+
+	return cmd
+}
+
+// GetCmdPendingChannelReopen returns the command handler for the controller submodule pending channel reopening querying.
+func GetCmdPendingChannelReopen() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-channel-reopen [owner] [connection-id]",
+		Short:   "Query the pending channel reopening of the interchain account of a given owner on a particular connection",
+		Long:    "Query the controller submodule for the channel opening handshake initiated automatically to reopen the closed channel of the interchain account of a given owner on a particular connection",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts controller pending-channel-reopen cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryPendingChannelReopenRequest{
+				Owner:        args[0],
+				ConnectionId: args[1],
+			}
+
+			res, err := queryClient.PendingChannelReopen(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPendingChannelReopens returns the command handler for the controller submodule pending channel reopenings querying.
+func GetCmdPendingChannelReopens() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-channel-reopens",
+		Short:   "Query the pending channel reopenings of interchain accounts",
+		Long:    "Query the controller submodule for all the channel opening handshakes initiated automatically to reopen the closed channels of interchain accounts",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts controller pending-channel-reopens", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingChannelReopens(cmd.Context(), &types.QueryPendingChannelReopensRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending channel reopenings")
+
+	return cmd
+}
+
+// GetCmdPacketResult returns the command handler for the controller submodule packet result querying.
+func GetCmdPacketResult() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-result [owner] [connection-id] [sequence]",
+		Short: "Query the result of a packet sent by the interchain account of a given owner on a particular connection",
+		Long: `Query the controller submodule for the result of the packet with the given sequence sent by the interchain account of a given owner on a particular connection.
+The packet is looked up on the active channel of the interchain account, unless a channel is given with the channel-id flag.`,
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s query interchain-accounts controller packet-result cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs connection-0 1", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			channelID, err := cmd.Flags().GetString(flagChannelID)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryPacketResultRequest{
+				Owner:        args[0],
+				ConnectionId: args[1],
+				Sequence:     sequence,
+				ChannelId:    channelID,
+			}
+
+			res, err := queryClient.PacketResult(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagChannelID, "", "Channel on which the packet was sent, defaults to the active channel of the interchain account")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPacketResults returns the command handler for the controller submodule packet results querying.
+func GetCmdPacketResults() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packet-results [owner]",
+		Short:   "Query the results of the packets sent by the interchain accounts of a given owner",
+		Long:    "Query the controller submodule for the recorded results of the packets sent by the interchain accounts of a given owner, on all connections or on the connection given with the connection-id flag",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts controller packet-results cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs --connection-id connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			connectionID, err := cmd.Flags().GetString(flagConnectionID)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryPacketResultsRequest{
+				Owner:        args[0],
+				ConnectionId: connectionID,
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.PacketResults(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagConnectionID, "", "Connection on which the packets were sent")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "packet results")
+
+	return cmd
+}
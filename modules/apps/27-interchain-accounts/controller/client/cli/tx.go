@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
@@ -27,6 +29,13 @@ const (
 	flagOrdering               = "ordering"
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	flagAbsoluteTimeouts       = "absolute-timeouts"
+	flagHeight                 = "height"
+	flagTimestamp              = "timestamp"
+	flagInterval               = "interval"
+	flagMaxExecutions          = "max-executions"
+	flagOwner                  = "owner"
+	flagChannelID              = "channel-id"
+	flagConnectionID           = "connection-id"
 )
 
 // defaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
@@ -96,19 +105,9 @@ If no timeout value is set then a default relative timeout value of 10 minutes i
 			connectionID := args[0]
 			owner := clientCtx.GetFromAddress().String()
 
-			// attempt to unmarshal ica msg data argument
-			var icaMsgData icatypes.InterchainAccountPacketData
-			msgContentOrFileName := args[1]
-			if err := cdc.UnmarshalJSON([]byte(msgContentOrFileName), &icaMsgData); err != nil {
-				// check for file path if JSON input is not provided
-				contents, err := os.ReadFile(msgContentOrFileName)
-				if err != nil {
-					return fmt.Errorf("neither JSON input nor path to .json file for packet data with messages were provided: %w", err)
-				}
-
-				if err := cdc.UnmarshalJSON(contents, &icaMsgData); err != nil {
-					return fmt.Errorf("error unmarshalling packet data with messages file: %w", err)
-				}
+			icaMsgData, err := parsePacketData(cdc, args[1])
+			if err != nil {
+				return err
 			}
 
 			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
@@ -149,6 +148,153 @@ If no timeout value is set then a default relative timeout value of 10 minutes i
 	return cmd
 }
 
+func newScheduleTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-tx [connection-id] [path/to/packet_msg.json]",
+		Short: "Schedule an interchain account tx to be sent on the provided connection.",
+		Long: strings.TrimSpace(`Schedules pre-built packet data containing messages to be executed on the host chain to be sent at a block height, 
+provided using the flag {height}, or at a unix timestamp in nanoseconds, provided using the flag {timestamp}. 
+Packet data is provided as json, file or string. The transaction is sent again every {interval} blocks or nanoseconds if the flag is set, 
+at most {max-executions} times if the flag is set. The timeout timestamp of each packet is {packet-timeout-timestamp} added to the block time 
+at which it is sent. If no timeout value is set then a default relative timeout value of 10 minutes is used.`),
+		Example: fmt.Sprintf("%s tx interchain-accounts controller schedule-tx connection-0 packet-data.json --height 1000 --interval 100 --max-executions 10", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			connectionID := args[0]
+			owner := clientCtx.GetFromAddress().String()
+
+			icaMsgData, err := parsePacketData(cdc, args[1])
+			if err != nil {
+				return err
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			height, err := cmd.Flags().GetUint64(flagHeight)
+			if err != nil {
+				return err
+			}
+
+			timestamp, err := cmd.Flags().GetUint64(flagTimestamp)
+			if err != nil {
+				return err
+			}
+
+			interval, err := cmd.Flags().GetUint64(flagInterval)
+			if err != nil {
+				return err
+			}
+
+			maxExecutions, err := cmd.Flags().GetUint64(flagMaxExecutions)
+			if err != nil {
+				return err
+			}
+
+			schedule := types.Schedule{
+				Height:        height,
+				Timestamp:     timestamp,
+				Interval:      interval,
+				MaxExecutions: maxExecutions,
+			}
+
+			msg := types.NewMsgScheduleTx(owner, connectionID, timeoutTimestamp, icaMsgData, schedule)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, defaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from the block time at which the packet is sent. Default is 10 minutes.")
+	cmd.Flags().Uint64(flagHeight, 0, "Block height at which the transaction is sent")
+	cmd.Flags().Uint64(flagTimestamp, 0, "Unix timestamp in nanoseconds at or after which the transaction is sent")
+	cmd.Flags().Uint64(flagInterval, 0, "Number of blocks or nanoseconds after which the transaction is sent again")
+	cmd.Flags().Uint64(flagMaxExecutions, 0, "Maximum number of times a recurring transaction is sent. Zero sends it until cancelled")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func newCancelScheduledTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-scheduled-tx [id]",
+		Short:   "Cancel a scheduled interchain account tx.",
+		Long:    "Cancels the scheduled interchain account tx with the provided identifier, which must be owned by the sender.",
+		Example: fmt.Sprintf("%s tx interchain-accounts controller cancel-scheduled-tx 1", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelScheduledTx(clientCtx.GetFromAddress().String(), id)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func newTransferOwnershipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-ownership [connection-id] [new-owner]",
+		Short: "Transfer the ownership of an interchain account on the provided connection.",
+		Long: `Hands the interchain account owned by the sender on the provided connection, together with its active channel, over to the new owner.
+The controller port of the interchain account is unchanged. The scheduled transactions of the sender on the connection are cancelled.`,
+		Example: fmt.Sprintf("%s tx interchain-accounts controller transfer-ownership connection-0 cosmos1...", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferOwnership(clientCtx.GetFromAddress().String(), args[0], args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parsePacketData unmarshals the interchain account packet data provided as JSON, or as a path to a JSON file.
+func parsePacketData(cdc *codec.ProtoCodec, msgContentOrFileName string) (icatypes.InterchainAccountPacketData, error) {
+	// attempt to unmarshal ica msg data argument
+	var icaMsgData icatypes.InterchainAccountPacketData
+	if err := cdc.UnmarshalJSON([]byte(msgContentOrFileName), &icaMsgData); err != nil {
+		// check for file path if JSON input is not provided
+		contents, err := os.ReadFile(msgContentOrFileName)
+		if err != nil {
+			return icatypes.InterchainAccountPacketData{}, fmt.Errorf("neither JSON input nor path to .json file for packet data with messages were provided: %w", err)
+		}
+
+		if err := cdc.UnmarshalJSON(contents, &icaMsgData); err != nil {
+			return icatypes.InterchainAccountPacketData{}, fmt.Errorf("error unmarshalling packet data with messages file: %w", err)
+		}
+	}
+
+	return icaMsgData, nil
+}
+
 // parseOrdering gets the channel ordering from the flags.
 func parseOrdering(cmd *cobra.Command) (channeltypes.Order, error) {
 	orderString, err := cmd.Flags().GetString(flagOrdering)
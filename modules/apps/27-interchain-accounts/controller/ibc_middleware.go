@@ -203,6 +203,8 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		return err
 	}
 
+	im.keeper.OnAcknowledgementPacket(ctx, packet, acknowledgement)
+
 	// call underlying app's OnAcknowledgementPacket callback.
 	if im.app != nil && im.keeper.IsMiddlewareEnabled(ctx, packet.GetSourcePort(), connectionID) {
 		return im.app.OnAcknowledgementPacket(ctx, channelVersion, packet, acknowledgement, relayer)
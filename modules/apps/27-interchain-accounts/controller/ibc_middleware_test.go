@@ -1232,6 +1232,87 @@ func (suite *InterchainAccountsTestSuite) TestClosedChannelReopensWithMsgServer(
 	}
 }
 
+func (suite *InterchainAccountsTestSuite) TestClosedChannelReopensAutomatically() {
+	testCases := []struct {
+		name              string
+		autoReopenEnabled bool
+	}{
+		{"success: channel is reopened", true},
+		{"channel is not reopened when automatic reopening is disabled", false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB, channeltypes.ORDERED)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			controllerKeeper := &suite.chainA.GetSimApp().ICAControllerKeeper
+			params := types.DefaultParams()
+			params.AutoReopenChannels = tc.autoReopenEnabled
+			controllerKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			closedChannelID := path.EndpointA.ChannelID
+			version := path.EndpointA.GetChannel().Version
+			channelSeq := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext())
+
+			// send a packet and time it out, closing the ORDERED channel
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, []byte("packet data"))
+			suite.Require().NoError(err)
+
+			packet := channeltypes.NewPacket([]byte("packet data"), sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+			suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+			suite.Require().Equal(channeltypes.CLOSED, path.EndpointA.GetChannel().State)
+
+			pendingReopen, found := controllerKeeper.GetPendingChannelReopen(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID)
+			if !tc.autoReopenEnabled {
+				suite.Require().False(found)
+				suite.Require().True(controllerKeeper.IsActiveChannelClosed(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID))
+				return
+			}
+
+			suite.Require().True(found)
+			suite.Require().Equal(types.PendingChannelReopen{
+				PortId:          path.EndpointA.ChannelConfig.PortID,
+				ConnectionId:    path.EndpointA.ConnectionID,
+				ClosedChannelId: closedChannelID,
+				ChannelId:       channeltypes.FormatChannelIdentifier(channelSeq),
+				Version:         version,
+			}, pendingReopen)
+
+			channel, found := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, pendingReopen.ChannelId)
+			suite.Require().True(found)
+			suite.Require().Equal(channeltypes.INIT, channel.State)
+			suite.Require().Equal(channeltypes.ORDERED, channel.Ordering)
+
+			// complete the handshake, the host channel end being closed by the relayer
+			path.EndpointB.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+			path.EndpointA.ChannelID = pendingReopen.ChannelId
+			path.EndpointB.ChannelID = ""
+
+			suite.Require().NoError(path.EndpointB.ChanOpenTry())
+			suite.Require().NoError(path.EndpointA.ChanOpenAck())
+			suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+			_, found = controllerKeeper.GetPendingChannelReopen(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID)
+			suite.Require().False(found)
+
+			activeChannelID, found := controllerKeeper.GetOpenActiveChannel(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+			suite.Require().Equal(pendingReopen.ChannelId, activeChannelID)
+		})
+	}
+}
+
 func (suite *InterchainAccountsTestSuite) TestPacketDataUnmarshalerInterface() {
 	for _, ordering := range []channeltypes.Order{channeltypes.UNORDERED, channeltypes.ORDERED} {
 		suite.SetupTest() // reset
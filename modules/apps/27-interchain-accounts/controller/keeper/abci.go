@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
+)
+
+// EndBlocker sends the scheduled interchain accounts transactions which are due, up to the MaxScheduledTxsPerBlock
+// parameter, leaving the remaining ones queued for the following blocks. A failure to send a transaction is
+// reported in an event without preventing the sending of the other transactions. Transactions with a recurring
+// schedule are queued again, one interval after the current block, until they reach their maximum number of executions.
+func EndBlocker(ctx context.Context, k Keeper) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+
+	for _, id := range k.getDueScheduledTxIDs(ctx, k.GetParams(ctx).MaxScheduledTxsPerBlock) {
+		scheduledTx, found := k.GetScheduledTx(ctx, id)
+		if !found {
+			continue
+		}
+
+		sequence, err := k.sendScheduledTx(sdkCtx, scheduledTx)
+		if err != nil {
+			k.Logger(ctx).Error("failed to send scheduled interchain account transaction", "id", id, "owner", scheduledTx.Owner, "error", err.Error())
+		}
+		EmitScheduledTxSentEvent(ctx, scheduledTx, sequence, err)
+
+		k.DeleteScheduledTx(ctx, scheduledTx)
+
+		scheduledTx.Executions++
+		if scheduledTx.IsCompleted() {
+			continue
+		}
+
+		if scheduledTx.Schedule.Height != 0 {
+			scheduledTx.Schedule.Height = uint64(sdkCtx.BlockHeight()) + scheduledTx.Schedule.Interval
+		} else {
+			scheduledTx.Schedule.Timestamp = uint64(sdkCtx.BlockTime().UnixNano()) + scheduledTx.Schedule.Interval
+		}
+		k.SetScheduledTx(ctx, scheduledTx)
+	}
+}
+
+// sendScheduledTx sends the packet data of the scheduled transaction in a cached context, whose state changes are only
+// committed if the sending succeeds. The packet sequence is returned.
+func (k Keeper) sendScheduledTx(ctx sdk.Context, scheduledTx types.ScheduledTx) (uint64, error) {
+	portID, err := k.GetControllerPortID(ctx, scheduledTx.Owner, scheduledTx.ConnectionId)
+	if err != nil {
+		return 0, err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	sequence, err := k.sendTxWithRelativeTimeout(cacheCtx, scheduledTx.ConnectionId, portID, scheduledTx.PacketData, scheduledTx.RelativeTimeout)
+	if err != nil {
+		return 0, err
+	}
+
+	writeCache()
+
+	return sequence, nil
+}
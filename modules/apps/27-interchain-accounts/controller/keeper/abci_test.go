@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/keeper"
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func (suite *KeeperTestSuite) TestEndBlocker() {
+	var (
+		scheduledTx types.ScheduledTx
+		path        *ibctesting.Path
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expSent     bool
+		expSchedule func() *types.Schedule // the schedule of the transaction after the end blocker, nil if it is removed
+	}{
+		{
+			"success: one-off height schedule is sent and removed",
+			func() {},
+			true,
+			func() *types.Schedule { return nil },
+		},
+		{
+			"success: one-off time schedule is sent and removed",
+			func() {
+				scheduledTx.Schedule = types.NewTimeSchedule(uint64(suite.chainA.GetContext().BlockTime().UnixNano()), 0, 0)
+			},
+			true,
+			func() *types.Schedule { return nil },
+		},
+		{
+			"success: recurring height schedule is sent and queued again",
+			func() {
+				scheduledTx.Schedule.Interval = 10
+			},
+			true,
+			func() *types.Schedule {
+				return &types.Schedule{Height: uint64(suite.chainA.GetContext().BlockHeight()) + 10, Interval: 10}
+			},
+		},
+		{
+			"success: recurring time schedule is sent and queued again",
+			func() {
+				scheduledTx.Schedule = types.NewTimeSchedule(uint64(suite.chainA.GetContext().BlockTime().UnixNano()), uint64(time.Hour), 0)
+			},
+			true,
+			func() *types.Schedule {
+				return &types.Schedule{Timestamp: uint64(suite.chainA.GetContext().BlockTime().Add(time.Hour).UnixNano()), Interval: uint64(time.Hour)}
+			},
+		},
+		{
+			"success: recurring schedule reaching its max executions is sent and removed",
+			func() {
+				scheduledTx.Schedule.Interval = 10
+				scheduledTx.Schedule.MaxExecutions = 2
+				scheduledTx.Executions = 1
+			},
+			true,
+			func() *types.Schedule { return nil },
+		},
+		{
+			"success: schedule which is not due is not sent",
+			func() {
+				scheduledTx.Schedule.Height++
+			},
+			false,
+			func() *types.Schedule {
+				return &types.Schedule{Height: uint64(suite.chainA.GetContext().BlockHeight()) + 1}
+			},
+		},
+		{
+			"success: due schedule beyond the max scheduled txs per block is not sent",
+			func() {
+				params := types.DefaultParams()
+				params.MaxScheduledTxsPerBlock = 0
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			false,
+			func() *types.Schedule {
+				return &types.Schedule{Height: uint64(suite.chainA.GetContext().BlockHeight())}
+			},
+		},
+		{
+			"failure: one-off schedule failing to be sent is removed",
+			func() {
+				params := types.DefaultParams()
+				params.ControllerEnabled = false
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			false,
+			func() *types.Schedule { return nil },
+		},
+		{
+			"failure: recurring schedule failing to be sent is queued again",
+			func() {
+				scheduledTx.Schedule.Interval = 10
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+			},
+			false,
+			func() *types.Schedule {
+				return &types.Schedule{Height: uint64(suite.chainA.GetContext().BlockHeight()) + 10, Interval: 10}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB, channeltypes.ORDERED)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: []byte("data"),
+			}
+			schedule := types.NewHeightSchedule(uint64(suite.chainA.GetContext().BlockHeight()), 0, 0)
+			scheduledTx = types.NewScheduledTx(1, types.NewMsgScheduleTx(TestOwnerAddress, path.EndpointA.ConnectionID, uint64(time.Minute), packetData, schedule))
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetScheduledTx(ctx, scheduledTx)
+
+			keeper.EndBlocker(ctx, suite.chainA.GetSimApp().ICAControllerKeeper)
+
+			sent := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.HasPacketCommitment(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+			suite.Require().Equal(tc.expSent, sent)
+
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != icatypes.EventTypeScheduledTxSent {
+					continue
+				}
+
+				for _, attr := range event.Attributes {
+					if attr.Key == icatypes.AttributeKeyAckSuccess {
+						suite.Require().Equal(strconv.FormatBool(tc.expSent), attr.Value)
+					}
+				}
+			}
+
+			storedTx, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetScheduledTx(ctx, scheduledTx.Id)
+			expSchedule := tc.expSchedule()
+			if expSchedule == nil {
+				suite.Require().False(found)
+				suite.Require().Empty(suite.chainA.GetSimApp().ICAControllerKeeper.GetAllScheduledTxs(ctx))
+				return
+			}
+
+			suite.Require().True(found)
+			suite.Require().Equal(*expSchedule, storedTx.Schedule)
+		})
+	}
+}
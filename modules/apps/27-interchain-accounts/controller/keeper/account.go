@@ -15,7 +15,8 @@ import (
 )
 
 // RegisterInterchainAccount is the entry point to registering an interchain account:
-// - It generates a new port identifier using the provided owner string.
+// - It generates a new port identifier using the provided owner string, unless the ownership of an interchain account
+// has been transferred to the owner on the connection, in which case its port identifier is used.
 // - Callers are expected to provide the appropriate application version string.
 // - For example, this could be an ICS27 encoded metadata type or an ICS29 encoded metadata type with a nested application version.
 // - A new MsgChannelOpenInit is routed through the MsgServiceRouter, executing the OnOpenChanInit callback stack as configured.
@@ -31,7 +32,7 @@ import (
 // by the underlying application. For a full summary of the changes in v6.x.x, please see ADR009.
 // This API will be removed in later releases.
 func (k Keeper) RegisterInterchainAccount(ctx context.Context, connectionID, owner, version string, ordering channeltypes.Order) error {
-	portID, err := icatypes.NewControllerPortID(owner)
+	portID, err := k.GetControllerPortID(ctx, owner, connectionID)
 	if err != nil {
 		return err
 	}
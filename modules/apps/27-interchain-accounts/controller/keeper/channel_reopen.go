@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// GetPendingChannelReopen retrieves the pending channel reopening of the interchain account with the given port and
+// connection identifiers from the store
+func (k Keeper) GetPendingChannelReopen(ctx context.Context, portID, connectionID string) (types.PendingChannelReopen, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PendingChannelReopenKey(portID, connectionID))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.PendingChannelReopen{}, false
+	}
+
+	var pendingReopen types.PendingChannelReopen
+	k.cdc.MustUnmarshal(bz, &pendingReopen)
+	return pendingReopen, true
+}
+
+// SetPendingChannelReopen stores the pending channel reopening keyed by its port and connection identifiers
+func (k Keeper) SetPendingChannelReopen(ctx context.Context, pendingReopen types.PendingChannelReopen) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&pendingReopen)
+	if err := store.Set(types.PendingChannelReopenKey(pendingReopen.PortId, pendingReopen.ConnectionId), bz); err != nil {
+		panic(err)
+	}
+}
+
+// DeletePendingChannelReopen removes the pending channel reopening of the interchain account with the given port and
+// connection identifiers from the store
+func (k Keeper) DeletePendingChannelReopen(ctx context.Context, portID, connectionID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.PendingChannelReopenKey(portID, connectionID)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllPendingChannelReopens returns all the pending channel reopenings
+func (k Keeper) GetAllPendingChannelReopens(ctx context.Context) []types.PendingChannelReopen {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.PendingChannelReopenKeyPrefix+"/"))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var pendingReopens []types.PendingChannelReopen
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingReopen types.PendingChannelReopen
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingReopen)
+		pendingReopens = append(pendingReopens, pendingReopen)
+	}
+
+	return pendingReopens
+}
+
+// reopenChannel initiates the opening handshake of a new channel for the interchain account whose active channel has
+// been closed, with the ordering and version of the closed channel. The handshake is initiated in a cached context,
+// whose state changes are only committed if it succeeds, so that a failure does not affect the caller. The reopening
+// is recorded as pending until the handshake completes.
+func (k Keeper) reopenChannel(ctx context.Context, portID, closedChannelID string, channel channeltypes.Channel) {
+	connectionID := channel.ConnectionHops[0]
+	pendingReopen := types.PendingChannelReopen{
+		PortId:          portID,
+		ConnectionId:    connectionID,
+		ClosedChannelId: closedChannelID,
+		Version:         channel.Version,
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	cacheCtx, writeCache := sdkCtx.CacheContext()
+	channelID, err := k.registerInterchainAccount(cacheCtx, connectionID, portID, channel.Version, channel.Ordering)
+	if err != nil {
+		k.Logger(ctx).Error("failed to reopen interchain account channel", "port-id", portID, "channel-id", closedChannelID, "error", err.Error())
+		EmitChannelReopenInitEvent(ctx, pendingReopen, err)
+		return
+	}
+
+	writeCache()
+
+	pendingReopen.ChannelId = channelID
+	k.SetPendingChannelReopen(ctx, pendingReopen)
+	EmitChannelReopenInitEvent(ctx, pendingReopen, nil)
+}
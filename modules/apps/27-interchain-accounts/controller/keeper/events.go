@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
@@ -32,3 +33,88 @@ func EmitAcknowledgementEvent(ctx context.Context, packet channeltypes.Packet, a
 		),
 	)
 }
+
+// EmitScheduledTxSentEvent emits an event signalling the sending of a scheduled transaction, including the sequence of
+// the packet sent if it succeeded or the error if it failed.
+func EmitScheduledTxSentEvent(ctx context.Context, scheduledTx types.ScheduledTx, sequence uint64, err error) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+		sdk.NewAttribute(icatypes.AttributeKeyScheduledTxID, strconv.FormatUint(scheduledTx.Id, 10)),
+		sdk.NewAttribute(icatypes.AttributeKeyOwner, scheduledTx.Owner),
+		sdk.NewAttribute(icatypes.AttributeKeyConnectionID, scheduledTx.ConnectionId),
+		sdk.NewAttribute(icatypes.AttributeKeyAckSuccess, strconv.FormatBool(err == nil)),
+	}
+
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(icatypes.AttributeKeyAckError, err.Error()))
+	} else {
+		attributes = append(attributes, sdk.NewAttribute(icatypes.AttributeKeySequence, strconv.FormatUint(sequence, 10)))
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeScheduledTxSent,
+			attributes...,
+		),
+	)
+}
+
+// EmitOwnershipTransferredEvent emits an event signalling the transfer of the ownership of an interchain account and of
+// its active channel, if any, to a new owner.
+func EmitOwnershipTransferredEvent(ctx context.Context, portID, connectionID, channelID, owner, newOwner string) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeOwnershipTransferred,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerPortID, portID),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, channelID),
+			sdk.NewAttribute(icatypes.AttributeKeyOwner, owner),
+			sdk.NewAttribute(icatypes.AttributeKeyNewOwner, newOwner),
+		),
+	)
+}
+
+// EmitChannelReopenInitEvent emits an event signalling the initiation of the reopening of the closed active channel of
+// an interchain account, including the identifier of the new channel if it succeeded or the error if it failed.
+func EmitChannelReopenInitEvent(ctx context.Context, pendingReopen types.PendingChannelReopen, err error) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+		sdk.NewAttribute(icatypes.AttributeKeyControllerPortID, pendingReopen.PortId),
+		sdk.NewAttribute(icatypes.AttributeKeyConnectionID, pendingReopen.ConnectionId),
+		sdk.NewAttribute(icatypes.AttributeKeyClosedChannelID, pendingReopen.ClosedChannelId),
+		sdk.NewAttribute(icatypes.AttributeKeyAckSuccess, strconv.FormatBool(err == nil)),
+	}
+
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(icatypes.AttributeKeyAckError, err.Error()))
+	} else {
+		attributes = append(attributes, sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, pendingReopen.ChannelId))
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeChannelReopenInit,
+			attributes...,
+		),
+	)
+}
+
+// EmitChannelReopenedEvent emits an event signalling the completion of the reopening of the closed active channel of
+// an interchain account.
+func EmitChannelReopenedEvent(ctx context.Context, pendingReopen types.PendingChannelReopen) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeChannelReopened,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerPortID, pendingReopen.PortId),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, pendingReopen.ConnectionId),
+			sdk.NewAttribute(icatypes.AttributeKeyClosedChannelID, pendingReopen.ClosedChannelId),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, pendingReopen.ChannelId),
+		),
+	)
+}
@@ -26,15 +26,41 @@ func InitGenesis(ctx context.Context, keeper Keeper, state genesistypes.Controll
 		keeper.SetInterchainAccountAddress(ctx, acc.ConnectionId, acc.PortId, acc.AccountAddress)
 	}
 
+	for _, scheduledTx := range state.ScheduledTxs {
+		keeper.SetScheduledTx(ctx, scheduledTx)
+	}
+	keeper.SetNextScheduledTxID(ctx, state.NextScheduledTxId)
+
+	for _, pendingReopen := range state.PendingChannelReopens {
+		keeper.SetPendingChannelReopen(ctx, pendingReopen)
+	}
+
+	for _, accountOwner := range state.AccountOwners {
+		keeper.SetAccountOwner(ctx, accountOwner)
+	}
+
+	for _, packetResult := range state.PacketResults {
+		keeper.SetPacketResult(ctx, packetResult)
+	}
+	keeper.SetNextPacketResultID(ctx, state.NextPacketResultId)
+
 	keeper.SetParams(ctx, state.Params)
 }
 
 // ExportGenesis returns the interchain accounts controller exported genesis
 func ExportGenesis(ctx context.Context, keeper Keeper) genesistypes.ControllerGenesisState {
-	return genesistypes.NewControllerGenesisState(
+	genesisState := genesistypes.NewControllerGenesisState(
 		keeper.GetAllActiveChannels(ctx),
 		keeper.GetAllInterchainAccounts(ctx),
 		keeper.GetAllPorts(ctx),
 		keeper.GetParams(ctx),
 	)
+	genesisState.ScheduledTxs = keeper.GetAllScheduledTxs(ctx)
+	genesisState.NextScheduledTxId = keeper.GetNextScheduledTxID(ctx)
+	genesisState.PendingChannelReopens = keeper.GetAllPendingChannelReopens(ctx)
+	genesisState.AccountOwners = keeper.GetAllAccountOwners(ctx)
+	genesisState.PacketResults = keeper.GetAllPacketResults(ctx)
+	genesisState.NextPacketResultId = keeper.GetNextPacketResultID(ctx)
+
+	return genesisState
 }
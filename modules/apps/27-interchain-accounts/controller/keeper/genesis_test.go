@@ -45,6 +45,46 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			},
 		},
 		Ports: ports,
+		ScheduledTxs: []types.ScheduledTx{
+			{
+				Id:              1,
+				Owner:           TestOwnerAddress,
+				ConnectionId:    ibctesting.FirstConnectionID,
+				PacketData:      icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data")},
+				RelativeTimeout: 100,
+				Schedule:        types.NewHeightSchedule(10, 5, 0),
+			},
+		},
+		NextScheduledTxId: 2,
+		PendingChannelReopens: []types.PendingChannelReopen{
+			{
+				PortId:          TestPortID,
+				ConnectionId:    ibctesting.FirstConnectionID,
+				ClosedChannelId: ibctesting.FirstChannelID,
+				ChannelId:       "channel-1",
+				Version:         TestVersion,
+			},
+		},
+		AccountOwners: []types.AccountOwner{
+			{
+				PortId:       TestPortID,
+				ConnectionId: ibctesting.FirstConnectionID,
+				Owner:        "new-owner",
+			},
+		},
+		PacketResults: []types.PacketResult{
+			{
+				Id:           4,
+				Owner:        TestOwnerAddress,
+				ConnectionId: ibctesting.FirstConnectionID,
+				ChannelId:    ibctesting.FirstChannelID,
+				Sequence:     1,
+				Status:       types.STATUS_SUCCESS,
+				Result:       []byte("result"),
+				Height:       10,
+			},
+		},
+		NextPacketResultId: 5,
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -78,6 +118,18 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
 				suite.Require().True(store.Has(icatypes.KeyPort(port)))
 			}
+
+			suite.Require().Equal(genesisState.ScheduledTxs, suite.chainA.GetSimApp().ICAControllerKeeper.GetAllScheduledTxs(suite.chainA.GetContext()))
+			suite.Require().Equal(genesisState.NextScheduledTxId, suite.chainA.GetSimApp().ICAControllerKeeper.GetNextScheduledTxID(suite.chainA.GetContext()))
+			suite.Require().Equal(genesisState.PendingChannelReopens, suite.chainA.GetSimApp().ICAControllerKeeper.GetAllPendingChannelReopens(suite.chainA.GetContext()))
+			suite.Require().Equal(genesisState.AccountOwners, suite.chainA.GetSimApp().ICAControllerKeeper.GetAllAccountOwners(suite.chainA.GetContext()))
+
+			suite.Require().Equal(genesisState.PacketResults, suite.chainA.GetSimApp().ICAControllerKeeper.GetAllPacketResults(suite.chainA.GetContext()))
+			suite.Require().Equal(genesisState.NextPacketResultId, suite.chainA.GetSimApp().ICAControllerKeeper.GetNextPacketResultID(suite.chainA.GetContext()))
+
+			portID, err := suite.chainA.GetSimApp().ICAControllerKeeper.GetControllerPortID(suite.chainA.GetContext(), "new-owner", ibctesting.FirstConnectionID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(TestPortID, portID)
 		})
 	}
 }
@@ -95,6 +147,45 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 		interchainAccAddr, exists := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
 		suite.Require().True(exists)
 
+		scheduledTx := types.ScheduledTx{
+			Id:              3,
+			Owner:           TestOwnerAddress,
+			ConnectionId:    path.EndpointA.ConnectionID,
+			PacketData:      icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data")},
+			RelativeTimeout: 100,
+			Schedule:        types.NewTimeSchedule(10, 0, 0),
+		}
+		suite.chainA.GetSimApp().ICAControllerKeeper.SetScheduledTx(suite.chainA.GetContext(), scheduledTx)
+		suite.chainA.GetSimApp().ICAControllerKeeper.SetNextScheduledTxID(suite.chainA.GetContext(), 4)
+
+		pendingReopen := types.PendingChannelReopen{
+			PortId:          TestPortID,
+			ConnectionId:    path.EndpointA.ConnectionID,
+			ClosedChannelId: path.EndpointA.ChannelID,
+			ChannelId:       "channel-1",
+			Version:         path.EndpointA.ChannelConfig.Version,
+		}
+		suite.chainA.GetSimApp().ICAControllerKeeper.SetPendingChannelReopen(suite.chainA.GetContext(), pendingReopen)
+
+		accountOwner := types.AccountOwner{
+			PortId:       TestPortID,
+			ConnectionId: path.EndpointA.ConnectionID,
+			Owner:        suite.chainA.SenderAccount.GetAddress().String(),
+		}
+		suite.chainA.GetSimApp().ICAControllerKeeper.SetAccountOwner(suite.chainA.GetContext(), accountOwner)
+
+		packetResult := types.PacketResult{
+			Id:           2,
+			Owner:        TestOwnerAddress,
+			ConnectionId: path.EndpointA.ConnectionID,
+			ChannelId:    path.EndpointA.ChannelID,
+			Sequence:     1,
+			Status:       types.STATUS_TIMEOUT,
+			Height:       10,
+		}
+		suite.chainA.GetSimApp().ICAControllerKeeper.SetPacketResult(suite.chainA.GetContext(), packetResult)
+		suite.chainA.GetSimApp().ICAControllerKeeper.SetNextPacketResultID(suite.chainA.GetContext(), 3)
+
 		genesisState := keeper.ExportGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAControllerKeeper)
 
 		suite.Require().Equal(path.EndpointA.ChannelID, genesisState.ActiveChannels[0].ChannelId)
@@ -108,5 +199,12 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 
 		expParams := types.DefaultParams()
 		suite.Require().Equal(expParams, genesisState.GetParams())
+
+		suite.Require().Equal([]types.ScheduledTx{scheduledTx}, genesisState.ScheduledTxs)
+		suite.Require().Equal(uint64(4), genesisState.NextScheduledTxId)
+		suite.Require().Equal([]types.PendingChannelReopen{pendingReopen}, genesisState.PendingChannelReopens)
+		suite.Require().Equal([]types.AccountOwner{accountOwner}, genesisState.AccountOwners)
+		suite.Require().Equal([]types.PacketResult{packetResult}, genesisState.PacketResults)
+		suite.Require().Equal(uint64(3), genesisState.NextPacketResultId)
 	}
 }
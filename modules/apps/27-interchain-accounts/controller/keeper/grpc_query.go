@@ -2,14 +2,19 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
-	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
@@ -22,7 +27,7 @@ func (k Keeper) InterchainAccount(goCtx context.Context, req *types.QueryInterch
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	portID, err := icatypes.NewControllerPortID(req.Owner)
+	portID, err := k.GetControllerPortID(ctx, req.Owner, req.ConnectionId)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "failed to generate portID from owner address: %s", err)
 	}
@@ -46,3 +51,190 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// ScheduledTx implements the Query/ScheduledTx gRPC method
+func (k Keeper) ScheduledTx(goCtx context.Context, req *types.QueryScheduledTxRequest) (*types.QueryScheduledTxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	scheduledTx, found := k.GetScheduledTx(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrapf(types.ErrScheduledTxNotFound, "scheduled transaction with ID %d", req.Id).Error())
+	}
+
+	return &types.QueryScheduledTxResponse{
+		ScheduledTx: scheduledTx,
+	}, nil
+}
+
+// ScheduledTxs implements the Query/ScheduledTxs gRPC method
+func (k Keeper) ScheduledTxs(goCtx context.Context, req *types.QueryScheduledTxsRequest) (*types.QueryScheduledTxsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	kvStore := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	var (
+		scheduledTxs []types.ScheduledTx
+		pageRes      *query.PageResponse
+		err          error
+	)
+	if req.Owner == "" {
+		store := prefix.NewStore(kvStore, []byte(types.ScheduledTxKeyPrefix+"/"))
+		pageRes, err = query.Paginate(store, req.Pagination, func(_, value []byte) error {
+			var scheduledTx types.ScheduledTx
+			if err := k.cdc.Unmarshal(value, &scheduledTx); err != nil {
+				return err
+			}
+
+			scheduledTxs = append(scheduledTxs, scheduledTx)
+			return nil
+		})
+	} else {
+		store := prefix.NewStore(kvStore, types.ScheduledTxOwnerPrefix(req.Owner))
+		pageRes, err = query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+			scheduledTx, found := k.GetScheduledTx(ctx, sdk.BigEndianToUint64(key))
+			if !found {
+				return errorsmod.Wrapf(types.ErrScheduledTxNotFound, "scheduled transaction with ID %d", sdk.BigEndianToUint64(key))
+			}
+
+			scheduledTxs = append(scheduledTxs, scheduledTx)
+			return nil
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryScheduledTxsResponse{
+		ScheduledTxs: scheduledTxs,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// PendingChannelReopen implements the Query/PendingChannelReopen gRPC method
+func (k Keeper) PendingChannelReopen(goCtx context.Context, req *types.QueryPendingChannelReopenRequest) (*types.QueryPendingChannelReopenResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := k.GetControllerPortID(ctx, req.Owner, req.ConnectionId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to generate portID from owner address: %s", err)
+	}
+
+	pendingReopen, found := k.GetPendingChannelReopen(ctx, portID, req.ConnectionId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "failed to retrieve pending channel reopening for %s on connection %s", portID, req.ConnectionId)
+	}
+
+	return &types.QueryPendingChannelReopenResponse{
+		PendingChannelReopen: pendingReopen,
+	}, nil
+}
+
+// PendingChannelReopens implements the Query/PendingChannelReopens gRPC method
+func (k Keeper) PendingChannelReopens(goCtx context.Context, req *types.QueryPendingChannelReopensRequest) (*types.QueryPendingChannelReopensResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), []byte(types.PendingChannelReopenKeyPrefix+"/"))
+
+	var pendingReopens []types.PendingChannelReopen
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var pendingReopen types.PendingChannelReopen
+		if err := k.cdc.Unmarshal(value, &pendingReopen); err != nil {
+			return err
+		}
+
+		pendingReopens = append(pendingReopens, pendingReopen)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingChannelReopensResponse{
+		PendingChannelReopens: pendingReopens,
+		Pagination:            pageRes,
+	}, nil
+}
+
+// PacketResult implements the Query/PacketResult gRPC method
+func (k Keeper) PacketResult(goCtx context.Context, req *types.QueryPacketResultRequest) (*types.QueryPacketResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	channelID := req.ChannelId
+	if channelID == "" {
+		portID, err := k.GetControllerPortID(ctx, req.Owner, req.ConnectionId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to generate portID from owner address: %s", err)
+		}
+
+		activeChannelID, found := k.GetActiveChannelID(ctx, req.ConnectionId, portID)
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "failed to retrieve active channel for %s on connection %s", portID, req.ConnectionId)
+		}
+		channelID = activeChannelID
+	}
+
+	packetResult, found := k.GetPacketResult(ctx, req.Owner, req.ConnectionId, channelID, req.Sequence)
+	if !found {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrapf(types.ErrPacketResultNotFound, "packet with sequence %d sent by %s on channel %s of connection %s", req.Sequence, req.Owner, channelID, req.ConnectionId).Error())
+	}
+
+	return &types.QueryPacketResultResponse{
+		PacketResult: packetResult,
+	}, nil
+}
+
+// PacketResults implements the Query/PacketResults gRPC method
+func (k Keeper) PacketResults(goCtx context.Context, req *types.QueryPacketResultsRequest) (*types.QueryPacketResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.Owner) == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	keyPrefix := types.PacketResultOwnerPrefix(req.Owner)
+	if req.ConnectionId != "" {
+		keyPrefix = types.PacketResultConnectionPrefix(req.Owner, req.ConnectionId)
+	}
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+
+	var packetResults []types.PacketResult
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var packetResult types.PacketResult
+		if err := k.cdc.Unmarshal(value, &packetResult); err != nil {
+			return err
+		}
+
+		packetResults = append(packetResults, packetResult)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPacketResultsResponse{
+		PacketResults: packetResults,
+		Pagination:    pageRes,
+	}, nil
+}
@@ -1,7 +1,16 @@
 package keeper_test
 
 import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
 	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 )
@@ -84,3 +93,512 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.chainA.GetSimApp().ICAControllerKeeper.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryScheduledTx() {
+	var req *types.QueryScheduledTxRequest
+
+	scheduledTx := types.ScheduledTx{
+		Id:              1,
+		Owner:           TestOwnerAddress,
+		ConnectionId:    ibctesting.FirstConnectionID,
+		PacketData:      icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data")},
+		RelativeTimeout: 100,
+		Schedule:        types.NewHeightSchedule(10, 0, 0),
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"failure: scheduled transaction not found",
+			func() {
+				req.Id = 2
+			},
+			status.Error(codes.NotFound, errorsmod.Wrapf(types.ErrScheduledTxNotFound, "scheduled transaction with ID 2").Error()),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetScheduledTx(suite.chainA.GetContext(), scheduledTx)
+
+			req = &types.QueryScheduledTxRequest{Id: scheduledTx.Id}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.ScheduledTx(suite.chainA.GetContext(), req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(scheduledTx, res.ScheduledTx)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryScheduledTxs() {
+	var (
+		req            *types.QueryScheduledTxsRequest
+		expScheduledTx []types.ScheduledTx
+	)
+
+	otherOwner := suite.chainA.SenderAccount.GetAddress().String()
+	newScheduledTx := func(id uint64, owner string) types.ScheduledTx {
+		return types.ScheduledTx{
+			Id:              id,
+			Owner:           owner,
+			ConnectionId:    ibctesting.FirstConnectionID,
+			PacketData:      icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data")},
+			RelativeTimeout: 100,
+			Schedule:        types.NewHeightSchedule(10+id, 0, 0),
+		}
+	}
+	scheduledTxs := []types.ScheduledTx{newScheduledTx(1, TestOwnerAddress), newScheduledTx(2, otherOwner), newScheduledTx(3, TestOwnerAddress)}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: all scheduled transactions",
+			func() {
+				expScheduledTx = scheduledTxs
+			},
+			nil,
+		},
+		{
+			"success: scheduled transactions of owner",
+			func() {
+				req.Owner = TestOwnerAddress
+				expScheduledTx = []types.ScheduledTx{scheduledTxs[0], scheduledTxs[2]}
+			},
+			nil,
+		},
+		{
+			"success: scheduled transactions of owner with pagination",
+			func() {
+				req.Owner = TestOwnerAddress
+				req.Pagination = &query.PageRequest{Limit: 1}
+				expScheduledTx = []types.ScheduledTx{scheduledTxs[0]}
+			},
+			nil,
+		},
+		{
+			"success: no scheduled transactions of owner",
+			func() {
+				req.Owner = ibctesting.InvalidID
+				expScheduledTx = nil
+			},
+			nil,
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			for _, scheduledTx := range scheduledTxs {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetScheduledTx(suite.chainA.GetContext(), scheduledTx)
+			}
+
+			req = &types.QueryScheduledTxsRequest{}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.ScheduledTxs(suite.chainA.GetContext(), req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expScheduledTx, res.ScheduledTxs)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPendingChannelReopen() {
+	var (
+		req              *types.QueryPendingChannelReopenRequest
+		expPendingReopen types.PendingChannelReopen
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"failure: empty owner",
+			func() {
+				req.Owner = ""
+			},
+			status.Error(codes.InvalidArgument, "failed to generate portID from owner address: owner address cannot be empty: invalid account address"),
+		},
+		{
+			"failure: pending channel reopen not found",
+			func() {
+				req.ConnectionId = ibctesting.InvalidID
+			},
+			status.Error(codes.NotFound, "failed to retrieve pending channel reopening for icacontroller-cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs on connection IDisInvalid"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			expPendingReopen = types.PendingChannelReopen{
+				PortId:          TestPortID,
+				ConnectionId:    ibctesting.FirstConnectionID,
+				ClosedChannelId: ibctesting.FirstChannelID,
+				ChannelId:       "channel-1",
+				Version:         TestVersion,
+			}
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetPendingChannelReopen(suite.chainA.GetContext(), expPendingReopen)
+
+			req = &types.QueryPendingChannelReopenRequest{
+				Owner:        TestOwnerAddress,
+				ConnectionId: ibctesting.FirstConnectionID,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.PendingChannelReopen(suite.chainA.GetContext(), req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPendingReopen, res.PendingChannelReopen)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPendingChannelReopens() {
+	var (
+		req               *types.QueryPendingChannelReopensRequest
+		expPendingReopens []types.PendingChannelReopen
+	)
+
+	pendingReopens := []types.PendingChannelReopen{
+		{
+			PortId:          TestPortID,
+			ConnectionId:    ibctesting.FirstConnectionID,
+			ClosedChannelId: "channel-0",
+			ChannelId:       "channel-2",
+			Version:         TestVersion,
+		},
+		{
+			PortId:          TestPortID,
+			ConnectionId:    "connection-1",
+			ClosedChannelId: "channel-1",
+			ChannelId:       "channel-3",
+			Version:         TestVersion,
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {
+				expPendingReopens = pendingReopens
+			},
+			nil,
+		},
+		{
+			"success with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{Limit: 1}
+				expPendingReopens = pendingReopens[:1]
+			},
+			nil,
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			for _, pendingReopen := range pendingReopens {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetPendingChannelReopen(suite.chainA.GetContext(), pendingReopen)
+			}
+
+			req = &types.QueryPendingChannelReopensRequest{}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.PendingChannelReopens(suite.chainA.GetContext(), req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPendingReopens, res.PendingChannelReopens)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPacketResult() {
+	var (
+		req             *types.QueryPacketResultRequest
+		expPacketResult types.PacketResult
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: packet result on a given channel",
+			func() {
+				expPacketResult.ChannelId = "channel-1"
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetPacketResult(suite.chainA.GetContext(), expPacketResult)
+
+				req.ChannelId = "channel-1"
+			},
+			nil,
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"failure: empty owner",
+			func() {
+				req.Owner = ""
+			},
+			status.Error(codes.InvalidArgument, "failed to generate portID from owner address: owner address cannot be empty: invalid account address"),
+		},
+		{
+			"failure: active channel not found",
+			func() {
+				req.ConnectionId = ibctesting.InvalidID
+			},
+			status.Error(codes.NotFound, "failed to retrieve active channel for icacontroller-cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs on connection IDisInvalid"),
+		},
+		{
+			"failure: packet result not found",
+			func() {
+				req.Sequence = 2
+			},
+			status.Error(codes.NotFound, "packet with sequence 2 sent by cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs on channel channel-0 of connection connection-0: packet result not found"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID, ibctesting.FirstChannelID)
+
+			expPacketResult = types.PacketResult{
+				Id:           0,
+				Owner:        TestOwnerAddress,
+				ConnectionId: ibctesting.FirstConnectionID,
+				ChannelId:    ibctesting.FirstChannelID,
+				Sequence:     1,
+				Status:       types.STATUS_TIMEOUT,
+				Height:       1,
+			}
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetPacketResult(suite.chainA.GetContext(), expPacketResult)
+
+			req = &types.QueryPacketResultRequest{
+				Owner:        TestOwnerAddress,
+				ConnectionId: ibctesting.FirstConnectionID,
+				Sequence:     1,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.PacketResult(suite.chainA.GetContext(), req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPacketResult, res.PacketResult)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPacketResults() {
+	var (
+		req              *types.QueryPacketResultsRequest
+		expPacketResults []types.PacketResult
+	)
+
+	packetResults := []types.PacketResult{
+		{
+			Id:           0,
+			Owner:        TestOwnerAddress,
+			ConnectionId: ibctesting.FirstConnectionID,
+			ChannelId:    ibctesting.FirstChannelID,
+			Sequence:     1,
+			Status:       types.STATUS_SUCCESS,
+			Result:       []byte("result"),
+			Height:       1,
+		},
+		{
+			Id:           1,
+			Owner:        TestOwnerAddress,
+			ConnectionId: "connection-1",
+			ChannelId:    "channel-1",
+			Sequence:     1,
+			Status:       types.STATUS_ERROR,
+			Error:        "error",
+			Height:       2,
+		},
+		{
+			Id:           2,
+			Owner:        sdk.AccAddress("other-owner").String(),
+			ConnectionId: ibctesting.FirstConnectionID,
+			ChannelId:    "channel-2",
+			Sequence:     1,
+			Status:       types.STATUS_TIMEOUT,
+			Height:       3,
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {
+				expPacketResults = packetResults[:2]
+			},
+			nil,
+		},
+		{
+			"success: packet results on a connection",
+			func() {
+				req.ConnectionId = "connection-1"
+				expPacketResults = packetResults[1:2]
+			},
+			nil,
+		},
+		{
+			"success with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{Limit: 1}
+				expPacketResults = packetResults[:1]
+			},
+			nil,
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"failure: empty owner",
+			func() {
+				req.Owner = ""
+			},
+			status.Error(codes.InvalidArgument, "owner address cannot be empty"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			for _, packetResult := range packetResults {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetPacketResult(suite.chainA.GetContext(), packetResult)
+			}
+
+			req = &types.QueryPacketResultsRequest{
+				Owner: TestOwnerAddress,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.PacketResults(suite.chainA.GetContext(), req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPacketResults, res.PacketResults)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
@@ -51,7 +51,7 @@ func (k Keeper) OnChanOpenInit(
 		}
 	}
 
-	if err := icatypes.ValidateControllerMetadata(ctx, k.channelKeeper, connectionHops, metadata); err != nil {
+	if err := icatypes.ValidateControllerMetadata(ctx, k.channelKeeper, k.encodingRegistry, connectionHops, metadata); err != nil {
 		return "", err
 	}
 
@@ -112,7 +112,7 @@ func (k Keeper) OnChanOpenAck(
 		return errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID)
 	}
 
-	if err := icatypes.ValidateControllerMetadata(ctx, k.channelKeeper, channel.ConnectionHops, metadata); err != nil {
+	if err := icatypes.ValidateControllerMetadata(ctx, k.channelKeeper, k.encodingRegistry, channel.ConnectionHops, metadata); err != nil {
 		return err
 	}
 
@@ -123,6 +123,14 @@ func (k Keeper) OnChanOpenAck(
 	k.SetActiveChannelID(ctx, metadata.ControllerConnectionId, portID, channelID)
 	k.SetInterchainAccountAddress(ctx, metadata.ControllerConnectionId, portID, metadata.Address)
 
+	// any channel opening completes a pending reopening, whether it was initiated automatically or not
+	if pendingReopen, found := k.GetPendingChannelReopen(ctx, portID, metadata.ControllerConnectionId); found {
+		k.DeletePendingChannelReopen(ctx, portID, metadata.ControllerConnectionId)
+		if pendingReopen.ChannelId == channelID {
+			EmitChannelReopenedEvent(ctx, pendingReopen)
+		}
+	}
+
 	return nil
 }
 
@@ -177,7 +185,7 @@ func (k Keeper) OnChanUpgradeInit(ctx context.Context, portID, channelID string,
 
 	// ValidateControllerMetadata will ensure the ICS27 protocol version has not changed and that the
 	// tx type and encoding are supported
-	if err := icatypes.ValidateControllerMetadata(ctx, k.channelKeeper, proposedConnectionHops, proposedMetadata); err != nil {
+	if err := icatypes.ValidateControllerMetadata(ctx, k.channelKeeper, k.encodingRegistry, proposedConnectionHops, proposedMetadata); err != nil {
 		return "", errorsmod.Wrap(err, "invalid upgrade metadata")
 	}
 
@@ -235,7 +243,7 @@ func (k Keeper) OnChanUpgradeAck(ctx context.Context, portID, channelID, counter
 	// ValidateControllerMetadata will ensure the ICS27 protocol version has not changed and that the
 	// tx type and encoding are supported. Note, we pass in the current channel connection hops. The upgrade init
 	// step will verify that the proposed connection hops will not change.
-	if err := icatypes.ValidateControllerMetadata(ctx, k.channelKeeper, channel.ConnectionHops, proposedMetadata); err != nil {
+	if err := icatypes.ValidateControllerMetadata(ctx, k.channelKeeper, k.encodingRegistry, channel.ConnectionHops, proposedMetadata); err != nil {
 		return errorsmod.Wrap(err, "invalid upgrade metadata")
 	}
 
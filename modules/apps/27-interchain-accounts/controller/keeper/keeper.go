@@ -35,6 +35,9 @@ type Keeper struct {
 
 	msgRouter icatypes.MessageRouter
 
+	// encodingRegistry holds the encoding formats which may be negotiated in the channel version metadata
+	encodingRegistry icatypes.EncodingRegistry
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -51,13 +54,14 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		storeService:   storeService,
-		cdc:            cdc,
-		legacySubspace: legacySubspace,
-		ics4Wrapper:    ics4Wrapper,
-		channelKeeper:  channelKeeper,
-		msgRouter:      msgRouter,
-		authority:      authority,
+		storeService:     storeService,
+		cdc:              cdc,
+		legacySubspace:   legacySubspace,
+		ics4Wrapper:      ics4Wrapper,
+		channelKeeper:    channelKeeper,
+		msgRouter:        msgRouter,
+		encodingRegistry: icatypes.NewEncodingRegistry(),
+		authority:        authority,
 	}
 }
 
@@ -73,6 +77,19 @@ func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
 	return k.ics4Wrapper
 }
 
+// RegisterEncoding registers an encoding format which may be negotiated in the channel version metadata, in addition
+// to the protobuf and proto3 JSON encoding formats. The encoding format is shared with every copy of the keeper. It
+// panics if the encoding format is already registered.
+func (k Keeper) RegisterEncoding(encoding string, encoder icatypes.Encoder) {
+	k.encodingRegistry.RegisterEncoding(encoding, encoder)
+}
+
+// GetEncodingRegistry returns the registry of the encoding formats which may be negotiated in the channel version
+// metadata.
+func (k Keeper) GetEncodingRegistry() icatypes.EncodingRegistry {
+	return k.encodingRegistry
+}
+
 // Logger returns the application logger, scoped to the associated module
 func (Keeper) Logger(ctx context.Context) log.Logger {
 	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
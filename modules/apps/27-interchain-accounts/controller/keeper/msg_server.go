@@ -29,7 +29,7 @@ func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
 func (s msgServer) RegisterInterchainAccount(goCtx context.Context, msg *types.MsgRegisterInterchainAccount) (*types.MsgRegisterInterchainAccountResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	portID, err := s.GetControllerPortID(ctx, msg.Owner, msg.ConnectionId)
 	if err != nil {
 		return nil, err
 	}
@@ -64,15 +64,12 @@ func (s msgServer) RegisterInterchainAccount(goCtx context.Context, msg *types.M
 func (s msgServer) SendTx(goCtx context.Context, msg *types.MsgSendTx) (*types.MsgSendTxResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	portID, err := s.GetControllerPortID(ctx, msg.Owner, msg.ConnectionId)
 	if err != nil {
 		return nil, err
 	}
 
-	// the absolute timeout value is calculated using the controller chain block time + the relative timeout value
-	// this assumes time synchrony to a certain degree between the controller and counterparty host chain
-	absoluteTimeout := uint64(ctx.BlockTime().UnixNano()) + msg.RelativeTimeout
-	seq, err := s.sendTx(ctx, msg.ConnectionId, portID, msg.PacketData, absoluteTimeout)
+	seq, err := s.sendTxWithRelativeTimeout(ctx, msg.ConnectionId, portID, msg.PacketData, msg.RelativeTimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +77,83 @@ func (s msgServer) SendTx(goCtx context.Context, msg *types.MsgSendTx) (*types.M
 	return &types.MsgSendTxResponse{Sequence: seq}, nil
 }
 
+// ScheduleTx defines a rpc handler for MsgScheduleTx
+func (s msgServer) ScheduleTx(goCtx context.Context, msg *types.MsgScheduleTx) (*types.MsgScheduleTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !s.GetParams(ctx).ControllerEnabled {
+		return nil, types.ErrControllerSubModuleDisabled
+	}
+
+	portID, err := s.GetControllerPortID(ctx, msg.Owner, msg.ConnectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.IsActiveChannel(ctx, msg.ConnectionId, portID) {
+		return nil, errorsmod.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", msg.ConnectionId, portID)
+	}
+
+	if msg.Schedule.Height != 0 && msg.Schedule.Height <= uint64(ctx.BlockHeight()) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSchedule, "height (%d) must be greater than the current block height (%d)", msg.Schedule.Height, ctx.BlockHeight())
+	}
+
+	if msg.Schedule.Timestamp != 0 && msg.Schedule.Timestamp <= uint64(ctx.BlockTime().UnixNano()) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSchedule, "timestamp (%d) must be greater than the current block time (%d)", msg.Schedule.Timestamp, ctx.BlockTime().UnixNano())
+	}
+
+	if s.getScheduledTxCount(ctx, msg.Owner) >= types.MaxScheduledTxsPerOwner {
+		return nil, errorsmod.Wrapf(types.ErrMaxScheduledTxs, "owner %s cannot have more than %d scheduled transactions", msg.Owner, types.MaxScheduledTxsPerOwner)
+	}
+
+	id := s.GetNextScheduledTxID(ctx)
+	s.SetScheduledTx(ctx, types.NewScheduledTx(id, msg))
+	s.SetNextScheduledTxID(ctx, id+1)
+
+	s.Logger(ctx).Info("successfully scheduled interchain account transaction", "id", id, "owner", msg.Owner)
+
+	return &types.MsgScheduleTxResponse{Id: id}, nil
+}
+
+// CancelScheduledTx defines a rpc handler for MsgCancelScheduledTx
+func (s msgServer) CancelScheduledTx(goCtx context.Context, msg *types.MsgCancelScheduledTx) (*types.MsgCancelScheduledTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	scheduledTx, found := s.GetScheduledTx(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrScheduledTxNotFound, "scheduled transaction with ID %d", msg.Id)
+	}
+
+	if scheduledTx.Owner != msg.Owner {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected owner %s, got %s", scheduledTx.Owner, msg.Owner)
+	}
+
+	s.DeleteScheduledTx(ctx, scheduledTx)
+
+	return &types.MsgCancelScheduledTxResponse{}, nil
+}
+
+// TransferOwnership defines a rpc handler for MsgTransferOwnership
+func (s msgServer) TransferOwnership(goCtx context.Context, msg *types.MsgTransferOwnership) (*types.MsgTransferOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !s.GetParams(ctx).ControllerEnabled {
+		return nil, types.ErrControllerSubModuleDisabled
+	}
+
+	portID, err := s.transferOwnership(ctx, msg.Owner, msg.ConnectionId, msg.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	channelID, _ := s.GetActiveChannelID(ctx, msg.ConnectionId, portID)
+	EmitOwnershipTransferredEvent(ctx, portID, msg.ConnectionId, channelID, msg.Owner, msg.NewOwner)
+
+	s.Logger(ctx).Info("successfully transferred interchain account ownership", "port-id", portID, "owner", msg.Owner, "new-owner", msg.NewOwner)
+
+	return &types.MsgTransferOwnershipResponse{PortId: portID}, nil
+}
+
 // UpdateParams defines an rpc handler method for MsgUpdateParams. Updates the ica/controller submodule's parameters.
 func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != msg.Signer {
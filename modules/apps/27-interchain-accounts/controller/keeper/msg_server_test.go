@@ -5,6 +5,8 @@ import (
 
 	"github.com/cosmos/gogoproto/proto"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -205,6 +207,326 @@ func (suite *KeeperTestSuite) TestSubmitTx() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestScheduleTx() {
+	var msg *types.MsgScheduleTx
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: height schedule", func() {},
+			nil,
+		},
+		{
+			"success: time schedule", func() {
+				msg.Schedule = types.NewTimeSchedule(uint64(suite.chainA.GetContext().BlockTime().Add(time.Hour).UnixNano()), uint64(time.Hour), 0)
+			},
+			nil,
+		},
+		{
+			"failure: controller submodule disabled", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+			},
+			types.ErrControllerSubModuleDisabled,
+		},
+		{
+			"failure: active channel does not exist for connection ID", func() {
+				msg.ConnectionId = "connection-100"
+			},
+			icatypes.ErrActiveChannelNotFound,
+		},
+		{
+			"failure: height is not in the future", func() {
+				msg.Schedule.Height = uint64(suite.chainA.GetContext().BlockHeight())
+			},
+			types.ErrInvalidSchedule,
+		},
+		{
+			"failure: timestamp is not in the future", func() {
+				msg.Schedule = types.NewTimeSchedule(uint64(suite.chainA.GetContext().BlockTime().UnixNano()), 0, 0)
+			},
+			types.ErrInvalidSchedule,
+		},
+		{
+			"failure: maximum number of scheduled transactions reached", func() {
+				for i := uint64(0); i < types.MaxScheduledTxsPerOwner; i++ {
+					scheduledTx := types.NewScheduledTx(100+i, msg)
+					suite.chainA.GetSimApp().ICAControllerKeeper.SetScheduledTx(suite.chainA.GetContext(), scheduledTx)
+				}
+			},
+			types.ErrMaxScheduledTxs,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB, channeltypes.ORDERED)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: []byte("data"),
+			}
+			schedule := types.NewHeightSchedule(uint64(suite.chainA.GetContext().BlockHeight())+10, 0, 0)
+			msg = types.NewMsgScheduleTx(TestOwnerAddress, path.EndpointA.ConnectionID, uint64(time.Minute), packetData, schedule)
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.ScheduleTx(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				scheduledTx, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetScheduledTx(ctx, res.Id)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewScheduledTx(res.Id, msg), scheduledTx)
+				suite.Require().Equal(res.Id+1, suite.chainA.GetSimApp().ICAControllerKeeper.GetNextScheduledTxID(ctx))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCancelScheduledTx() {
+	var msg *types.MsgCancelScheduledTx
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {},
+			nil,
+		},
+		{
+			"failure: scheduled transaction not found", func() {
+				msg.Id = 100
+			},
+			types.ErrScheduledTxNotFound,
+		},
+		{
+			"failure: sender is not the owner", func() {
+				msg.Owner = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB, channeltypes.ORDERED)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: []byte("data"),
+			}
+			schedule := types.NewHeightSchedule(uint64(suite.chainA.GetContext().BlockHeight())+10, 0, 0)
+			scheduleMsg := types.NewMsgScheduleTx(TestOwnerAddress, path.EndpointA.ConnectionID, uint64(time.Minute), packetData, schedule)
+
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			scheduleRes, err := msgServer.ScheduleTx(suite.chainA.GetContext(), scheduleMsg)
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgCancelScheduledTx(TestOwnerAddress, scheduleRes.Id)
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			res, err := msgServer.CancelScheduledTx(ctx, msg)
+
+			_, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetScheduledTx(ctx, scheduleRes.Id)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().False(found)
+				suite.Require().Empty(suite.chainA.GetSimApp().ICAControllerKeeper.GetAllScheduledTxs(ctx))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+				suite.Require().True(found)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTransferOwnership() {
+	var (
+		path     *ibctesting.Path
+		msg      *types.MsgTransferOwnership
+		newOwner string
+	)
+
+	intermediateOwner := sdk.AccAddress("intermediate-owner").String()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {},
+			nil,
+		},
+		{
+			"success: new owner is the owner encoded in the controller port", func() {
+				msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+				_, err := msgServer.TransferOwnership(suite.chainA.GetContext(), types.NewMsgTransferOwnership(TestOwnerAddress, path.EndpointA.ConnectionID, intermediateOwner))
+				suite.Require().NoError(err)
+
+				msg.Owner = intermediateOwner
+				msg.NewOwner = TestOwnerAddress
+			},
+			nil,
+		},
+		{
+			"failure: controller submodule disabled", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+			},
+			types.ErrControllerSubModuleDisabled,
+		},
+		{
+			"failure: interchain account not found", func() {
+				msg.ConnectionId = "connection-100"
+			},
+			icatypes.ErrInterchainAccountNotFound,
+		},
+		{
+			"failure: ownership already transferred away", func() {
+				msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+				_, err := msgServer.TransferOwnership(suite.chainA.GetContext(), types.NewMsgTransferOwnership(TestOwnerAddress, path.EndpointA.ConnectionID, intermediateOwner))
+				suite.Require().NoError(err)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: interchain account authenticated by an underlying application", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetMiddlewareEnabled(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID)
+			},
+			types.ErrInvalidOwnershipTransfer,
+		},
+		{
+			"failure: new owner already registered an interchain account", func() {
+				newOwnerPortID, err := icatypes.NewControllerPortID(newOwner)
+				suite.Require().NoError(err)
+
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetMiddlewareDisabled(suite.chainA.GetContext(), newOwnerPortID, path.EndpointA.ConnectionID)
+			},
+			types.ErrInvalidOwnershipTransfer,
+		},
+		{
+			"failure: new owner already owns a transferred interchain account", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetAccountOwner(suite.chainA.GetContext(), types.AccountOwner{
+					PortId:       icatypes.ControllerPortPrefix + "other",
+					ConnectionId: path.EndpointA.ConnectionID,
+					Owner:        newOwner,
+				})
+			},
+			types.ErrInvalidOwnershipTransfer,
+		},
+	}
+
+	for _, ordering := range []channeltypes.Order{channeltypes.UNORDERED, channeltypes.ORDERED} {
+		for _, tc := range testCases {
+			tc := tc
+
+			suite.Run(tc.name, func() {
+				suite.SetupTest()
+
+				path = NewICAPath(suite.chainA, suite.chainB, ordering)
+				path.SetupConnections()
+
+				err := SetupICAPath(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				// interchain accounts registered with MsgRegisterInterchainAccount are not authenticated by an underlying application
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetMiddlewareDisabled(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID)
+
+				packetData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: []byte("data"),
+				}
+				schedule := types.NewHeightSchedule(uint64(suite.chainA.GetContext().BlockHeight())+10, 0, 0)
+				scheduleMsg := types.NewMsgScheduleTx(TestOwnerAddress, path.EndpointA.ConnectionID, uint64(time.Minute), packetData, schedule)
+
+				msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+				_, err = msgServer.ScheduleTx(suite.chainA.GetContext(), scheduleMsg)
+				suite.Require().NoError(err)
+
+				newOwner = suite.chainA.SenderAccount.GetAddress().String()
+				msg = types.NewMsgTransferOwnership(TestOwnerAddress, path.EndpointA.ConnectionID, newOwner)
+
+				tc.malleate() // malleate mutates test data
+
+				ctx := suite.chainA.GetContext()
+				res, err := msgServer.TransferOwnership(ctx, msg)
+
+				if tc.expErr == nil {
+					suite.Require().NoError(err)
+					suite.Require().NotNil(res)
+					suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, res.PortId)
+
+					portID, err := suite.chainA.GetSimApp().ICAControllerKeeper.GetControllerPortID(ctx, msg.NewOwner, path.EndpointA.ConnectionID)
+					suite.Require().NoError(err)
+					suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, portID)
+
+					_, err = suite.chainA.GetSimApp().ICAControllerKeeper.GetControllerPortID(ctx, msg.Owner, path.EndpointA.ConnectionID)
+					if msg.NewOwner == TestOwnerAddress {
+						suite.Require().NoError(err)
+						suite.Require().Empty(suite.chainA.GetSimApp().ICAControllerKeeper.GetAllAccountOwners(ctx))
+					} else {
+						suite.Require().ErrorIs(err, ibcerrors.ErrUnauthorized)
+					}
+
+					suite.Require().Empty(suite.chainA.GetSimApp().ICAControllerKeeper.GetAllScheduledTxs(ctx))
+
+					// the new owner sends transactions on the active channel of the interchain account
+					sendMsg := types.NewMsgSendTx(msg.NewOwner, path.EndpointA.ConnectionID, uint64(time.Minute), packetData)
+					_, err = msgServer.SendTx(ctx, sendMsg)
+					suite.Require().NoError(err)
+
+					suite.Require().Contains(ctx.EventManager().Events().ToABCIEvents(), abci.Event{
+						Type: icatypes.EventTypeOwnershipTransferred,
+						Attributes: []abci.EventAttribute{
+							{Key: sdk.AttributeKeyModule, Value: icatypes.ModuleName},
+							{Key: icatypes.AttributeKeyControllerPortID, Value: path.EndpointA.ChannelConfig.PortID},
+							{Key: icatypes.AttributeKeyConnectionID, Value: path.EndpointA.ConnectionID},
+							{Key: icatypes.AttributeKeyControllerChannelID, Value: path.EndpointA.ChannelID},
+							{Key: icatypes.AttributeKeyOwner, Value: msg.Owner},
+							{Key: icatypes.AttributeKeyNewOwner, Value: msg.NewOwner},
+						},
+					})
+				} else {
+					suite.Require().ErrorIs(err, tc.expErr)
+					suite.Require().Nil(res)
+				}
+			})
+		}
+	}
+}
+
 // TestUpdateParams tests UpdateParams rpc handler
 func (suite *KeeperTestSuite) TestUpdateParams() {
 	signer := suite.chainA.GetSimApp().TransferKeeper.GetAuthority()
@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// GetControllerPortID returns the controller port of the interchain account owned by the owner on the given
+// connection. It is the port to which the ownership of an interchain account has been transferred to the owner, if
+// any, otherwise the port encoded from the owner address. An error is returned if the ownership of the interchain
+// account encoded from the owner address has been transferred away.
+func (k Keeper) GetControllerPortID(ctx context.Context, owner, connectionID string) (string, error) {
+	if portID, found := k.getOwnerPortID(ctx, owner, connectionID); found {
+		return portID, nil
+	}
+
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", err
+	}
+
+	if newOwner, found := k.GetAccountOwner(ctx, portID, connectionID); found {
+		return "", errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "ownership of the interchain account for port %s on connection %s has been transferred to %s", portID, connectionID, newOwner)
+	}
+
+	return portID, nil
+}
+
+// GetAccountOwner retrieves the owner of the interchain account with the given port and connection identifiers, if
+// its ownership has been transferred
+func (k Keeper) GetAccountOwner(ctx context.Context, portID, connectionID string) (string, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.AccountOwnerKey(portID, connectionID))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// SetAccountOwner stores the owner of the interchain account, keyed by its port and connection identifiers and indexed
+// by owner
+func (k Keeper) SetAccountOwner(ctx context.Context, accountOwner types.AccountOwner) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.AccountOwnerKey(accountOwner.PortId, accountOwner.ConnectionId), []byte(accountOwner.Owner)); err != nil {
+		panic(err)
+	}
+
+	if err := store.Set(types.OwnerPortKey(accountOwner.Owner, accountOwner.ConnectionId), []byte(accountOwner.PortId)); err != nil {
+		panic(err)
+	}
+}
+
+// DeleteAccountOwner removes the owner of the interchain account with the given port and connection identifiers and
+// its index from the store
+func (k Keeper) DeleteAccountOwner(ctx context.Context, portID, connectionID string) {
+	owner, found := k.GetAccountOwner(ctx, portID, connectionID)
+	if !found {
+		return
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.AccountOwnerKey(portID, connectionID)); err != nil {
+		panic(err)
+	}
+
+	if err := store.Delete(types.OwnerPortKey(owner, connectionID)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllAccountOwners returns the owners of all the interchain accounts whose ownership has been transferred
+func (k Keeper) GetAllAccountOwners(ctx context.Context) []types.AccountOwner {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.AccountOwnerKeyPrefix+"/"))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var accountOwners []types.AccountOwner
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+
+		accountOwners = append(accountOwners, types.AccountOwner{
+			PortId:       keySplit[1],
+			ConnectionId: keySplit[2],
+			Owner:        string(iterator.Value()),
+		})
+	}
+
+	return accountOwners
+}
+
+// transferOwnership hands the interchain account owned by the owner on the given connection, together with its active
+// channel, over to the new owner. The controller port of the interchain account is unchanged, so that packets in
+// flight on its channel, whether ORDERED or UNORDERED, are acknowledged or timed out as usual. The scheduled
+// transactions of the owner on the connection are cancelled. The controller port is returned.
+func (k Keeper) transferOwnership(ctx context.Context, owner, connectionID, newOwner string) (string, error) {
+	portID, err := k.GetControllerPortID(ctx, owner, connectionID)
+	if err != nil {
+		return "", err
+	}
+
+	if _, found := k.GetInterchainAccountAddress(ctx, connectionID, portID); !found {
+		return "", errorsmod.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account for port %s on connection %s", portID, connectionID)
+	}
+
+	if k.IsMiddlewareEnabled(ctx, portID, connectionID) {
+		return "", errorsmod.Wrap(types.ErrInvalidOwnershipTransfer, "interchain accounts authenticated by an underlying application cannot be transferred")
+	}
+
+	if _, found := k.getOwnerPortID(ctx, newOwner, connectionID); found {
+		return "", errorsmod.Wrapf(types.ErrInvalidOwnershipTransfer, "new owner %s already owns an interchain account on connection %s", newOwner, connectionID)
+	}
+
+	newOwnerPortID, err := icatypes.NewControllerPortID(newOwner)
+	if err != nil {
+		return "", err
+	}
+
+	// the interchain account of the new owner would otherwise become unreachable
+	if newOwnerPortID != portID && (k.IsMiddlewareEnabled(ctx, newOwnerPortID, connectionID) || k.IsMiddlewareDisabled(ctx, newOwnerPortID, connectionID)) {
+		return "", errorsmod.Wrapf(types.ErrInvalidOwnershipTransfer, "new owner %s already registered an interchain account on connection %s", newOwner, connectionID)
+	}
+
+	k.DeleteAccountOwner(ctx, portID, connectionID)
+
+	// handing the interchain account back to the owner encoded in its controller port requires no record
+	if newOwnerPortID != portID {
+		k.SetAccountOwner(ctx, types.AccountOwner{
+			PortId:       portID,
+			ConnectionId: connectionID,
+			Owner:        newOwner,
+		})
+	}
+
+	for _, scheduledTx := range k.getScheduledTxsByOwner(ctx, owner) {
+		if scheduledTx.ConnectionId == connectionID {
+			k.DeleteScheduledTx(ctx, scheduledTx)
+		}
+	}
+
+	return portID, nil
+}
+
+// getOwnerPortID retrieves the controller port of the interchain account transferred to the owner on the given
+// connection
+func (k Keeper) getOwnerPortID(ctx context.Context, owner, connectionID string) (string, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.OwnerPortKey(owner, connectionID))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return "", false
+	}
+
+	return string(bz), true
+}
@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// GetNextPacketResultID returns the identifier to be assigned to the next packet result
+func (k Keeper) GetNextPacketResultID(ctx context.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get([]byte(types.NextPacketResultIDKey))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextPacketResultID stores the identifier to be assigned to the next packet result
+func (k Keeper) SetNextPacketResultID(ctx context.Context, id uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set([]byte(types.NextPacketResultIDKey), sdk.Uint64ToBigEndian(id)); err != nil {
+		panic(err)
+	}
+}
+
+// GetPacketResult retrieves the result of the packet with the given sequence, sent by the owner on the given connection
+// and channel, from the store
+func (k Keeper) GetPacketResult(ctx context.Context, owner, connectionID, channelID string, sequence uint64) (types.PacketResult, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PacketResultKey(owner, connectionID, channelID, sequence))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.PacketResult{}, false
+	}
+
+	var packetResult types.PacketResult
+	k.cdc.MustUnmarshal(bz, &packetResult)
+	return packetResult, true
+}
+
+// SetPacketResult stores the packet result and queues it in the order of recording of the results of its owner
+func (k Keeper) SetPacketResult(ctx context.Context, packetResult types.PacketResult) {
+	store := k.storeService.OpenKVStore(ctx)
+	key := types.PacketResultKey(packetResult.Owner, packetResult.ConnectionId, packetResult.ChannelId, packetResult.Sequence)
+	bz := k.cdc.MustMarshal(&packetResult)
+	if err := store.Set(key, bz); err != nil {
+		panic(err)
+	}
+
+	if err := store.Set(types.PacketResultQueueKey(packetResult.Owner, packetResult.Id), key); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllPacketResults returns all the packet results stored, ordered by owner, connection, channel and sequence
+func (k Keeper) GetAllPacketResults(ctx context.Context) []types.PacketResult {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.PacketResultKeyPrefix+"/"))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var packetResults []types.PacketResult
+	for ; iterator.Valid(); iterator.Next() {
+		var packetResult types.PacketResult
+		k.cdc.MustUnmarshal(iterator.Value(), &packetResult)
+
+		packetResults = append(packetResults, packetResult)
+	}
+
+	return packetResults
+}
+
+// recordPacketResult records the outcome of a packet sent by an interchain account, under the current owner of the
+// interchain account. The oldest results of the owner are pruned so that no more results than allowed by the
+// MaxPacketResultsPerOwner parameter are kept. Nothing is recorded if the parameter is zero.
+func (k Keeper) recordPacketResult(ctx context.Context, packet channeltypes.Packet, status types.PacketStatus, acknowledgement *channeltypes.Acknowledgement) {
+	maxResults := k.GetParams(ctx).MaxPacketResultsPerOwner
+	if maxResults == 0 {
+		return
+	}
+
+	connectionID, err := k.GetConnectionID(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if err != nil {
+		k.Logger(ctx).Error("failed to record packet result", "port-id", packet.GetSourcePort(), "channel-id", packet.GetSourceChannel(), "error", err.Error())
+		return
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	packetResult := types.PacketResult{
+		Id:           k.GetNextPacketResultID(ctx),
+		Owner:        k.getOwner(ctx, packet.GetSourcePort(), connectionID),
+		ConnectionId: connectionID,
+		ChannelId:    packet.GetSourceChannel(),
+		Sequence:     packet.GetSequence(),
+		Status:       status,
+		Height:       uint64(sdkCtx.BlockHeight()),
+	}
+
+	if acknowledgement != nil {
+		if acknowledgement.Success() {
+			packetResult.MsgResponses, packetResult.Result = decodeAcknowledgementResult(packet, acknowledgement.GetResult())
+		} else {
+			packetResult.Error = acknowledgement.GetError()
+		}
+	}
+
+	k.prunePacketResults(ctx, packetResult.Owner, maxResults-1)
+
+	k.SetPacketResult(ctx, packetResult)
+	k.SetNextPacketResultID(ctx, packetResult.Id+1)
+}
+
+// prunePacketResults removes the oldest packet results of the owner until at most the given number of results is left
+func (k Keeper) prunePacketResults(ctx context.Context, owner string, maxResults uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	var queueKeys, resultKeys [][]byte
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.PacketResultQueuePrefix(owner))
+	for count := uint64(0); iterator.Valid(); iterator.Next() {
+		count++
+		if count > maxResults {
+			queueKeys = append(queueKeys, iterator.Key())
+			resultKeys = append(resultKeys, iterator.Value())
+		}
+	}
+
+	if err := iterator.Close(); err != nil {
+		k.Logger(ctx).Error("failed to close iterator", "error", err)
+	}
+
+	for i := range queueKeys {
+		store.Delete(queueKeys[i])
+		store.Delete(resultKeys[i])
+	}
+}
+
+// getOwner returns the owner of the interchain account with the given port and connection identifiers: the owner to
+// which its ownership has been transferred, if any, otherwise the owner encoded in its port identifier.
+func (k Keeper) getOwner(ctx context.Context, portID, connectionID string) string {
+	if owner, found := k.GetAccountOwner(ctx, portID, connectionID); found {
+		return owner
+	}
+
+	return icatypes.InterchainAccountPacketData{}.GetPacketSender(portID)
+}
+
+// decodeAcknowledgementResult decodes the result of a successful acknowledgement into the responses of the messages of
+// a transaction executed atomically. The result is returned as is if it is not a TxMsgData, as for query packets and
+// for transactions executed in best-effort mode.
+func decodeAcknowledgementResult(packet channeltypes.Packet, result []byte) ([]types.MsgResponse, []byte) {
+	var packetData icatypes.InterchainAccountPacketData
+	if err := packetData.UnmarshalJSON(packet.GetData()); err != nil || packetData.Type != icatypes.EXECUTE_TX || packetData.ExecutionMode != icatypes.ATOMIC {
+		return nil, result
+	}
+
+	var txMsgData sdk.TxMsgData
+	if err := proto.Unmarshal(result, &txMsgData); err != nil {
+		return nil, result
+	}
+
+	msgResponses := make([]types.MsgResponse, 0, len(txMsgData.MsgResponses))
+	for _, msgResponse := range txMsgData.MsgResponses {
+		msgResponses = append(msgResponses, types.MsgResponse{
+			TypeUrl: msgResponse.GetTypeUrl(),
+			Value:   msgResponse.GetValue(),
+		})
+	}
+
+	return msgResponses, nil
+}
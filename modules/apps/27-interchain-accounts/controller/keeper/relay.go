@@ -11,6 +11,7 @@ import (
 	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 )
 
 // SendTx takes pre-built packet data containing messages to be executed on the host chain from an authentication module and attempts to send the packet.
@@ -26,6 +27,15 @@ func (k Keeper) SendTx(ctx context.Context, connectionID, portID string, icaPack
 	return k.sendTx(ctx, connectionID, portID, icaPacketData, timeoutTimestamp)
 }
 
+// sendTxWithRelativeTimeout sends the packet data with a timeout timestamp relative to the current block time.
+func (k Keeper) sendTxWithRelativeTimeout(ctx context.Context, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, relativeTimeout uint64) (uint64, error) {
+	// the absolute timeout value is calculated using the controller chain block time + the relative timeout value
+	// this assumes time synchrony to a certain degree between the controller and counterparty host chain
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	absoluteTimeout := uint64(sdkCtx.BlockTime().UnixNano()) + relativeTimeout
+	return k.sendTx(ctx, connectionID, portID, icaPacketData, absoluteTimeout)
+}
+
 func (k Keeper) sendTx(ctx context.Context, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64) (uint64, error) {
 	if !k.GetParams(ctx).ControllerEnabled {
 		return 0, types.ErrControllerSubModuleDisabled
@@ -53,8 +63,49 @@ func (k Keeper) sendTx(ctx context.Context, connectionID, portID string, icaPack
 	return sequence, nil
 }
 
-// OnTimeoutPacket removes the active channel associated with the provided packet, the underlying channel end is closed
-// due to the semantics of ORDERED channels
-func (Keeper) OnTimeoutPacket(ctx context.Context, packet channeltypes.Packet) error {
+// OnAcknowledgementPacket records the result of a packet sent on the active channel of an interchain account from its
+// acknowledgement. An acknowledgement which cannot be decoded is recorded as an error.
+func (k Keeper) OnAcknowledgementPacket(ctx context.Context, packet channeltypes.Packet, acknowledgement []byte) {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		ack = channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-27 packet acknowledgement: %v", err))
+	}
+
+	status := types.STATUS_SUCCESS
+	if !ack.Success() {
+		status = types.STATUS_ERROR
+	}
+
+	k.recordPacketResult(ctx, packet, status, &ack)
+}
+
+// OnTimeoutPacket handles the timeout of a packet sent on the active channel of an interchain account, whose result is
+// recorded. If the timeout closed the channel, due to the semantics of ORDERED channels, and the automatic reopening
+// of channels is enabled, the opening handshake of a new channel is initiated with the ordering and version of the
+// closed channel.
+func (k Keeper) OnTimeoutPacket(ctx context.Context, packet channeltypes.Packet) error {
+	k.recordPacketResult(ctx, packet, types.STATUS_TIMEOUT, nil)
+
+	if !k.GetParams(ctx).AutoReopenChannels {
+		return nil
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if !found || channel.State != channeltypes.CLOSED {
+		return nil
+	}
+
+	// the timeouts of the other packets in flight on the closed channel must not initiate another reopening
+	connectionID := channel.ConnectionHops[0]
+	if activeChannelID, found := k.GetActiveChannelID(ctx, connectionID, packet.GetSourcePort()); !found || activeChannelID != packet.GetSourceChannel() {
+		return nil
+	}
+
+	if _, found := k.GetPendingChannelReopen(ctx, packet.GetSourcePort(), connectionID); found {
+		return nil
+	}
+
+	k.reopenChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
+
 	return nil
 }
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"github.com/cosmos/gogoproto/proto"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -10,6 +11,7 @@ import (
 	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 )
 
@@ -169,6 +171,197 @@ func (suite *KeeperTestSuite) TestSendTx() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
+	var (
+		path            *ibctesting.Path
+		packetData      icatypes.InterchainAccountPacketData
+		acknowledgement []byte
+		expOwner        string
+		expResult       types.PacketResult
+		expRecorded     bool
+	)
+
+	msgResponse := &codectypes.Any{TypeUrl: sdk.MsgTypeURL(&banktypes.MsgSendResponse{}), Value: []byte("response")}
+	errorAck := channeltypes.NewErrorAcknowledgement(ibcerrors.ErrInvalidType)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"success: responses of an atomic transaction are decoded",
+			func() {},
+		},
+		{
+			"success: result of a best-effort transaction is recorded as is",
+			func() {
+				packetData.ExecutionMode = icatypes.BEST_EFFORT
+				acknowledgement = channeltypes.NewResultAcknowledgement([]byte("result")).Acknowledgement()
+
+				expResult.MsgResponses = nil
+				expResult.Result = []byte("result")
+			},
+		},
+		{
+			"success: error acknowledgement",
+			func() {
+				acknowledgement = errorAck.Acknowledgement()
+
+				expResult.Status = types.STATUS_ERROR
+				expResult.MsgResponses = nil
+				expResult.Error = errorAck.GetError()
+			},
+		},
+		{
+			"success: acknowledgement which cannot be decoded is recorded as an error",
+			func() {
+				acknowledgement = []byte("invalid acknowledgement")
+
+				expResult.Status = types.STATUS_ERROR
+				expResult.MsgResponses = nil
+				expResult.Error = errorAck.GetError()
+			},
+		},
+		{
+			"success: result is recorded under the owner to which the interchain account was transferred",
+			func() {
+				expOwner = sdk.AccAddress("new-owner").String()
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetAccountOwner(suite.chainA.GetContext(), types.AccountOwner{
+					PortId:       path.EndpointA.ChannelConfig.PortID,
+					ConnectionId: path.EndpointA.ConnectionID,
+					Owner:        expOwner,
+				})
+			},
+		},
+		{
+			"recording of packet results is disabled",
+			func() {
+				params := types.DefaultParams()
+				params.MaxPacketResultsPerOwner = 0
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				expRecorded = false
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB, channeltypes.ORDERED)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			packetData = icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: []byte("data"),
+			}
+
+			txMsgData, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{msgResponse}})
+			suite.Require().NoError(err)
+			acknowledgement = channeltypes.NewResultAcknowledgement(txMsgData).Acknowledgement()
+
+			expOwner = TestOwnerAddress
+			expResult = types.PacketResult{
+				Id:           0,
+				ConnectionId: path.EndpointA.ConnectionID,
+				ChannelId:    path.EndpointA.ChannelID,
+				Sequence:     1,
+				Status:       types.STATUS_SUCCESS,
+				MsgResponses: []types.MsgResponse{{TypeUrl: msgResponse.TypeUrl, Value: msgResponse.Value}},
+				Height:       uint64(suite.chainA.GetContext().BlockHeight()),
+			}
+			expRecorded = true
+
+			tc.malleate() // malleate mutates test data
+
+			packet := channeltypes.NewPacket(
+				packetData.GetBytes(),
+				1,
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			suite.chainA.GetSimApp().ICAControllerKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, acknowledgement)
+
+			packetResult, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetPacketResult(suite.chainA.GetContext(), expOwner, path.EndpointA.ConnectionID, path.EndpointA.ChannelID, 1)
+			if expRecorded {
+				expResult.Owner = expOwner
+
+				suite.Require().True(found)
+				suite.Require().Equal(expResult, packetResult)
+				suite.Require().Equal(uint64(1), suite.chainA.GetSimApp().ICAControllerKeeper.GetNextPacketResultID(suite.chainA.GetContext()))
+			} else {
+				suite.Require().False(found)
+				suite.Require().Empty(suite.chainA.GetSimApp().ICAControllerKeeper.GetAllPacketResults(suite.chainA.GetContext()))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPacketResultsPruning() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB, channeltypes.ORDERED)
+	path.SetupConnections()
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	params := types.DefaultParams()
+	params.MaxPacketResultsPerOwner = 2
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	for sequence := uint64(1); sequence <= 3; sequence++ {
+		packet := channeltypes.NewPacket(
+			[]byte{},
+			sequence,
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			path.EndpointB.ChannelConfig.PortID,
+			path.EndpointB.ChannelID,
+			clienttypes.NewHeight(0, 100),
+			0,
+		)
+
+		err = suite.chainA.GetSimApp().ICAControllerKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet)
+		suite.Require().NoError(err)
+	}
+
+	// the result of the oldest packet is pruned
+	_, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetPacketResult(suite.chainA.GetContext(), TestOwnerAddress, path.EndpointA.ConnectionID, path.EndpointA.ChannelID, 1)
+	suite.Require().False(found)
+
+	packetResults := suite.chainA.GetSimApp().ICAControllerKeeper.GetAllPacketResults(suite.chainA.GetContext())
+	suite.Require().Len(packetResults, 2)
+	for i, packetResult := range packetResults {
+		suite.Require().Equal(uint64(i+2), packetResult.Sequence)
+		suite.Require().Equal(types.STATUS_TIMEOUT, packetResult.Status)
+	}
+
+	// lowering the maximum prunes the results of the owner on the next recording
+	params.MaxPacketResultsPerOwner = 1
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	packet := channeltypes.NewPacket([]byte{}, 4, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+	err = suite.chainA.GetSimApp().ICAControllerKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet)
+	suite.Require().NoError(err)
+
+	packetResults = suite.chainA.GetSimApp().ICAControllerKeeper.GetAllPacketResults(suite.chainA.GetContext())
+	suite.Require().Len(packetResults, 1)
+	suite.Require().Equal(uint64(4), packetResults[0].Sequence)
+	suite.Require().Equal(uint64(4), suite.chainA.GetSimApp().ICAControllerKeeper.GetNextPacketResultID(suite.chainA.GetContext()))
+}
+
 func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 	var path *ibctesting.Path
 
@@ -214,6 +407,10 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 
 				if tc.expErr == nil {
 					suite.Require().NoError(err)
+
+					packetResult, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetPacketResult(suite.chainA.GetContext(), TestOwnerAddress, path.EndpointA.ConnectionID, path.EndpointA.ChannelID, 1)
+					suite.Require().True(found)
+					suite.Require().Equal(types.STATUS_TIMEOUT, packetResult.Status)
 				} else {
 					suite.Require().Error(err)
 				}
@@ -221,3 +418,120 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestOnTimeoutPacketReopensChannel() {
+	var (
+		path      *ibctesting.Path
+		expReopen bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: channel reopening is initiated",
+			func() {},
+		},
+		{
+			"automatic reopening is disabled",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.DefaultParams())
+				expReopen = false
+			},
+		},
+		{
+			"channel is not closed",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.OPEN })
+				expReopen = false
+			},
+		},
+		{
+			"channel is not the active channel",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, "channel-100")
+				expReopen = false
+			},
+		},
+		{
+			"channel reopening is already pending",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetPendingChannelReopen(suite.chainA.GetContext(), types.PendingChannelReopen{
+					PortId:          path.EndpointA.ChannelConfig.PortID,
+					ConnectionId:    path.EndpointA.ConnectionID,
+					ClosedChannelId: path.EndpointA.ChannelID,
+					ChannelId:       "channel-100",
+					Version:         path.EndpointA.ChannelConfig.Version,
+				})
+				expReopen = false
+			},
+		},
+		{
+			"failure: channel opening handshake fails without failing the timeout",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.Version = "invalid-version" })
+				expReopen = false
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB, channeltypes.ORDERED)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			params := types.DefaultParams()
+			params.AutoReopenChannels = true
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+			expReopen = true
+
+			tc.malleate() // malleate mutates test data
+
+			packet := channeltypes.NewPacket(
+				[]byte{},
+				1,
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			channelSeq := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext())
+			pendingReopenBefore, _ := suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingChannelReopen(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID)
+
+			err = suite.chainA.GetSimApp().ICAControllerKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet)
+			suite.Require().NoError(err)
+
+			pendingReopen, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingChannelReopen(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID)
+			if expReopen {
+				suite.Require().True(found)
+				suite.Require().Equal(types.PendingChannelReopen{
+					PortId:          path.EndpointA.ChannelConfig.PortID,
+					ConnectionId:    path.EndpointA.ConnectionID,
+					ClosedChannelId: path.EndpointA.ChannelID,
+					ChannelId:       channeltypes.FormatChannelIdentifier(channelSeq),
+					Version:         path.EndpointA.ChannelConfig.Version,
+				}, pendingReopen)
+
+				channel, found := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, pendingReopen.ChannelId)
+				suite.Require().True(found)
+				suite.Require().Equal(channeltypes.INIT, channel.State)
+			} else {
+				suite.Require().Equal(pendingReopenBefore, pendingReopen)
+				suite.Require().Equal(channelSeq, suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext()))
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
+)
+
+// GetNextScheduledTxID returns the identifier to be assigned to the next scheduled transaction
+func (k Keeper) GetNextScheduledTxID(ctx context.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get([]byte(types.NextScheduledTxIDKey))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextScheduledTxID stores the identifier to be assigned to the next scheduled transaction
+func (k Keeper) SetNextScheduledTxID(ctx context.Context, id uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set([]byte(types.NextScheduledTxIDKey), sdk.Uint64ToBigEndian(id)); err != nil {
+		panic(err)
+	}
+}
+
+// GetScheduledTx retrieves the scheduled transaction with the given identifier from the store
+func (k Keeper) GetScheduledTx(ctx context.Context, id uint64) (types.ScheduledTx, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.ScheduledTxKey(id))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.ScheduledTx{}, false
+	}
+
+	var scheduledTx types.ScheduledTx
+	k.cdc.MustUnmarshal(bz, &scheduledTx)
+	return scheduledTx, true
+}
+
+// SetScheduledTx stores the scheduled transaction, indexed by its owner and queued according to its schedule
+func (k Keeper) SetScheduledTx(ctx context.Context, scheduledTx types.ScheduledTx) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&scheduledTx)
+	if err := store.Set(types.ScheduledTxKey(scheduledTx.Id), bz); err != nil {
+		panic(err)
+	}
+
+	if err := store.Set(types.ScheduledTxOwnerKey(scheduledTx.Owner, scheduledTx.Id), []byte{0x01}); err != nil {
+		panic(err)
+	}
+
+	if err := store.Set(scheduledTxQueueKey(scheduledTx), []byte{0x01}); err != nil {
+		panic(err)
+	}
+}
+
+// DeleteScheduledTx removes the scheduled transaction, its owner index and its queue entry from the store
+func (k Keeper) DeleteScheduledTx(ctx context.Context, scheduledTx types.ScheduledTx) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.ScheduledTxKey(scheduledTx.Id)); err != nil {
+		panic(err)
+	}
+
+	if err := store.Delete(types.ScheduledTxOwnerKey(scheduledTx.Owner, scheduledTx.Id)); err != nil {
+		panic(err)
+	}
+
+	if err := store.Delete(scheduledTxQueueKey(scheduledTx)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllScheduledTxs returns all the scheduled transactions stored, ordered by identifier
+func (k Keeper) GetAllScheduledTxs(ctx context.Context) []types.ScheduledTx {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.ScheduledTxKeyPrefix+"/"))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var scheduledTxs []types.ScheduledTx
+	for ; iterator.Valid(); iterator.Next() {
+		var scheduledTx types.ScheduledTx
+		k.cdc.MustUnmarshal(iterator.Value(), &scheduledTx)
+
+		scheduledTxs = append(scheduledTxs, scheduledTx)
+	}
+
+	return scheduledTxs
+}
+
+// getScheduledTxCount returns the number of scheduled transactions of the owner
+func (k Keeper) getScheduledTxCount(ctx context.Context, owner string) int {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.ScheduledTxOwnerPrefix(owner))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var count int
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+
+	return count
+}
+
+// getScheduledTxsByOwner returns the scheduled transactions of the owner, ordered by identifier
+func (k Keeper) getScheduledTxsByOwner(ctx context.Context, owner string) []types.ScheduledTx {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.ScheduledTxOwnerPrefix(owner))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var scheduledTxs []types.ScheduledTx
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if scheduledTx, found := k.GetScheduledTx(ctx, sdk.BigEndianToUint64(key[len(key)-8:])); found {
+			scheduledTxs = append(scheduledTxs, scheduledTx)
+		}
+	}
+
+	return scheduledTxs
+}
+
+// getDueScheduledTxIDs returns the identifiers of at most limit scheduled transactions whose height or timestamp has
+// been reached, ordered by height and then by timestamp.
+func (k Keeper) getDueScheduledTxIDs(ctx context.Context, limit uint64) []uint64 {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	var ids []uint64
+	for _, queue := range []struct {
+		prefix string
+		end    []byte
+	}{
+		{types.ScheduledTxHeightQueueKeyPrefix, types.ScheduledTxHeightQueueKey(uint64(sdkCtx.BlockHeight())+1, 0)},
+		{types.ScheduledTxTimeQueueKeyPrefix, types.ScheduledTxTimeQueueKey(uint64(sdkCtx.BlockTime().UnixNano())+1, 0)},
+	} {
+		iterator := store.Iterator([]byte(queue.prefix+"/"), queue.end)
+		for ; iterator.Valid() && uint64(len(ids)) < limit; iterator.Next() {
+			key := iterator.Key()
+			ids = append(ids, sdk.BigEndianToUint64(key[len(key)-8:]))
+		}
+
+		if err := iterator.Close(); err != nil {
+			k.Logger(ctx).Error("failed to close iterator", "error", err)
+		}
+	}
+
+	return ids
+}
+
+// scheduledTxQueueKey returns the store key under which the scheduled transaction is queued according to its schedule
+func scheduledTxQueueKey(scheduledTx types.ScheduledTx) []byte {
+	if scheduledTx.Schedule.Height != 0 {
+		return types.ScheduledTxHeightQueueKey(scheduledTx.Schedule.Height, scheduledTx.Id)
+	}
+
+	return types.ScheduledTxTimeQueueKey(scheduledTx.Schedule.Timestamp, scheduledTx.Id)
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// Validate performs basic validation of the account owner
+func (o AccountOwner) Validate() error {
+	if err := host.PortIdentifierValidator(o.PortId); err != nil {
+		return err
+	}
+
+	if !strings.HasPrefix(o.PortId, icatypes.ControllerPortPrefix) {
+		return errorsmod.Wrapf(icatypes.ErrInvalidControllerPort, "expected %s{owner-account-address}, got %s", icatypes.ControllerPortPrefix, o.PortId)
+	}
+
+	if err := host.ConnectionIdentifierValidator(o.ConnectionId); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(o.Owner) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	// the owner must be encodable in a controller port identifier, so that it cannot collide with the key separator
+	if err := host.PortIdentifierValidator(icatypes.ControllerPortPrefix + o.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner")
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
+// Validate performs basic validation of the pending channel reopening
+func (r PendingChannelReopen) Validate() error {
+	if err := host.PortIdentifierValidator(r.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ConnectionIdentifierValidator(r.ConnectionId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(r.ClosedChannelId); err != nil {
+		return err
+	}
+
+	return host.ChannelIdentifierValidator(r.ChannelId)
+}
@@ -13,6 +13,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgRegisterInterchainAccount{},
 		&MsgSendTx{},
 		&MsgUpdateParams{},
+		&MsgScheduleTx{},
+		&MsgCancelScheduledTx{},
+		&MsgTransferOwnership{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -34,6 +34,21 @@ func TestCodecTypeRegistration(t *testing.T) {
 			sdk.MsgTypeURL(&types.MsgUpdateParams{}),
 			nil,
 		},
+		{
+			"success: MsgScheduleTx",
+			sdk.MsgTypeURL(&types.MsgScheduleTx{}),
+			nil,
+		},
+		{
+			"success: MsgCancelScheduledTx",
+			sdk.MsgTypeURL(&types.MsgCancelScheduledTx{}),
+			nil,
+		},
+		{
+			"success: MsgTransferOwnership",
+			sdk.MsgTypeURL(&types.MsgTransferOwnership{}),
+			nil,
+		},
 		{
 			"type not registered on codec",
 			"ibc.invalid.MsgTypeURL",
@@ -5,7 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -22,11 +24,57 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PacketStatus defines the outcome of an interchain accounts packet sent by the controller chain.
+type PacketStatus int32
+
+const (
+	// Default zero value enumeration
+	STATUS_UNSPECIFIED PacketStatus = 0
+	// The packet was acknowledged with a successful acknowledgement
+	STATUS_SUCCESS PacketStatus = 1
+	// The packet was acknowledged with an error acknowledgement
+	STATUS_ERROR PacketStatus = 2
+	// The packet timed out
+	STATUS_TIMEOUT PacketStatus = 3
+)
+
+var PacketStatus_name = map[int32]string{
+	0: "PACKET_STATUS_UNSPECIFIED",
+	1: "PACKET_STATUS_SUCCESS",
+	2: "PACKET_STATUS_ERROR",
+	3: "PACKET_STATUS_TIMEOUT",
+}
+
+var PacketStatus_value = map[string]int32{
+	"PACKET_STATUS_UNSPECIFIED": 0,
+	"PACKET_STATUS_SUCCESS":     1,
+	"PACKET_STATUS_ERROR":       2,
+	"PACKET_STATUS_TIMEOUT":     3,
+}
+
+func (x PacketStatus) String() string {
+	return proto.EnumName(PacketStatus_name, int32(x))
+}
+
+func (PacketStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{0}
+}
+
 // Params defines the set of on-chain interchain accounts parameters.
 // The following parameters may be used to disable the controller submodule.
 type Params struct {
 	// controller_enabled enables or disables the controller submodule.
 	ControllerEnabled bool `protobuf:"varint,1,opt,name=controller_enabled,json=controllerEnabled,proto3" json:"controller_enabled,omitempty"`
+	// auto_reopen_channels enables the automatic reopening of the active channel of an interchain account once it is
+	// closed, for example after the timeout of a packet sent on an ORDERED channel.
+	AutoReopenChannels bool `protobuf:"varint,2,opt,name=auto_reopen_channels,json=autoReopenChannels,proto3" json:"auto_reopen_channels,omitempty"`
+	// max_packet_results_per_owner is the maximum number of packet results recorded for an owner, beyond which the
+	// oldest results are pruned. A value of zero disables the recording of packet results.
+	MaxPacketResultsPerOwner uint64 `protobuf:"varint,3,opt,name=max_packet_results_per_owner,json=maxPacketResultsPerOwner,proto3" json:"max_packet_results_per_owner,omitempty"`
+	// max_scheduled_txs_per_block is the maximum number of scheduled transactions sent at the end of a block, across
+	// all owners. Due transactions beyond the limit are sent in the following blocks. A value of zero pauses the
+	// sending of scheduled transactions.
+	MaxScheduledTxsPerBlock uint64 `protobuf:"varint,4,opt,name=max_scheduled_txs_per_block,json=maxScheduledTxsPerBlock,proto3" json:"max_scheduled_txs_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,143 +117,2480 @@ func (m *Params) GetControllerEnabled() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.controller.v1.Params")
+func (m *Params) GetAutoReopenChannels() bool {
+	if m != nil {
+		return m.AutoReopenChannels
+	}
+	return false
 }
 
-func init() {
-	proto.RegisterFile("ibc/applications/interchain_accounts/controller/v1/controller.proto", fileDescriptor_177fd0fec5eb3400)
+func (m *Params) GetMaxPacketResultsPerOwner() uint64 {
+	if m != nil {
+		return m.MaxPacketResultsPerOwner
+	}
+	return 0
 }
 
-var fileDescriptor_177fd0fec5eb3400 = []byte{
-	// 221 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x8f, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0xe3, 0xa5, 0x42, 0xd9, 0xc8, 0xc4, 0x64, 0x21, 0x26, 0x96, 0xf8, 0xd4, 0x32, 0x54,
-	0xac, 0x20, 0xf6, 0x8a, 0x91, 0xa5, 0xb2, 0xaf, 0x56, 0x7b, 0xc8, 0xf1, 0x45, 0x3e, 0x27, 0x12,
-	0x6f, 0xc1, 0x63, 0x31, 0x76, 0x64, 0x44, 0xc9, 0x8b, 0x20, 0xe8, 0x90, 0x0c, 0x19, 0x4f, 0x9f,
-	0xee, 0xd3, 0xff, 0x95, 0xcf, 0xe4, 0x10, 0x6c, 0xdb, 0x06, 0x42, 0x9b, 0x89, 0xa3, 0x00, 0xc5,
-	0xec, 0x13, 0x9e, 0x2c, 0xc5, 0xbd, 0x45, 0xe4, 0x2e, 0x66, 0x01, 0xe4, 0x98, 0x13, 0x87, 0xe0,
-	0x13, 0xf4, 0xeb, 0xd9, 0x65, 0xda, 0xc4, 0x99, 0xab, 0x0d, 0x39, 0x34, 0x73, 0x89, 0x59, 0x90,
-	0x98, 0xd9, 0x5b, 0xbf, 0xbe, 0xdb, 0x96, 0xab, 0x9d, 0x4d, 0xb6, 0x91, 0xaa, 0x2e, 0xab, 0x09,
-	0xed, 0x7d, 0xb4, 0x2e, 0xf8, 0xc3, 0x8d, 0xba, 0x55, 0xf7, 0x57, 0xaf, 0xd7, 0x13, 0x79, 0xb9,
-	0x80, 0xa7, 0xf7, 0xaf, 0x41, 0xab, 0xf3, 0xa0, 0xd5, 0xcf, 0xa0, 0xd5, 0xe7, 0xa8, 0x8b, 0xf3,
-	0xa8, 0x8b, 0xef, 0x51, 0x17, 0x6f, 0xbb, 0x23, 0xe5, 0x53, 0xe7, 0x0c, 0x72, 0x03, 0xc8, 0xd2,
-	0xb0, 0x00, 0x39, 0xac, 0x8f, 0x0c, 0xfd, 0x23, 0x34, 0x7c, 0xe8, 0x82, 0x97, 0xbf, 0x56, 0x81,
-	0xcd, 0xb6, 0x9e, 0x16, 0xd6, 0x4b, 0x99, 0xf9, 0xa3, 0xf5, 0xe2, 0x56, 0xff, 0x7d, 0x0f, 0xbf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x0d, 0xbb, 0xb2, 0xf3, 0x26, 0x01, 0x00, 0x00,
+func (m *Params) GetMaxScheduledTxsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxScheduledTxsPerBlock
+	}
+	return 0
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// Schedule defines when a scheduled interchain accounts transaction is sent. The transaction is sent at the end of
+// the block at the given height, or of the first block whose time is at or after the given timestamp, and, if an
+// interval is set, sent again every interval after the block in which it was last sent.
+type Schedule struct {
+	// height is the block height at which the transaction is sent next. It must not be set together with timestamp.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// timestamp is the unix time in nanoseconds at or after which the transaction is sent next. It must not be set
+	// together with height.
+	Timestamp uint64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// interval is the number of blocks, if height is set, or of nanoseconds, if timestamp is set, after which the
+	// transaction is sent again. A value of zero sends the transaction only once.
+	Interval uint64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// max_executions is the maximum number of times a recurring transaction is sent. A value of zero sends the
+	// transaction until the schedule is cancelled. It must be zero if the interval is zero.
+	MaxExecutions uint64 `protobuf:"varint,4,opt,name=max_executions,json=maxExecutions,proto3" json:"max_executions,omitempty"`
+}
+
+func (m *Schedule) Reset()         { *m = Schedule{} }
+func (m *Schedule) String() string { return proto.CompactTextString(m) }
+func (*Schedule) ProtoMessage()    {}
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{1}
+}
+func (m *Schedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *Schedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schedule.Merge(m, src)
+}
+func (m *Schedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schedule.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Schedule proto.InternalMessageInfo
+
+func (m *Schedule) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ControllerEnabled {
-		i--
-		if m.ControllerEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *Schedule) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Schedule) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *Schedule) GetMaxExecutions() uint64 {
+	if m != nil {
+		return m.MaxExecutions
+	}
+	return 0
+}
+
+// ScheduledTx defines an interchain accounts transaction scheduled to be sent by the controller chain.
+type ScheduledTx struct {
+	// id is the unique identifier of the scheduled transaction.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the owner of the interchain account which sends the transaction.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// connection_id is the connection on which the interchain account is registered.
+	ConnectionId string `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// packet_data is the interchain account packet data sent.
+	PacketData types.InterchainAccountPacketData `protobuf:"bytes,4,opt,name=packet_data,json=packetData,proto3" json:"packet_data"`
+	// relative_timeout is added to the block time at which the transaction is sent to obtain the packet timeout.
+	RelativeTimeout uint64 `protobuf:"varint,5,opt,name=relative_timeout,json=relativeTimeout,proto3" json:"relative_timeout,omitempty"`
+	// schedule defines when the transaction is sent next.
+	Schedule Schedule `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule"`
+	// executions is the number of times the transaction has been sent, whether successfully or not.
+	Executions uint64 `protobuf:"varint,7,opt,name=executions,proto3" json:"executions,omitempty"`
+}
+
+func (m *ScheduledTx) Reset()         { *m = ScheduledTx{} }
+func (m *ScheduledTx) String() string { return proto.CompactTextString(m) }
+func (*ScheduledTx) ProtoMessage()    {}
+func (*ScheduledTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{2}
+}
+func (m *ScheduledTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ScheduledTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTx.Merge(m, src)
+}
+func (m *ScheduledTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTx.DiscardUnknown(m)
 }
 
-func encodeVarintController(dAtA []byte, offset int, v uint64) int {
-	offset -= sovController(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ScheduledTx proto.InternalMessageInfo
+
+func (m *ScheduledTx) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ScheduledTx) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	var l int
-	_ = l
-	if m.ControllerEnabled {
-		n += 2
+	return ""
+}
+
+func (m *ScheduledTx) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
 	}
-	return n
+	return ""
 }
 
-func sovController(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ScheduledTx) GetPacketData() types.InterchainAccountPacketData {
+	if m != nil {
+		return m.PacketData
+	}
+	return types.InterchainAccountPacketData{}
 }
-func sozController(x uint64) (n int) {
-	return sovController(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ScheduledTx) GetRelativeTimeout() uint64 {
+	if m != nil {
+		return m.RelativeTimeout
+	}
+	return 0
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowController
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *ScheduledTx) GetSchedule() Schedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return Schedule{}
+}
+
+func (m *ScheduledTx) GetExecutions() uint64 {
+	if m != nil {
+		return m.Executions
+	}
+	return 0
+}
+
+// PendingChannelReopen defines a channel opening handshake initiated automatically by the controller submodule to
+// reopen the closed active channel of an interchain account. It is removed once the handshake completes.
+type PendingChannelReopen struct {
+	// port_id is the controller port of the interchain account.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// connection_id is the connection on which the interchain account is registered.
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// closed_channel_id is the identifier of the closed active channel.
+	ClosedChannelId string `protobuf:"bytes,3,opt,name=closed_channel_id,json=closedChannelId,proto3" json:"closed_channel_id,omitempty"`
+	// channel_id is the identifier of the channel being opened.
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// version is the version of the closed channel, with which the channel is reopened.
+	Version string `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *PendingChannelReopen) Reset()         { *m = PendingChannelReopen{} }
+func (m *PendingChannelReopen) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelReopen) ProtoMessage()    {}
+func (*PendingChannelReopen) Descriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{3}
+}
+func (m *PendingChannelReopen) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingChannelReopen) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingChannelReopen.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		return b[:n], nil
+	}
+}
+func (m *PendingChannelReopen) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelReopen.Merge(m, src)
+}
+func (m *PendingChannelReopen) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingChannelReopen) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelReopen.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingChannelReopen proto.InternalMessageInfo
+
+func (m *PendingChannelReopen) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PendingChannelReopen) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *PendingChannelReopen) GetClosedChannelId() string {
+	if m != nil {
+		return m.ClosedChannelId
+	}
+	return ""
+}
+
+func (m *PendingChannelReopen) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PendingChannelReopen) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// AccountOwner defines the owner of an interchain account whose ownership has been transferred away from the owner
+// encoded in its controller port identifier.
+type AccountOwner struct {
+	// port_id is the controller port of the interchain account.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// connection_id is the connection on which the interchain account is registered.
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// owner is the address which owns the interchain account.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *AccountOwner) Reset()         { *m = AccountOwner{} }
+func (m *AccountOwner) String() string { return proto.CompactTextString(m) }
+func (*AccountOwner) ProtoMessage()    {}
+func (*AccountOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{4}
+}
+func (m *AccountOwner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountOwner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		return b[:n], nil
+	}
+}
+func (m *AccountOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountOwner.Merge(m, src)
+}
+func (m *AccountOwner) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountOwner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountOwner proto.InternalMessageInfo
+
+func (m *AccountOwner) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *AccountOwner) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *AccountOwner) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// PacketResult defines the recorded outcome of an interchain accounts packet sent by the controller chain.
+type PacketResult struct {
+	// id is the identifier assigned to the result in the order of recording.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the owner of the interchain account which sent the packet, at the time the result was recorded.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// connection_id is the connection on which the interchain account is registered.
+	ConnectionId string `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// channel_id is the channel on which the packet was sent.
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the sequence of the packet.
+	Sequence uint64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// status is the outcome of the packet.
+	Status PacketStatus `protobuf:"varint,6,opt,name=status,proto3,enum=ibc.applications.interchain_accounts.controller.v1.PacketStatus" json:"status,omitempty"`
+	// error is the error of the acknowledgement, set if the status is PACKET_STATUS_ERROR.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// msg_responses are the responses of the messages of a transaction executed atomically on the host chain, decoded
+	// from the TxMsgData of the acknowledgement.
+	MsgResponses []MsgResponse `protobuf:"bytes,8,rep,name=msg_responses,json=msgResponses,proto3" json:"msg_responses"`
+	// result is the result of the acknowledgement, set if it is not a TxMsgData, as for query packets and for
+	// transactions executed in best-effort mode.
+	Result []byte `protobuf:"bytes,9,opt,name=result,proto3" json:"result,omitempty"`
+	// height is the block height at which the result was recorded.
+	Height uint64 `protobuf:"varint,10,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *PacketResult) Reset()         { *m = PacketResult{} }
+func (m *PacketResult) String() string { return proto.CompactTextString(m) }
+func (*PacketResult) ProtoMessage()    {}
+func (*PacketResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{5}
+}
+func (m *PacketResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControllerEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowController
-				}
+		return b[:n], nil
+	}
+}
+func (m *PacketResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketResult.Merge(m, src)
+}
+func (m *PacketResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketResult proto.InternalMessageInfo
+
+func (m *PacketResult) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PacketResult) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *PacketResult) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *PacketResult) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PacketResult) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PacketResult) GetStatus() PacketStatus {
+	if m != nil {
+		return m.Status
+	}
+	return STATUS_UNSPECIFIED
+}
+
+func (m *PacketResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *PacketResult) GetMsgResponses() []MsgResponse {
+	if m != nil {
+		return m.MsgResponses
+	}
+	return nil
+}
+
+func (m *PacketResult) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *PacketResult) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// MsgResponse defines the response of a message executed on the host chain. It is not a google.protobuf.Any, as the
+// response types of the host chain are not necessarily registered on the controller chain.
+type MsgResponse struct {
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	Value   []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *MsgResponse) Reset()         { *m = MsgResponse{} }
+func (m *MsgResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResponse) ProtoMessage()    {}
+func (*MsgResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{6}
+}
+func (m *MsgResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResponse.Merge(m, src)
+}
+func (m *MsgResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResponse proto.InternalMessageInfo
+
+func (m *MsgResponse) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *MsgResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("ibc.applications.interchain_accounts.controller.v1.PacketStatus", PacketStatus_name, PacketStatus_value)
+	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.controller.v1.Params")
+	proto.RegisterType((*Schedule)(nil), "ibc.applications.interchain_accounts.controller.v1.Schedule")
+	proto.RegisterType((*ScheduledTx)(nil), "ibc.applications.interchain_accounts.controller.v1.ScheduledTx")
+	proto.RegisterType((*PendingChannelReopen)(nil), "ibc.applications.interchain_accounts.controller.v1.PendingChannelReopen")
+	proto.RegisterType((*AccountOwner)(nil), "ibc.applications.interchain_accounts.controller.v1.AccountOwner")
+	proto.RegisterType((*PacketResult)(nil), "ibc.applications.interchain_accounts.controller.v1.PacketResult")
+	proto.RegisterType((*MsgResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgResponse")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/interchain_accounts/controller/v1/controller.proto", fileDescriptor_177fd0fec5eb3400)
+}
+
+var fileDescriptor_177fd0fec5eb3400 = []byte{
+	// 931 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x3a, 0xae, 0x63, 0x3f, 0x3b, 0xa9, 0x3b, 0x84, 0x76, 0x6b, 0x8a, 0xb1, 0x8c, 0x90,
+	0xd2, 0x4a, 0xf6, 0x12, 0x03, 0x42, 0x48, 0x55, 0x21, 0x71, 0x8c, 0x64, 0xa1, 0x12, 0x6b, 0x6c,
+	0x4b, 0x88, 0x03, 0xab, 0xf1, 0xec, 0xc8, 0xde, 0x66, 0x77, 0x67, 0xd9, 0x99, 0x35, 0xe6, 0xcc,
+	0x05, 0xe5, 0xc4, 0x17, 0x88, 0x84, 0xc4, 0xa7, 0xe0, 0x1b, 0xf4, 0xd8, 0x03, 0x07, 0x4e, 0x08,
+	0x25, 0x07, 0xae, 0x7c, 0x04, 0x34, 0xb3, 0x6b, 0x7b, 0xa3, 0x44, 0xa2, 0x94, 0xde, 0xfc, 0x7e,
+	0x6f, 0xde, 0xbf, 0xdf, 0xfb, 0xf9, 0x2d, 0xf4, 0xdc, 0x29, 0xb5, 0x48, 0x18, 0x7a, 0x2e, 0x25,
+	0xd2, 0xe5, 0x81, 0xb0, 0xdc, 0x40, 0xb2, 0x88, 0xce, 0x89, 0x1b, 0xd8, 0x84, 0x52, 0x1e, 0x07,
+	0x52, 0x58, 0x94, 0x07, 0x32, 0xe2, 0x9e, 0xc7, 0x22, 0x6b, 0x71, 0x90, 0xb1, 0x3a, 0x61, 0xc4,
+	0x25, 0x47, 0x5d, 0x77, 0x4a, 0x3b, 0xd9, 0x24, 0x9d, 0x1b, 0x92, 0x74, 0x32, 0x61, 0x8b, 0x83,
+	0xfa, 0xde, 0x8c, 0xcf, 0xb8, 0x0e, 0xb7, 0xd4, 0xaf, 0x24, 0x53, 0xfd, 0xc3, 0x97, 0x6a, 0x67,
+	0x71, 0x60, 0x85, 0x84, 0x9e, 0x32, 0x99, 0x44, 0xb5, 0xfe, 0x32, 0xa0, 0x38, 0x24, 0x11, 0xf1,
+	0x05, 0x6a, 0x03, 0xda, 0xd4, 0xb1, 0x59, 0x40, 0xa6, 0x1e, 0x73, 0x4c, 0xa3, 0x69, 0xec, 0x97,
+	0xf0, 0x9d, 0x8d, 0xa7, 0x9f, 0x38, 0xd0, 0xfb, 0xb0, 0x47, 0x62, 0xc9, 0xed, 0x88, 0xf1, 0x90,
+	0x05, 0x36, 0x9d, 0x93, 0x20, 0x60, 0x9e, 0x30, 0xf3, 0x3a, 0x00, 0x29, 0x1f, 0xd6, 0xae, 0x5e,
+	0xea, 0x41, 0x4f, 0xe0, 0x81, 0x4f, 0x96, 0x76, 0x52, 0xdf, 0x8e, 0x98, 0x88, 0x3d, 0x29, 0xec,
+	0x90, 0x45, 0x36, 0xff, 0x2e, 0x60, 0x91, 0xb9, 0xd5, 0x34, 0xf6, 0x0b, 0xd8, 0xf4, 0xc9, 0x72,
+	0xa8, 0x9f, 0xe0, 0xe4, 0xc5, 0x90, 0x45, 0x27, 0xca, 0x8f, 0x1e, 0xc3, 0x5b, 0x2a, 0x5e, 0xd0,
+	0x39, 0x73, 0x62, 0x8f, 0x39, 0xb6, 0x5c, 0x26, 0xe1, 0x53, 0x8f, 0xd3, 0x53, 0xb3, 0xa0, 0xc3,
+	0xef, 0xf9, 0x64, 0x39, 0x5a, 0xbd, 0x18, 0x2f, 0x55, 0xf4, 0x91, 0x72, 0xb7, 0x7e, 0x30, 0xa0,
+	0xb4, 0x72, 0xa0, 0xbb, 0x50, 0x9c, 0x33, 0x77, 0x36, 0x97, 0x7a, 0xbe, 0x02, 0x4e, 0x2d, 0xf4,
+	0x00, 0xca, 0xd2, 0xf5, 0x99, 0x90, 0xc4, 0x0f, 0xf5, 0x24, 0x05, 0xbc, 0x01, 0x50, 0x1d, 0x4a,
+	0x9a, 0xd3, 0x05, 0xf1, 0xd2, 0x66, 0xd7, 0x36, 0x7a, 0x0f, 0x76, 0x55, 0x73, 0x6c, 0xc9, 0x68,
+	0xac, 0xe9, 0x4f, 0xfb, 0xd9, 0xf1, 0xc9, 0xb2, 0xbf, 0x06, 0x5b, 0x7f, 0xe7, 0xa1, 0x92, 0x69,
+	0x0f, 0xed, 0x42, 0xde, 0x75, 0xd2, 0x26, 0xf2, 0xae, 0x83, 0xf6, 0xe0, 0x56, 0x42, 0x86, 0x2a,
+	0x5e, 0xc6, 0x89, 0x81, 0xde, 0x85, 0x1d, 0xca, 0x83, 0x80, 0x51, 0x95, 0xc4, 0x76, 0x1d, 0x5d,
+	0xbd, 0x8c, 0xab, 0x1b, 0x70, 0xe0, 0xa0, 0x53, 0xa8, 0xa4, 0xd4, 0x3a, 0x44, 0x12, 0x5d, 0xbe,
+	0xd2, 0x3d, 0xee, 0xbc, 0x94, 0xc0, 0x16, 0x07, 0x9d, 0xc1, 0x1a, 0x3e, 0x4c, 0xd0, 0x64, 0x09,
+	0xc7, 0x44, 0x92, 0xa3, 0xc2, 0xf3, 0x3f, 0xde, 0xc9, 0x61, 0x08, 0xd7, 0x08, 0x7a, 0x08, 0xb5,
+	0x88, 0x79, 0x44, 0xba, 0x0b, 0x66, 0x2b, 0x82, 0x78, 0x2c, 0xcd, 0x5b, 0x7a, 0x8a, 0xdb, 0x2b,
+	0x7c, 0x9c, 0xc0, 0xe8, 0x1b, 0x28, 0xad, 0x56, 0x66, 0x16, 0x75, 0x53, 0x8f, 0x3b, 0xff, 0x5d,
+	0xf5, 0x9d, 0x15, 0x6b, 0x69, 0x33, 0xeb, 0x9c, 0xa8, 0x01, 0x90, 0x61, 0x7d, 0x5b, 0x37, 0x91,
+	0x41, 0x5a, 0xbf, 0x1a, 0xb0, 0x37, 0x64, 0x81, 0xe3, 0x06, 0xb3, 0x54, 0x8a, 0x89, 0x2e, 0xd1,
+	0x3d, 0xd8, 0x0e, 0x79, 0x24, 0xed, 0x74, 0x01, 0x65, 0x5c, 0x54, 0xe6, 0xc0, 0xb9, 0x4e, 0x77,
+	0xfe, 0x06, 0xba, 0x1f, 0xc1, 0x1d, 0xea, 0x71, 0xc1, 0x9c, 0x95, 0xf4, 0x37, 0x7b, 0xb9, 0x9d,
+	0x38, 0xd2, 0x6a, 0x03, 0x07, 0xbd, 0x0d, 0x90, 0x79, 0x54, 0xd0, 0x8f, 0xca, 0x74, 0xed, 0x36,
+	0x61, 0x7b, 0xc1, 0x22, 0xe1, 0xf2, 0x40, 0x73, 0x58, 0xc6, 0x2b, 0xb3, 0x35, 0x85, 0x6a, 0xba,
+	0x8d, 0xe4, 0x2f, 0xf0, 0xff, 0x5a, 0x5e, 0x8b, 0x6b, 0x2b, 0x23, 0xae, 0xd6, 0xcf, 0x5b, 0x50,
+	0xcd, 0xfe, 0xe1, 0x5e, 0xa7, 0x26, 0xff, 0x65, 0xf0, 0x3a, 0x94, 0x04, 0xfb, 0x36, 0x66, 0x01,
+	0x65, 0xa9, 0x7a, 0xd6, 0x36, 0xfa, 0x0a, 0x8a, 0x42, 0x12, 0x19, 0x0b, 0x2d, 0x9a, 0xdd, 0xee,
+	0x67, 0xaf, 0x22, 0x9a, 0x64, 0xae, 0x91, 0xce, 0x83, 0xd3, 0x7c, 0x6a, 0x1e, 0x16, 0x45, 0x3c,
+	0xd2, 0x5a, 0x29, 0xe3, 0xc4, 0x40, 0xcf, 0x60, 0xc7, 0x17, 0x33, 0x75, 0x96, 0x42, 0x1e, 0x08,
+	0x26, 0xcc, 0x52, 0x73, 0x6b, 0xbf, 0xd2, 0xfd, 0xf4, 0x55, 0xca, 0x3e, 0x15, 0x33, 0x9c, 0xe6,
+	0x49, 0xe5, 0x5a, 0xf5, 0x37, 0x90, 0x50, 0xe7, 0x27, 0x39, 0x7f, 0x66, 0xb9, 0x69, 0xec, 0x57,
+	0x71, 0x6a, 0x65, 0xce, 0x12, 0x64, 0xcf, 0x52, 0xeb, 0x09, 0x54, 0x32, 0x29, 0xd1, 0x7d, 0x28,
+	0xc9, 0xef, 0x43, 0x66, 0xc7, 0x91, 0x97, 0xca, 0x60, 0x5b, 0xd9, 0x93, 0xc8, 0x53, 0xb3, 0x2d,
+	0x88, 0x17, 0x33, 0xbd, 0xab, 0x2a, 0x4e, 0x8c, 0x47, 0xbf, 0x19, 0x50, 0xcd, 0x52, 0x81, 0x3e,
+	0x82, 0xfb, 0xc3, 0xc3, 0xde, 0x17, 0xfd, 0xb1, 0x3d, 0x1a, 0x1f, 0x8e, 0x27, 0x23, 0x7b, 0xf2,
+	0xe5, 0x68, 0xd8, 0xef, 0x0d, 0x3e, 0x1f, 0xf4, 0x8f, 0x6b, 0xb9, 0xfa, 0xdd, 0xb3, 0xf3, 0x26,
+	0xba, 0xee, 0x41, 0x6d, 0x78, 0xf3, 0x6a, 0xd8, 0x68, 0xd2, 0xeb, 0xf5, 0x47, 0xa3, 0x9a, 0x51,
+	0x47, 0x67, 0xe7, 0xcd, 0xdd, 0xab, 0x28, 0x7a, 0x08, 0x6f, 0x5c, 0x7d, 0xde, 0xc7, 0xf8, 0x04,
+	0xd7, 0xf2, 0xf5, 0xda, 0xd9, 0x79, 0xb3, 0x9a, 0xc5, 0xae, 0x67, 0x1e, 0x0f, 0x9e, 0xf6, 0x4f,
+	0x26, 0xe3, 0xda, 0xd6, 0x95, 0xcc, 0x29, 0x5a, 0x2f, 0xfc, 0xf8, 0x4b, 0x23, 0x77, 0xf4, 0xec,
+	0xf9, 0x45, 0xc3, 0x78, 0x71, 0xd1, 0x30, 0xfe, 0xbc, 0x68, 0x18, 0x3f, 0x5d, 0x36, 0x72, 0x2f,
+	0x2e, 0x1b, 0xb9, 0xdf, 0x2f, 0x1b, 0xb9, 0xaf, 0x87, 0x33, 0x57, 0xce, 0xe3, 0x69, 0x87, 0x72,
+	0xdf, 0xa2, 0x5c, 0xf8, 0x5c, 0x58, 0xee, 0x94, 0xb6, 0x67, 0xdc, 0x5a, 0x7c, 0x62, 0xf9, 0x5c,
+	0xdd, 0x0d, 0xa1, 0x3e, 0x96, 0xc2, 0xea, 0x7e, 0xdc, 0xde, 0xec, 0xb3, 0x7d, 0xd3, 0x67, 0x5b,
+	0x51, 0x2b, 0xa6, 0x45, 0xfd, 0xbd, 0xfc, 0xe0, 0x9f, 0x01, 0x00, 0x9f, 0x53, 0xe1, 0xc4, 0xf6,
+	0x07, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxScheduledTxsPerBlock != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.MaxScheduledTxsPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxPacketResultsPerOwner != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.MaxPacketResultsPerOwner))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AutoReopenChannels {
+		i--
+		if m.AutoReopenChannels {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ControllerEnabled {
+		i--
+		if m.ControllerEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Schedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxExecutions != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.MaxExecutions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Interval != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executions != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintController(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.RelativeTimeout != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.RelativeTimeout))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.PacketData.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintController(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingChannelReopen) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingChannelReopen) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingChannelReopen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ClosedChannelId) > 0 {
+		i -= len(m.ClosedChannelId)
+		copy(dAtA[i:], m.ClosedChannelId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ClosedChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.MsgResponses) > 0 {
+		for iNdEx := len(m.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintController(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Status != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintController(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintController(dAtA []byte, offset int, v uint64) int {
+	offset -= sovController(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ControllerEnabled {
+		n += 2
+	}
+	if m.AutoReopenChannels {
+		n += 2
+	}
+	if m.MaxPacketResultsPerOwner != 0 {
+		n += 1 + sovController(uint64(m.MaxPacketResultsPerOwner))
+	}
+	if m.MaxScheduledTxsPerBlock != 0 {
+		n += 1 + sovController(uint64(m.MaxScheduledTxsPerBlock))
+	}
+	return n
+}
+
+func (m *Schedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovController(uint64(m.Height))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovController(uint64(m.Timestamp))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovController(uint64(m.Interval))
+	}
+	if m.MaxExecutions != 0 {
+		n += 1 + sovController(uint64(m.MaxExecutions))
+	}
+	return n
+}
+
+func (m *ScheduledTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovController(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = m.PacketData.Size()
+	n += 1 + l + sovController(uint64(l))
+	if m.RelativeTimeout != 0 {
+		n += 1 + sovController(uint64(m.RelativeTimeout))
+	}
+	l = m.Schedule.Size()
+	n += 1 + l + sovController(uint64(l))
+	if m.Executions != 0 {
+		n += 1 + sovController(uint64(m.Executions))
+	}
+	return n
+}
+
+func (m *PendingChannelReopen) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.ClosedChannelId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	return n
+}
+
+func (m *PacketResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovController(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovController(uint64(m.Sequence))
+	}
+	if m.Status != 0 {
+		n += 1 + sovController(uint64(m.Status))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	if len(m.MsgResponses) > 0 {
+		for _, e := range m.MsgResponses {
+			l = e.Size()
+			n += 1 + l + sovController(uint64(l))
+		}
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovController(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MsgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	return n
+}
+
+func sovController(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozController(x uint64) (n int) {
+	return sovController(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ControllerEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoReopenChannels", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoReopenChannels = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketResultsPerOwner", wireType)
+			}
+			m.MaxPacketResultsPerOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketResultsPerOwner |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScheduledTxsPerBlock", wireType)
+			}
+			m.MaxScheduledTxsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxScheduledTxsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Schedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Schedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutions", wireType)
+			}
+			m.MaxExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelativeTimeout", wireType)
+			}
+			m.RelativeTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelativeTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingChannelReopen) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingChannelReopen: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingChannelReopen: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClosedChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ControllerEnabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PacketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResponses = append(m.MsgResponses, MsgResponse{})
+			if err := m.MsgResponses[len(m.MsgResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipController(dAtA[iNdEx:])
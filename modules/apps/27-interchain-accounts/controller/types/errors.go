@@ -7,4 +7,9 @@ import (
 // ICA Controller sentinel errors
 var (
 	ErrControllerSubModuleDisabled = errorsmod.Register(SubModuleName, 2, "controller submodule is disabled")
+	ErrInvalidSchedule             = errorsmod.Register(SubModuleName, 3, "invalid schedule")
+	ErrScheduledTxNotFound         = errorsmod.Register(SubModuleName, 4, "scheduled transaction not found")
+	ErrMaxScheduledTxs             = errorsmod.Register(SubModuleName, 5, "maximum number of scheduled transactions reached")
+	ErrInvalidOwnershipTransfer    = errorsmod.Register(SubModuleName, 6, "invalid ownership transfer")
+	ErrPacketResultNotFound        = errorsmod.Register(SubModuleName, 7, "packet result not found")
 )
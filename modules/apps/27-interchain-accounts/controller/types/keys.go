@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// SubModuleName defines the interchain accounts controller module name
 	SubModuleName = "icacontroller"
@@ -9,4 +15,116 @@ const (
 
 	// ParamsKey is the store key for the interchain accounts controller parameters
 	ParamsKey = "params"
+
+	// ScheduledTxKeyPrefix defines the key prefix used to store the scheduled transactions
+	ScheduledTxKeyPrefix = "scheduledTx"
+
+	// ScheduledTxOwnerKeyPrefix defines the key prefix used to index the scheduled transactions by owner
+	ScheduledTxOwnerKeyPrefix = "scheduledTxOwner"
+
+	// ScheduledTxHeightQueueKeyPrefix defines the key prefix used to queue the scheduled transactions by height
+	ScheduledTxHeightQueueKeyPrefix = "scheduledTxHeightQueue"
+
+	// ScheduledTxTimeQueueKeyPrefix defines the key prefix used to queue the scheduled transactions by timestamp
+	ScheduledTxTimeQueueKeyPrefix = "scheduledTxTimeQueue"
+
+	// NextScheduledTxIDKey is the store key for the identifier of the next scheduled transaction
+	NextScheduledTxIDKey = "nextScheduledTxID"
+
+	// PendingChannelReopenKeyPrefix defines the key prefix used to store the pending channel reopenings
+	PendingChannelReopenKeyPrefix = "pendingChannelReopen"
+
+	// AccountOwnerKeyPrefix defines the key prefix used to store the owners of interchain accounts whose ownership has
+	// been transferred
+	AccountOwnerKeyPrefix = "accountOwner"
+
+	// OwnerPortKeyPrefix defines the key prefix used to index the controller ports of the transferred interchain
+	// accounts by owner
+	OwnerPortKeyPrefix = "ownerPort"
+
+	// PacketResultKeyPrefix defines the key prefix used to store the packet results
+	PacketResultKeyPrefix = "packetResult"
+
+	// PacketResultQueueKeyPrefix defines the key prefix used to queue the packet results of an owner in the order of
+	// recording
+	PacketResultQueueKeyPrefix = "packetResultQueue"
+
+	// NextPacketResultIDKey is the store key for the identifier of the next packet result
+	NextPacketResultIDKey = "nextPacketResultID"
 )
+
+// ScheduledTxKey returns the store key under which the scheduled transaction with the given identifier is stored
+func ScheduledTxKey(id uint64) []byte {
+	return append([]byte(ScheduledTxKeyPrefix+"/"), sdk.Uint64ToBigEndian(id)...)
+}
+
+// ScheduledTxOwnerPrefix returns the store key prefix under which the scheduled transactions of the owner are indexed
+func ScheduledTxOwnerPrefix(owner string) []byte {
+	return []byte(ScheduledTxOwnerKeyPrefix + "/" + owner + "/")
+}
+
+// ScheduledTxOwnerKey returns the store key under which the scheduled transaction of the owner is indexed
+func ScheduledTxOwnerKey(owner string, id uint64) []byte {
+	return append(ScheduledTxOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// ScheduledTxHeightQueueKey returns the store key under which the scheduled transaction is queued to be sent at the
+// given height. The queue is ordered by height.
+func ScheduledTxHeightQueueKey(height, id uint64) []byte {
+	key := append([]byte(ScheduledTxHeightQueueKeyPrefix+"/"), sdk.Uint64ToBigEndian(height)...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// ScheduledTxTimeQueueKey returns the store key under which the scheduled transaction is queued to be sent at or
+// after the given timestamp. The queue is ordered by timestamp.
+func ScheduledTxTimeQueueKey(timestamp, id uint64) []byte {
+	key := append([]byte(ScheduledTxTimeQueueKeyPrefix+"/"), sdk.Uint64ToBigEndian(timestamp)...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// PendingChannelReopenKey returns the store key under which the pending channel reopening of the interchain account
+// with the given port and connection identifiers is stored
+func PendingChannelReopenKey(portID, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", PendingChannelReopenKeyPrefix, portID, connectionID))
+}
+
+// AccountOwnerKey returns the store key under which the owner of the interchain account with the given port and
+// connection identifiers is stored
+func AccountOwnerKey(portID, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", AccountOwnerKeyPrefix, portID, connectionID))
+}
+
+// OwnerPortKey returns the store key under which the controller port of the interchain account transferred to the
+// owner on the given connection is indexed
+func OwnerPortKey(owner, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", OwnerPortKeyPrefix, owner, connectionID))
+}
+
+// PacketResultOwnerPrefix returns the store key prefix under which the packet results of the owner are stored
+func PacketResultOwnerPrefix(owner string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", PacketResultKeyPrefix, owner))
+}
+
+// PacketResultConnectionPrefix returns the store key prefix under which the packet results of the owner on the given
+// connection are stored
+func PacketResultConnectionPrefix(owner, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/", PacketResultKeyPrefix, owner, connectionID))
+}
+
+// PacketResultKey returns the store key under which the result of the packet with the given sequence, sent by the
+// owner on the given connection and channel, is stored
+func PacketResultKey(owner, connectionID, channelID string, sequence uint64) []byte {
+	key := append(PacketResultConnectionPrefix(owner, connectionID), []byte(channelID+"/")...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// PacketResultQueuePrefix returns the store key prefix under which the packet results of the owner are queued
+func PacketResultQueuePrefix(owner string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", PacketResultQueueKeyPrefix, owner))
+}
+
+// PacketResultQueueKey returns the store key under which the packet result with the given identifier is queued. The
+// queue is ordered by identifier, and thus by order of recording.
+func PacketResultQueueKey(owner string, id uint64) []byte {
+	return append(PacketResultQueuePrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
@@ -20,10 +20,16 @@ var (
 	_ sdk.Msg = (*MsgRegisterInterchainAccount)(nil)
 	_ sdk.Msg = (*MsgSendTx)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgScheduleTx)(nil)
+	_ sdk.Msg = (*MsgCancelScheduledTx)(nil)
+	_ sdk.Msg = (*MsgTransferOwnership)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgRegisterInterchainAccount)(nil)
 	_ sdk.HasValidateBasic = (*MsgSendTx)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgScheduleTx)(nil)
+	_ sdk.HasValidateBasic = (*MsgCancelScheduledTx)(nil)
+	_ sdk.HasValidateBasic = (*MsgTransferOwnership)(nil)
 )
 
 // NewMsgRegisterInterchainAccount creates a new instance of MsgRegisterInterchainAccount
@@ -109,3 +115,88 @@ func (msg MsgUpdateParams) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgScheduleTx creates a new instance of MsgScheduleTx
+func NewMsgScheduleTx(owner, connectionID string, relativeTimeoutTimestamp uint64, packetData icatypes.InterchainAccountPacketData, schedule Schedule) *MsgScheduleTx {
+	return &MsgScheduleTx{
+		ConnectionId:    connectionID,
+		Owner:           owner,
+		RelativeTimeout: relativeTimeoutTimestamp,
+		PacketData:      packetData,
+		Schedule:        schedule,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgScheduleTx) ValidateBasic() error {
+	sendTx := NewMsgSendTx(msg.Owner, msg.ConnectionId, msg.RelativeTimeout, msg.PacketData)
+	if err := sendTx.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return msg.Schedule.Validate()
+}
+
+// NewMsgCancelScheduledTx creates a new instance of MsgCancelScheduledTx
+func NewMsgCancelScheduledTx(owner string, id uint64) *MsgCancelScheduledTx {
+	return &MsgCancelScheduledTx{
+		Owner: owner,
+		Id:    id,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgCancelScheduledTx) ValidateBasic() error {
+	if strings.TrimSpace(msg.Owner) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if len(msg.Owner) > MaximumOwnerLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "owner address must not exceed %d bytes", MaximumOwnerLength)
+	}
+
+	return nil
+}
+
+// NewMsgTransferOwnership creates a new instance of MsgTransferOwnership
+func NewMsgTransferOwnership(owner, connectionID, newOwner string) *MsgTransferOwnership {
+	return &MsgTransferOwnership{
+		Owner:        owner,
+		ConnectionId: connectionID,
+		NewOwner:     newOwner,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgTransferOwnership) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return errorsmod.Wrap(err, "invalid connection ID")
+	}
+
+	if strings.TrimSpace(msg.Owner) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if len(msg.Owner) > MaximumOwnerLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "owner address must not exceed %d bytes", MaximumOwnerLength)
+	}
+
+	if strings.TrimSpace(msg.NewOwner) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "new owner address cannot be empty")
+	}
+
+	if len(msg.NewOwner) > MaximumOwnerLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "new owner address must not exceed %d bytes", MaximumOwnerLength)
+	}
+
+	if msg.NewOwner == msg.Owner {
+		return errorsmod.Wrap(ErrInvalidOwnershipTransfer, "new owner must be different from the owner")
+	}
+
+	// the new owner must be encodable in a controller port identifier, so that it cannot collide with the key separator
+	if err := host.PortIdentifierValidator(icatypes.ControllerPortPrefix + msg.NewOwner); err != nil {
+		return errorsmod.Wrap(err, "invalid new owner")
+	}
+
+	return nil
+}
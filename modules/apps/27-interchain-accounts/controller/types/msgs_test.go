@@ -234,6 +234,150 @@ func TestMsgSendTxGetSigners(t *testing.T) {
 	require.Equal(t, expSigner.Bytes(), signers[0])
 }
 
+func TestMsgScheduleTxValidateBasic(t *testing.T) {
+	var msg *types.MsgScheduleTx
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: height schedule",
+			func() {},
+			nil,
+		},
+		{
+			"success: recurring time schedule",
+			func() {
+				msg.Schedule = types.NewTimeSchedule(1000, 100, 10)
+			},
+			nil,
+		},
+		{
+			"owner address is empty",
+			func() {
+				msg.Owner = ""
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"relative timeout is not set",
+			func() {
+				msg.RelativeTimeout = 0
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"messages array is empty",
+			func() {
+				msg.PacketData = icatypes.InterchainAccountPacketData{}
+			},
+			icatypes.ErrInvalidOutgoingData,
+		},
+		{
+			"neither height nor timestamp is set",
+			func() {
+				msg.Schedule = types.Schedule{}
+			},
+			types.ErrInvalidSchedule,
+		},
+		{
+			"both height and timestamp are set",
+			func() {
+				msg.Schedule.Timestamp = 1000
+			},
+			types.ErrInvalidSchedule,
+		},
+		{
+			"max executions set without an interval",
+			func() {
+				msg.Schedule.MaxExecutions = 10
+			},
+			types.ErrInvalidSchedule,
+		},
+	}
+
+	for i, tc := range testCases {
+		i, tc := i, tc
+
+		packetData := icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: []byte("data"),
+		}
+
+		msg = types.NewMsgScheduleTx(
+			ibctesting.TestAccAddress,
+			ibctesting.FirstConnectionID,
+			100000,
+			packetData,
+			types.NewHeightSchedule(100, 0, 0),
+		)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expErr == nil {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestMsgCancelScheduledTxValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgCancelScheduledTx
+		expErr error
+	}{
+		{"success", types.NewMsgCancelScheduledTx(ibctesting.TestAccAddress, 1), nil},
+		{"failure: empty owner", types.NewMsgCancelScheduledTx("", 1), ibcerrors.ErrInvalidAddress},
+		{"failure: owner too long", types.NewMsgCancelScheduledTx(ibctesting.GenerateString(types.MaximumOwnerLength+1), 1), ibcerrors.ErrInvalidAddress},
+	}
+
+	for i, tc := range testCases {
+		i, tc := i, tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expErr == nil {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestMsgTransferOwnershipValidateBasic(t *testing.T) {
+	newOwner := sdk.AccAddress("new-owner").String()
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgTransferOwnership
+		expErr error
+	}{
+		{"success", types.NewMsgTransferOwnership(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, newOwner), nil},
+		{"failure: invalid connection ID", types.NewMsgTransferOwnership(ibctesting.TestAccAddress, "", newOwner), host.ErrInvalidID},
+		{"failure: empty owner", types.NewMsgTransferOwnership("", ibctesting.FirstConnectionID, newOwner), ibcerrors.ErrInvalidAddress},
+		{"failure: owner too long", types.NewMsgTransferOwnership(ibctesting.GenerateString(types.MaximumOwnerLength+1), ibctesting.FirstConnectionID, newOwner), ibcerrors.ErrInvalidAddress},
+		{"failure: empty new owner", types.NewMsgTransferOwnership(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, " "), ibcerrors.ErrInvalidAddress},
+		{"failure: new owner too long", types.NewMsgTransferOwnership(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, ibctesting.GenerateString(types.MaximumOwnerLength+1)), ibcerrors.ErrInvalidAddress},
+		{"failure: new owner is the owner", types.NewMsgTransferOwnership(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, ibctesting.TestAccAddress), types.ErrInvalidOwnershipTransfer},
+		{"failure: new owner is not a valid port identifier", types.NewMsgTransferOwnership(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, "new/owner"), host.ErrInvalidID},
+	}
+
+	for i, tc := range testCases {
+		i, tc := i, tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expErr == nil {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
 // TestMsgUpdateParamsValidateBasic tests ValidateBasic for MsgUpdateParams
 func TestMsgUpdateParamsValidateBasic(t *testing.T) {
 	testCases := []struct {
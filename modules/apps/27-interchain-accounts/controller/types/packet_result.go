@@ -0,0 +1,39 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// Validate performs basic validation of the packet result
+func (r PacketResult) Validate() error {
+	if strings.TrimSpace(r.Owner) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if strings.Contains(r.Owner, "/") {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "owner address cannot contain the key separator")
+	}
+
+	if err := host.ConnectionIdentifierValidator(r.ConnectionId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return err
+	}
+
+	if r.Sequence == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidSequence, "packet sequence cannot be zero")
+	}
+
+	if _, ok := PacketStatus_name[int32(r.Status)]; !ok || r.Status == STATUS_UNSPECIFIED {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "invalid packet status %s", r.Status)
+	}
+
+	return nil
+}
@@ -3,6 +3,10 @@ package types
 const (
 	// DefaultControllerEnabled is the default value for the controller param (set to true)
 	DefaultControllerEnabled = true
+	// DefaultMaxPacketResultsPerOwner is the default maximum number of packet results recorded for an owner
+	DefaultMaxPacketResultsPerOwner = 100
+	// DefaultMaxScheduledTxsPerBlock is the default maximum number of scheduled transactions sent at the end of a block
+	DefaultMaxScheduledTxsPerBlock = 100
 )
 
 // NewParams creates a new parameter configuration for the controller submodule
@@ -14,5 +18,8 @@ func NewParams(enableController bool) Params {
 
 // DefaultParams is the default parameter configuration for the controller submodule
 func DefaultParams() Params {
-	return NewParams(DefaultControllerEnabled)
+	params := NewParams(DefaultControllerEnabled)
+	params.MaxPacketResultsPerOwner = DefaultMaxPacketResultsPerOwner
+	params.MaxScheduledTxsPerBlock = DefaultMaxScheduledTxsPerBlock
+	return params
 }
@@ -0,0 +1,80 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// MaxScheduledTxsPerOwner is the maximum number of scheduled transactions an owner may have at once
+const MaxScheduledTxsPerOwner = 100
+
+// NewHeightSchedule creates a new Schedule sending a transaction at the given height and, if the interval is
+// non-zero, every interval blocks after, at most maxExecutions times.
+func NewHeightSchedule(height, interval, maxExecutions uint64) Schedule {
+	return Schedule{
+		Height:        height,
+		Interval:      interval,
+		MaxExecutions: maxExecutions,
+	}
+}
+
+// NewTimeSchedule creates a new Schedule sending a transaction at or after the given timestamp and, if the interval
+// is non-zero, every interval nanoseconds after, at most maxExecutions times.
+func NewTimeSchedule(timestamp, interval, maxExecutions uint64) Schedule {
+	return Schedule{
+		Timestamp:     timestamp,
+		Interval:      interval,
+		MaxExecutions: maxExecutions,
+	}
+}
+
+// Validate performs basic validation of the schedule
+func (s Schedule) Validate() error {
+	if s.Height == 0 && s.Timestamp == 0 {
+		return errorsmod.Wrap(ErrInvalidSchedule, "either height or timestamp must be set")
+	}
+
+	if s.Height != 0 && s.Timestamp != 0 {
+		return errorsmod.Wrap(ErrInvalidSchedule, "height and timestamp cannot both be set")
+	}
+
+	if s.Interval == 0 && s.MaxExecutions != 0 {
+		return errorsmod.Wrap(ErrInvalidSchedule, "max executions cannot be set without an interval")
+	}
+
+	return nil
+}
+
+// NewScheduledTx creates a new ScheduledTx instance
+func NewScheduledTx(id uint64, msg *MsgScheduleTx) ScheduledTx {
+	return ScheduledTx{
+		Id:              id,
+		Owner:           msg.Owner,
+		ConnectionId:    msg.ConnectionId,
+		PacketData:      msg.PacketData,
+		RelativeTimeout: msg.RelativeTimeout,
+		Schedule:        msg.Schedule,
+	}
+}
+
+// Validate performs basic validation of the scheduled transaction
+func (tx ScheduledTx) Validate() error {
+	msg := NewMsgScheduleTx(tx.Owner, tx.ConnectionId, tx.RelativeTimeout, tx.PacketData, tx.Schedule)
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if tx.Schedule.MaxExecutions != 0 && tx.Executions >= tx.Schedule.MaxExecutions {
+		return errorsmod.Wrapf(ErrInvalidSchedule, "executions (%d) must be less than max executions (%d)", tx.Executions, tx.Schedule.MaxExecutions)
+	}
+
+	return nil
+}
+
+// IsCompleted returns true if the scheduled transaction has been sent as many times as its schedule defines
+func (tx ScheduledTx) IsCompleted() bool {
+	if tx.Schedule.Interval == 0 {
+		return tx.Executions > 0
+	}
+
+	return tx.Schedule.MaxExecutions != 0 && tx.Executions >= tx.Schedule.MaxExecutions
+}
@@ -0,0 +1,33 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
+)
+
+func TestScheduledTxIsCompleted(t *testing.T) {
+	testCases := []struct {
+		name         string
+		schedule     types.Schedule
+		executions   uint64
+		expCompleted bool
+	}{
+		{"one-off schedule not executed", types.NewHeightSchedule(10, 0, 0), 0, false},
+		{"one-off schedule executed", types.NewHeightSchedule(10, 0, 0), 1, true},
+		{"recurring schedule without max executions", types.NewTimeSchedule(10, 5, 0), 100, false},
+		{"recurring schedule below max executions", types.NewTimeSchedule(10, 5, 3), 2, false},
+		{"recurring schedule reaching max executions", types.NewTimeSchedule(10, 5, 3), 3, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			scheduledTx := types.ScheduledTx{Schedule: tc.schedule, Executions: tc.executions}
+			require.Equal(t, tc.expCompleted, scheduledTx.IsCompleted())
+		})
+	}
+}
@@ -272,6 +272,244 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgScheduleTx defines the payload for Msg/ScheduleTx
+type MsgScheduleTx struct {
+	Owner        string                             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	ConnectionId string                             `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	PacketData   types1.InterchainAccountPacketData `protobuf:"bytes,3,opt,name=packet_data,json=packetData,proto3" json:"packet_data"`
+	// Relative timeout timestamp provided will be added to the block time at which the transaction is sent.
+	// The timeout timestamp must be non-zero.
+	RelativeTimeout uint64 `protobuf:"varint,4,opt,name=relative_timeout,json=relativeTimeout,proto3" json:"relative_timeout,omitempty"`
+	// schedule defines when the transaction is sent.
+	Schedule Schedule `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *MsgScheduleTx) Reset()         { *m = MsgScheduleTx{} }
+func (m *MsgScheduleTx) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleTx) ProtoMessage()    {}
+func (*MsgScheduleTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{6}
+}
+func (m *MsgScheduleTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleTx.Merge(m, src)
+}
+func (m *MsgScheduleTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleTx proto.InternalMessageInfo
+
+// MsgScheduleTxResponse defines the response for MsgScheduleTx
+type MsgScheduleTxResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgScheduleTxResponse) Reset()         { *m = MsgScheduleTxResponse{} }
+func (m *MsgScheduleTxResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleTxResponse) ProtoMessage()    {}
+func (*MsgScheduleTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{7}
+}
+func (m *MsgScheduleTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleTxResponse.Merge(m, src)
+}
+func (m *MsgScheduleTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleTxResponse proto.InternalMessageInfo
+
+// MsgCancelScheduledTx defines the payload for Msg/CancelScheduledTx
+type MsgCancelScheduledTx struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Id    uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelScheduledTx) Reset()         { *m = MsgCancelScheduledTx{} }
+func (m *MsgCancelScheduledTx) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledTx) ProtoMessage()    {}
+func (*MsgCancelScheduledTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{8}
+}
+func (m *MsgCancelScheduledTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledTx.Merge(m, src)
+}
+func (m *MsgCancelScheduledTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledTx proto.InternalMessageInfo
+
+// MsgCancelScheduledTxResponse defines the response for MsgCancelScheduledTx
+type MsgCancelScheduledTxResponse struct {
+}
+
+func (m *MsgCancelScheduledTxResponse) Reset()         { *m = MsgCancelScheduledTxResponse{} }
+func (m *MsgCancelScheduledTxResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledTxResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{9}
+}
+func (m *MsgCancelScheduledTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledTxResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledTxResponse proto.InternalMessageInfo
+
+// MsgTransferOwnership defines the payload for Msg/TransferOwnership
+type MsgTransferOwnership struct {
+	Owner        string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// new_owner is the address to which the interchain account and its active channel are handed over.
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+}
+
+func (m *MsgTransferOwnership) Reset()         { *m = MsgTransferOwnership{} }
+func (m *MsgTransferOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferOwnership) ProtoMessage()    {}
+func (*MsgTransferOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{10}
+}
+func (m *MsgTransferOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferOwnership.Merge(m, src)
+}
+func (m *MsgTransferOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferOwnership proto.InternalMessageInfo
+
+// MsgTransferOwnershipResponse defines the response for MsgTransferOwnership
+type MsgTransferOwnershipResponse struct {
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+}
+
+func (m *MsgTransferOwnershipResponse) Reset()         { *m = MsgTransferOwnershipResponse{} }
+func (m *MsgTransferOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferOwnershipResponse) ProtoMessage()    {}
+func (*MsgTransferOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{11}
+}
+func (m *MsgTransferOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferOwnershipResponse.Merge(m, src)
+}
+func (m *MsgTransferOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferOwnershipResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRegisterInterchainAccount)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgRegisterInterchainAccount")
 	proto.RegisterType((*MsgRegisterInterchainAccountResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgRegisterInterchainAccountResponse")
@@ -279,6 +517,12 @@ func init() {
 	proto.RegisterType((*MsgSendTxResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgSendTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgScheduleTx)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgScheduleTx")
+	proto.RegisterType((*MsgScheduleTxResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgScheduleTxResponse")
+	proto.RegisterType((*MsgCancelScheduledTx)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgCancelScheduledTx")
+	proto.RegisterType((*MsgCancelScheduledTxResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgCancelScheduledTxResponse")
+	proto.RegisterType((*MsgTransferOwnership)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgTransferOwnership")
+	proto.RegisterType((*MsgTransferOwnershipResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgTransferOwnershipResponse")
 }
 
 func init() {
@@ -286,49 +530,61 @@ func init() {
 }
 
 var fileDescriptor_7def041328c84a30 = []byte{
-	// 671 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x4f, 0x13, 0x4d,
-	0x1c, 0xee, 0xbe, 0x94, 0x02, 0x03, 0x2f, 0xbc, 0xef, 0x86, 0x48, 0xd9, 0x68, 0xc1, 0xea, 0x01,
-	0x49, 0x98, 0x49, 0xeb, 0xbf, 0x58, 0xe3, 0x41, 0xc0, 0x43, 0x63, 0x1a, 0x9b, 0x15, 0x13, 0xe2,
-	0xa5, 0x99, 0xce, 0x4e, 0x86, 0x91, 0xee, 0xcc, 0x3a, 0x33, 0x5d, 0xf1, 0x66, 0x3c, 0x19, 0x0f,
-	0xc6, 0x83, 0x1f, 0x80, 0x8f, 0xc0, 0xdd, 0x0f, 0x20, 0x47, 0x8e, 0x9e, 0x8c, 0x81, 0x03, 0x37,
-	0x3f, 0x83, 0xd9, 0x3f, 0xdd, 0xa2, 0x20, 0xc1, 0xc2, 0x6d, 0x7f, 0xbf, 0x99, 0xe7, 0xf9, 0x3d,
-	0xcf, 0x33, 0xb3, 0x03, 0xee, 0xf3, 0x36, 0x41, 0x38, 0x08, 0x3a, 0x9c, 0x60, 0xc3, 0xa5, 0xd0,
-	0x88, 0x0b, 0x43, 0x15, 0xd9, 0xc0, 0x5c, 0xb4, 0x30, 0x21, 0xb2, 0x2b, 0x8c, 0x46, 0x44, 0x0a,
-	0xa3, 0x64, 0xa7, 0x43, 0x15, 0x0a, 0x2b, 0xc8, 0x6c, 0xc1, 0x40, 0x49, 0x23, 0xed, 0x2a, 0x6f,
-	0x13, 0x78, 0x14, 0x0c, 0x4f, 0x00, 0xc3, 0x3e, 0x18, 0x86, 0x15, 0x67, 0x9a, 0x49, 0x26, 0x63,
-	0x38, 0x8a, 0xbe, 0x12, 0x26, 0xe7, 0xd6, 0x99, 0x64, 0x84, 0x15, 0x14, 0x60, 0xb2, 0x49, 0x4d,
-	0x8a, 0x5a, 0x19, 0x40, 0xfc, 0x11, 0x35, 0x09, 0xc9, 0x0c, 0x91, 0xda, 0x97, 0x1a, 0xf9, 0x9a,
-	0x45, 0xeb, 0xbe, 0x66, 0xe9, 0xc2, 0xd5, 0x88, 0x9d, 0x48, 0x45, 0x11, 0xd9, 0xc0, 0x42, 0xd0,
-	0x4e, 0x0c, 0x4f, 0x3e, 0x93, 0x2d, 0xe5, 0xcf, 0x16, 0xb8, 0xdc, 0xd0, 0xcc, 0xa5, 0x8c, 0x6b,
-	0x43, 0x55, 0x3d, 0x9b, 0xfe, 0x30, 0x19, 0x6e, 0x4f, 0x83, 0x61, 0xf9, 0x4a, 0x50, 0x55, 0xb4,
-	0xe6, 0xad, 0x85, 0x31, 0x37, 0x29, 0xec, 0x6b, 0xe0, 0x5f, 0x22, 0x85, 0xa0, 0x24, 0x12, 0xdd,
-	0xe2, 0x5e, 0xf1, 0x9f, 0x78, 0x75, 0xa2, 0xdf, 0xac, 0x7b, 0x76, 0x11, 0x8c, 0x84, 0x54, 0x69,
-	0x2e, 0x45, 0x71, 0x28, 0x5e, 0xee, 0x95, 0xf6, 0x1d, 0x30, 0x2a, 0x95, 0x47, 0x15, 0x17, 0xac,
-	0x98, 0x9f, 0xb7, 0x16, 0x26, 0xab, 0x0e, 0x8c, 0x4e, 0x22, 0xd2, 0x0a, 0x7b, 0x02, 0xc3, 0x0a,
-	0x7c, 0x12, 0x6d, 0x72, 0xb3, 0xbd, 0xb5, 0xc9, 0x77, 0xdb, 0x73, 0xb9, 0xb7, 0x87, 0x3b, 0x8b,
-	0x89, 0x8c, 0xb2, 0x07, 0xae, 0x9f, 0x26, 0xde, 0xa5, 0x3a, 0x90, 0x42, 0x53, 0xfb, 0x0a, 0x00,
-	0x29, 0x6b, 0xa4, 0x35, 0x71, 0x32, 0x96, 0x76, 0xea, 0x9e, 0x3d, 0x03, 0x46, 0x02, 0xa9, 0x4c,
-	0xdf, 0x47, 0x21, 0x2a, 0xeb, 0x5e, 0x2d, 0x1f, 0xcd, 0x2b, 0xff, 0xb0, 0xc0, 0x58, 0x43, 0xb3,
-	0xa7, 0x54, 0x78, 0x6b, 0x5b, 0xe7, 0x09, 0x64, 0x13, 0x8c, 0x27, 0xa7, 0xdf, 0xf2, 0xb0, 0xc1,
-	0x71, 0x28, 0xe3, 0xd5, 0x55, 0x78, 0xa6, 0x3b, 0x18, 0x56, 0xe0, 0x31, 0x7f, 0xcd, 0x98, 0x6c,
-	0x15, 0x1b, 0xbc, 0x9c, 0xdf, 0xfd, 0x36, 0x97, 0x73, 0x41, 0x90, 0x75, 0xec, 0x1b, 0xe0, 0x3f,
-	0x45, 0x3b, 0xd8, 0xf0, 0x90, 0xb6, 0x0c, 0xf7, 0xa9, 0xec, 0x9a, 0x38, 0xeb, 0xbc, 0x3b, 0xd5,
-	0xeb, 0xaf, 0x25, 0xed, 0x63, 0xb1, 0xde, 0x06, 0xff, 0x67, 0x7e, 0xb3, 0x0c, 0x1d, 0x30, 0xaa,
-	0xe9, 0xcb, 0x2e, 0x15, 0x84, 0xc6, 0xd6, 0xf3, 0x6e, 0x56, 0xa7, 0x39, 0x7d, 0xb2, 0xc0, 0x54,
-	0x43, 0xb3, 0x67, 0x81, 0x87, 0x0d, 0x6d, 0x62, 0x85, 0x7d, 0x6d, 0x5f, 0x02, 0x05, 0xcd, 0x59,
-	0x3f, 0xae, 0xb4, 0xb2, 0xd7, 0x41, 0x21, 0x88, 0x77, 0xc4, 0x41, 0x8d, 0x57, 0x6b, 0xf0, 0xef,
-	0xff, 0x44, 0x98, 0xcc, 0x48, 0xbd, 0xa7, 0x7c, 0xb5, 0xa9, 0x9e, 0x99, 0x74, 0x54, 0x79, 0x16,
-	0xcc, 0xfc, 0xa6, 0xaa, 0xe7, 0xa9, 0xfa, 0x3e, 0x0f, 0x86, 0x1a, 0x9a, 0xd9, 0x5f, 0x2c, 0x30,
-	0xfb, 0xe7, 0x5f, 0xa0, 0x39, 0x88, 0xb6, 0xd3, 0xee, 0xa5, 0xb3, 0x7e, 0xd1, 0x8c, 0xd9, 0x29,
-	0x7d, 0xb0, 0x40, 0x21, 0xbd, 0xa8, 0x0f, 0x06, 0x1c, 0x92, 0xc0, 0x9d, 0x47, 0xe7, 0x82, 0x67,
-	0x82, 0xb6, 0x2d, 0x30, 0xf1, 0xcb, 0x8d, 0x58, 0x19, 0x90, 0xf7, 0x28, 0x89, 0xf3, 0xf8, 0x02,
-	0x48, 0x7a, 0x12, 0x9d, 0xe1, 0x37, 0x87, 0x3b, 0x8b, 0xd6, 0xf2, 0x8b, 0xdd, 0xfd, 0x92, 0xb5,
-	0xb7, 0x5f, 0xb2, 0xbe, 0xef, 0x97, 0xac, 0x8f, 0x07, 0xa5, 0xdc, 0xde, 0x41, 0x29, 0xf7, 0xf5,
-	0xa0, 0x94, 0x7b, 0xde, 0x64, 0xdc, 0x6c, 0x74, 0xdb, 0x90, 0x48, 0x1f, 0xa5, 0x6f, 0x2d, 0x6f,
-	0x93, 0x25, 0x26, 0x51, 0x78, 0x0f, 0xf9, 0xd2, 0xeb, 0x76, 0xa8, 0x8e, 0x5e, 0x71, 0x8d, 0xaa,
-	0x77, 0x97, 0xfa, 0x3a, 0x96, 0x4e, 0x7a, 0xc0, 0xcd, 0xeb, 0x80, 0xea, 0x76, 0x21, 0x7e, 0x7d,
-	0x6f, 0xfe, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x9e, 0x8a, 0x4a, 0x4c, 0xbd, 0x06, 0x00, 0x00,
+	// 856 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xbf, 0x8f, 0x1b, 0x45,
+	0x14, 0xf6, 0x38, 0x3e, 0xc7, 0xf7, 0x2e, 0xb9, 0x23, 0xab, 0x83, 0x73, 0x96, 0xc3, 0x09, 0x86,
+	0x22, 0x44, 0xf2, 0xae, 0x6c, 0x7e, 0x09, 0x43, 0x8a, 0xe4, 0x82, 0x84, 0x05, 0x56, 0xac, 0xe5,
+	0x90, 0x22, 0x0a, 0xac, 0xf1, 0xec, 0xb0, 0x1e, 0x62, 0xcf, 0x2c, 0x3b, 0xe3, 0xbd, 0xd0, 0x20,
+	0x44, 0x85, 0x84, 0x84, 0x28, 0xa8, 0xa8, 0xf2, 0x1f, 0x90, 0x9e, 0x3f, 0x80, 0x94, 0x11, 0x15,
+	0x15, 0x42, 0x77, 0x45, 0x3a, 0xfe, 0x06, 0xb4, 0xbf, 0xc6, 0xbe, 0xac, 0x13, 0x85, 0xb5, 0xab,
+	0x74, 0xfb, 0x66, 0xe6, 0x7d, 0xef, 0xfb, 0xbe, 0xb7, 0xf3, 0x34, 0xf0, 0x3e, 0x1b, 0x11, 0x1b,
+	0xfb, 0xfe, 0x84, 0x11, 0xac, 0x98, 0xe0, 0xd2, 0x66, 0x5c, 0xd1, 0x80, 0x8c, 0x31, 0xe3, 0x43,
+	0x4c, 0x88, 0x98, 0x71, 0x25, 0x6d, 0x22, 0xb8, 0x0a, 0xc4, 0x64, 0x42, 0x03, 0x3b, 0x6c, 0xdb,
+	0xea, 0xae, 0xe5, 0x07, 0x42, 0x09, 0xa3, 0xc3, 0x46, 0xc4, 0x5a, 0x4c, 0xb6, 0x96, 0x24, 0x5b,
+	0xf3, 0x64, 0x2b, 0x6c, 0x9b, 0xbb, 0x9e, 0xf0, 0x44, 0x9c, 0x6e, 0x47, 0x5f, 0x09, 0x92, 0xf9,
+	0xd6, 0x33, 0xd1, 0x08, 0xdb, 0xb6, 0x8f, 0xc9, 0x1d, 0xaa, 0xd2, 0xac, 0x83, 0x02, 0xe4, 0xe7,
+	0x51, 0x0a, 0xb2, 0x47, 0x84, 0x9c, 0x0a, 0x69, 0x4f, 0xa5, 0x17, 0xed, 0x4f, 0xa5, 0x97, 0x6e,
+	0xbc, 0x1a, 0xa1, 0x13, 0x11, 0x50, 0x9b, 0x8c, 0x31, 0xe7, 0x74, 0x12, 0xa7, 0x27, 0x9f, 0xc9,
+	0x91, 0xe6, 0xef, 0x08, 0xf6, 0xfb, 0xd2, 0x73, 0xa8, 0xc7, 0xa4, 0xa2, 0x41, 0x4f, 0x57, 0xbf,
+	0x9e, 0x14, 0x37, 0x76, 0x61, 0x43, 0x1c, 0x71, 0x1a, 0xd4, 0xd1, 0x65, 0x74, 0x65, 0xd3, 0x49,
+	0x02, 0xe3, 0x35, 0x38, 0x4f, 0x04, 0xe7, 0x94, 0x44, 0xa4, 0x87, 0xcc, 0xad, 0x97, 0xe3, 0xdd,
+	0x73, 0xf3, 0xc5, 0x9e, 0x6b, 0xd4, 0xe1, 0x6c, 0x48, 0x03, 0xc9, 0x04, 0xaf, 0x9f, 0x89, 0xb7,
+	0xb3, 0xd0, 0x78, 0x07, 0x6a, 0x22, 0x70, 0x69, 0xc0, 0xb8, 0x57, 0xaf, 0x5c, 0x46, 0x57, 0xb6,
+	0x3b, 0xa6, 0x15, 0x75, 0x22, 0xe2, 0x6a, 0x65, 0x04, 0xc3, 0xb6, 0x75, 0x2b, 0x3a, 0xe4, 0xe8,
+	0xb3, 0xdd, 0xed, 0x1f, 0xee, 0x5d, 0x2a, 0x7d, 0xff, 0xe8, 0xfe, 0xd5, 0x84, 0x46, 0xd3, 0x85,
+	0xd7, 0x9f, 0x46, 0xde, 0xa1, 0xd2, 0x17, 0x5c, 0x52, 0xe3, 0x15, 0x80, 0x14, 0x35, 0xe2, 0x9a,
+	0x28, 0xd9, 0x4c, 0x57, 0x7a, 0xae, 0xb1, 0x07, 0x67, 0x7d, 0x11, 0xa8, 0xb9, 0x8e, 0x6a, 0x14,
+	0xf6, 0xdc, 0x6e, 0x25, 0xaa, 0xd7, 0xfc, 0x17, 0xc1, 0x66, 0x5f, 0x7a, 0x9f, 0x52, 0xee, 0x1e,
+	0xde, 0x5d, 0xc5, 0x90, 0x3b, 0xb0, 0x95, 0x74, 0x7f, 0xe8, 0x62, 0x85, 0x63, 0x53, 0xb6, 0x3a,
+	0x37, 0xad, 0x67, 0xfa, 0x07, 0xc3, 0xb6, 0x95, 0xd3, 0x37, 0x88, 0xc1, 0x6e, 0x62, 0x85, 0x6f,
+	0x54, 0x1e, 0xfc, 0x7d, 0xa9, 0xe4, 0x80, 0xaf, 0x57, 0x8c, 0x37, 0xe0, 0x85, 0x80, 0x4e, 0xb0,
+	0x62, 0x21, 0x1d, 0x2a, 0x36, 0xa5, 0x62, 0xa6, 0x62, 0xaf, 0x2b, 0xce, 0x4e, 0xb6, 0x7e, 0x98,
+	0x2c, 0xe7, 0x6c, 0x7d, 0x1b, 0x2e, 0x68, 0xbd, 0xda, 0x43, 0x13, 0x6a, 0x92, 0x7e, 0x3d, 0xa3,
+	0x9c, 0xd0, 0x58, 0x7a, 0xc5, 0xd1, 0x71, 0xea, 0xd3, 0x2f, 0x08, 0x76, 0xfa, 0xd2, 0xfb, 0xcc,
+	0x77, 0xb1, 0xa2, 0x03, 0x1c, 0xe0, 0xa9, 0x34, 0x5e, 0x82, 0xaa, 0x64, 0xde, 0xdc, 0xae, 0x34,
+	0x32, 0x6e, 0x43, 0xd5, 0x8f, 0x4f, 0xc4, 0x46, 0x6d, 0x75, 0xba, 0xd6, 0xff, 0xbf, 0x89, 0x56,
+	0x52, 0x23, 0xd5, 0x9e, 0xe2, 0x75, 0x77, 0x32, 0x31, 0x69, 0xa9, 0xe6, 0x45, 0xd8, 0x7b, 0x8c,
+	0x55, 0xa6, 0xa9, 0xf9, 0x67, 0x19, 0xce, 0x47, 0x4a, 0xc9, 0x98, 0xba, 0xb3, 0x09, 0x7d, 0x2e,
+	0xbb, 0x6b, 0x7c, 0x01, 0x35, 0x99, 0x0a, 0xac, 0x6f, 0xc4, 0xa4, 0x3e, 0x28, 0x62, 0x76, 0x66,
+	0x52, 0x4a, 0x46, 0x63, 0xe6, 0xfe, 0x9e, 0x16, 0xbc, 0x78, 0xca, 0x53, 0xfd, 0x07, 0x6d, 0x43,
+	0x39, 0xbd, 0x7d, 0x15, 0xa7, 0xcc, 0xb2, 0xdb, 0xf5, 0x09, 0xec, 0xf6, 0xa5, 0x77, 0x80, 0x39,
+	0xa1, 0x93, 0x2c, 0xe9, 0xc9, 0xf7, 0x2c, 0xc1, 0x28, 0x6b, 0x8c, 0xc7, 0x8b, 0x37, 0x60, 0x7f,
+	0x19, 0x9a, 0xee, 0xf8, 0xb7, 0x71, 0xb5, 0xc3, 0x00, 0x73, 0xf9, 0x25, 0x0d, 0x6e, 0x45, 0x39,
+	0x72, 0xcc, 0xfc, 0x55, 0xfa, 0xfe, 0x32, 0x6c, 0x72, 0x7a, 0x34, 0x4c, 0xd2, 0x93, 0x41, 0x57,
+	0xe3, 0xf4, 0x28, 0xc6, 0xce, 0xf1, 0xbb, 0x06, 0xfb, 0xcb, 0xea, 0x6b, 0x8f, 0x16, 0x46, 0x11,
+	0xca, 0x8f, 0xa2, 0xce, 0x8f, 0x35, 0x38, 0xd3, 0x97, 0x9e, 0xf1, 0x07, 0x82, 0x8b, 0x4f, 0x9e,
+	0xd9, 0x83, 0x22, 0xfd, 0x7d, 0xda, 0x20, 0x35, 0x6f, 0xaf, 0x1b, 0x51, 0x0b, 0xfe, 0x09, 0x41,
+	0x35, 0x9d, 0xac, 0xd7, 0x0a, 0x16, 0x49, 0xd2, 0xcd, 0x0f, 0x57, 0x4a, 0xd7, 0x84, 0xee, 0x21,
+	0x38, 0x77, 0x6a, 0x84, 0x1d, 0x14, 0xc4, 0x5d, 0x04, 0x31, 0x3f, 0x5e, 0x03, 0x88, 0xa6, 0xf8,
+	0x2b, 0x02, 0x58, 0x98, 0x59, 0xd7, 0x8b, 0x0a, 0xd7, 0x10, 0x66, 0x6f, 0x65, 0x08, 0x4d, 0xee,
+	0x37, 0x04, 0x17, 0xf2, 0xb7, 0xf9, 0xa3, 0x82, 0x05, 0x72, 0x48, 0xe6, 0x60, 0x5d, 0x48, 0xa7,
+	0x18, 0xe7, 0x27, 0x42, 0x51, 0xc6, 0x39, 0x24, 0x73, 0xb0, 0x2e, 0xa4, 0x8c, 0xb1, 0xb9, 0xf1,
+	0xdd, 0xa3, 0xfb, 0x57, 0xd1, 0x8d, 0xaf, 0x1e, 0x1c, 0x37, 0xd0, 0xc3, 0xe3, 0x06, 0xfa, 0xe7,
+	0xb8, 0x81, 0x7e, 0x3e, 0x69, 0x94, 0x1e, 0x9e, 0x34, 0x4a, 0x7f, 0x9d, 0x34, 0x4a, 0x9f, 0x0f,
+	0x3c, 0xa6, 0xc6, 0xb3, 0x91, 0x45, 0xc4, 0xd4, 0x4e, 0x5f, 0x87, 0x6c, 0x44, 0x5a, 0x9e, 0xb0,
+	0xc3, 0xf7, 0xec, 0xa9, 0x88, 0x1c, 0x90, 0xd1, 0xbb, 0x53, 0xda, 0x9d, 0x77, 0x5b, 0x73, 0x32,
+	0xad, 0x65, 0x4f, 0x4e, 0xf5, 0x8d, 0x4f, 0xe5, 0xa8, 0x1a, 0xbf, 0x17, 0xdf, 0xfc, 0x6f, 0x00,
+	0x06, 0xfb, 0x30, 0x34, 0x6f, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -349,6 +605,12 @@ type MsgClient interface {
 	SendTx(ctx context.Context, in *MsgSendTx, opts ...grpc.CallOption) (*MsgSendTxResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ScheduleTx defines a rpc handler for MsgScheduleTx.
+	ScheduleTx(ctx context.Context, in *MsgScheduleTx, opts ...grpc.CallOption) (*MsgScheduleTxResponse, error)
+	// CancelScheduledTx defines a rpc handler for MsgCancelScheduledTx.
+	CancelScheduledTx(ctx context.Context, in *MsgCancelScheduledTx, opts ...grpc.CallOption) (*MsgCancelScheduledTxResponse, error)
+	// TransferOwnership defines a rpc handler for MsgTransferOwnership.
+	TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error)
 }
 
 type msgClient struct {
@@ -386,6 +648,33 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ScheduleTx(ctx context.Context, in *MsgScheduleTx, opts ...grpc.CallOption) (*MsgScheduleTxResponse, error) {
+	out := new(MsgScheduleTxResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Msg/ScheduleTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledTx(ctx context.Context, in *MsgCancelScheduledTx, opts ...grpc.CallOption) (*MsgCancelScheduledTxResponse, error) {
+	out := new(MsgCancelScheduledTxResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Msg/CancelScheduledTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error) {
+	out := new(MsgTransferOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Msg/TransferOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RegisterInterchainAccount defines a rpc handler for MsgRegisterInterchainAccount.
@@ -394,6 +683,12 @@ type MsgServer interface {
 	SendTx(context.Context, *MsgSendTx) (*MsgSendTxResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ScheduleTx defines a rpc handler for MsgScheduleTx.
+	ScheduleTx(context.Context, *MsgScheduleTx) (*MsgScheduleTxResponse, error)
+	// CancelScheduledTx defines a rpc handler for MsgCancelScheduledTx.
+	CancelScheduledTx(context.Context, *MsgCancelScheduledTx) (*MsgCancelScheduledTxResponse, error)
+	// TransferOwnership defines a rpc handler for MsgTransferOwnership.
+	TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -409,6 +704,15 @@ func (*UnimplementedMsgServer) SendTx(ctx context.Context, req *MsgSendTx) (*Msg
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleTx(ctx context.Context, req *MsgScheduleTx) (*MsgScheduleTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleTx not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledTx(ctx context.Context, req *MsgCancelScheduledTx) (*MsgCancelScheduledTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledTx not implemented")
+}
+func (*UnimplementedMsgServer) TransferOwnership(ctx context.Context, req *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -468,6 +772,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Msg/ScheduleTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleTx(ctx, req.(*MsgScheduleTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Msg/CancelScheduledTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledTx(ctx, req.(*MsgCancelScheduledTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Msg/TransferOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferOwnership(ctx, req.(*MsgTransferOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.interchain_accounts.controller.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -484,6 +842,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ScheduleTx",
+			Handler:    _Msg_ScheduleTx_Handler,
+		},
+		{
+			MethodName: "CancelScheduledTx",
+			Handler:    _Msg_CancelScheduledTx_Handler,
+		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _Msg_TransferOwnership_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/interchain_accounts/controller/v1/tx.proto",
@@ -718,54 +1088,276 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgScheduleTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRegisterInterchainAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Ordering != 0 {
-		n += 1 + sovTx(uint64(m.Ordering))
-	}
-	return n
+
+func (m *MsgScheduleTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterInterchainAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgScheduleTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.RelativeTimeout != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RelativeTimeout))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.PacketData.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterInterchainAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Ordering != 0 {
+		n += 1 + sovTx(uint64(m.Ordering))
+	}
+	return n
+}
+
+func (m *MsgRegisterInterchainAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
@@ -789,52 +1381,826 @@ func (m *MsgSendTx) Size() (n int) {
 	if m.RelativeTimeout != 0 {
 		n += 1 + sovTx(uint64(m.RelativeTimeout))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgSendTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PacketData.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.RelativeTimeout != 0 {
+		n += 1 + sovTx(uint64(m.RelativeTimeout))
+	}
+	l = m.Schedule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterInterchainAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterInterchainAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterInterchainAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			}
+			m.Ordering = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ordering |= types.Order(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterInterchainAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterInterchainAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterInterchainAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelativeTimeout", wireType)
+			}
+			m.RelativeTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelativeTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgSendTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovTx(uint64(m.Sequence))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgRegisterInterchainAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -857,10 +2223,10 @@ func (m *MsgRegisterInterchainAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterInterchainAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterInterchainAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -929,9 +2295,9 @@ func (m *MsgRegisterInterchainAccount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -941,29 +2307,49 @@ func (m *MsgRegisterInterchainAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			if err := m.PacketData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelativeTimeout", wireType)
+			}
+			m.RelativeTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelativeTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
-			m.Ordering = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -973,11 +2359,25 @@ func (m *MsgRegisterInterchainAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Ordering |= types.Order(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -999,7 +2399,7 @@ func (m *MsgRegisterInterchainAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterInterchainAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1022,49 +2422,17 @@ func (m *MsgRegisterInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterInterchainAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterInterchainAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1074,24 +2442,11 @@ func (m *MsgRegisterInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1113,7 +2468,7 @@ func (m *MsgRegisterInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendTx) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1136,10 +2491,10 @@ func (m *MsgSendTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1175,75 +2530,10 @@ func (m *MsgSendTx) Unmarshal(dAtA []byte) error {
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PacketData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelativeTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.RelativeTimeout = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1253,7 +2543,7 @@ func (m *MsgSendTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RelativeTimeout |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1279,7 +2569,7 @@ func (m *MsgSendTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendTxResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1302,31 +2592,12 @@ func (m *MsgSendTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1348,7 +2619,7 @@ func (m *MsgSendTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1371,15 +2642,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1407,13 +2678,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1423,24 +2694,55 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1463,7 +2765,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1486,12 +2788,44 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
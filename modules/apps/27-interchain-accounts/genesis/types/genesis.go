@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	controllertypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
 	hosttypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
@@ -77,6 +79,78 @@ func (gs ControllerGenesisState) Validate() error {
 		}
 	}
 
+	seenScheduledTxs := make(map[uint64]bool)
+	for _, tx := range gs.ScheduledTxs {
+		if err := tx.Validate(); err != nil {
+			return err
+		}
+
+		if tx.Id >= gs.NextScheduledTxId {
+			return fmt.Errorf("scheduled transaction ID (%d) must be less than the next scheduled transaction ID (%d)", tx.Id, gs.NextScheduledTxId)
+		}
+
+		if seenScheduledTxs[tx.Id] {
+			return fmt.Errorf("duplicate scheduled transaction ID (%d)", tx.Id)
+		}
+		seenScheduledTxs[tx.Id] = true
+	}
+
+	seenPendingReopens := make(map[string]bool)
+	for _, pendingReopen := range gs.PendingChannelReopens {
+		if err := pendingReopen.Validate(); err != nil {
+			return err
+		}
+
+		key := string(controllertypes.PendingChannelReopenKey(pendingReopen.PortId, pendingReopen.ConnectionId))
+		if seenPendingReopens[key] {
+			return fmt.Errorf("duplicate pending channel reopening for port ID (%s) and connection ID (%s)", pendingReopen.PortId, pendingReopen.ConnectionId)
+		}
+		seenPendingReopens[key] = true
+	}
+
+	seenAccountOwners := make(map[string]bool)
+	seenOwners := make(map[string]bool)
+	for _, accountOwner := range gs.AccountOwners {
+		if err := accountOwner.Validate(); err != nil {
+			return err
+		}
+
+		key := string(controllertypes.AccountOwnerKey(accountOwner.PortId, accountOwner.ConnectionId))
+		if seenAccountOwners[key] {
+			return fmt.Errorf("duplicate account owner for port ID (%s) and connection ID (%s)", accountOwner.PortId, accountOwner.ConnectionId)
+		}
+		seenAccountOwners[key] = true
+
+		ownerKey := string(controllertypes.OwnerPortKey(accountOwner.Owner, accountOwner.ConnectionId))
+		if seenOwners[ownerKey] {
+			return fmt.Errorf("owner (%s) owns more than one interchain account on connection ID (%s)", accountOwner.Owner, accountOwner.ConnectionId)
+		}
+		seenOwners[ownerKey] = true
+	}
+
+	seenPacketResults := make(map[string]bool)
+	seenPacketResultIDs := make(map[uint64]bool)
+	for _, packetResult := range gs.PacketResults {
+		if err := packetResult.Validate(); err != nil {
+			return err
+		}
+
+		if packetResult.Id >= gs.NextPacketResultId {
+			return fmt.Errorf("packet result ID (%d) must be less than the next packet result ID (%d)", packetResult.Id, gs.NextPacketResultId)
+		}
+
+		if seenPacketResultIDs[packetResult.Id] {
+			return fmt.Errorf("duplicate packet result ID (%d)", packetResult.Id)
+		}
+		seenPacketResultIDs[packetResult.Id] = true
+
+		key := string(controllertypes.PacketResultKey(packetResult.Owner, packetResult.ConnectionId, packetResult.ChannelId, packetResult.Sequence))
+		if seenPacketResults[key] {
+			return fmt.Errorf("duplicate packet result for owner (%s), connection ID (%s), channel ID (%s) and sequence (%d)", packetResult.Owner, packetResult.ConnectionId, packetResult.ChannelId, packetResult.Sequence)
+		}
+		seenPacketResults[key] = true
+	}
+
 	return nil
 }
 
@@ -124,5 +198,18 @@ func (gs HostGenesisState) Validate() error {
 		return err
 	}
 
+	seenAllowlists := make(map[string]bool)
+	for _, allowlist := range gs.MessageAllowlists {
+		if err := allowlist.Validate(); err != nil {
+			return err
+		}
+
+		key := string(hosttypes.MessageAllowlistKey(allowlist.ConnectionId, allowlist.PortId, allowlist.Address))
+		if seenAllowlists[key] {
+			return fmt.Errorf("duplicate message allowlist for connection ID (%s), port ID (%s), address (%s)", allowlist.ConnectionId, allowlist.PortId, allowlist.Address)
+		}
+		seenAllowlists[key] = true
+	}
+
 	return gs.Params.Validate()
 }
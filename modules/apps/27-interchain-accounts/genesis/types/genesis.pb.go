@@ -84,6 +84,14 @@ type ControllerGenesisState struct {
 	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts"`
 	Ports              []string                      `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
 	Params             types.Params                  `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	ScheduledTxs       []types.ScheduledTx           `protobuf:"bytes,5,rep,name=scheduled_txs,json=scheduledTxs,proto3" json:"scheduled_txs"`
+	// next_scheduled_tx_id is the identifier assigned to the next scheduled transaction.
+	NextScheduledTxId     uint64                       `protobuf:"varint,6,opt,name=next_scheduled_tx_id,json=nextScheduledTxId,proto3" json:"next_scheduled_tx_id,omitempty"`
+	PendingChannelReopens []types.PendingChannelReopen `protobuf:"bytes,7,rep,name=pending_channel_reopens,json=pendingChannelReopens,proto3" json:"pending_channel_reopens"`
+	AccountOwners         []types.AccountOwner         `protobuf:"bytes,8,rep,name=account_owners,json=accountOwners,proto3" json:"account_owners"`
+	PacketResults         []types.PacketResult         `protobuf:"bytes,9,rep,name=packet_results,json=packetResults,proto3" json:"packet_results"`
+	// next_packet_result_id is the identifier assigned to the next recorded packet result.
+	NextPacketResultId uint64 `protobuf:"varint,10,opt,name=next_packet_result_id,json=nextPacketResultId,proto3" json:"next_packet_result_id,omitempty"`
 }
 
 func (m *ControllerGenesisState) Reset()         { *m = ControllerGenesisState{} }
@@ -147,12 +155,55 @@ func (m *ControllerGenesisState) GetParams() types.Params {
 	return types.Params{}
 }
 
+func (m *ControllerGenesisState) GetScheduledTxs() []types.ScheduledTx {
+	if m != nil {
+		return m.ScheduledTxs
+	}
+	return nil
+}
+
+func (m *ControllerGenesisState) GetNextScheduledTxId() uint64 {
+	if m != nil {
+		return m.NextScheduledTxId
+	}
+	return 0
+}
+
+func (m *ControllerGenesisState) GetPendingChannelReopens() []types.PendingChannelReopen {
+	if m != nil {
+		return m.PendingChannelReopens
+	}
+	return nil
+}
+
+func (m *ControllerGenesisState) GetAccountOwners() []types.AccountOwner {
+	if m != nil {
+		return m.AccountOwners
+	}
+	return nil
+}
+
+func (m *ControllerGenesisState) GetPacketResults() []types.PacketResult {
+	if m != nil {
+		return m.PacketResults
+	}
+	return nil
+}
+
+func (m *ControllerGenesisState) GetNextPacketResultId() uint64 {
+	if m != nil {
+		return m.NextPacketResultId
+	}
+	return 0
+}
+
 // HostGenesisState defines the interchain accounts host genesis state
 type HostGenesisState struct {
 	ActiveChannels     []ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels"`
 	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts"`
 	Port               string                        `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
 	Params             types1.Params                 `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	MessageAllowlists  []types1.MessageAllowlist     `protobuf:"bytes,5,rep,name=message_allowlists,json=messageAllowlists,proto3" json:"message_allowlists"`
 }
 
 func (m *HostGenesisState) Reset()         { *m = HostGenesisState{} }
@@ -216,6 +267,13 @@ func (m *HostGenesisState) GetParams() types1.Params {
 	return types1.Params{}
 }
 
+func (m *HostGenesisState) GetMessageAllowlists() []types1.MessageAllowlist {
+	if m != nil {
+		return m.MessageAllowlists
+	}
+	return nil
+}
+
 // ActiveChannel contains a connection ID, port ID and associated active channel ID, as well as a boolean flag to
 // indicate if the channel is middleware enabled
 type ActiveChannel struct {
@@ -360,44 +418,57 @@ func init() {
 }
 
 var fileDescriptor_d4aa48c8e29a1947 = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x95, 0xcf, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x9b, 0xb4, 0x56, 0x3b, 0xfb, 0xc3, 0x65, 0x76, 0x5d, 0xc3, 0x8a, 0xb1, 0xd4, 0x83,
-	0xbd, 0x34, 0xa1, 0x55, 0x58, 0x14, 0x14, 0xba, 0x45, 0xd6, 0x82, 0x0b, 0x12, 0x2f, 0xe2, 0x25,
-	0x4c, 0x27, 0x43, 0x3a, 0x90, 0x64, 0x42, 0xde, 0xb4, 0xe2, 0x59, 0xc1, 0xa3, 0xfe, 0x09, 0xfe,
-	0x39, 0x7b, 0xdc, 0xa3, 0x27, 0x91, 0xf6, 0x0f, 0xf0, 0x2f, 0x10, 0x64, 0x26, 0xd9, 0xb6, 0xd6,
-	0x2a, 0x2d, 0x1e, 0x3d, 0x65, 0xe6, 0x7d, 0xf3, 0xbe, 0xef, 0x93, 0xf7, 0x92, 0x0c, 0x7a, 0xcc,
-	0x07, 0xd4, 0x25, 0x69, 0x1a, 0x71, 0x4a, 0x24, 0x17, 0x09, 0xb8, 0x3c, 0x91, 0x2c, 0xa3, 0x43,
-	0xc2, 0x13, 0x9f, 0x50, 0x2a, 0x46, 0x89, 0x04, 0x37, 0x64, 0x09, 0x03, 0x0e, 0xee, 0xb8, 0x7d,
-	0xb9, 0x74, 0xd2, 0x4c, 0x48, 0x81, 0x5d, 0x3e, 0xa0, 0xce, 0x62, 0xba, 0xb3, 0x22, 0xdd, 0xb9,
-	0xcc, 0x19, 0xb7, 0x8f, 0x0e, 0x42, 0x11, 0x0a, 0x9d, 0xeb, 0xaa, 0x55, 0x6e, 0x73, 0xd4, 0x5b,
-	0x8b, 0x82, 0x8a, 0x44, 0x66, 0x22, 0x8a, 0x58, 0xa6, 0x40, 0xe6, 0xbb, 0xc2, 0xe4, 0x78, 0x2d,
-	0x93, 0xa1, 0x00, 0xa9, 0xd2, 0xd5, 0x35, 0x4f, 0x6c, 0x7c, 0x34, 0xd1, 0xf6, 0x69, 0x8e, 0xf8,
-	0x52, 0x12, 0xc9, 0xf0, 0x07, 0x03, 0x59, 0x73, 0x7b, 0xbf, 0xc0, 0xf7, 0x41, 0x89, 0x96, 0x51,
-	0x37, 0x9a, 0x5b, 0x9d, 0x53, 0x67, 0xc3, 0x27, 0x77, 0x7a, 0x33, 0xc3, 0xc5, 0x5a, 0x27, 0x95,
-	0xf3, 0xaf, 0x77, 0x4a, 0xde, 0x21, 0x5d, 0xa9, 0xe2, 0x11, 0xc2, 0x0a, 0x74, 0x09, 0xc1, 0xd4,
-	0x08, 0xdd, 0x8d, 0x11, 0x9e, 0x09, 0x90, 0x2b, 0x8a, 0xef, 0x0d, 0x97, 0xe2, 0x8d, 0x1f, 0x26,
-	0x3a, 0x5c, 0xcd, 0x8b, 0x63, 0x74, 0x9d, 0x50, 0xc9, 0xc7, 0xcc, 0xa7, 0x43, 0x92, 0x24, 0x2c,
-	0x02, 0xcb, 0xa8, 0x97, 0x9b, 0x5b, 0x9d, 0x27, 0x1b, 0xe3, 0x74, 0xb5, 0x4f, 0x2f, 0xb7, 0x29,
-	0x58, 0x76, 0xc9, 0x62, 0x10, 0xf0, 0x3b, 0x03, 0xed, 0xaf, 0xb0, 0xb1, 0x4c, 0x5d, 0xf3, 0xf9,
-	0xc6, 0x35, 0x3d, 0x16, 0x72, 0x90, 0x2c, 0x63, 0x41, 0x7f, 0x76, 0x63, 0x37, 0xbf, 0xaf, 0x20,
-	0xc0, 0x7c, 0x59, 0x00, 0x7c, 0x80, 0xae, 0xa4, 0x22, 0x93, 0x60, 0x95, 0xeb, 0xe5, 0x66, 0xcd,
-	0xcb, 0x37, 0xf8, 0x15, 0xaa, 0xa6, 0x24, 0x23, 0x31, 0x58, 0x15, 0x3d, 0x90, 0x47, 0xeb, 0xd1,
-	0x2c, 0xbc, 0xb8, 0xe3, 0xb6, 0xf3, 0x42, 0x3b, 0x14, 0xb5, 0x0b, 0xbf, 0xc6, 0x77, 0x13, 0xed,
-	0x2d, 0x0f, 0xeb, 0xff, 0xec, 0x3c, 0x46, 0x15, 0xd5, 0x6c, 0xab, 0x5c, 0x37, 0x9a, 0x35, 0x4f,
-	0xaf, 0xb1, 0xb7, 0xd4, 0xf7, 0x07, 0xeb, 0xb1, 0xe8, 0x2f, 0xfe, 0x4f, 0x1d, 0xff, 0x6c, 0xa0,
-	0x9d, 0x5f, 0xba, 0x82, 0xef, 0xa2, 0x1d, 0x2a, 0x92, 0x84, 0x51, 0xe5, 0xe8, 0xf3, 0x40, 0x7f,
-	0xf8, 0x35, 0x6f, 0x7b, 0x1e, 0xec, 0x07, 0xf8, 0x26, 0xba, 0xaa, 0x90, 0x94, 0x6c, 0x6a, 0xb9,
-	0xaa, 0xb6, 0xfd, 0x00, 0xdf, 0x46, 0xa8, 0x98, 0x92, 0xd2, 0x72, 0xfa, 0x5a, 0x11, 0xe9, 0x07,
-	0xb8, 0x83, 0x6e, 0x70, 0xf0, 0x63, 0x1e, 0x04, 0x11, 0x7b, 0x43, 0x32, 0xe6, 0xb3, 0x84, 0x0c,
-	0x22, 0x16, 0xe8, 0x27, 0xba, 0xe6, 0xed, 0x73, 0x38, 0x9b, 0x69, 0x4f, 0x73, 0xa9, 0xf1, 0xde,
-	0x40, 0xb7, 0xfe, 0xd2, 0xc4, 0x7f, 0x04, 0xbe, 0xa7, 0xde, 0x2e, 0x6d, 0xe4, 0x93, 0x20, 0xc8,
-	0x18, 0x40, 0x41, 0xbd, 0x5b, 0x84, 0xbb, 0x79, 0xf4, 0x24, 0x3c, 0x9f, 0xd8, 0xc6, 0xc5, 0xc4,
-	0x36, 0xbe, 0x4d, 0x6c, 0xe3, 0xd3, 0xd4, 0x2e, 0x5d, 0x4c, 0xed, 0xd2, 0x97, 0xa9, 0x5d, 0x7a,
-	0x7d, 0x16, 0x72, 0x39, 0x1c, 0x0d, 0x1c, 0x2a, 0x62, 0x97, 0x0a, 0x88, 0x05, 0xa8, 0xe3, 0xa1,
-	0x15, 0x0a, 0x77, 0xfc, 0xd0, 0x8d, 0x45, 0x30, 0x8a, 0x18, 0xa8, 0x1f, 0x34, 0xb8, 0x9d, 0xe3,
-	0xd6, 0x7c, 0x42, 0xad, 0xdf, 0x8e, 0x19, 0xf9, 0x36, 0x65, 0x30, 0xa8, 0xea, 0xbf, 0xf3, 0xfd,
-	0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3b, 0x52, 0x16, 0x78, 0xa3, 0x06, 0x00, 0x00,
+	// 787 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x96, 0x41, 0x8f, 0xdb, 0x44,
+	0x14, 0xc7, 0xd7, 0x9b, 0x34, 0xdb, 0x4c, 0x37, 0xa5, 0x9d, 0xee, 0xb6, 0x56, 0x11, 0x21, 0x0a,
+	0x07, 0x72, 0x59, 0x5b, 0x09, 0x48, 0x15, 0x48, 0x14, 0xd2, 0x15, 0x6a, 0x23, 0xb1, 0xa2, 0x72,
+	0x39, 0x20, 0x2e, 0xd6, 0x64, 0x66, 0xe4, 0x0c, 0xd8, 0x33, 0x96, 0xdf, 0x24, 0xbb, 0x9c, 0x41,
+	0x70, 0x84, 0x8f, 0xc0, 0x87, 0xe0, 0x43, 0xf4, 0xd8, 0x23, 0x27, 0x84, 0x76, 0xf9, 0x20, 0x68,
+	0xc6, 0xe3, 0x8d, 0x1b, 0x02, 0x4a, 0xc2, 0x91, 0x53, 0xec, 0xf7, 0xfc, 0x7e, 0xff, 0xbf, 0xe7,
+	0xcd, 0x73, 0x06, 0x7d, 0x24, 0xa6, 0x34, 0x24, 0x79, 0x9e, 0x0a, 0x4a, 0xb4, 0x50, 0x12, 0x42,
+	0x21, 0x35, 0x2f, 0xe8, 0x8c, 0x08, 0x19, 0x13, 0x4a, 0xd5, 0x5c, 0x6a, 0x08, 0x13, 0x2e, 0x39,
+	0x08, 0x08, 0x17, 0xc3, 0xea, 0x32, 0xc8, 0x0b, 0xa5, 0x15, 0x0e, 0xc5, 0x94, 0x06, 0xf5, 0xf2,
+	0x60, 0x4d, 0x79, 0x50, 0xd5, 0x2c, 0x86, 0x0f, 0x8f, 0x12, 0x95, 0x28, 0x5b, 0x1b, 0x9a, 0xab,
+	0x12, 0xf3, 0xf0, 0x74, 0x23, 0x17, 0x54, 0x49, 0x5d, 0xa8, 0x34, 0xe5, 0x85, 0x31, 0xb2, 0xbc,
+	0x73, 0x90, 0x47, 0x1b, 0x41, 0x66, 0x0a, 0xb4, 0x29, 0x37, 0xbf, 0x65, 0x61, 0xff, 0xa7, 0x7d,
+	0x74, 0xf8, 0xb4, 0xb4, 0xf8, 0x42, 0x13, 0xcd, 0xf1, 0x8f, 0x1e, 0xf2, 0x97, 0xf8, 0xd8, 0xd9,
+	0x8f, 0xc1, 0x24, 0x7d, 0xaf, 0xe7, 0x0d, 0x6e, 0x8d, 0x9e, 0x06, 0x5b, 0xbe, 0x79, 0x70, 0x7a,
+	0x0d, 0xac, 0x6b, 0x3d, 0x69, 0xbe, 0xfc, 0xfd, 0xed, 0xbd, 0xe8, 0x3e, 0x5d, 0x9b, 0xc5, 0x73,
+	0x84, 0x8d, 0xd1, 0x15, 0x0b, 0xfb, 0xd6, 0xc2, 0x78, 0x6b, 0x0b, 0xcf, 0x14, 0xe8, 0x35, 0xe2,
+	0x77, 0x66, 0x2b, 0xf1, 0xfe, 0xaf, 0x07, 0xe8, 0xfe, 0x7a, 0xbf, 0x38, 0x43, 0x6f, 0x10, 0xaa,
+	0xc5, 0x82, 0xc7, 0x74, 0x46, 0xa4, 0xe4, 0x29, 0xf8, 0x5e, 0xaf, 0x31, 0xb8, 0x35, 0x7a, 0xbc,
+	0xb5, 0x9d, 0xb1, 0xe5, 0x9c, 0x96, 0x18, 0xe7, 0xe5, 0x36, 0xa9, 0x07, 0x01, 0x7f, 0xe7, 0xa1,
+	0x7b, 0x6b, 0x30, 0xfe, 0xbe, 0xd5, 0xfc, 0x6c, 0x6b, 0xcd, 0x88, 0x27, 0x02, 0x34, 0x2f, 0x38,
+	0x9b, 0x5c, 0x3f, 0x38, 0x2e, 0x9f, 0x73, 0x0e, 0xb0, 0x58, 0x4d, 0x00, 0x3e, 0x42, 0x37, 0x72,
+	0x55, 0x68, 0xf0, 0x1b, 0xbd, 0xc6, 0xa0, 0x1d, 0x95, 0x37, 0xf8, 0x4b, 0xd4, 0xca, 0x49, 0x41,
+	0x32, 0xf0, 0x9b, 0xb6, 0x21, 0x1f, 0x6e, 0xe6, 0xa6, 0xb6, 0x71, 0x17, 0xc3, 0xe0, 0xb9, 0x25,
+	0x38, 0x6d, 0xc7, 0xc3, 0x5f, 0xa3, 0x0e, 0xd0, 0x19, 0x67, 0xf3, 0x94, 0xb3, 0x58, 0x5f, 0x80,
+	0x7f, 0xc3, 0xbe, 0xee, 0xc7, 0xbb, 0x08, 0xbc, 0xa8, 0x40, 0x5f, 0x5c, 0x38, 0x95, 0x43, 0x58,
+	0x86, 0x00, 0x87, 0xe8, 0x48, 0xf2, 0x0b, 0x1d, 0xd7, 0x05, 0x63, 0xc1, 0xfc, 0x56, 0xcf, 0x1b,
+	0x34, 0xa3, 0xbb, 0x26, 0x57, 0x43, 0x4c, 0x18, 0xfe, 0xc1, 0x43, 0x0f, 0x72, 0x2e, 0x99, 0x90,
+	0x49, 0xb5, 0x07, 0xe2, 0x82, 0xab, 0x9c, 0x4b, 0xf0, 0x0f, 0xac, 0xcf, 0x67, 0x3b, 0x2d, 0x44,
+	0x89, 0x74, 0x9d, 0x8f, 0x2c, 0xd0, 0x19, 0x3e, 0xce, 0xd7, 0xe4, 0x00, 0x67, 0xe8, 0xb6, 0x63,
+	0xc5, 0xea, 0x5c, 0xf2, 0x02, 0xfc, 0x9b, 0x56, 0xfe, 0x93, 0x5d, 0xe4, 0x5d, 0xaf, 0x3f, 0x37,
+	0x20, 0x27, 0xdb, 0x21, 0xb5, 0x98, 0x95, 0xcb, 0x09, 0xfd, 0x86, 0xeb, 0xb8, 0xe0, 0x30, 0x4f,
+	0x35, 0xf8, 0xed, 0xdd, 0xe5, 0x9e, 0x5b, 0x52, 0x64, 0x41, 0x95, 0x5c, 0x5e, 0x8b, 0x01, 0x1e,
+	0xa2, 0x63, 0xdb, 0x97, 0xd7, 0x34, 0x4d, 0x63, 0x90, 0x6d, 0x0c, 0x36, 0xc9, 0x3a, 0x65, 0xc2,
+	0xfa, 0x7f, 0x36, 0xd0, 0x9d, 0xd5, 0x19, 0xff, 0x7f, 0x0e, 0x2c, 0x46, 0x4d, 0x33, 0xa3, 0x7e,
+	0xa3, 0xe7, 0x0d, 0xda, 0x91, 0xbd, 0xc6, 0xd1, 0xca, 0xb8, 0xbe, 0xbf, 0x99, 0x17, 0xfb, 0x47,
+	0xf1, 0x4f, 0x83, 0x0a, 0x08, 0x67, 0x1c, 0x80, 0x24, 0x3c, 0x26, 0x69, 0xaa, 0xce, 0x53, 0x01,
+	0xba, 0x9a, 0xd6, 0xc7, 0xdb, 0xf1, 0xcf, 0x4a, 0xce, 0xb8, 0xc2, 0x38, 0xa5, 0xbb, 0xd9, 0x4a,
+	0x1c, 0xfa, 0xbf, 0x78, 0xa8, 0xf3, 0x5a, 0x2b, 0xf0, 0x3b, 0xa8, 0x43, 0x95, 0x94, 0x9c, 0x1a,
+	0x19, 0xb3, 0x47, 0x3c, 0xfb, 0xde, 0x87, 0xcb, 0xe0, 0x84, 0xe1, 0x07, 0xe8, 0xc0, 0xac, 0x83,
+	0x49, 0xef, 0xdb, 0x74, 0xcb, 0xdc, 0x4e, 0x18, 0x7e, 0x0b, 0xa1, 0x6a, 0x8e, 0x05, 0x73, 0x4b,
+	0xd6, 0x76, 0x91, 0x09, 0xc3, 0x23, 0x74, 0x2c, 0x20, 0xce, 0x04, 0x63, 0x29, 0x3f, 0x27, 0x05,
+	0x8f, 0xb9, 0x24, 0xd3, 0x94, 0x33, 0xbb, 0x8c, 0x37, 0xa3, 0x7b, 0x02, 0xce, 0xae, 0x73, 0x9f,
+	0x96, 0xa9, 0xfe, 0xf7, 0x1e, 0x7a, 0xf3, 0x5f, 0x3a, 0xf7, 0x1f, 0x0d, 0xbf, 0x6b, 0xb6, 0x74,
+	0x39, 0xf8, 0x84, 0xb1, 0x82, 0x03, 0x38, 0xd7, 0xd5, 0xf7, 0x60, 0x5c, 0x46, 0x9f, 0x24, 0x2f,
+	0x2f, 0xbb, 0xde, 0xab, 0xcb, 0xae, 0xf7, 0xc7, 0x65, 0xd7, 0xfb, 0xf9, 0xaa, 0xbb, 0xf7, 0xea,
+	0xaa, 0xbb, 0xf7, 0xdb, 0x55, 0x77, 0xef, 0xab, 0xb3, 0x44, 0xe8, 0xd9, 0x7c, 0x1a, 0x50, 0x95,
+	0x85, 0x54, 0x41, 0xa6, 0xc0, 0x1c, 0x65, 0x4e, 0x12, 0x15, 0x2e, 0x3e, 0x08, 0x33, 0x65, 0xbe,
+	0x76, 0x60, 0x0e, 0x13, 0x10, 0x8e, 0x1e, 0x9d, 0x2c, 0xdb, 0x76, 0xf2, 0xb7, 0x23, 0x91, 0xfe,
+	0x36, 0xe7, 0x30, 0x6d, 0xd9, 0x93, 0xc4, 0x7b, 0x7f, 0x0d, 0x00, 0xbe, 0xe4, 0xd4, 0x25, 0x4f,
+	0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -463,6 +534,72 @@ func (m *ControllerGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.NextPacketResultId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPacketResultId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.PacketResults) > 0 {
+		for iNdEx := len(m.PacketResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AccountOwners) > 0 {
+		for iNdEx := len(m.AccountOwners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountOwners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PendingChannelReopens) > 0 {
+		for iNdEx := len(m.PendingChannelReopens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingChannelReopens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.NextScheduledTxId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextScheduledTxId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ScheduledTxs) > 0 {
+		for iNdEx := len(m.ScheduledTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -533,6 +670,20 @@ func (m *HostGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MessageAllowlists) > 0 {
+		for iNdEx := len(m.MessageAllowlists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageAllowlists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -729,6 +880,36 @@ func (m *ControllerGenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ScheduledTxs) > 0 {
+		for _, e := range m.ScheduledTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextScheduledTxId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextScheduledTxId))
+	}
+	if len(m.PendingChannelReopens) > 0 {
+		for _, e := range m.PendingChannelReopens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccountOwners) > 0 {
+		for _, e := range m.AccountOwners {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PacketResults) > 0 {
+		for _, e := range m.PacketResults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextPacketResultId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextPacketResultId))
+	}
 	return n
 }
 
@@ -756,6 +937,12 @@ func (m *HostGenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MessageAllowlists) > 0 {
+		for _, e := range m.MessageAllowlists {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1088,6 +1275,180 @@ func (m *ControllerGenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledTxs = append(m.ScheduledTxs, types.ScheduledTx{})
+			if err := m.ScheduledTxs[len(m.ScheduledTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduledTxId", wireType)
+			}
+			m.NextScheduledTxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextScheduledTxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChannelReopens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingChannelReopens = append(m.PendingChannelReopens, types.PendingChannelReopen{})
+			if err := m.PendingChannelReopens[len(m.PendingChannelReopens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountOwners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountOwners = append(m.AccountOwners, types.AccountOwner{})
+			if err := m.AccountOwners[len(m.AccountOwners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketResults = append(m.PacketResults, types.PacketResult{})
+			if err := m.PacketResults[len(m.PacketResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPacketResultId", wireType)
+			}
+			m.NextPacketResultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPacketResultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1271,6 +1632,40 @@ func (m *HostGenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageAllowlists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageAllowlists = append(m.MessageAllowlists, types1.MessageAllowlist{})
+			if err := m.MessageAllowlists[len(m.MessageAllowlists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -86,6 +86,47 @@ func (suite *GenesisTypesTestSuite) TestValidateGenesisState() {
 func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 	var genesisState genesistypes.ControllerGenesisState
 
+	newScheduledTx := func(id uint64) controllertypes.ScheduledTx {
+		return controllertypes.ScheduledTx{
+			Id:              id,
+			Owner:           TestOwnerAddress,
+			ConnectionId:    ibctesting.FirstConnectionID,
+			PacketData:      icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data")},
+			RelativeTimeout: 100,
+			Schedule:        controllertypes.NewHeightSchedule(10, 0, 0),
+		}
+	}
+
+	newPendingReopen := func(connectionID string) controllertypes.PendingChannelReopen {
+		return controllertypes.PendingChannelReopen{
+			PortId:          TestPortID,
+			ConnectionId:    connectionID,
+			ClosedChannelId: ibctesting.FirstChannelID,
+			ChannelId:       "channel-1",
+			Version:         icatypes.NewDefaultMetadataString(ibctesting.FirstConnectionID, ibctesting.FirstConnectionID),
+		}
+	}
+
+	newAccountOwner := func(portID, owner string) controllertypes.AccountOwner {
+		return controllertypes.AccountOwner{
+			PortId:       portID,
+			ConnectionId: ibctesting.FirstConnectionID,
+			Owner:        owner,
+		}
+	}
+
+	newPacketResult := func(id, sequence uint64) controllertypes.PacketResult {
+		return controllertypes.PacketResult{
+			Id:           id,
+			Owner:        TestOwnerAddress,
+			ConnectionId: ibctesting.FirstConnectionID,
+			ChannelId:    ibctesting.FirstChannelID,
+			Sequence:     sequence,
+			Status:       controllertypes.STATUS_SUCCESS,
+			Height:       1,
+		}
+	}
+
 	testCases := []struct {
 		name     string
 		malleate func()
@@ -187,6 +228,151 @@ func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 			},
 			false,
 		},
+		{
+			"success: scheduled transactions",
+			func() {
+				genesisState.ScheduledTxs = []controllertypes.ScheduledTx{newScheduledTx(0), newScheduledTx(1)}
+				genesisState.NextScheduledTxId = 2
+			},
+			true,
+		},
+		{
+			"failed to validate scheduled transaction - invalid schedule",
+			func() {
+				scheduledTx := newScheduledTx(0)
+				scheduledTx.Schedule = controllertypes.Schedule{}
+				genesisState.ScheduledTxs = []controllertypes.ScheduledTx{scheduledTx}
+				genesisState.NextScheduledTxId = 1
+			},
+			false,
+		},
+		{
+			"failed to validate scheduled transaction - max executions reached",
+			func() {
+				scheduledTx := newScheduledTx(0)
+				scheduledTx.Schedule = controllertypes.NewHeightSchedule(10, 5, 2)
+				scheduledTx.Executions = 2
+				genesisState.ScheduledTxs = []controllertypes.ScheduledTx{scheduledTx}
+				genesisState.NextScheduledTxId = 1
+			},
+			false,
+		},
+		{
+			"failed to validate scheduled transactions - ID not less than next ID",
+			func() {
+				genesisState.ScheduledTxs = []controllertypes.ScheduledTx{newScheduledTx(1)}
+				genesisState.NextScheduledTxId = 1
+			},
+			false,
+		},
+		{
+			"failed to validate scheduled transactions - duplicate ID",
+			func() {
+				genesisState.ScheduledTxs = []controllertypes.ScheduledTx{newScheduledTx(0), newScheduledTx(0)}
+				genesisState.NextScheduledTxId = 1
+			},
+			false,
+		},
+		{
+			"success: pending channel reopenings",
+			func() {
+				genesisState.PendingChannelReopens = []controllertypes.PendingChannelReopen{newPendingReopen(ibctesting.FirstConnectionID), newPendingReopen("connection-1")}
+			},
+			true,
+		},
+		{
+			"failed to validate pending channel reopening - invalid channel identifier",
+			func() {
+				pendingReopen := newPendingReopen(ibctesting.FirstConnectionID)
+				pendingReopen.ChannelId = ""
+				genesisState.PendingChannelReopens = []controllertypes.PendingChannelReopen{pendingReopen}
+			},
+			false,
+		},
+		{
+			"failed to validate pending channel reopenings - duplicate port and connection identifiers",
+			func() {
+				genesisState.PendingChannelReopens = []controllertypes.PendingChannelReopen{newPendingReopen(ibctesting.FirstConnectionID), newPendingReopen(ibctesting.FirstConnectionID)}
+			},
+			false,
+		},
+		{
+			"success: account owners",
+			func() {
+				genesisState.AccountOwners = []controllertypes.AccountOwner{newAccountOwner(TestPortID, "owner-1"), newAccountOwner(icatypes.ControllerPortPrefix+"other", "owner-2")}
+			},
+			true,
+		},
+		{
+			"failed to validate account owner - port is not a controller port",
+			func() {
+				genesisState.AccountOwners = []controllertypes.AccountOwner{newAccountOwner(icatypes.HostPortID, "owner-1")}
+			},
+			false,
+		},
+		{
+			"failed to validate account owner - invalid owner",
+			func() {
+				genesisState.AccountOwners = []controllertypes.AccountOwner{newAccountOwner(TestPortID, "owner/1")}
+			},
+			false,
+		},
+		{
+			"failed to validate account owners - duplicate port and connection identifiers",
+			func() {
+				genesisState.AccountOwners = []controllertypes.AccountOwner{newAccountOwner(TestPortID, "owner-1"), newAccountOwner(TestPortID, "owner-2")}
+			},
+			false,
+		},
+		{
+			"failed to validate account owners - owner owns more than one interchain account on a connection",
+			func() {
+				genesisState.AccountOwners = []controllertypes.AccountOwner{newAccountOwner(TestPortID, "owner-1"), newAccountOwner(icatypes.ControllerPortPrefix+"other", "owner-1")}
+			},
+			false,
+		},
+		{
+			"success: packet results",
+			func() {
+				genesisState.PacketResults = []controllertypes.PacketResult{newPacketResult(0, 1), newPacketResult(1, 2)}
+				genesisState.NextPacketResultId = 2
+			},
+			true,
+		},
+		{
+			"failed to validate packet result - invalid status",
+			func() {
+				packetResult := newPacketResult(0, 1)
+				packetResult.Status = controllertypes.STATUS_UNSPECIFIED
+				genesisState.PacketResults = []controllertypes.PacketResult{packetResult}
+				genesisState.NextPacketResultId = 1
+			},
+			false,
+		},
+		{
+			"failed to validate packet result - ID is not less than the next packet result ID",
+			func() {
+				genesisState.PacketResults = []controllertypes.PacketResult{newPacketResult(1, 1)}
+				genesisState.NextPacketResultId = 1
+			},
+			false,
+		},
+		{
+			"failed to validate packet results - duplicate ID",
+			func() {
+				genesisState.PacketResults = []controllertypes.PacketResult{newPacketResult(0, 1), newPacketResult(0, 2)}
+				genesisState.NextPacketResultId = 1
+			},
+			false,
+		},
+		{
+			"failed to validate packet results - duplicate owner, connection, channel and sequence",
+			func() {
+				genesisState.PacketResults = []controllertypes.PacketResult{newPacketResult(0, 1), newPacketResult(1, 1)}
+				genesisState.NextPacketResultId = 2
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -312,6 +498,35 @@ func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 			},
 			false,
 		},
+		{
+			"success: message allowlists",
+			func() {
+				genesisState.MessageAllowlists = []hosttypes.MessageAllowlist{
+					hosttypes.NewMessageAllowlist(ibctesting.FirstConnectionID, "", "", []string{"/cosmos.staking.v1beta1.MsgDelegate"}),
+					hosttypes.NewMessageAllowlist(ibctesting.FirstConnectionID, TestPortID, "", []string{"/cosmos.staking.v1beta1.MsgDelegate"}),
+				}
+			},
+			true,
+		},
+		{
+			"failed to validate message allowlist - empty scope",
+			func() {
+				genesisState.MessageAllowlists = []hosttypes.MessageAllowlist{
+					hosttypes.NewMessageAllowlist("", "", "", []string{"/cosmos.staking.v1beta1.MsgDelegate"}),
+				}
+			},
+			false,
+		},
+		{
+			"failed to validate message allowlists - duplicate scope",
+			func() {
+				genesisState.MessageAllowlists = []hosttypes.MessageAllowlist{
+					hosttypes.NewMessageAllowlist(ibctesting.FirstConnectionID, "", "", []string{"/cosmos.staking.v1beta1.MsgDelegate"}),
+					hosttypes.NewMessageAllowlist(ibctesting.FirstConnectionID, "", "", []string{"/cosmos.bank.v1beta1.MsgSend"}),
+				}
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
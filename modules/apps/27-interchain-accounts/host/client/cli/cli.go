@@ -18,6 +18,8 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdParams(),
 		GetCmdPacketEvents(),
+		GetCmdMessageAllowlists(),
+		GetCmdAllowedMessages(),
 	)
 
 	return queryCmd
@@ -98,3 +98,70 @@ func GetCmdPacketEvents() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdMessageAllowlists returns the command handler for the host submodule message allowlists querying.
+func GetCmdMessageAllowlists() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "message-allowlists",
+		Short:   "Query the interchain-accounts host submodule message allowlists",
+		Long:    "Query the message allowlists of the interchain-accounts host submodule, scoped by connection, controller port or interchain account address",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts host message-allowlists", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.MessageAllowlists(cmd.Context(), &types.QueryMessageAllowlistsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "message allowlists")
+
+	return cmd
+}
+
+// GetCmdAllowedMessages returns the command handler for querying the messages an interchain account is allowed to execute.
+func GetCmdAllowedMessages() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "allowed-messages [connection-id] [controller-port-id]",
+		Short:   "Query the messages an interchain account is allowed to execute",
+		Long:    "Query the messages the interchain account of a controller port on a connection is allowed to execute, taking into account the params and the message allowlists within whose scope it is",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts host allowed-messages connection-0 icacontroller-cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllowedMessages(cmd.Context(), &types.QueryAllowedMessagesRequest{
+				ConnectionId: args[0],
+				PortId:       args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -17,8 +17,10 @@ import (
 )
 
 const (
-	memoFlag     string = "memo"
-	encodingFlag string = "encoding"
+	memoFlag       string = "memo"
+	encodingFlag   string = "encoding"
+	bestEffortFlag string = "best-effort"
+	gasLimitFlag   string = "gas-limit"
 )
 
 func generatePacketDataCmd() *cobra.Command {
@@ -29,7 +31,10 @@ func generatePacketDataCmd() *cobra.Command {
 encoding parameter) using protobuf or proto3 JSON into packet data which is outputted to stdout.
 It can be used in conjunction with send-tx which submits pre-built packet data containing messages 
 to be executed on the host chain. The default encoding format is protobuf if none is specified;
-otherwise the encoding flag can be used in combination with either "proto3" or "proto3json".`,
+otherwise the encoding flag can be used in combination with either "proto3" or "proto3json".
+The messages are executed atomically on the host chain unless the best-effort flag is set, in which
+case each message is executed independently and the acknowledgement contains the result of every message.
+The gas-limit flag can be used to limit the gas the execution of the messages may consume on the host chain.`,
 		Example: fmt.Sprintf(`%s tx interchain-accounts host generate-packet-data '{
     "@type":"/cosmos.bank.v1beta1.MsgSend",
     "from_address":"cosmos15ccshhmp0gsx29qpqq6g4zmltnnvgmyu9ueuadh9y2nc5zj0szls5gtddz",
@@ -86,7 +91,22 @@ otherwise the encoding flag can be used in combination with either "proto3" or "
 				return fmt.Errorf("unsupported encoding type: %s", encoding)
 			}
 
-			packetDataBytes, err := generatePacketData(cdc, []byte(args[0]), memo, encoding)
+			bestEffort, err := cmd.Flags().GetBool(bestEffortFlag)
+			if err != nil {
+				return err
+			}
+
+			executionMode := icatypes.ATOMIC
+			if bestEffort {
+				executionMode = icatypes.BEST_EFFORT
+			}
+
+			gasLimit, err := cmd.Flags().GetUint64(gasLimitFlag)
+			if err != nil {
+				return err
+			}
+
+			packetDataBytes, err := generatePacketData(cdc, []byte(args[0]), memo, encoding, executionMode, gasLimit)
 			if err != nil {
 				return err
 			}
@@ -99,18 +119,20 @@ otherwise the encoding flag can be used in combination with either "proto3" or "
 
 	cmd.Flags().String(memoFlag, "", "optional memo to be included in the interchain accounts packet data")
 	cmd.Flags().String(encodingFlag, "", "optional encoding format of the messages in the interchain accounts packet data")
+	cmd.Flags().Bool(bestEffortFlag, false, "execute the messages independently instead of atomically on the host chain")
+	cmd.Flags().Uint64(gasLimitFlag, 0, "optional maximum amount of gas the execution of the messages may consume on the host chain")
 	return cmd
 }
 
-// generatePacketData takes in message bytes and a memo and serializes the message into an
+// generatePacketData takes in message bytes, a memo, an execution mode and a gas limit and serializes the message into an
 // instance of InterchainAccountPacketData which is returned as bytes.
-func generatePacketData(cdc *codec.ProtoCodec, msgBytes []byte, memo string, encoding string) ([]byte, error) {
+func generatePacketData(cdc *codec.ProtoCodec, msgBytes []byte, memo string, encoding string, executionMode icatypes.ExecutionMode, gasLimit uint64) ([]byte, error) {
 	protoMessages, err := convertBytesIntoProtoMessages(cdc, msgBytes)
 	if err != nil {
 		return nil, err
 	}
 
-	return generateIcaPacketDataFromProtoMessages(cdc, protoMessages, memo, encoding)
+	return generateIcaPacketDataFromProtoMessages(cdc, protoMessages, memo, encoding, executionMode, gasLimit)
 }
 
 // convertBytesIntoProtoMessages returns a list of proto messages from bytes. The bytes can be in the form of a single
@@ -141,17 +163,20 @@ func convertBytesIntoProtoMessages(cdc *codec.ProtoCodec, msgBytes []byte) ([]pr
 	return sdkMessages, nil
 }
 
-// generateIcaPacketDataFromProtoMessages generates ica packet data as bytes from a given set of proto encoded sdk messages and a memo.
-func generateIcaPacketDataFromProtoMessages(cdc *codec.ProtoCodec, sdkMessages []proto.Message, memo string, encoding string) ([]byte, error) {
+// generateIcaPacketDataFromProtoMessages generates ica packet data as bytes from a given set of proto encoded sdk messages,
+// a memo, an execution mode and a gas limit.
+func generateIcaPacketDataFromProtoMessages(cdc *codec.ProtoCodec, sdkMessages []proto.Message, memo string, encoding string, executionMode icatypes.ExecutionMode, gasLimit uint64) ([]byte, error) {
 	icaPacketDataBytes, err := icatypes.SerializeCosmosTx(cdc, sdkMessages, encoding)
 	if err != nil {
 		return nil, err
 	}
 
 	icaPacketData := icatypes.InterchainAccountPacketData{
-		Type: icatypes.EXECUTE_TX,
-		Data: icaPacketDataBytes,
-		Memo: memo,
+		Type:          icatypes.EXECUTE_TX,
+		Data:          icaPacketDataBytes,
+		Memo:          memo,
+		ExecutionMode: executionMode,
+		GasLimit:      gasLimit,
 	}
 
 	if err := icaPacketData.ValidateBasic(); err != nil {
@@ -102,44 +102,50 @@ func TestGeneratePacketData(t *testing.T) {
 	}
 
 	encodings := []string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON}
+	executionModes := []icatypes.ExecutionMode{icatypes.ATOMIC, icatypes.BEST_EFFORT}
+	gasLimit := uint64(100_000)
 	for _, encoding := range encodings {
-		for _, tc := range tests {
-			tc := tc
-			ir := codectypes.NewInterfaceRegistry()
-			if tc.registerInterfaceFn != nil {
-				tc.registerInterfaceFn(ir)
-			}
+		for _, executionMode := range executionModes {
+			for _, tc := range tests {
+				tc := tc
+				ir := codectypes.NewInterfaceRegistry()
+				if tc.registerInterfaceFn != nil {
+					tc.registerInterfaceFn(ir)
+				}
 
-			cdc := codec.NewProtoCodec(ir)
+				cdc := codec.NewProtoCodec(ir)
 
-			t.Run(fmt.Sprintf("%s with %s encoding", tc.name, encoding), func(t *testing.T) {
-				bz, err := generatePacketData(cdc, []byte(tc.message), tc.memo, encoding)
+				t.Run(fmt.Sprintf("%s with %s encoding and %s execution mode", tc.name, encoding, executionMode), func(t *testing.T) {
+					bz, err := generatePacketData(cdc, []byte(tc.message), tc.memo, encoding, executionMode, gasLimit)
 
-				if tc.expectedPass {
-					require.NoError(t, err)
-					require.NotNil(t, bz)
+					if tc.expectedPass {
+						require.NoError(t, err)
+						require.NotNil(t, bz)
 
-					packetData := icatypes.InterchainAccountPacketData{}
-					err = cdc.UnmarshalJSON(bz, &packetData)
-					require.NoError(t, err)
+						packetData := icatypes.InterchainAccountPacketData{}
+						err = cdc.UnmarshalJSON(bz, &packetData)
+						require.NoError(t, err)
 
-					require.Equal(t, icatypes.EXECUTE_TX, packetData.Type)
-					require.Equal(t, tc.memo, packetData.Memo)
+						require.Equal(t, icatypes.EXECUTE_TX, packetData.Type)
+						require.Equal(t, tc.memo, packetData.Memo)
+						require.Equal(t, executionMode, packetData.ExecutionMode)
+						require.Equal(t, gasLimit, packetData.GasLimit)
 
-					data := packetData.Data
-					messages, err := icatypes.DeserializeCosmosTx(cdc, data, encoding)
+						data := packetData.Data
+						messages, err := icatypes.DeserializeCosmosTx(cdc, data, encoding)
 
-					require.NoError(t, err)
-					require.NotNil(t, messages)
+						require.NoError(t, err)
+						require.NotNil(t, messages)
 
-					if tc.assertionFn != nil {
-						tc.assertionFn(t, messages)
+						if tc.assertionFn != nil {
+							tc.assertionFn(t, messages)
+						}
+					} else {
+						require.Error(t, err)
+						require.Nil(t, bz)
 					}
-				} else {
-					require.Error(t, err)
-					require.Nil(t, bz)
-				}
-			})
+				})
+			}
 		}
 	}
 }
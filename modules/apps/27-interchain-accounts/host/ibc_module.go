@@ -111,20 +111,20 @@ func (im IBCModule) OnChanCloseConfirm(
 // OnRecvPacket implements the IBCModule interface
 func (im IBCModule) OnRecvPacket(
 	ctx context.Context,
-	_ string,
+	channelVersion string,
 	packet channeltypes.Packet,
 	_ sdk.AccAddress,
 ) ibcexported.Acknowledgement {
 	if !im.keeper.GetParams(ctx).HostEnabled {
 		im.keeper.Logger(ctx).Info("host submodule is disabled")
 		keeper.EmitHostDisabledEvent(ctx, packet)
-		return channeltypes.NewErrorAcknowledgement(types.ErrHostSubModuleDisabled)
+		return newErrorAcknowledgement(channelVersion, types.ErrHostSubModuleDisabled)
 	}
 
 	txResponse, err := im.keeper.OnRecvPacket(ctx, packet)
 	ack := channeltypes.NewResultAcknowledgement(txResponse)
 	if err != nil {
-		ack = channeltypes.NewErrorAcknowledgement(err)
+		ack = newErrorAcknowledgement(channelVersion, err)
 		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", err.Error(), packet.Sequence))
 	} else {
 		im.keeper.Logger(ctx).Info("successfully handled packet", "sequence", packet.Sequence)
@@ -137,6 +137,18 @@ func (im IBCModule) OnRecvPacket(
 	return ack
 }
 
+// newErrorAcknowledgement returns a structured error acknowledgement for the error if their use has been
+// negotiated in the channel version metadata. Otherwise an error acknowledgement containing only the ABCI
+// code is returned.
+func newErrorAcknowledgement(channelVersion string, err error) channeltypes.Acknowledgement {
+	metadata, metadataErr := icatypes.MetadataFromVersion(channelVersion)
+	if metadataErr == nil && metadata.HasStructuredErrors() {
+		return channeltypes.NewStructuredErrorAcknowledgement(err, channeltypes.ErrorReason(err))
+	}
+
+	return channeltypes.NewErrorAcknowledgement(err)
+}
+
 // OnAcknowledgementPacket implements the IBCModule interface
 func (IBCModule) OnAcknowledgementPacket(
 	_ context.Context,
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 	testifysuite "github.com/stretchr/testify/suite"
 
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -23,6 +24,7 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v9/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 )
@@ -527,6 +529,71 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 	}
 }
 
+func (suite *InterchainAccountsTestSuite) TestOnRecvPacketStructuredErrors() {
+	var packetData []byte
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   *errorsmod.Error
+	}{
+		{
+			"host submodule disabled", func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
+			},
+			types.ErrHostSubModuleDisabled,
+		},
+		{
+			"cannot unmarshal packet data", func() {
+				packetData = []byte("invalid data")
+			},
+			ibcerrors.ErrInvalidType,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			version := string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+				Version:                channeltypes.NewStructuredErrorsVersion(icatypes.Version),
+				ControllerConnectionId: ibctesting.FirstConnectionID,
+				HostConnectionId:       ibctesting.FirstConnectionID,
+				Encoding:               icatypes.EncodingProtobuf,
+				TxType:                 icatypes.TxTypeSDKMultiMsg,
+			}))
+
+			path := NewICAPath(suite.chainA, suite.chainB, channeltypes.ORDERED)
+			path.EndpointA.ChannelConfig.Version = version
+			path.EndpointB.ChannelConfig.Version = version
+			path.SetupConnections()
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			packetData = icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX}.GetBytes()
+
+			tc.malleate()
+
+			packet := channeltypes.NewPacket(packetData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+			cbs, ok := suite.chainB.App.GetIBCKeeper().PortKeeper.Route(path.EndpointB.ChannelConfig.PortID)
+			suite.Require().True(ok)
+
+			ack := cbs.OnRecvPacket(suite.chainB.GetContext(), path.EndpointB.GetChannel().Version, packet, nil)
+			suite.Require().False(ack.Success())
+
+			expAck := channeltypes.NewStructuredErrorAcknowledgement(tc.expErr, channeltypes.ErrorReason(tc.expErr))
+			suite.Require().Equal(expAck, ack)
+
+			errorAck, found := expAck.StructuredError()
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expErr.Codespace(), errorAck.Codespace)
+			suite.Require().Equal(tc.expErr.ABCICode(), errorAck.Code)
+		})
+	}
+}
+
 func (suite *InterchainAccountsTestSuite) TestOnAcknowledgementPacket() {
 	testCases := []struct {
 		name     string
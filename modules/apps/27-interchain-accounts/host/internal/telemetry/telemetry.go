@@ -0,0 +1,32 @@
+package telemetry
+
+import (
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/host/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	coremetrics "github.com/cosmos/ibc-go/v9/modules/core/metrics"
+)
+
+func ReportOnRecvPacketGas(packet channeltypes.Packet, gasUsed uint64, outOfGas bool) {
+	labels := []metrics.Label{
+		telemetry.NewLabel(coremetrics.LabelSourcePort, packet.SourcePort),
+		telemetry.NewLabel(coremetrics.LabelDestinationChannel, packet.DestinationChannel),
+	}
+
+	telemetry.SetGaugeWithLabels(
+		[]string{"ibc", types.SubModuleName, "packet", "gas_used"},
+		float32(gasUsed),
+		labels,
+	)
+
+	if outOfGas {
+		telemetry.IncrCounterWithLabels(
+			[]string{"ibc", types.SubModuleName, "packet", "out_of_gas"},
+			1,
+			labels,
+		)
+	}
+}
@@ -6,30 +6,44 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
 )
 
 // createInterchainAccount creates a new interchain account. An address is generated using the host connectionID, the controller portID,
 // and block dependent information. An error is returned if an account already exists for the generated account.
-// An interchain account type is set in the account keeper and the interchain account address mapping is updated.
-func (k Keeper) createInterchainAccount(ctx context.Context, connectionID, controllerPortID string) (sdk.AccAddress, error) {
+// The account is created by the account factory of the host connection from the channel version metadata, set in the account keeper
+// and the interchain account address mapping is updated.
+func (k Keeper) createInterchainAccount(ctx context.Context, connectionID, controllerPortID string, metadata icatypes.Metadata) (sdk.AccAddress, error) {
 	accAddress := icatypes.GenerateAddress(ctx, connectionID, controllerPortID)
 
 	if acc := k.accountKeeper.GetAccount(ctx, accAddress); acc != nil {
 		return nil, errorsmod.Wrapf(icatypes.ErrAccountAlreadyExist, "existing account for newly generated interchain account address %s", accAddress)
 	}
 
-	interchainAccount := icatypes.NewInterchainAccount(
-		authtypes.NewBaseAccountWithAddress(accAddress),
-		controllerPortID,
-	)
+	interchainAccount, err := k.accountFactories.GetAccountFactory(connectionID).NewAccount(ctx, accAddress, controllerPortID, metadata)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAccount, "failed to create interchain account %s: %v", accAddress, err)
+	}
+
+	if interchainAccount == nil || !accAddress.Equals(interchainAccount.GetAddress()) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAccount, "account factory must create an account with address %s", accAddress)
+	}
 
-	k.accountKeeper.NewAccount(ctx, interchainAccount)
+	interchainAccount = k.accountKeeper.NewAccount(ctx, interchainAccount)
 	k.accountKeeper.SetAccount(ctx, interchainAccount)
 
-	k.SetInterchainAccountAddress(ctx, connectionID, controllerPortID, interchainAccount.Address)
+	k.SetInterchainAccountAddress(ctx, connectionID, controllerPortID, accAddress.String())
 
 	return accAddress, nil
 }
+
+// isInterchainAccount returns true if the account has an account type created by the account factory of the host
+// connection, or is an InterchainAccount created by the default account factory. The latter accepts the interchain
+// accounts registered before a custom account factory was set for the connection, so that their channels may still
+// be reopened.
+func (k Keeper) isInterchainAccount(connectionID string, account sdk.AccountI) bool {
+	return k.accountFactories.GetAccountFactory(connectionID).IsInterchainAccount(account) ||
+		types.NewInterchainAccountFactory().IsInterchainAccount(account)
+}
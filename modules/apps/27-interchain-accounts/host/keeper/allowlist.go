@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/host/types"
+)
+
+// GetMessageAllowlist retrieves the message allowlist with the given scope from the store
+func (k Keeper) GetMessageAllowlist(ctx context.Context, connectionID, portID, address string) (types.MessageAllowlist, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.MessageAllowlistKey(connectionID, portID, address))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.MessageAllowlist{}, false
+	}
+
+	var allowlist types.MessageAllowlist
+	k.cdc.MustUnmarshal(bz, &allowlist)
+	return allowlist, true
+}
+
+// SetMessageAllowlist stores the message allowlist, keyed by its scope
+func (k Keeper) SetMessageAllowlist(ctx context.Context, allowlist types.MessageAllowlist) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&allowlist)
+	if err := store.Set(types.MessageAllowlistKey(allowlist.ConnectionId, allowlist.PortId, allowlist.Address), bz); err != nil {
+		panic(err)
+	}
+}
+
+// DeleteMessageAllowlist removes the message allowlist with the given scope from the store
+func (k Keeper) DeleteMessageAllowlist(ctx context.Context, connectionID, portID, address string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.MessageAllowlistKey(connectionID, portID, address)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllMessageAllowlists returns all the message allowlists stored
+func (k Keeper) GetAllMessageAllowlists(ctx context.Context) []types.MessageAllowlist {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.MessageAllowlistKeyPrefix+"/"))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var allowlists []types.MessageAllowlist
+	for ; iterator.Valid(); iterator.Next() {
+		var allowlist types.MessageAllowlist
+		k.cdc.MustUnmarshal(iterator.Value(), &allowlist)
+
+		allowlists = append(allowlists, allowlist)
+	}
+
+	return allowlists
+}
+
+// GetAllowedMessages returns the sdk message typeURLs that the interchain account of the controller port on the
+// connection with the given address is allowed to execute: the messages allowed by the params together with the
+// messages of every message allowlist within whose scope the interchain account is. Since each scope field of an
+// allowlist is either empty or equal to the corresponding identifier of the interchain account, the allowlists are
+// looked up directly by their scope instead of iterating over every stored allowlist.
+func (k Keeper) GetAllowedMessages(ctx context.Context, connectionID, portID, address string) []string {
+	allowMsgs := slices.Clone(k.GetParams(ctx).AllowMessages)
+	if slices.Contains(allowMsgs, types.AllowAllHostMsgs) {
+		return []string{types.AllowAllHostMsgs}
+	}
+
+	for _, scopeConnectionID := range []string{"", connectionID} {
+		for _, scopePortID := range []string{"", portID} {
+			for _, scopeAddress := range []string{"", address} {
+				allowlist, found := k.GetMessageAllowlist(ctx, scopeConnectionID, scopePortID, scopeAddress)
+				if !found {
+					continue
+				}
+
+				if slices.Contains(allowlist.AllowMessages, types.AllowAllHostMsgs) {
+					return []string{types.AllowAllHostMsgs}
+				}
+
+				for _, typeURL := range allowlist.AllowMessages {
+					if !slices.Contains(allowMsgs, typeURL) {
+						allowMsgs = append(allowMsgs, typeURL)
+					}
+				}
+			}
+		}
+	}
+
+	return allowMsgs
+}
@@ -0,0 +1,239 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func (suite *KeeperTestSuite) TestGetAllowedMessages() {
+	var (
+		params     types.Params
+		allowlists []types.MessageAllowlist
+	)
+
+	msgSendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	msgDelegateTypeURL := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+	msgUndelegateTypeURL := sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{})
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expAllows []string
+	}{
+		{
+			"no allowlists",
+			func() {},
+			[]string{msgSendTypeURL},
+		},
+		{
+			"allowlists within scope",
+			func() {
+				allowlists = []types.MessageAllowlist{
+					types.NewMessageAllowlist(ibctesting.FirstConnectionID, "", "", []string{msgDelegateTypeURL, msgSendTypeURL}),
+					types.NewMessageAllowlist("", TestPortID, "", []string{msgUndelegateTypeURL}),
+					types.NewMessageAllowlist("", "", TestOwnerAddress, []string{msgDelegateTypeURL}),
+				}
+			},
+			[]string{msgSendTypeURL, msgDelegateTypeURL, msgUndelegateTypeURL},
+		},
+		{
+			"allowlists out of scope",
+			func() {
+				allowlists = []types.MessageAllowlist{
+					types.NewMessageAllowlist("connection-1", "", "", []string{msgDelegateTypeURL}),
+					types.NewMessageAllowlist("", "icacontroller-cosmos1other", "", []string{msgDelegateTypeURL}),
+					types.NewMessageAllowlist(ibctesting.FirstConnectionID, "", "cosmos1other", []string{msgDelegateTypeURL}),
+				}
+			},
+			[]string{msgSendTypeURL},
+		},
+		{
+			"allowlist within scope allows all messages",
+			func() {
+				allowlists = []types.MessageAllowlist{
+					types.NewMessageAllowlist(ibctesting.FirstConnectionID, "", "", []string{types.AllowAllHostMsgs}),
+				}
+			},
+			[]string{types.AllowAllHostMsgs},
+		},
+		{
+			"params allow all messages",
+			func() {
+				params.AllowMessages = []string{types.AllowAllHostMsgs}
+				allowlists = []types.MessageAllowlist{
+					types.NewMessageAllowlist(ibctesting.FirstConnectionID, "", "", []string{msgDelegateTypeURL}),
+				}
+			},
+			[]string{types.AllowAllHostMsgs},
+		},
+		{
+			"no messages allowed by the params",
+			func() {
+				params.AllowMessages = nil
+				allowlists = []types.MessageAllowlist{
+					types.NewMessageAllowlist(ibctesting.FirstConnectionID, "", "", []string{msgDelegateTypeURL}),
+				}
+			},
+			[]string{msgDelegateTypeURL},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			params = types.NewParams(true, []string{msgSendTypeURL})
+			allowlists = nil
+
+			tc.malleate()
+
+			ctx := suite.chainB.GetContext()
+			hostKeeper := suite.chainB.GetSimApp().ICAHostKeeper
+			hostKeeper.SetParams(ctx, params)
+			for _, allowlist := range allowlists {
+				hostKeeper.SetMessageAllowlist(ctx, allowlist)
+			}
+
+			allowMsgs := hostKeeper.GetAllowedMessages(ctx, ibctesting.FirstConnectionID, TestPortID, TestOwnerAddress)
+			suite.Require().ElementsMatch(tc.expAllows, allowMsgs)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketMessageAllowlist() {
+	var allowlist func(address string) types.MessageAllowlist
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: message allowed by an allowlist scoped by connection",
+			func() {
+				allowlist = func(string) types.MessageAllowlist {
+					return types.NewMessageAllowlist(ibctesting.FirstConnectionID, "", "", []string{sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})})
+				}
+			},
+			nil,
+		},
+		{
+			"success: message allowed by an allowlist scoped by controller port",
+			func() {
+				allowlist = func(string) types.MessageAllowlist {
+					return types.NewMessageAllowlist("", TestPortID, "", []string{sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})})
+				}
+			},
+			nil,
+		},
+		{
+			"success: message allowed by an allowlist scoped by address",
+			func() {
+				allowlist = func(address string) types.MessageAllowlist {
+					return types.NewMessageAllowlist("", "", address, []string{sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})})
+				}
+			},
+			nil,
+		},
+		{
+			"failure: message allowed by an allowlist out of scope",
+			func() {
+				allowlist = func(string) types.MessageAllowlist {
+					return types.NewMessageAllowlist("connection-1", "", "", []string{sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})})
+				}
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: message allowed by an allowlist scoped by another controller port",
+			func() {
+				allowlist = func(string) types.MessageAllowlist {
+					return types.NewMessageAllowlist("", TestPortID+"other", "", []string{sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})})
+				}
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: message not allowed by the allowlist within scope",
+			func() {
+				allowlist = func(address string) types.MessageAllowlist {
+					return types.NewMessageAllowlist("", "", address, []string{sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{})})
+				}
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf, channeltypes.ORDERED)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000000))))
+
+			tc.malleate()
+
+			// only bank sends are allowed to every interchain account by the params
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}))
+			suite.chainB.GetSimApp().ICAHostKeeper.SetMessageAllowlist(suite.chainB.GetContext(), allowlist(interchainAccountAddr))
+
+			msg := &stakingtypes.MsgDelegate{
+				DelegatorAddress: interchainAccountAddr,
+				ValidatorAddress: sdk.ValAddress(suite.chainB.Vals.Validators[0].Address).String(),
+				Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(5000)),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				suite.chainB.GetTimeoutHeight(),
+				0,
+			)
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(txResponse)
+			}
+		})
+	}
+}
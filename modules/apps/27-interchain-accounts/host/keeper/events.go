@@ -46,3 +46,20 @@ func EmitHostDisabledEvent(ctx context.Context, packet channeltypes.Packet) {
 		),
 	)
 }
+
+// EmitGasUsageEvent emits an event signalling the amount of gas consumed by the execution of the transaction of the
+// interchain account owned by the packet source port, and the gas limit it was executed with. A gas limit of zero
+// signals that no limit was set.
+func EmitGasUsageEvent(ctx context.Context, packet channeltypes.Packet, gasUsed, gasLimit uint64) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeGasUsage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerPortID, packet.GetSourcePort()),
+			sdk.NewAttribute(icatypes.AttributeKeyHostChannelID, packet.GetDestChannel()),
+			sdk.NewAttribute(icatypes.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+			sdk.NewAttribute(icatypes.AttributeKeyGasLimit, strconv.FormatUint(gasLimit, 10)),
+		),
+	)
+}
@@ -20,6 +20,10 @@ func InitGenesis(ctx context.Context, keeper Keeper, state genesistypes.HostGene
 		keeper.SetInterchainAccountAddress(ctx, acc.ConnectionId, acc.PortId, acc.AccountAddress)
 	}
 
+	for _, allowlist := range state.MessageAllowlists {
+		keeper.SetMessageAllowlist(ctx, allowlist)
+	}
+
 	if err := state.Params.Validate(); err != nil {
 		panic(fmt.Errorf("could not set ica host params at genesis: %v", err))
 	}
@@ -28,10 +32,13 @@ func InitGenesis(ctx context.Context, keeper Keeper, state genesistypes.HostGene
 
 // ExportGenesis returns the interchain accounts host exported genesis
 func ExportGenesis(ctx context.Context, keeper Keeper) genesistypes.HostGenesisState {
-	return genesistypes.NewHostGenesisState(
+	genesisState := genesistypes.NewHostGenesisState(
 		keeper.GetAllActiveChannels(ctx),
 		keeper.GetAllInterchainAccounts(ctx),
 		icatypes.HostPortID,
 		keeper.GetParams(ctx),
 	)
+	genesisState.MessageAllowlists = keeper.GetAllMessageAllowlists(ctx)
+
+	return genesisState
 }
@@ -27,6 +27,9 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			},
 		},
 		Port: icatypes.HostPortID,
+		MessageAllowlists: []types.MessageAllowlist{
+			types.NewMessageAllowlist(ibctesting.FirstConnectionID, TestPortID, "", []string{"/cosmos.staking.v1beta1.MsgDelegate"}),
+		},
 	}
 
 	keeper.InitGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAHostKeeper, genesisState)
@@ -43,6 +46,8 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	params := suite.chainA.GetSimApp().ICAHostKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
 
+	suite.Require().Equal(genesisState.MessageAllowlists, suite.chainA.GetSimApp().ICAHostKeeper.GetAllMessageAllowlists(suite.chainA.GetContext()))
+
 	store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
 	suite.Require().True(store.Has(icatypes.KeyPort(icatypes.HostPortID)))
 }
@@ -120,6 +125,9 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 		interchainAccAddr, exists := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
 		suite.Require().True(exists)
 
+		allowlist := types.NewMessageAllowlist(path.EndpointB.ConnectionID, "", interchainAccAddr, []string{"/cosmos.staking.v1beta1.MsgDelegate"})
+		suite.chainB.GetSimApp().ICAHostKeeper.SetMessageAllowlist(suite.chainB.GetContext(), allowlist)
+
 		genesisState := keeper.ExportGenesis(suite.chainB.GetContext(), suite.chainB.GetSimApp().ICAHostKeeper)
 
 		suite.Require().Equal(path.EndpointB.ChannelID, genesisState.ActiveChannels[0].ChannelId)
@@ -132,5 +140,7 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 
 		expParams := types.DefaultParams()
 		suite.Require().Equal(expParams, genesisState.GetParams())
+
+		suite.Require().Equal([]types.MessageAllowlist{allowlist}, genesisState.MessageAllowlists)
 	}
 }
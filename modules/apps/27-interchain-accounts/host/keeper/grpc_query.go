@@ -3,9 +3,17 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/host/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
@@ -19,3 +27,56 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// MessageAllowlists implements the Query/MessageAllowlists gRPC method
+func (k Keeper) MessageAllowlists(ctx context.Context, req *types.QueryMessageAllowlistsRequest) (*types.QueryMessageAllowlistsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var allowlists []types.MessageAllowlist
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), []byte(types.MessageAllowlistKeyPrefix+"/"))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var allowlist types.MessageAllowlist
+		if err := k.cdc.Unmarshal(value, &allowlist); err != nil {
+			return err
+		}
+
+		allowlists = append(allowlists, allowlist)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMessageAllowlistsResponse{
+		MessageAllowlists: allowlists,
+		Pagination:        pageRes,
+	}, nil
+}
+
+// AllowedMessages implements the Query/AllowedMessages gRPC method
+func (k Keeper) AllowedMessages(ctx context.Context, req *types.QueryAllowedMessagesRequest) (*types.QueryAllowedMessagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	address, found := k.GetInterchainAccountAddress(ctx, req.ConnectionId, req.PortId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "interchain account not found for connection ID (%s) and port ID (%s)", req.ConnectionId, req.PortId)
+	}
+
+	return &types.QueryAllowedMessagesResponse{
+		Address:       address,
+		AllowMessages: k.GetAllowedMessages(ctx, req.ConnectionId, req.PortId, address),
+	}, nil
+}
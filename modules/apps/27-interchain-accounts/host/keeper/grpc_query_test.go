@@ -1,7 +1,17 @@
 package keeper_test
 
 import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
 	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 )
 
 func (suite *KeeperTestSuite) TestQueryParams() {
@@ -10,3 +20,110 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.chainA.GetSimApp().ICAHostKeeper.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryMessageAllowlists() {
+	suite.SetupTest()
+
+	ctx := suite.chainA.GetContext()
+	expAllowlists := []types.MessageAllowlist{
+		types.NewMessageAllowlist(ibctesting.FirstConnectionID, "", "", []string{sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})}),
+		types.NewMessageAllowlist(ibctesting.FirstConnectionID, TestPortID, "", []string{types.AllowAllHostMsgs}),
+	}
+	for _, allowlist := range expAllowlists {
+		suite.chainA.GetSimApp().ICAHostKeeper.SetMessageAllowlist(ctx, allowlist)
+	}
+
+	res, err := suite.chainA.GetSimApp().ICAHostKeeper.MessageAllowlists(ctx, &types.QueryMessageAllowlistsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expAllowlists, res.MessageAllowlists)
+
+	_, err = suite.chainA.GetSimApp().ICAHostKeeper.MessageAllowlists(ctx, nil)
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *KeeperTestSuite) TestQueryAllowedMessages() {
+	var (
+		req  *types.QueryAllowedMessagesRequest
+		path *ibctesting.Path
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expCode  codes.Code
+	}{
+		{
+			"success",
+			func() {},
+			codes.OK,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			codes.InvalidArgument,
+		},
+		{
+			"invalid connection ID",
+			func() {
+				req.ConnectionId = "invalid|connection"
+			},
+			codes.InvalidArgument,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = "invalid|port"
+			},
+			codes.InvalidArgument,
+		},
+		{
+			"interchain account not found",
+			func() {
+				req.PortId = icatypes.ControllerPortPrefix + "cosmos1other"
+			},
+			codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf, channeltypes.ORDERED)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			ctx := suite.chainB.GetContext()
+			hostKeeper := suite.chainB.GetSimApp().ICAHostKeeper
+			hostKeeper.SetParams(ctx, types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}))
+			hostKeeper.SetMessageAllowlist(ctx, types.NewMessageAllowlist(path.EndpointB.ConnectionID, "", "", []string{sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})}))
+
+			req = &types.QueryAllowedMessagesRequest{
+				ConnectionId: path.EndpointB.ConnectionID,
+				PortId:       path.EndpointA.ChannelConfig.PortID,
+			}
+
+			tc.malleate()
+
+			res, err := hostKeeper.AllowedMessages(ctx, req)
+
+			if tc.expCode == codes.OK {
+				suite.Require().NoError(err)
+
+				expAddress, found := hostKeeper.GetInterchainAccountAddress(ctx, path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+				suite.Require().Equal(expAddress, res.Address)
+				suite.Require().Equal([]string{sdk.MsgTypeURL(&banktypes.MsgSend{}), sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})}, res.AllowMessages)
+			} else {
+				suite.Require().Equal(tc.expCode, status.Code(err))
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
@@ -47,7 +47,7 @@ func (k Keeper) OnChanOpenTry(
 	// set here the HostConnectionId in case the controller did not set it
 	metadata.HostConnectionId = connectionHops[0]
 
-	if err = icatypes.ValidateHostMetadata(ctx, k.channelKeeper, connectionHops, metadata); err != nil {
+	if err = icatypes.ValidateHostMetadata(ctx, k.channelKeeper, k.encodingRegistry, connectionHops, metadata); err != nil {
 		return "", err
 	}
 
@@ -74,12 +74,12 @@ func (k Keeper) OnChanOpenTry(
 		// reopening an interchain account
 		k.Logger(ctx).Info("reopening existing interchain account", "address", interchainAccAddr)
 		accAddress = sdk.MustAccAddressFromBech32(interchainAccAddr)
-		if _, ok := k.accountKeeper.GetAccount(ctx, accAddress).(*icatypes.InterchainAccount); !ok {
+		if !k.isInterchainAccount(metadata.HostConnectionId, k.accountKeeper.GetAccount(ctx, accAddress)) {
 			return "", errorsmod.Wrapf(icatypes.ErrInvalidAccountReopening, "existing account address %s, does not have interchain account type", accAddress)
 		}
 
 	} else {
-		accAddress, err = k.createInterchainAccount(ctx, metadata.HostConnectionId, counterparty.PortId)
+		accAddress, err = k.createInterchainAccount(ctx, metadata.HostConnectionId, counterparty.PortId, metadata)
 		if err != nil {
 			return "", err
 		}
@@ -169,7 +169,7 @@ func (k Keeper) OnChanUpgradeTry(ctx context.Context, portID, channelID string,
 
 	// ValidateHostMetadata will ensure the ICS27 protocol version has not changed and that the
 	// tx type and encoding are supported. It also validates the connection params against the counterparty metadata.
-	if err := icatypes.ValidateHostMetadata(ctx, k.channelKeeper, proposedConnectionHops, proposedCounterpartyMetadata); err != nil {
+	if err := icatypes.ValidateHostMetadata(ctx, k.channelKeeper, k.encodingRegistry, proposedConnectionHops, proposedCounterpartyMetadata); err != nil {
 		return "", errorsmod.Wrap(err, "invalid metadata")
 	}
 
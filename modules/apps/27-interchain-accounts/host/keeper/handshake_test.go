@@ -1,9 +1,13 @@
 package keeper_test
 
 import (
+	"context"
+	"errors"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	hosttypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
@@ -18,6 +22,32 @@ const (
 	differentConnectionID = "connection-100"
 )
 
+var _ hosttypes.AccountFactory = (*baseAccountFactory)(nil)
+
+// baseAccountFactory is an AccountFactory creating BaseAccount accounts with the address, if set, or failing with the
+// error, if set
+type baseAccountFactory struct {
+	address sdk.AccAddress
+	err     error
+}
+
+func (f baseAccountFactory) NewAccount(_ context.Context, address sdk.AccAddress, _ string, _ icatypes.Metadata) (sdk.AccountI, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	if f.address != nil {
+		address = f.address
+	}
+
+	return authtypes.NewBaseAccountWithAddress(address), nil
+}
+
+func (baseAccountFactory) IsInterchainAccount(account sdk.AccountI) bool {
+	_, ok := account.(*authtypes.BaseAccount)
+	return ok
+}
+
 // open and close channel is a helper function for TestOnChanOpenTry for reopening accounts
 func (suite *KeeperTestSuite) openAndCloseChannel(path *ibctesting.Path) {
 	err := path.EndpointB.ChanOpenTry()
@@ -120,6 +150,27 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 					path.EndpointB.SetChannel(*channel)
 				}, nil,
 			},
+			{
+				"success - account created by the account factory of the connection",
+				func() {
+					suite.chainB.GetSimApp().ICAHostKeeper.SetAccountFactory(path.EndpointB.ConnectionID, baseAccountFactory{})
+				},
+				nil,
+			},
+			{
+				"success - account created by the default account factory",
+				func() {
+					suite.chainB.GetSimApp().ICAHostKeeper.SetDefaultAccountFactory(baseAccountFactory{})
+				},
+				nil,
+			},
+			{
+				"success - account factory of another connection is not used",
+				func() {
+					suite.chainB.GetSimApp().ICAHostKeeper.SetAccountFactory(differentConnectionID, baseAccountFactory{err: errors.New("account factory error")})
+				},
+				nil,
+			},
 			{
 				"invalid metadata bytestring",
 				func() {
@@ -169,6 +220,55 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 				},
 				icatypes.ErrInvalidAccountReopening,
 			},
+			{
+				"success - reopening account created by the default account factory before the account factory of the connection was set",
+				func() {
+					// create interchain account
+					// undo setup
+					path.EndpointB.ChannelID = ""
+
+					suite.openAndCloseChannel(path)
+
+					suite.chainB.GetSimApp().ICAHostKeeper.SetAccountFactory(path.EndpointB.ConnectionID, baseAccountFactory{})
+				},
+				nil,
+			},
+			{
+				"reopening account fails - existing account is not created by the account factory of the connection",
+				func() {
+					// create interchain account with a custom account type
+					// undo setup
+					path.EndpointB.ChannelID = ""
+
+					suite.chainB.GetSimApp().ICAHostKeeper.SetAccountFactory(path.EndpointB.ConnectionID, baseAccountFactory{})
+					suite.openAndCloseChannel(path)
+
+					// overwrite the account with a module account, which no account factory creates
+					addr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+					suite.Require().True(found)
+
+					acc := suite.chainB.GetSimApp().AccountKeeper.GetAccount(suite.chainB.GetContext(), sdk.MustAccAddressFromBech32(addr))
+					baseAcc, ok := acc.(*authtypes.BaseAccount)
+					suite.Require().True(ok)
+
+					suite.chainB.GetSimApp().AccountKeeper.SetAccount(suite.chainB.GetContext(), authtypes.NewModuleAccount(baseAcc, "ica-test-module"))
+				},
+				icatypes.ErrInvalidAccountReopening,
+			},
+			{
+				"account factory fails to create the account",
+				func() {
+					suite.chainB.GetSimApp().ICAHostKeeper.SetAccountFactory(path.EndpointB.ConnectionID, baseAccountFactory{err: errors.New("account factory error")})
+				},
+				hosttypes.ErrInvalidAccount,
+			},
+			{
+				"account factory creates an account with a different address",
+				func() {
+					suite.chainB.GetSimApp().ICAHostKeeper.SetAccountFactory(path.EndpointB.ConnectionID, baseAccountFactory{address: suite.chainB.SenderAccount.GetAddress()})
+				},
+				hosttypes.ErrInvalidAccount,
+			},
 			{
 				"account already exists",
 				func() {
@@ -323,6 +423,11 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 					// Check if account is created
 					interchainAccount := suite.chainB.GetSimApp().AccountKeeper.GetAccount(suite.chainB.GetContext(), interchainAccAddr)
 					suite.Require().Equal(interchainAccount.GetAddress().String(), storedAddr)
+					// the account is created by the account factory of the connection, or by the default account factory if
+					// it was created before the account factory of the connection was set
+					isInterchainAccount := suite.chainB.GetSimApp().ICAHostKeeper.GetAccountFactory(path.EndpointB.ConnectionID).IsInterchainAccount(interchainAccount) ||
+						hosttypes.NewInterchainAccountFactory().IsInterchainAccount(interchainAccount)
+					suite.Require().True(isInterchainAccount)
 
 					expectedMetadata.Address = storedAddr
 					expectedVersionBytes, err := icatypes.ModuleCdc.MarshalJSON(&expectedMetadata)
@@ -46,6 +46,12 @@ type Keeper struct {
 	// mqsAllowList is a list of all module safe query paths
 	mqsAllowList []string
 
+	// encodingRegistry holds the encoding formats which may be negotiated in the channel version metadata
+	encodingRegistry icatypes.EncodingRegistry
+
+	// accountFactories holds the account factories creating the accounts of new interchain accounts
+	accountFactories types.AccountFactoryRouter
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -67,16 +73,18 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		storeService:   storeService,
-		cdc:            cdc,
-		legacySubspace: legacySubspace,
-		ics4Wrapper:    ics4Wrapper,
-		channelKeeper:  channelKeeper,
-		accountKeeper:  accountKeeper,
-		msgRouter:      msgRouter,
-		queryRouter:    queryRouter,
-		mqsAllowList:   newModuleQuerySafeAllowList(),
-		authority:      authority,
+		storeService:     storeService,
+		cdc:              cdc,
+		legacySubspace:   legacySubspace,
+		ics4Wrapper:      ics4Wrapper,
+		channelKeeper:    channelKeeper,
+		accountKeeper:    accountKeeper,
+		msgRouter:        msgRouter,
+		queryRouter:      queryRouter,
+		mqsAllowList:     newModuleQuerySafeAllowList(),
+		encodingRegistry: icatypes.NewEncodingRegistry(),
+		accountFactories: types.NewAccountFactoryRouter(),
+		authority:        authority,
 	}
 }
 
@@ -92,6 +100,39 @@ func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
 	return k.ics4Wrapper
 }
 
+// RegisterEncoding registers an encoding format which may be negotiated in the channel version metadata, in addition
+// to the protobuf and proto3 JSON encoding formats. The encoding format is shared with every copy of the keeper. It
+// panics if the encoding format is already registered.
+func (k Keeper) RegisterEncoding(encoding string, encoder icatypes.Encoder) {
+	k.encodingRegistry.RegisterEncoding(encoding, encoder)
+}
+
+// GetEncodingRegistry returns the registry of the encoding formats which may be negotiated in the channel version
+// metadata.
+func (k Keeper) GetEncodingRegistry() icatypes.EncodingRegistry {
+	return k.encodingRegistry
+}
+
+// SetDefaultAccountFactory sets the account factory creating the accounts of the interchain accounts registered on the
+// connections without an account factory of their own. By default, InterchainAccount accounts are created. The account
+// factory is shared with every copy of the keeper.
+func (k Keeper) SetDefaultAccountFactory(factory types.AccountFactory) {
+	k.accountFactories.SetDefaultAccountFactory(factory)
+}
+
+// SetAccountFactory sets the account factory creating the accounts of the interchain accounts registered on the given
+// host connection. The account factory is shared with every copy of the keeper. It panics if an account factory is
+// already set for the connection.
+func (k Keeper) SetAccountFactory(connectionID string, factory types.AccountFactory) {
+	k.accountFactories.SetAccountFactory(connectionID, factory)
+}
+
+// GetAccountFactory returns the account factory creating the accounts of the interchain accounts registered on the
+// given host connection.
+func (k Keeper) GetAccountFactory(connectionID string) types.AccountFactory {
+	return k.accountFactories.GetAccountFactory(connectionID)
+}
+
 // Logger returns the application logger, scoped to the associated module
 func (Keeper) Logger(ctx context.Context) log.Logger {
 	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: remove after context.Context is removed from core IBC
@@ -73,6 +73,9 @@ func NewICAPath(chainA, chainB *ibctesting.TestChain, encoding string, ordering
 		version = TestVersion
 	case icatypes.EncodingProto3JSON:
 		version = TestVersionWithJSONEncoding
+	case icatypes.EncodingCBOR, icatypes.EncodingAminoJSON:
+		metadata := icatypes.NewMetadata(icatypes.Version, ibctesting.FirstConnectionID, ibctesting.FirstConnectionID, "", encoding, icatypes.TxTypeSDKMultiMsg)
+		version = string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
 	default:
 		panic(fmt.Errorf("unsupported encoding type: %s", encoding))
 	}
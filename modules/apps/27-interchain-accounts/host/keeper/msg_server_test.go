@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/host/types"
 	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
 	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 )
 
 func (suite *KeeperTestSuite) TestModuleQuerySafe() {
@@ -190,3 +191,108 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSetMessageAllowlist() {
+	allowlist := types.NewMessageAllowlist(ibctesting.FirstConnectionID, TestPortID, "", []string{sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})})
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgSetMessageAllowlist
+		expErr error
+	}{
+		{
+			"success",
+			types.NewMsgSetMessageAllowlist(suite.chainA.GetSimApp().ICAHostKeeper.GetAuthority(), allowlist),
+			nil,
+		},
+		{
+			"invalid signer address",
+			types.NewMsgSetMessageAllowlist("signer", allowlist),
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.chainA.GetContext()
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAHostKeeper)
+			res, err := msgServer.SetMessageAllowlist(ctx, tc.msg)
+
+			storedAllowlist, found := suite.chainA.GetSimApp().ICAHostKeeper.GetMessageAllowlist(ctx, allowlist.ConnectionId, allowlist.PortId, allowlist.Address)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().True(found)
+				suite.Require().Equal(allowlist, storedAllowlist)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRemoveMessageAllowlist() {
+	var msg *types.MsgRemoveMessageAllowlist
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = "signer"
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"allowlist not found",
+			func() {
+				msg.PortId = ""
+			},
+			types.ErrMessageAllowlistNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.chainA.GetContext()
+			allowlist := types.NewMessageAllowlist(ibctesting.FirstConnectionID, TestPortID, "", []string{sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})})
+			suite.chainA.GetSimApp().ICAHostKeeper.SetMessageAllowlist(ctx, allowlist)
+
+			msg = types.NewMsgRemoveMessageAllowlist(suite.chainA.GetSimApp().ICAHostKeeper.GetAuthority(), allowlist.ConnectionId, allowlist.PortId, allowlist.Address)
+
+			tc.malleate()
+
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAHostKeeper)
+			res, err := msgServer.RemoveMessageAllowlist(ctx, msg)
+
+			_, found := suite.chainA.GetSimApp().ICAHostKeeper.GetMessageAllowlist(ctx, allowlist.ConnectionId, allowlist.PortId, allowlist.Address)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+				suite.Require().True(found)
+			}
+		})
+	}
+}
@@ -2,14 +2,20 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"slices"
 
 	"github.com/cosmos/gogoproto/proto"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/host/internal/telemetry"
 	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
@@ -17,7 +23,7 @@ import (
 )
 
 // OnRecvPacket handles a given interchain accounts packet on a destination host chain.
-// If the transaction is successfully executed, the transaction response bytes will be returned.
+// If the transaction, or the queries of a query packet, are successfully executed, the response bytes will be returned.
 func (k Keeper) OnRecvPacket(ctx context.Context, packet channeltypes.Packet) ([]byte, error) {
 	var data icatypes.InterchainAccountPacketData
 	err := data.UnmarshalJSON(packet.GetData())
@@ -31,21 +37,99 @@ func (k Keeper) OnRecvPacket(ctx context.Context, packet channeltypes.Packet) ([
 		return nil, err
 	}
 
+	var (
+		execute   func(ctx sdk.Context) ([]byte, error)
+		operation string
+	)
 	switch data.Type {
 	case icatypes.EXECUTE_TX:
-		msgs, err := icatypes.DeserializeCosmosTx(k.cdc, data.Data, metadata.Encoding)
+		operation = "transaction"
+		msgs, err := k.encodingRegistry.DeserializeCosmosTx(k.cdc, data.Data, metadata.Encoding)
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to deserialize interchain account transaction")
 		}
 
-		txResponse, err := k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs)
+		switch data.ExecutionMode {
+		case icatypes.ATOMIC:
+			execute = func(ctx sdk.Context) ([]byte, error) {
+				return k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs)
+			}
+		case icatypes.BEST_EFFORT:
+			execute = func(ctx sdk.Context) ([]byte, error) {
+				return k.executeTxBestEffort(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs)
+			}
+		default:
+			return nil, errorsmod.Wrapf(icatypes.ErrInvalidOutgoingData, "invalid execution mode %d", data.ExecutionMode)
+		}
+	case icatypes.QUERY:
+		operation = "query"
+		query, err := k.encodingRegistry.DeserializeCosmosQuery(k.cdc, data.Data, metadata.Encoding)
 		if err != nil {
-			return nil, errorsmod.Wrapf(err, "failed to execute interchain account transaction")
+			return nil, errorsmod.Wrapf(err, "failed to deserialize interchain account query")
+		}
+
+		execute = func(ctx sdk.Context) ([]byte, error) {
+			return k.executeQuery(ctx, query)
 		}
-		return txResponse, nil
 	default:
 		return nil, icatypes.ErrUnknownDataType
 	}
+
+	gasLimit := k.getPacketGasLimit(ctx, data)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	txResponse, gasUsed, err := executeWithGasLimit(sdkCtx, gasLimit, execute)
+
+	EmitGasUsageEvent(ctx, packet, gasUsed, gasLimit)
+	telemetry.ReportOnRecvPacketGas(packet, gasUsed, errors.Is(err, types.ErrOutOfGas))
+
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to execute interchain account %s", operation)
+	}
+	return txResponse, nil
+}
+
+// getPacketGasLimit returns the maximum amount of gas which the execution of the transaction of the given packet data
+// may consume. It is the lowest of the gas limit of the packet data and the maximum gas per packet of the params, where
+// a value of zero indicates that no limit is set. Zero is returned if neither sets a limit.
+func (k Keeper) getPacketGasLimit(ctx context.Context, data icatypes.InterchainAccountPacketData) uint64 {
+	gasLimit := k.GetParams(ctx).MaxGasPerPacket
+	if data.GasLimit != 0 && (gasLimit == 0 || data.GasLimit < gasLimit) {
+		gasLimit = data.GasLimit
+	}
+
+	return gasLimit
+}
+
+// executeWithGasLimit calls execute and returns its results together with the amount of gas it consumed. If the gas limit
+// is non-zero, execute is called with a gas meter limited to it and running out of gas results in a deterministic error,
+// instead of a panic, with the gas consumed up to the limit. In both cases the gas consumed is charged to the gas meter
+// of the provided context, which is the gas meter of the transaction submitting the packet.
+func executeWithGasLimit(ctx sdk.Context, gasLimit uint64, execute func(ctx sdk.Context) ([]byte, error)) (txResponse []byte, gasUsed uint64, err error) {
+	if gasLimit == 0 {
+		gasBefore := ctx.GasMeter().GasConsumed()
+		txResponse, err = execute(ctx)
+		return txResponse, ctx.GasMeter().GasConsumed() - gasBefore, err
+	}
+
+	gasMeter := storetypes.NewGasMeter(gasLimit)
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			txResponse = nil
+			err = errorsmod.Wrapf(types.ErrOutOfGas, "out of gas in location: %s; gas limit: %d", outOfGas.Descriptor, gasLimit)
+		}
+
+		gasUsed = gasMeter.GasConsumedToLimit()
+		ctx.GasMeter().ConsumeGas(gasUsed, "interchain account transaction")
+	}()
+
+	txResponse, err = execute(ctx.WithGasMeter(gasMeter))
+	return txResponse, gasUsed, err
 }
 
 // executeTx attempts to execute the provided transaction. It begins by authenticating the transaction signer.
@@ -71,13 +155,7 @@ func (k Keeper) executeTx(ctx context.Context, sourcePort, destPort, destChannel
 	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
 	cacheCtx, writeCache := sdkCtx.CacheContext()
 	for i, msg := range msgs {
-		if m, ok := msg.(sdk.HasValidateBasic); ok {
-			if err := m.ValidateBasic(); err != nil {
-				return nil, err
-			}
-		}
-
-		protoAny, err := k.executeMsg(cacheCtx, msg)
+		protoAny, err := k.validateAndExecuteMsg(cacheCtx, msg)
 		if err != nil {
 			return nil, err
 		}
@@ -95,15 +173,72 @@ func (k Keeper) executeTx(ctx context.Context, sourcePort, destPort, destChannel
 	return txResponse, nil
 }
 
+// executeTxBestEffort attempts to execute the provided transaction in best-effort mode. As in executeTx, the transaction
+// signer is authenticated first and the transaction fails if authentication fails. Each message is then validated and
+// delivered independently: the state changes of a message are only committed if it succeeds, and the failure of a
+// message does not prevent the execution of the following ones. The result of every message is returned.
+func (k Keeper) executeTxBestEffort(ctx context.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg) ([]byte, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
+	if !found {
+		return nil, channeltypes.ErrChannelNotFound
+	}
+
+	if err := k.authenticateTx(ctx, msgs, channel.ConnectionHops[0], sourcePort); err != nil {
+		return nil, err
+	}
+
+	txResponse := &icatypes.BestEffortTxResponse{
+		Results: make([]icatypes.MsgResult, len(msgs)),
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	for i, msg := range msgs {
+		// each message is delivered in its own cached context, which is written only if the message succeeds
+		cacheCtx, writeCache := sdkCtx.CacheContext()
+
+		protoAny, err := k.validateAndExecuteMsg(cacheCtx, msg)
+		if err != nil {
+			k.Logger(ctx).Debug("interchain account message failed", "index", i, "msg", sdk.MsgTypeURL(msg), "error", err)
+			txResponse.Results[i] = newMsgErrorResult(err)
+			continue
+		}
+
+		writeCache()
+		txResponse.Results[i] = icatypes.MsgResult{Response: protoAny}
+	}
+
+	bz, err := proto.Marshal(txResponse)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to marshal tx data")
+	}
+
+	return bz, nil
+}
+
+// newMsgErrorResult returns the result of a message which failed with the given error. Only the deterministic
+// ABCI codespace and code of the error, and the reason chosen for it, are included in the result.
+func newMsgErrorResult(err error) icatypes.MsgResult {
+	codespace, code, _ := errorsmod.ABCIInfo(err, false) // discard non-deterministic log value
+
+	return icatypes.MsgResult{
+		Error: &channeltypes.ErrorAcknowledgement{
+			Codespace: codespace,
+			Code:      code,
+			Reason:    channeltypes.ErrorReason(err),
+		},
+	}
+}
+
 // authenticateTx ensures the provided msgs contain the correct interchain account signer address retrieved
-// from state using the provided controller port identifier
+// from state using the provided controller port identifier, and that the interchain account is allowed to
+// execute the msgs by the params or by a message allowlist within whose scope it is
 func (k Keeper) authenticateTx(ctx context.Context, msgs []sdk.Msg, connectionID, portID string) error {
 	interchainAccountAddr, found := k.GetInterchainAccountAddress(ctx, connectionID, portID)
 	if !found {
 		return errorsmod.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", portID)
 	}
 
-	allowMsgs := k.GetParams(ctx).AllowMessages
+	allowMsgs := k.GetAllowedMessages(ctx, connectionID, portID, interchainAccountAddr)
 	for _, msg := range msgs {
 		if !types.ContainsMsgType(allowMsgs, msg) {
 			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg))
@@ -129,6 +264,17 @@ func (k Keeper) authenticateTx(ctx context.Context, msgs []sdk.Msg, connectionID
 	return nil
 }
 
+// validateAndExecuteMsg performs basic validation of the message before executing it.
+func (k Keeper) validateAndExecuteMsg(ctx sdk.Context, msg sdk.Msg) (*codectypes.Any, error) {
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+
+	return k.executeMsg(ctx, msg)
+}
+
 // Attempts to get the message handler from the router and if found will then execute the message.
 // If the message execution is successful, the proto marshaled message response will be returned.
 func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) (*codectypes.Any, error) { // TODO: https://github.com/cosmos/ibc-go/issues/7223
@@ -153,3 +299,64 @@ func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) (*codectypes.Any, error
 
 	return msgResponse, nil
 }
+
+// executeQuery executes the module safe queries of a query packet in a cached context, whose state changes are
+// discarded, and returns the protobuf encoded CosmosQueryResponse. The queries are not authenticated against an
+// interchain account. The height at which the queries were executed is included in the response if requested.
+func (k Keeper) executeQuery(ctx sdk.Context, query icatypes.CosmosQuery) ([]byte, error) {
+	if len(query.Requests) == 0 {
+		return nil, errorsmod.Wrap(icatypes.ErrInvalidOutgoingData, "query packet must contain at least one query")
+	}
+
+	queryResponse := &icatypes.CosmosQueryResponse{
+		Responses: make([][]byte, len(query.Requests)),
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	for i, request := range query.Requests {
+		res, err := k.queryModuleSafe(cacheCtx, request.Path, request.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		queryResponse.Responses[i] = res
+	}
+
+	if query.IncludeHeight {
+		queryResponse.Height = uint64(ctx.BlockHeight())
+	}
+
+	bz, err := proto.Marshal(queryResponse)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to marshal query response")
+	}
+
+	return bz, nil
+}
+
+// queryModuleSafe routes the query with the given path and data to the query router if it is module_query_safe and
+// returns the response value.
+func (k Keeper) queryModuleSafe(ctx sdk.Context, path string, data []byte) ([]byte, error) {
+	if !slices.Contains(k.mqsAllowList, path) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "not module query safe: %s", path)
+	}
+
+	route := k.queryRouter.Route(path)
+	if route == nil {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "no route to query: %s", path)
+	}
+
+	res, err := route(ctx, &abci.RequestQuery{
+		Path: path,
+		Data: data,
+	})
+	if err != nil {
+		k.Logger(ctx).Debug("query failed", "path", path, "error", err)
+		return nil, err
+	}
+	if res == nil || res.Value == nil {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "no response for query: %s", path)
+	}
+
+	return res.Value, nil
+}
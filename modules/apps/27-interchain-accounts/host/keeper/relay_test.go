@@ -559,6 +559,109 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnRecvPacketRegisteredEncodings() {
+	var (
+		path       *ibctesting.Path
+		packetData []byte
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func(encoding string)
+		expErr   error
+	}{
+		{
+			"success: interchain account executes a message encoded with the registered encoding",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(ibctesting.TestCoin),
+				}
+
+				data, err := suite.chainB.GetSimApp().ICAHostKeeper.GetEncodingRegistry().SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+			},
+			nil,
+		},
+		{
+			"failure: messages encoded with another encoding",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(ibctesting.TestCoin),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+			},
+			ibcerrors.ErrInvalidType,
+		},
+	}
+
+	for _, encoding := range []string{icatypes.EncodingCBOR, icatypes.EncodingAminoJSON} {
+		for _, tc := range testCases {
+			tc := tc
+
+			suite.Run(fmt.Sprintf("%s: %s", encoding, tc.msg), func() {
+				suite.SetupTest() // reset
+
+				path = NewICAPath(suite.chainA, suite.chainB, encoding, channeltypes.ORDERED)
+				path.SetupConnections()
+
+				err := SetupICAPath(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000000))))
+
+				tc.malleate(encoding) // malleate mutates test data
+
+				packet := channeltypes.NewPacket(
+					packetData,
+					suite.chainA.SenderAccount.GetSequence(),
+					path.EndpointA.ChannelConfig.PortID,
+					path.EndpointA.ChannelID,
+					path.EndpointB.ChannelConfig.PortID,
+					path.EndpointB.ChannelID,
+					suite.chainB.GetTimeoutHeight(),
+					0,
+				)
+
+				txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+				if tc.expErr == nil {
+					suite.Require().NoError(err)
+					suite.Require().NotNil(txResponse)
+				} else {
+					suite.Require().ErrorIs(err, tc.expErr)
+					suite.Require().Nil(txResponse)
+				}
+			})
+		}
+	}
+}
+
 func (suite *KeeperTestSuite) TestJSONOnRecvPacket() {
 	var (
 		path       *ibctesting.Path
@@ -906,3 +1009,449 @@ func getTestProposalMessage() sdk.Msg {
 	_, _, addr := testdata.KeyTestPubAddr()
 	return banktypes.NewMsgSend(authtypes.NewModuleAddress("gov"), addr, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1000))))
 }
+
+func (suite *KeeperTestSuite) TestOnRecvPacketBestEffort() {
+	var (
+		path *ibctesting.Path
+		msgs []proto.Message
+	)
+
+	delegate := func(address string, amount int64) *stakingtypes.MsgDelegate {
+		return &stakingtypes.MsgDelegate{
+			DelegatorAddress: address,
+			ValidatorAddress: sdk.ValAddress(suite.chainB.Vals.Validators[0].Address).String(),
+			Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(amount)),
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		malleate   func(address string)
+		expSuccess []bool
+		expErr     error
+	}{
+		{
+			"all messages succeed",
+			func(address string) {
+				msgs = []proto.Message{delegate(address, 5000), delegate(address, 5000)}
+			},
+			[]bool{true, true},
+			nil,
+		},
+		{
+			"failing message does not revert the other messages",
+			func(address string) {
+				msgs = []proto.Message{delegate(address, 5000), delegate(address, 100_000_000), delegate(address, 5000)}
+			},
+			[]bool{true, false, true},
+			nil,
+		},
+		{
+			"all messages fail",
+			func(address string) {
+				msgs = []proto.Message{delegate(address, 100_000_000), delegate(address, 100_000_000)}
+			},
+			[]bool{false, false},
+			nil,
+		},
+		{
+			"unauthorized signer fails the whole transaction",
+			func(address string) {
+				msgs = []proto.Message{delegate(address, 5000), delegate(suite.chainB.SenderAccount.GetAddress().String(), 5000)}
+			},
+			nil,
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf, channeltypes.ORDERED)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000000))))
+
+			tc.malleate(interchainAccountAddr)
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), msgs, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type:          icatypes.EXECUTE_TX,
+				Data:          data,
+				ExecutionMode: icatypes.BEST_EFFORT,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				suite.chainB.GetTimeoutHeight(),
+				0,
+			)
+
+			ctx := suite.chainB.GetContext()
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(txResponse)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			var bestEffortResponse icatypes.BestEffortTxResponse
+			suite.Require().NoError(proto.Unmarshal(txResponse, &bestEffortResponse))
+			suite.Require().Len(bestEffortResponse.Results, len(tc.expSuccess))
+
+			expDelegated := sdkmath.ZeroInt()
+			for i, result := range bestEffortResponse.Results {
+				suite.Require().Equal(tc.expSuccess[i], result.IsSuccess(), "unexpected result for message %d", i)
+				if result.IsSuccess() {
+					suite.Require().NotNil(result.Response)
+					expDelegated = expDelegated.Add(msgs[i].(*stakingtypes.MsgDelegate).Amount.Amount)
+				} else {
+					suite.Require().Nil(result.Response)
+					suite.Require().NotEmpty(result.Error.Codespace)
+					suite.Require().NotZero(result.Error.Code)
+				}
+			}
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+			suite.Require().NoError(err)
+
+			delegated, err := suite.chainB.GetSimApp().StakingKeeper.GetDelegatorBonded(ctx, delegatorAddr)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expDelegated, delegated)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketGasLimit() {
+	var (
+		path            *ibctesting.Path
+		icaPacketData   icatypes.InterchainAccountPacketData
+		maxGasPerPacket uint64
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expGasLimit uint64
+		expErr      error
+	}{
+		{
+			"success: no gas limit",
+			func() {},
+			0,
+			nil,
+		},
+		{
+			"success: params gas limit is not exceeded",
+			func() {
+				maxGasPerPacket = 10_000_000
+			},
+			10_000_000,
+			nil,
+		},
+		{
+			"success: packet gas limit is not exceeded",
+			func() {
+				icaPacketData.GasLimit = 5_000_000
+			},
+			5_000_000,
+			nil,
+		},
+		{
+			"success: packet gas limit lower than params gas limit is used",
+			func() {
+				maxGasPerPacket = 10_000_000
+				icaPacketData.GasLimit = 5_000_000
+			},
+			5_000_000,
+			nil,
+		},
+		{
+			"failure: params gas limit is exceeded",
+			func() {
+				maxGasPerPacket = 1000
+			},
+			1000,
+			types.ErrOutOfGas,
+		},
+		{
+			"failure: packet gas limit is exceeded",
+			func() {
+				icaPacketData.GasLimit = 1000
+			},
+			1000,
+			types.ErrOutOfGas,
+		},
+		{
+			"failure: params gas limit lower than packet gas limit is exceeded",
+			func() {
+				maxGasPerPacket = 1000
+				icaPacketData.GasLimit = 5_000_000
+			},
+			1000,
+			types.ErrOutOfGas,
+		},
+		{
+			"failure: gas limit is exceeded in best-effort execution mode",
+			func() {
+				icaPacketData.ExecutionMode = icatypes.BEST_EFFORT
+				icaPacketData.GasLimit = 1000
+			},
+			1000,
+			types.ErrOutOfGas,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			maxGasPerPacket = 0
+
+			path = NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf, channeltypes.ORDERED)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000000))))
+
+			msg := &stakingtypes.MsgDelegate{
+				DelegatorAddress: interchainAccountAddr,
+				ValidatorAddress: sdk.ValAddress(suite.chainB.Vals.Validators[0].Address).String(),
+				Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(5000)),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData = icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			tc.malleate()
+
+			params := types.DefaultParams()
+			params.MaxGasPerPacket = maxGasPerPacket
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				suite.chainB.GetTimeoutHeight(),
+				0,
+			)
+
+			ctx := suite.chainB.GetContext()
+			gasBefore := ctx.GasMeter().GasConsumed()
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+
+			var gasUsedAttr, gasLimitAttr string
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != icatypes.EventTypeGasUsage {
+					continue
+				}
+
+				for _, attr := range event.Attributes {
+					switch attr.Key {
+					case icatypes.AttributeKeyGasUsed:
+						gasUsedAttr = attr.Value
+					case icatypes.AttributeKeyGasLimit:
+						gasLimitAttr = attr.Value
+					case icatypes.AttributeKeyControllerPortID:
+						suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, attr.Value)
+					}
+				}
+			}
+			suite.Require().Equal(fmt.Sprint(tc.expGasLimit), gasLimitAttr)
+
+			delegatorAddr, err2 := sdk.AccAddressFromBech32(interchainAccountAddr)
+			suite.Require().NoError(err2)
+
+			delegated, err2 := suite.chainB.GetSimApp().StakingKeeper.GetDelegatorBonded(ctx, delegatorAddr)
+			suite.Require().NoError(err2)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+				suite.Require().NotEqual("0", gasUsedAttr)
+				suite.Require().Equal(msg.Amount.Amount, delegated)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(txResponse)
+				// the gas consumed up to the limit is charged to the transaction submitting the packet
+				suite.Require().Equal(fmt.Sprint(tc.expGasLimit), gasUsedAttr)
+				suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed()-gasBefore, tc.expGasLimit)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketQuery() {
+	var (
+		path          *ibctesting.Path
+		sourcePort    string
+		requests      []icatypes.QueryRequest
+		includeHeight bool
+		icaPacketData icatypes.InterchainAccountPacketData
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func(encoding string)
+		expErr   error
+	}{
+		{
+			"success",
+			func(encoding string) {},
+			nil,
+		},
+		{
+			"success: height is included",
+			func(encoding string) {
+				includeHeight = true
+			},
+			nil,
+		},
+		{
+			"success: interchain account is not required",
+			func(encoding string) {
+				sourcePort = "icacontroller-unregistered"
+			},
+			nil,
+		},
+		{
+			"failure: query is not module query safe",
+			func(encoding string) {
+				requests[0].Path = "/ibc.applications.transfer.v1.Query/Params"
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: no queries",
+			func(encoding string) {
+				requests = nil
+			},
+			icatypes.ErrInvalidOutgoingData,
+		},
+		{
+			"failure: cannot deserialize query",
+			func(encoding string) {
+				icaPacketData.Data = []byte("invalid query")
+			},
+			ibcerrors.ErrInvalidType,
+		},
+		{
+			"failure: gas limit is exceeded",
+			func(encoding string) {
+				icaPacketData.GasLimit = 100
+			},
+			types.ErrOutOfGas,
+		},
+	}
+
+	for _, encoding := range []string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON} {
+		for _, tc := range testCases {
+			tc := tc
+
+			suite.Run(tc.name, func() {
+				suite.SetupTest() // reset
+
+				path = NewICAPath(suite.chainA, suite.chainB, encoding, channeltypes.ORDERED)
+				path.SetupConnections()
+
+				err := SetupICAPath(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				balanceQuery := banktypes.NewQueryBalanceRequest(suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+				queryBz, err := balanceQuery.Marshal()
+				suite.Require().NoError(err)
+
+				sourcePort = path.EndpointA.ChannelConfig.PortID
+				requests = []icatypes.QueryRequest{
+					{
+						Path: "/cosmos.bank.v1beta1.Query/Balance",
+						Data: queryBz,
+					},
+				}
+				includeHeight = false
+				icaPacketData = icatypes.InterchainAccountPacketData{
+					Type: icatypes.QUERY,
+				}
+
+				tc.malleate(encoding)
+
+				if icaPacketData.Data == nil {
+					icaPacketData.Data, err = icatypes.SerializeCosmosQuery(suite.chainA.GetSimApp().AppCodec(), requests, includeHeight, encoding)
+					suite.Require().NoError(err)
+				}
+
+				packet := channeltypes.NewPacket(
+					icaPacketData.GetBytes(),
+					suite.chainA.SenderAccount.GetSequence(),
+					sourcePort,
+					path.EndpointA.ChannelID,
+					path.EndpointB.ChannelConfig.PortID,
+					path.EndpointB.ChannelID,
+					suite.chainB.GetTimeoutHeight(),
+					0,
+				)
+
+				ctx := suite.chainB.GetContext()
+				txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+
+				if tc.expErr == nil {
+					suite.Require().NoError(err)
+
+					ack := channeltypes.NewResultAcknowledgement(txResponse)
+					queryResponse, err := icatypes.DecodeQueryAcknowledgement(ack.Acknowledgement())
+					suite.Require().NoError(err)
+					suite.Require().Len(queryResponse.Responses, 1)
+
+					var balanceResponse banktypes.QueryBalanceResponse
+					suite.Require().NoError(balanceResponse.Unmarshal(queryResponse.Responses[0]))
+
+					expBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+					suite.Require().Equal(expBalance, *balanceResponse.Balance)
+
+					if includeHeight {
+						suite.Require().Equal(uint64(ctx.BlockHeight()), queryResponse.Height)
+					} else {
+						suite.Require().Zero(queryResponse.Height)
+					}
+				} else {
+					suite.Require().ErrorIs(err, tc.expErr)
+					suite.Require().Nil(txResponse)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
+// AccountFactory defines the interface used by the host submodule to create the accounts of interchain accounts, so
+// that chains may register interchain accounts with their own account types.
+type AccountFactory interface {
+	// NewAccount returns the account of the interchain account with the given address, registered by the controller
+	// port with the channel version metadata proposed by the controller chain. The account must have the given address.
+	NewAccount(ctx context.Context, address sdk.AccAddress, controllerPortID string, metadata icatypes.Metadata) (sdk.AccountI, error)
+	// IsInterchainAccount returns true if the account, which may be nil, has an account type created by the factory.
+	// It is used to check the account of an interchain account whose channel is reopened.
+	IsInterchainAccount(account sdk.AccountI) bool
+}
+
+var _ AccountFactory = (*interchainAccountFactory)(nil)
+
+// interchainAccountFactory is the AccountFactory creating InterchainAccount accounts
+type interchainAccountFactory struct{}
+
+// NewInterchainAccountFactory returns the default AccountFactory, which creates an InterchainAccount wrapping a
+// BaseAccount and owned by the controller port.
+func NewInterchainAccountFactory() AccountFactory {
+	return interchainAccountFactory{}
+}
+
+// NewAccount implements AccountFactory
+func (interchainAccountFactory) NewAccount(_ context.Context, address sdk.AccAddress, controllerPortID string, _ icatypes.Metadata) (sdk.AccountI, error) {
+	return icatypes.NewInterchainAccount(authtypes.NewBaseAccountWithAddress(address), controllerPortID), nil
+}
+
+// IsInterchainAccount implements AccountFactory
+func (interchainAccountFactory) IsInterchainAccount(account sdk.AccountI) bool {
+	_, ok := account.(*icatypes.InterchainAccount)
+	return ok
+}
+
+// AccountFactoryRouter maps host connections to the AccountFactory creating the accounts of the interchain accounts
+// registered on them. Copies of an AccountFactoryRouter share the account factories set on any of them.
+type AccountFactoryRouter struct {
+	factories map[string]AccountFactory
+}
+
+// defaultAccountFactoryKey is the key of the account factory used for the connections without an account factory of
+// their own. It cannot collide with a connection identifier.
+const defaultAccountFactoryKey = ""
+
+// NewAccountFactoryRouter creates and returns a new AccountFactoryRouter, creating InterchainAccount accounts on every
+// connection.
+func NewAccountFactoryRouter() AccountFactoryRouter {
+	router := AccountFactoryRouter{
+		factories: make(map[string]AccountFactory),
+	}
+
+	router.SetDefaultAccountFactory(NewInterchainAccountFactory())
+
+	return router
+}
+
+// SetDefaultAccountFactory sets the account factory used for the connections without an account factory of their own.
+// It panics if the account factory is nil.
+func (r AccountFactoryRouter) SetDefaultAccountFactory(factory AccountFactory) {
+	if factory == nil {
+		panic(errors.New("default account factory cannot be nil"))
+	}
+
+	r.factories[defaultAccountFactoryKey] = factory
+}
+
+// SetAccountFactory sets the account factory used for the given host connection. It panics if the connection
+// identifier is invalid, if the account factory is nil or if an account factory is already set for the connection.
+func (r AccountFactoryRouter) SetAccountFactory(connectionID string, factory AccountFactory) {
+	if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+		panic(err)
+	}
+
+	if factory == nil {
+		panic(fmt.Errorf("account factory of connection %s cannot be nil", connectionID))
+	}
+
+	if _, found := r.factories[connectionID]; found {
+		panic(fmt.Errorf("account factory of connection %s is already set", connectionID))
+	}
+
+	r.factories[connectionID] = factory
+}
+
+// GetAccountFactory returns the account factory used for the given host connection: the account factory set for the
+// connection, if any, otherwise the default account factory.
+func (r AccountFactoryRouter) GetAccountFactory(connectionID string) AccountFactory {
+	if factory, found := r.factories[connectionID]; found {
+		return factory
+	}
+
+	return r.factories[defaultAccountFactoryKey]
+}
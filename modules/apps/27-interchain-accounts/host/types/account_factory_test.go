@@ -0,0 +1,32 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func TestAccountFactoryRouter(t *testing.T) {
+	router := types.NewAccountFactoryRouter()
+	address := sdk.AccAddress("address")
+
+	defaultFactory := router.GetAccountFactory(ibctesting.FirstConnectionID)
+	require.True(t, defaultFactory.IsInterchainAccount(icatypes.NewInterchainAccount(authtypes.NewBaseAccountWithAddress(address), "port")))
+	require.False(t, defaultFactory.IsInterchainAccount(authtypes.NewBaseAccountWithAddress(address)))
+	require.False(t, defaultFactory.IsInterchainAccount(nil))
+
+	// copies of the router share the account factories set
+	routerCopy := router
+	routerCopy.SetAccountFactory(ibctesting.FirstConnectionID, types.NewInterchainAccountFactory())
+	require.Panics(t, func() { router.SetAccountFactory(ibctesting.FirstConnectionID, types.NewInterchainAccountFactory()) })
+	require.Panics(t, func() { router.SetAccountFactory("", types.NewInterchainAccountFactory()) })
+	require.Panics(t, func() { router.SetAccountFactory("connection-1", nil) })
+	require.Panics(t, func() { router.SetDefaultAccountFactory(nil) })
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
+// NewMessageAllowlist creates a new MessageAllowlist instance. Empty scope fields match every interchain account.
+func NewMessageAllowlist(connectionID, portID, address string, allowMsgs []string) MessageAllowlist {
+	return MessageAllowlist{
+		ConnectionId:  connectionID,
+		PortId:        portID,
+		Address:       address,
+		AllowMessages: allowMsgs,
+	}
+}
+
+// Validate performs basic validation of the message allowlist.
+func (a MessageAllowlist) Validate() error {
+	if err := ValidateMessageAllowlistScope(a.ConnectionId, a.PortId, a.Address); err != nil {
+		return err
+	}
+
+	if len(a.AllowMessages) == 0 {
+		return errorsmod.Wrap(ErrInvalidMessageAllowlist, "allow list cannot be empty")
+	}
+
+	if err := validateAllowlist(a.AllowMessages); err != nil {
+		return errorsmod.Wrap(ErrInvalidMessageAllowlist, err.Error())
+	}
+
+	return nil
+}
+
+// ValidateMessageAllowlistScope validates the scope fields of a message allowlist. At least one of the
+// scope fields must be set, as the messages allowed to every interchain account are defined by the params.
+func ValidateMessageAllowlistScope(connectionID, portID, address string) error {
+	if connectionID == "" && portID == "" && address == "" {
+		return errorsmod.Wrap(ErrInvalidMessageAllowlist, "at least one of connection ID, port ID or address must be set")
+	}
+
+	if connectionID != "" {
+		if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMessageAllowlist, "invalid connection ID: %v", err)
+		}
+	}
+
+	if portID != "" {
+		if err := host.PortIdentifierValidator(portID); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMessageAllowlist, "invalid port ID: %v", err)
+		}
+	}
+
+	if address != "" {
+		if err := icatypes.ValidateAccountAddress(address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMessageAllowlist, "invalid address: %v", err)
+		}
+	}
+
+	return nil
+}
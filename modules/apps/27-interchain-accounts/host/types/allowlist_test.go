@@ -0,0 +1,93 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+const (
+	msgDelegateTypeURL = "/cosmos.staking.v1beta1.MsgDelegate"
+	controllerPortID   = "icacontroller-cosmos1dao"
+	icaAddress         = "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+)
+
+func TestMessageAllowlistValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		allowlist types.MessageAllowlist
+		expErr    error
+	}{
+		{
+			"success: connection scope",
+			types.NewMessageAllowlist(ibctesting.FirstConnectionID, "", "", []string{msgDelegateTypeURL}),
+			nil,
+		},
+		{
+			"success: controller port scope",
+			types.NewMessageAllowlist("", controllerPortID, "", []string{msgDelegateTypeURL}),
+			nil,
+		},
+		{
+			"success: address scope",
+			types.NewMessageAllowlist("", "", icaAddress, []string{msgDelegateTypeURL}),
+			nil,
+		},
+		{
+			"success: all messages allowed",
+			types.NewMessageAllowlist(ibctesting.FirstConnectionID, controllerPortID, icaAddress, []string{types.AllowAllHostMsgs}),
+			nil,
+		},
+		{
+			"failure: empty scope",
+			types.NewMessageAllowlist("", "", "", []string{msgDelegateTypeURL}),
+			types.ErrInvalidMessageAllowlist,
+		},
+		{
+			"failure: invalid connection ID",
+			types.NewMessageAllowlist("invalid|connection", "", "", []string{msgDelegateTypeURL}),
+			types.ErrInvalidMessageAllowlist,
+		},
+		{
+			"failure: invalid port ID",
+			types.NewMessageAllowlist("", "icacontroller/", "", []string{msgDelegateTypeURL}),
+			types.ErrInvalidMessageAllowlist,
+		},
+		{
+			"failure: invalid address",
+			types.NewMessageAllowlist("", "", "cosmos1-invalid", []string{msgDelegateTypeURL}),
+			types.ErrInvalidMessageAllowlist,
+		},
+		{
+			"failure: empty allow list",
+			types.NewMessageAllowlist(ibctesting.FirstConnectionID, "", "", nil),
+			types.ErrInvalidMessageAllowlist,
+		},
+		{
+			"failure: blank message type URL",
+			types.NewMessageAllowlist(ibctesting.FirstConnectionID, "", "", []string{" "}),
+			types.ErrInvalidMessageAllowlist,
+		},
+		{
+			"failure: wildcard with other message type URLs",
+			types.NewMessageAllowlist(ibctesting.FirstConnectionID, "", "", []string{types.AllowAllHostMsgs, msgDelegateTypeURL}),
+			types.ErrInvalidMessageAllowlist,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.allowlist.Validate()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -12,6 +12,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 		&MsgModuleQuerySafe{},
+		&MsgSetMessageAllowlist{},
+		&MsgRemoveMessageAllowlist{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -28,6 +28,16 @@ func TestCodecTypeRegistration(t *testing.T) {
 			sdk.MsgTypeURL(&types.MsgModuleQuerySafe{}),
 			"",
 		},
+		{
+			"success: MsgSetMessageAllowlist",
+			sdk.MsgTypeURL(&types.MsgSetMessageAllowlist{}),
+			"",
+		},
+		{
+			"success: MsgRemoveMessageAllowlist",
+			sdk.MsgTypeURL(&types.MsgRemoveMessageAllowlist{}),
+			"",
+		},
 		{
 			"type not registered on codec",
 			"ibc.invalid.MsgTypeURL",
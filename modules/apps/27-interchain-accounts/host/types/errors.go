@@ -6,5 +6,9 @@ import (
 
 // ICA Host sentinel errors
 var (
-	ErrHostSubModuleDisabled = errorsmod.Register(SubModuleName, 2, "host submodule is disabled")
+	ErrHostSubModuleDisabled    = errorsmod.Register(SubModuleName, 2, "host submodule is disabled")
+	ErrInvalidMessageAllowlist  = errorsmod.Register(SubModuleName, 3, "invalid message allowlist")
+	ErrMessageAllowlistNotFound = errorsmod.Register(SubModuleName, 4, "message allowlist not found")
+	ErrOutOfGas                 = errorsmod.Register(SubModuleName, 5, "interchain account transaction out of gas")
+	ErrInvalidAccount           = errorsmod.Register(SubModuleName, 6, "invalid interchain account")
 )
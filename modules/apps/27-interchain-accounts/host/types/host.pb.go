@@ -29,6 +29,9 @@ type Params struct {
 	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty"`
 	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain.
 	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty"`
+	// max_gas_per_packet defines the maximum amount of gas which the execution of the transaction of a single
+	// interchain accounts packet may consume on a host chain. A value of zero disables the limit.
+	MaxGasPerPacket uint64 `protobuf:"varint,3,opt,name=max_gas_per_packet,json=maxGasPerPacket,proto3" json:"max_gas_per_packet,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -78,6 +81,90 @@ func (m *Params) GetAllowMessages() []string {
 	return nil
 }
 
+func (m *Params) GetMaxGasPerPacket() uint64 {
+	if m != nil {
+		return m.MaxGasPerPacket
+	}
+	return 0
+}
+
+// MessageAllowlist defines a list of sdk message typeURLs that the interchain accounts within its scope are
+// allowed to execute on the host chain, in addition to the messages allowed by the params. An interchain account
+// is within the scope of the allowlist if it matches every non-empty scope field.
+type MessageAllowlist struct {
+	// connection_id restricts the allowlist to the interchain accounts of the connection.
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// port_id restricts the allowlist to the interchain accounts of the controller port, i.e. "icacontroller-"
+	// followed by the owner address.
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// address restricts the allowlist to a single interchain account address.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// allow_messages defines a list of sdk message typeURLs allowed to be executed by the interchain accounts within
+	// the scope of the allowlist.
+	AllowMessages []string `protobuf:"bytes,4,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty"`
+}
+
+func (m *MessageAllowlist) Reset()         { *m = MessageAllowlist{} }
+func (m *MessageAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MessageAllowlist) ProtoMessage()    {}
+func (*MessageAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{1}
+}
+func (m *MessageAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageAllowlist.Merge(m, src)
+}
+func (m *MessageAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageAllowlist proto.InternalMessageInfo
+
+func (m *MessageAllowlist) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *MessageAllowlist) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *MessageAllowlist) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MessageAllowlist) GetAllowMessages() []string {
+	if m != nil {
+		return m.AllowMessages
+	}
+	return nil
+}
+
 // QueryRequest defines the parameters for a particular query request
 // by an interchain account.
 type QueryRequest struct {
@@ -93,7 +180,7 @@ func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
 func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()    {}
 func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48e202774f13d08e, []int{1}
+	return fileDescriptor_48e202774f13d08e, []int{2}
 }
 func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -138,6 +225,7 @@ func (m *QueryRequest) GetData() []byte {
 
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.host.v1.Params")
+	proto.RegisterType((*MessageAllowlist)(nil), "ibc.applications.interchain_accounts.host.v1.MessageAllowlist")
 	proto.RegisterType((*QueryRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryRequest")
 }
 
@@ -146,25 +234,31 @@ func init() {
 }
 
 var fileDescriptor_48e202774f13d08e = []byte{
-	// 284 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xd0, 0x31, 0x4b, 0xfc, 0x30,
-	0x18, 0x06, 0xf0, 0xcb, 0xfd, 0xff, 0x1c, 0x5e, 0x3c, 0x1d, 0x3a, 0xdd, 0x14, 0xce, 0x03, 0xa1,
-	0x83, 0x6d, 0x38, 0x05, 0x0f, 0x57, 0xc1, 0x45, 0x10, 0x34, 0xa3, 0x4b, 0x79, 0x93, 0x86, 0x36,
-	0xd0, 0x36, 0xb5, 0x6f, 0x5a, 0xb9, 0x6f, 0xe1, 0xc7, 0x72, 0xbc, 0xd1, 0x51, 0xda, 0x2f, 0x22,
-	0x8d, 0x82, 0x0a, 0x4e, 0x79, 0xf8, 0xc1, 0x13, 0x78, 0x1f, 0xba, 0x35, 0x52, 0x71, 0xa8, 0xeb,
-	0xc2, 0x28, 0x70, 0xc6, 0x56, 0xc8, 0x4d, 0xe5, 0x74, 0xa3, 0x72, 0x30, 0x55, 0x02, 0x4a, 0xd9,
-	0xb6, 0x72, 0xc8, 0x73, 0x8b, 0x8e, 0x77, 0x1b, 0xff, 0xc6, 0x75, 0x63, 0x9d, 0x0d, 0xce, 0x8c,
-	0x54, 0xf1, 0xcf, 0x62, 0xfc, 0x47, 0x31, 0xf6, 0x85, 0x6e, 0xb3, 0x16, 0x74, 0x76, 0x0f, 0x0d,
-	0x94, 0x18, 0x9c, 0xd0, 0xc5, 0x88, 0x89, 0xae, 0x40, 0x16, 0x3a, 0x5d, 0x92, 0x15, 0x09, 0x0f,
-	0xc4, 0xe1, 0x68, 0x37, 0x9f, 0x14, 0x9c, 0xd2, 0x63, 0x28, 0x0a, 0xfb, 0x9c, 0x94, 0x1a, 0x11,
-	0x32, 0x8d, 0xcb, 0xe9, 0xea, 0x5f, 0x38, 0x17, 0x47, 0x5e, 0xef, 0xbe, 0x70, 0x7d, 0x49, 0x17,
-	0x0f, 0xad, 0x6e, 0x76, 0x42, 0x3f, 0xb5, 0x1a, 0x5d, 0x10, 0xd0, 0xff, 0x35, 0xb8, 0xdc, 0xff,
-	0x38, 0x17, 0x3e, 0x8f, 0x96, 0x82, 0x83, 0xe5, 0x74, 0x45, 0xc2, 0x85, 0xf0, 0xf9, 0x3a, 0x7d,
-	0xed, 0x19, 0xd9, 0xf7, 0x8c, 0xbc, 0xf7, 0x8c, 0xbc, 0x0c, 0x6c, 0xb2, 0x1f, 0xd8, 0xe4, 0x6d,
-	0x60, 0x93, 0xc7, 0xdb, 0xcc, 0xb8, 0xbc, 0x95, 0xb1, 0xb2, 0x25, 0x57, 0x16, 0x4b, 0x8b, 0xdc,
-	0x48, 0x15, 0x65, 0x96, 0x77, 0x57, 0xbc, 0xb4, 0x69, 0x5b, 0x68, 0x1c, 0xc7, 0x42, 0x7e, 0xbe,
-	0x8d, 0xbe, 0xcf, 0x8d, 0x7e, 0xef, 0xe4, 0x76, 0xb5, 0x46, 0x39, 0xf3, 0x33, 0x5d, 0x7c, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x81, 0xd5, 0x47, 0x47, 0x61, 0x01, 0x00, 0x00,
+	// 380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x41, 0xab, 0x13, 0x31,
+	0x10, 0xc7, 0xbb, 0xef, 0x95, 0x3e, 0x1b, 0xf7, 0xa9, 0xe4, 0xe2, 0x9e, 0x96, 0x5a, 0x11, 0x0a,
+	0xda, 0x0d, 0x4f, 0xc1, 0x87, 0x47, 0x05, 0x91, 0x0a, 0x42, 0xdd, 0xa3, 0x97, 0x65, 0x36, 0x09,
+	0xbb, 0xc1, 0xdd, 0x4d, 0xcc, 0x64, 0x6b, 0x7b, 0xf2, 0x2b, 0xe8, 0xb7, 0xf2, 0xd8, 0xa3, 0x47,
+	0x69, 0xbf, 0x88, 0x24, 0xad, 0x54, 0xa1, 0xa7, 0xcc, 0xfc, 0x86, 0x7f, 0x66, 0xfe, 0xfc, 0xc9,
+	0xad, 0x2a, 0x39, 0x03, 0x63, 0x1a, 0xc5, 0xc1, 0x29, 0xdd, 0x21, 0x53, 0x9d, 0x93, 0x96, 0xd7,
+	0xa0, 0xba, 0x02, 0x38, 0xd7, 0x7d, 0xe7, 0x90, 0xd5, 0x1a, 0x1d, 0x5b, 0xdd, 0x84, 0x37, 0x33,
+	0x56, 0x3b, 0x4d, 0x9f, 0xa9, 0x92, 0x67, 0xff, 0x0a, 0xb3, 0x33, 0xc2, 0x2c, 0x08, 0x56, 0x37,
+	0xd3, 0x6f, 0x64, 0xb4, 0x04, 0x0b, 0x2d, 0xd2, 0x47, 0x24, 0xf6, 0xb0, 0x90, 0x1d, 0x94, 0x8d,
+	0x14, 0x49, 0x34, 0x89, 0x66, 0x77, 0xf2, 0xbb, 0x9e, 0xbd, 0x3d, 0x20, 0xfa, 0x84, 0xdc, 0x83,
+	0xa6, 0xd1, 0x5f, 0x8b, 0x56, 0x22, 0x42, 0x25, 0x31, 0xb9, 0x98, 0x5c, 0xce, 0xc6, 0xf9, 0x75,
+	0xa0, 0x1f, 0x8e, 0x90, 0x3e, 0x25, 0xb4, 0x85, 0x75, 0x51, 0x01, 0x16, 0x46, 0xda, 0xc2, 0x00,
+	0xff, 0x2c, 0x5d, 0x72, 0x39, 0x89, 0x66, 0xc3, 0xfc, 0x7e, 0x0b, 0xeb, 0x77, 0x80, 0x4b, 0x69,
+	0x97, 0x01, 0x4f, 0x7f, 0x44, 0xe4, 0xc1, 0x51, 0xf9, 0xda, 0xff, 0xd2, 0x28, 0x74, 0xf4, 0x31,
+	0xb9, 0xe6, 0xba, 0xeb, 0x24, 0xf7, 0x06, 0x0a, 0x75, 0x38, 0x66, 0x9c, 0xc7, 0x27, 0xb8, 0x10,
+	0xf4, 0x21, 0xb9, 0x32, 0xda, 0x3a, 0x3f, 0xbe, 0x08, 0xe3, 0x91, 0x6f, 0x17, 0x82, 0x26, 0xe4,
+	0x0a, 0x84, 0xb0, 0x12, 0x31, 0x2c, 0x1d, 0xe7, 0x7f, 0xdb, 0x33, 0x06, 0x86, 0x67, 0x0c, 0x4c,
+	0x5f, 0x92, 0xf8, 0x63, 0x2f, 0xed, 0x26, 0x97, 0x5f, 0x7a, 0x89, 0x8e, 0x52, 0x32, 0x34, 0xe0,
+	0xea, 0xe3, 0x15, 0xa1, 0xf6, 0x4c, 0x80, 0x83, 0xb0, 0x3a, 0xce, 0x43, 0xfd, 0x46, 0xfc, 0xdc,
+	0xa5, 0xd1, 0x76, 0x97, 0x46, 0xbf, 0x77, 0x69, 0xf4, 0x7d, 0x9f, 0x0e, 0xb6, 0xfb, 0x74, 0xf0,
+	0x6b, 0x9f, 0x0e, 0x3e, 0xbd, 0xaf, 0x94, 0xab, 0xfb, 0x32, 0xe3, 0xba, 0x65, 0x5c, 0x63, 0xab,
+	0x91, 0xa9, 0x92, 0xcf, 0x2b, 0xcd, 0x56, 0xaf, 0x58, 0xab, 0x45, 0xdf, 0x48, 0xf4, 0x69, 0x23,
+	0x7b, 0x7e, 0x3b, 0x3f, 0xe5, 0x35, 0xff, 0x3f, 0x68, 0xb7, 0x31, 0x12, 0xcb, 0x51, 0xc8, 0xf9,
+	0xc5, 0x9f, 0x01, 0x00, 0xda, 0x43, 0x9b, 0x26, 0x22, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -187,6 +281,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxGasPerPacket != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxGasPerPacket))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.AllowMessages) > 0 {
 		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowMessages[iNdEx])
@@ -209,6 +308,59 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MessageAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowMessages) > 0 {
+		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowMessages[iNdEx])
+			copy(dAtA[i:], m.AllowMessages[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -272,6 +424,36 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovHost(uint64(l))
 		}
 	}
+	if m.MaxGasPerPacket != 0 {
+		n += 1 + sovHost(uint64(m.MaxGasPerPacket))
+	}
+	return n
+}
+
+func (m *MessageAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -379,6 +561,203 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerPacket", wireType)
+			}
+			m.MaxGasPerPacket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerPacket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHost(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -14,10 +16,18 @@ const (
 	// ParamsKey is the key to use for the storing params.
 	ParamsKey = "params"
 
+	// MessageAllowlistKeyPrefix defines the key prefix used to store the message allowlists
+	MessageAllowlistKeyPrefix = "messageAllowlist"
+
 	// AllowAllHostMsgs holds the string key that allows all message types on interchain accounts host module
 	AllowAllHostMsgs = "*"
 )
 
+// MessageAllowlistKey returns the store key under which the message allowlist with the given scope is stored
+func MessageAllowlistKey(connectionID, portID, address string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s", MessageAllowlistKeyPrefix, connectionID, portID, address))
+}
+
 // ContainsMsgType returns true if the sdk.Msg TypeURL is present in allowMsgs, otherwise false
 func ContainsMsgType(allowMsgs []string, msg sdk.Msg) bool {
 	// check that wildcard * option for allowing all message types is the only string in the array, if so, return true
@@ -14,6 +14,12 @@ var (
 
 	_ sdk.Msg              = (*MsgModuleQuerySafe)(nil)
 	_ sdk.HasValidateBasic = (*MsgModuleQuerySafe)(nil)
+
+	_ sdk.Msg              = (*MsgSetMessageAllowlist)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetMessageAllowlist)(nil)
+
+	_ sdk.Msg              = (*MsgRemoveMessageAllowlist)(nil)
+	_ sdk.HasValidateBasic = (*MsgRemoveMessageAllowlist)(nil)
 )
 
 // NewMsgUpdateParams creates a new MsgUpdateParams instance
@@ -55,3 +61,41 @@ func (msg MsgModuleQuerySafe) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgSetMessageAllowlist creates a new MsgSetMessageAllowlist instance
+func NewMsgSetMessageAllowlist(signer string, allowlist MessageAllowlist) *MsgSetMessageAllowlist {
+	return &MsgSetMessageAllowlist{
+		Signer:    signer,
+		Allowlist: allowlist,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (msg MsgSetMessageAllowlist) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.Allowlist.Validate()
+}
+
+// NewMsgRemoveMessageAllowlist creates a new MsgRemoveMessageAllowlist instance
+func NewMsgRemoveMessageAllowlist(signer, connectionID, portID, address string) *MsgRemoveMessageAllowlist {
+	return &MsgRemoveMessageAllowlist{
+		Signer:       signer,
+		ConnectionId: connectionID,
+		PortId:       portID,
+		Address:      address,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (msg MsgRemoveMessageAllowlist) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return ValidateMessageAllowlistScope(msg.ConnectionId, msg.PortId, msg.Address)
+}
@@ -147,3 +147,75 @@ func TestMsgModuleQuerySafeGetSigners(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetMessageAllowlistValidateBasic(t *testing.T) {
+	allowlist := types.NewMessageAllowlist(ibctesting.FirstConnectionID, "", "", []string{msgDelegateTypeURL})
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgSetMessageAllowlist
+		expErr error
+	}{
+		{
+			"success: valid allowlist",
+			types.NewMsgSetMessageAllowlist(sdk.AccAddress(ibctesting.TestAccAddress).String(), allowlist),
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			types.NewMsgSetMessageAllowlist("signer", allowlist),
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid allowlist",
+			types.NewMsgSetMessageAllowlist(sdk.AccAddress(ibctesting.TestAccAddress).String(), types.NewMessageAllowlist("", "", "", []string{msgDelegateTypeURL})),
+			types.ErrInvalidMessageAllowlist,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
+
+func TestMsgRemoveMessageAllowlistValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgRemoveMessageAllowlist
+		expErr error
+	}{
+		{
+			"success: valid scope",
+			types.NewMsgRemoveMessageAllowlist(sdk.AccAddress(ibctesting.TestAccAddress).String(), ibctesting.FirstConnectionID, controllerPortID, ""),
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			types.NewMsgRemoveMessageAllowlist("signer", ibctesting.FirstConnectionID, controllerPortID, ""),
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: empty scope",
+			types.NewMsgRemoveMessageAllowlist(sdk.AccAddress(ibctesting.TestAccAddress).String(), "", "", ""),
+			types.ErrInvalidMessageAllowlist,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -111,9 +113,224 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
+// QueryMessageAllowlistsRequest is the request type for the Query/MessageAllowlists RPC method.
+type QueryMessageAllowlistsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMessageAllowlistsRequest) Reset()         { *m = QueryMessageAllowlistsRequest{} }
+func (m *QueryMessageAllowlistsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMessageAllowlistsRequest) ProtoMessage()    {}
+func (*QueryMessageAllowlistsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{2}
+}
+func (m *QueryMessageAllowlistsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMessageAllowlistsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMessageAllowlistsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMessageAllowlistsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMessageAllowlistsRequest.Merge(m, src)
+}
+func (m *QueryMessageAllowlistsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMessageAllowlistsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMessageAllowlistsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMessageAllowlistsRequest proto.InternalMessageInfo
+
+func (m *QueryMessageAllowlistsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMessageAllowlistsResponse is the response type for the Query/MessageAllowlists RPC method.
+type QueryMessageAllowlistsResponse struct {
+	// message_allowlists returns all the message allowlists.
+	MessageAllowlists []MessageAllowlist `protobuf:"bytes,1,rep,name=message_allowlists,json=messageAllowlists,proto3" json:"message_allowlists"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMessageAllowlistsResponse) Reset()         { *m = QueryMessageAllowlistsResponse{} }
+func (m *QueryMessageAllowlistsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMessageAllowlistsResponse) ProtoMessage()    {}
+func (*QueryMessageAllowlistsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{3}
+}
+func (m *QueryMessageAllowlistsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMessageAllowlistsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMessageAllowlistsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMessageAllowlistsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMessageAllowlistsResponse.Merge(m, src)
+}
+func (m *QueryMessageAllowlistsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMessageAllowlistsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMessageAllowlistsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMessageAllowlistsResponse proto.InternalMessageInfo
+
+func (m *QueryMessageAllowlistsResponse) GetMessageAllowlists() []MessageAllowlist {
+	if m != nil {
+		return m.MessageAllowlists
+	}
+	return nil
+}
+
+func (m *QueryMessageAllowlistsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllowedMessagesRequest is the request type for the Query/AllowedMessages RPC method.
+type QueryAllowedMessagesRequest struct {
+	// connection identifier of the interchain account
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// controller port identifier of the interchain account
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+}
+
+func (m *QueryAllowedMessagesRequest) Reset()         { *m = QueryAllowedMessagesRequest{} }
+func (m *QueryAllowedMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedMessagesRequest) ProtoMessage()    {}
+func (*QueryAllowedMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{4}
+}
+func (m *QueryAllowedMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedMessagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedMessagesRequest.Merge(m, src)
+}
+func (m *QueryAllowedMessagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedMessagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedMessagesRequest proto.InternalMessageInfo
+
+func (m *QueryAllowedMessagesRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *QueryAllowedMessagesRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+// QueryAllowedMessagesResponse is the response type for the Query/AllowedMessages RPC method.
+type QueryAllowedMessagesResponse struct {
+	// address of the interchain account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// allow_messages defines the list of sdk message typeURLs the interchain account is allowed to execute.
+	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty"`
+}
+
+func (m *QueryAllowedMessagesResponse) Reset()         { *m = QueryAllowedMessagesResponse{} }
+func (m *QueryAllowedMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedMessagesResponse) ProtoMessage()    {}
+func (*QueryAllowedMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{5}
+}
+func (m *QueryAllowedMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedMessagesResponse.Merge(m, src)
+}
+func (m *QueryAllowedMessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedMessagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedMessagesResponse proto.InternalMessageInfo
+
+func (m *QueryAllowedMessagesResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryAllowedMessagesResponse) GetAllowMessages() []string {
+	if m != nil {
+		return m.AllowMessages
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryMessageAllowlistsRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryMessageAllowlistsRequest")
+	proto.RegisterType((*QueryMessageAllowlistsResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryMessageAllowlistsResponse")
+	proto.RegisterType((*QueryAllowedMessagesRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryAllowedMessagesRequest")
+	proto.RegisterType((*QueryAllowedMessagesResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryAllowedMessagesResponse")
 }
 
 func init() {
@@ -121,27 +338,47 @@ func init() {
 }
 
 var fileDescriptor_e6b7e23fc90c353a = []byte{
-	// 312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x91, 0x31, 0x4b, 0x33, 0x31,
-	0x18, 0xc7, 0x9b, 0x17, 0xde, 0x0e, 0x71, 0x8b, 0x0e, 0x52, 0x24, 0x48, 0x27, 0x87, 0x36, 0xa1,
-	0xb5, 0x50, 0xdd, 0xd4, 0x51, 0x1c, 0xd4, 0xd1, 0x45, 0x72, 0x69, 0xb8, 0x06, 0x7a, 0x79, 0xd2,
-	0x4b, 0xee, 0xa0, 0xab, 0x9f, 0x40, 0xf0, 0x23, 0xb9, 0xb8, 0x08, 0x05, 0x17, 0x47, 0xb9, 0xf3,
-	0x83, 0xc8, 0xe5, 0x0e, 0xb4, 0x28, 0xc2, 0xe1, 0xfa, 0x84, 0xdf, 0xef, 0xff, 0xfc, 0x9f, 0xe0,
-	0x23, 0x1d, 0x49, 0x2e, 0xac, 0x5d, 0x68, 0x29, 0xbc, 0x06, 0xe3, 0xb8, 0x36, 0x5e, 0xa5, 0x72,
-	0x2e, 0xb4, 0xb9, 0x15, 0x52, 0x42, 0x66, 0xbc, 0xe3, 0x73, 0x70, 0x9e, 0xe7, 0x23, 0xbe, 0xcc,
-	0x54, 0xba, 0x62, 0x36, 0x05, 0x0f, 0x64, 0xa0, 0x23, 0xc9, 0xbe, 0x92, 0xec, 0x07, 0x92, 0x55,
-	0x24, 0xcb, 0x47, 0xbd, 0xbd, 0x18, 0x20, 0x5e, 0x28, 0x2e, 0xac, 0xe6, 0xc2, 0x18, 0xf0, 0x0d,
-	0x13, 0x5c, 0xbd, 0x69, 0xab, 0x2d, 0x82, 0x33, 0x80, 0xfd, 0x1d, 0x4c, 0xae, 0xaa, 0x9d, 0x2e,
-	0x45, 0x2a, 0x12, 0x77, 0xad, 0x96, 0x99, 0x72, 0xbe, 0x2f, 0xf1, 0xf6, 0xc6, 0xd4, 0x59, 0x30,
-	0x4e, 0x91, 0x0b, 0xdc, 0xb5, 0x61, 0xb2, 0x8b, 0xf6, 0xd1, 0xc1, 0xd6, 0x78, 0xc2, 0xda, 0x54,
-	0x60, 0x8d, 0xad, 0x71, 0x8c, 0x9f, 0x11, 0xfe, 0x1f, 0x52, 0xc8, 0x23, 0xc2, 0xdd, 0xfa, 0x91,
-	0x9c, 0xb4, 0x53, 0x7e, 0xdf, 0xbd, 0x77, 0xfa, 0x07, 0x43, 0xdd, 0xb3, 0x3f, 0xb9, 0x7b, 0x79,
-	0x7f, 0xf8, 0xc7, 0xc8, 0x80, 0x37, 0x67, 0xfd, 0xfd, 0x9c, 0x75, 0x9f, 0xb3, 0xd9, 0x53, 0x41,
-	0xd1, 0xba, 0xa0, 0xe8, 0xad, 0xa0, 0xe8, 0xbe, 0xa4, 0x9d, 0x75, 0x49, 0x3b, 0xaf, 0x25, 0xed,
-	0xdc, 0x9c, 0xc7, 0xda, 0xcf, 0xb3, 0x88, 0x49, 0x48, 0xb8, 0x04, 0x97, 0x80, 0xab, 0xc4, 0xc3,
-	0x18, 0x78, 0x7e, 0xcc, 0x13, 0x98, 0x65, 0x0b, 0xe5, 0xea, 0x98, 0xf1, 0x74, 0xf8, 0x99, 0x34,
-	0xdc, 0x4c, 0xf2, 0x2b, 0xab, 0x5c, 0xd4, 0x0d, 0xff, 0x76, 0xf8, 0x11, 0x00, 0x00, 0xff, 0xff,
-	0x6b, 0x4c, 0x12, 0x61, 0x78, 0x02, 0x00, 0x00,
+	// 629 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0xbb, 0x45, 0x4a, 0x18, 0x44, 0xc3, 0x48, 0x62, 0x53, 0x71, 0x25, 0x6b, 0x54, 0x62,
+	0x60, 0x26, 0xad, 0x24, 0xa0, 0x07, 0x03, 0x1c, 0x34, 0x20, 0x26, 0xd8, 0xa3, 0x1e, 0x9a, 0xd9,
+	0xd9, 0xc9, 0x32, 0x66, 0x77, 0x67, 0xd9, 0x99, 0xd6, 0x10, 0xc2, 0xc5, 0xbf, 0xc0, 0x44, 0xff,
+	0x23, 0x2f, 0x1c, 0x49, 0x3c, 0xe8, 0x89, 0x18, 0xf0, 0x0f, 0xf0, 0x4f, 0x30, 0x3b, 0x33, 0xb4,
+	0xf4, 0x07, 0x4a, 0x91, 0x5b, 0x77, 0xde, 0xbc, 0xef, 0x7b, 0x9f, 0x6f, 0xdf, 0x1b, 0xb0, 0xcc,
+	0x7d, 0x8a, 0x49, 0x9a, 0x46, 0x9c, 0x12, 0xc5, 0x45, 0x22, 0x31, 0x4f, 0x14, 0xcb, 0xe8, 0x36,
+	0xe1, 0x49, 0x83, 0x50, 0x2a, 0x9a, 0x89, 0x92, 0x78, 0x5b, 0x48, 0x85, 0x5b, 0x55, 0xbc, 0xd3,
+	0x64, 0xd9, 0x2e, 0x4a, 0x33, 0xa1, 0x04, 0x9c, 0xe7, 0x3e, 0x45, 0x67, 0x33, 0xd1, 0x80, 0x4c,
+	0x94, 0x67, 0xa2, 0x56, 0xb5, 0x32, 0x1d, 0x8a, 0x50, 0xe8, 0x44, 0x9c, 0xff, 0x32, 0x1a, 0x95,
+	0x99, 0x50, 0x88, 0x30, 0x62, 0x98, 0xa4, 0x1c, 0x93, 0x24, 0x11, 0xca, 0x2a, 0x99, 0xe8, 0x63,
+	0x2a, 0x64, 0x2c, 0x24, 0xf6, 0x89, 0x64, 0xa6, 0x34, 0x6e, 0x55, 0x7d, 0xa6, 0x48, 0x15, 0xa7,
+	0x24, 0xe4, 0x89, 0xbe, 0x6c, 0xef, 0x2e, 0x0d, 0xc5, 0xa1, 0xbb, 0xd2, 0x89, 0xde, 0x34, 0x80,
+	0x6f, 0x72, 0xe9, 0x2d, 0x92, 0x91, 0x58, 0xd6, 0xd9, 0x4e, 0x93, 0x49, 0xe5, 0x51, 0x70, 0xab,
+	0xeb, 0x54, 0xa6, 0x22, 0x91, 0x0c, 0x6e, 0x82, 0x52, 0xaa, 0x4f, 0xca, 0xce, 0xac, 0x33, 0x37,
+	0x51, 0x5b, 0x44, 0xc3, 0x98, 0x80, 0xac, 0x9a, 0xd5, 0xf0, 0x42, 0x70, 0x57, 0x17, 0x79, 0xcd,
+	0xa4, 0x24, 0x21, 0x5b, 0x8d, 0x22, 0xf1, 0x21, 0xe2, 0x52, 0x9d, 0x76, 0x01, 0x5f, 0x00, 0xd0,
+	0x01, 0xb5, 0x25, 0x1f, 0x22, 0xe3, 0x0a, 0xca, 0x5d, 0x41, 0xe6, 0x0f, 0xb1, 0xae, 0xa0, 0x2d,
+	0x12, 0x32, 0x9b, 0x5b, 0x3f, 0x93, 0xe9, 0x1d, 0x39, 0xc0, 0x3d, 0xaf, 0x92, 0x25, 0x93, 0x00,
+	0xc6, 0x26, 0xd8, 0x20, 0xed, 0x68, 0xd9, 0x99, 0x1d, 0x99, 0x9b, 0xa8, 0x3d, 0x1f, 0x8e, 0xb2,
+	0xb7, 0xc8, 0xda, 0xb5, 0x83, 0xa3, 0x7b, 0x85, 0xfa, 0x54, 0xdc, 0x5b, 0x1c, 0xbe, 0xec, 0xe2,
+	0x2b, 0x6a, 0xbe, 0x47, 0xff, 0xe4, 0x33, 0x1d, 0x77, 0x01, 0xbe, 0x03, 0x77, 0x34, 0x9f, 0xd6,
+	0x66, 0x81, 0xed, 0xa0, 0xed, 0xe3, 0x7d, 0x30, 0x49, 0x45, 0x92, 0x30, 0x9a, 0x5f, 0x6e, 0xf0,
+	0x40, 0x5b, 0x39, 0x5e, 0xbf, 0xde, 0x39, 0x5c, 0x0f, 0xe0, 0x6d, 0x30, 0x96, 0x8a, 0x4c, 0xe5,
+	0xe1, 0xa2, 0x0e, 0x97, 0xf2, 0xcf, 0xf5, 0xc0, 0x6b, 0x80, 0x99, 0xc1, 0xe2, 0xd6, 0xba, 0x32,
+	0x18, 0x23, 0x41, 0x90, 0x31, 0x29, 0xad, 0xee, 0xe9, 0x27, 0x7c, 0x00, 0x6e, 0x68, 0x33, 0x1b,
+	0x16, 0x5d, 0x96, 0x8b, 0xb3, 0x23, 0x73, 0xe3, 0xf5, 0x49, 0x7d, 0x7a, 0x2a, 0x54, 0xfb, 0x3e,
+	0x0a, 0x46, 0x75, 0x05, 0xf8, 0xd5, 0x01, 0x25, 0x33, 0x24, 0x70, 0x65, 0x38, 0xd3, 0xfb, 0x67,
+	0xb8, 0xb2, 0xfa, 0x1f, 0x0a, 0x06, 0xcd, 0x5b, 0xfc, 0xf8, 0xed, 0xd7, 0xe7, 0x22, 0x82, 0xf3,
+	0xd8, 0xae, 0xd7, 0xdf, 0xd7, 0xca, 0xcc, 0x35, 0xfc, 0xed, 0x80, 0xa9, 0xbe, 0x49, 0x83, 0xaf,
+	0x2e, 0xd1, 0xce, 0x79, 0x9b, 0x51, 0xd9, 0xbc, 0x1a, 0x31, 0x8b, 0xb9, 0xa2, 0x31, 0x9f, 0xc1,
+	0xe5, 0x8b, 0x61, 0xf6, 0x2f, 0x0a, 0xfc, 0x52, 0x04, 0x37, 0x7b, 0xe6, 0x03, 0xae, 0x5f, 0xa2,
+	0xc7, 0xc1, 0x03, 0x5c, 0xd9, 0xb8, 0x0a, 0x29, 0x0b, 0xfb, 0x5e, 0xc3, 0x06, 0xd0, 0xbf, 0x18,
+	0x6c, 0x67, 0x47, 0x24, 0xde, 0xeb, 0xda, 0xa2, 0x7d, 0x9c, 0x2f, 0x88, 0xc4, 0x7b, 0x76, 0x6d,
+	0xf6, 0x31, 0x31, 0x25, 0xdb, 0xe3, 0xbe, 0x16, 0x1c, 0x1c, 0xbb, 0xce, 0xe1, 0xb1, 0xeb, 0xfc,
+	0x3c, 0x76, 0x9d, 0x4f, 0x27, 0x6e, 0xe1, 0xf0, 0xc4, 0x2d, 0xfc, 0x38, 0x71, 0x0b, 0x6f, 0x37,
+	0x42, 0xae, 0xb6, 0x9b, 0x3e, 0xa2, 0x22, 0xc6, 0xf6, 0x99, 0xe7, 0x3e, 0x5d, 0x08, 0x05, 0x6e,
+	0x3d, 0xc5, 0xb1, 0x08, 0x9a, 0x11, 0x93, 0xa6, 0xb9, 0xda, 0xd2, 0x42, 0xa7, 0xbf, 0x85, 0xee,
+	0xfe, 0xd4, 0x6e, 0xca, 0xa4, 0x5f, 0xd2, 0x2f, 0xf9, 0x93, 0x3f, 0x03, 0x00, 0x46, 0x84, 0x7e,
+	0xdb, 0xcc, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -158,6 +395,11 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries all parameters of the ICA host submodule.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// MessageAllowlists queries all the message allowlists of the ICA host submodule.
+	MessageAllowlists(ctx context.Context, in *QueryMessageAllowlistsRequest, opts ...grpc.CallOption) (*QueryMessageAllowlistsResponse, error)
+	// AllowedMessages queries the messages that the interchain account of a controller port on a connection
+	// is allowed to execute, taking into account the params and the message allowlists within its scope.
+	AllowedMessages(ctx context.Context, in *QueryAllowedMessagesRequest, opts ...grpc.CallOption) (*QueryAllowedMessagesResponse, error)
 }
 
 type queryClient struct {
@@ -177,10 +419,33 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) MessageAllowlists(ctx context.Context, in *QueryMessageAllowlistsRequest, opts ...grpc.CallOption) (*QueryMessageAllowlistsResponse, error) {
+	out := new(QueryMessageAllowlistsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/MessageAllowlists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowedMessages(ctx context.Context, in *QueryAllowedMessagesRequest, opts ...grpc.CallOption) (*QueryAllowedMessagesResponse, error) {
+	out := new(QueryAllowedMessagesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/AllowedMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters of the ICA host submodule.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// MessageAllowlists queries all the message allowlists of the ICA host submodule.
+	MessageAllowlists(context.Context, *QueryMessageAllowlistsRequest) (*QueryMessageAllowlistsResponse, error)
+	// AllowedMessages queries the messages that the interchain account of a controller port on a connection
+	// is allowed to execute, taking into account the params and the message allowlists within its scope.
+	AllowedMessages(context.Context, *QueryAllowedMessagesRequest) (*QueryAllowedMessagesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -190,6 +455,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) MessageAllowlists(ctx context.Context, req *QueryMessageAllowlistsRequest) (*QueryMessageAllowlistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageAllowlists not implemented")
+}
+func (*UnimplementedQueryServer) AllowedMessages(ctx context.Context, req *QueryAllowedMessagesRequest) (*QueryAllowedMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedMessages not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -213,6 +484,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MessageAllowlists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMessageAllowlistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MessageAllowlists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/MessageAllowlists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MessageAllowlists(ctx, req.(*QueryMessageAllowlistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/AllowedMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedMessages(ctx, req.(*QueryAllowedMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.interchain_accounts.host.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -221,6 +528,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "MessageAllowlists",
+			Handler:    _Query_MessageAllowlists_Handler,
+		},
+		{
+			MethodName: "AllowedMessages",
+			Handler:    _Query_AllowedMessages_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/interchain_accounts/host/v1/query.proto",
@@ -284,71 +599,299 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryMessageAllowlistsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryMessageAllowlistsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMessageAllowlistsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryMessageAllowlistsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryMessageAllowlistsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+
+func (m *QueryMessageAllowlistsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MessageAllowlists) > 0 {
+		for iNdEx := len(m.MessageAllowlists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageAllowlists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		if fieldNum <= 0 {
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowMessages) > 0 {
+		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowMessages[iNdEx])
+			copy(dAtA[i:], m.AllowMessages[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMessageAllowlistsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMessageAllowlistsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MessageAllowlists) > 0 {
+		for _, e := range m.MessageAllowlists {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowedMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowedMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
 			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
@@ -459,6 +1002,440 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryMessageAllowlistsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMessageAllowlistsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMessageAllowlistsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMessageAllowlistsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMessageAllowlistsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMessageAllowlistsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageAllowlists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageAllowlists = append(m.MessageAllowlists, MessageAllowlist{})
+			if err := m.MessageAllowlists[len(m.MessageAllowlists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -205,11 +205,176 @@ func (m *MsgModuleQuerySafeResponse) GetResponses() [][]byte {
 	return nil
 }
 
+// MsgSetMessageAllowlist defines the payload for Msg/SetMessageAllowlist. It adds a message allowlist
+// or replaces the allowed messages of the existing allowlist with the same scope.
+type MsgSetMessageAllowlist struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// allowlist defines the message allowlist to set.
+	Allowlist MessageAllowlist `protobuf:"bytes,2,opt,name=allowlist,proto3" json:"allowlist"`
+}
+
+func (m *MsgSetMessageAllowlist) Reset()         { *m = MsgSetMessageAllowlist{} }
+func (m *MsgSetMessageAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMessageAllowlist) ProtoMessage()    {}
+func (*MsgSetMessageAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{4}
+}
+func (m *MsgSetMessageAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMessageAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMessageAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMessageAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMessageAllowlist.Merge(m, src)
+}
+func (m *MsgSetMessageAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMessageAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMessageAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMessageAllowlist proto.InternalMessageInfo
+
+// MsgSetMessageAllowlistResponse defines the response for Msg/SetMessageAllowlist
+type MsgSetMessageAllowlistResponse struct {
+}
+
+func (m *MsgSetMessageAllowlistResponse) Reset()         { *m = MsgSetMessageAllowlistResponse{} }
+func (m *MsgSetMessageAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMessageAllowlistResponse) ProtoMessage()    {}
+func (*MsgSetMessageAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{5}
+}
+func (m *MsgSetMessageAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMessageAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMessageAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMessageAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMessageAllowlistResponse.Merge(m, src)
+}
+func (m *MsgSetMessageAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMessageAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMessageAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMessageAllowlistResponse proto.InternalMessageInfo
+
+// MsgRemoveMessageAllowlist defines the payload for Msg/RemoveMessageAllowlist
+type MsgRemoveMessageAllowlist struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// the connection identifier of the scope of the allowlist
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// the controller port identifier of the scope of the allowlist
+	PortId string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// the interchain account address of the scope of the allowlist
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgRemoveMessageAllowlist) Reset()         { *m = MsgRemoveMessageAllowlist{} }
+func (m *MsgRemoveMessageAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveMessageAllowlist) ProtoMessage()    {}
+func (*MsgRemoveMessageAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{6}
+}
+func (m *MsgRemoveMessageAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveMessageAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveMessageAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveMessageAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveMessageAllowlist.Merge(m, src)
+}
+func (m *MsgRemoveMessageAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveMessageAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveMessageAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveMessageAllowlist proto.InternalMessageInfo
+
+// MsgRemoveMessageAllowlistResponse defines the response for Msg/RemoveMessageAllowlist
+type MsgRemoveMessageAllowlistResponse struct {
+}
+
+func (m *MsgRemoveMessageAllowlistResponse) Reset()         { *m = MsgRemoveMessageAllowlistResponse{} }
+func (m *MsgRemoveMessageAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveMessageAllowlistResponse) ProtoMessage()    {}
+func (*MsgRemoveMessageAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{7}
+}
+func (m *MsgRemoveMessageAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveMessageAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveMessageAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveMessageAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveMessageAllowlistResponse.Merge(m, src)
+}
+func (m *MsgRemoveMessageAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveMessageAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveMessageAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveMessageAllowlistResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.interchain_accounts.host.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.interchain_accounts.host.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgModuleQuerySafe)(nil), "ibc.applications.interchain_accounts.host.v1.MsgModuleQuerySafe")
 	proto.RegisterType((*MsgModuleQuerySafeResponse)(nil), "ibc.applications.interchain_accounts.host.v1.MsgModuleQuerySafeResponse")
+	proto.RegisterType((*MsgSetMessageAllowlist)(nil), "ibc.applications.interchain_accounts.host.v1.MsgSetMessageAllowlist")
+	proto.RegisterType((*MsgSetMessageAllowlistResponse)(nil), "ibc.applications.interchain_accounts.host.v1.MsgSetMessageAllowlistResponse")
+	proto.RegisterType((*MsgRemoveMessageAllowlist)(nil), "ibc.applications.interchain_accounts.host.v1.MsgRemoveMessageAllowlist")
+	proto.RegisterType((*MsgRemoveMessageAllowlistResponse)(nil), "ibc.applications.interchain_accounts.host.v1.MsgRemoveMessageAllowlistResponse")
 }
 
 func init() {
@@ -217,36 +382,46 @@ func init() {
 }
 
 var fileDescriptor_fa437afde7f1e7ae = []byte{
-	// 457 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x6b, 0xd4, 0x40,
-	0x14, 0xc7, 0x33, 0x6d, 0x5d, 0xec, 0xb4, 0x50, 0x08, 0x62, 0x6b, 0x90, 0xb4, 0xec, 0x69, 0x29,
-	0xee, 0x0c, 0x5d, 0x95, 0x62, 0x41, 0x90, 0x82, 0x20, 0xc2, 0x82, 0x8e, 0x78, 0x11, 0x41, 0x26,
-	0xb3, 0xe3, 0x64, 0xa0, 0xc9, 0xc4, 0xbc, 0xc9, 0x62, 0x6f, 0xe2, 0xc9, 0x93, 0x78, 0xf0, 0x26,
-	0x82, 0x1f, 0xa1, 0xdf, 0xc1, 0x4b, 0x8f, 0x3d, 0x7a, 0x12, 0xd9, 0x3d, 0xf4, 0x6b, 0x48, 0x66,
-	0xa7, 0xad, 0x4d, 0xed, 0x21, 0xf4, 0x96, 0x49, 0xe6, 0xff, 0x7b, 0xbf, 0x97, 0x99, 0x87, 0xef,
-	0xeb, 0x44, 0x50, 0x5e, 0x14, 0x7b, 0x5a, 0x70, 0xab, 0x4d, 0x0e, 0x54, 0xe7, 0x56, 0x96, 0x22,
-	0xe5, 0x3a, 0x7f, 0xc3, 0x85, 0x30, 0x55, 0x6e, 0x81, 0xa6, 0x06, 0x2c, 0x1d, 0x6f, 0x51, 0xfb,
-	0x9e, 0x14, 0xa5, 0xb1, 0x26, 0xbc, 0xa3, 0x13, 0x41, 0xfe, 0x8d, 0x91, 0xff, 0xc4, 0x48, 0x1d,
-	0x23, 0xe3, 0xad, 0xe8, 0x86, 0x32, 0xca, 0xb8, 0x20, 0xad, 0x9f, 0x66, 0x8c, 0x68, 0x55, 0x18,
-	0xc8, 0x0c, 0xd0, 0x0c, 0x54, 0xcd, 0xce, 0x40, 0xf9, 0x0f, 0xdb, 0xad, 0x9c, 0x5c, 0x11, 0x17,
-	0xec, 0x7e, 0x46, 0x78, 0x65, 0x08, 0xea, 0x65, 0x31, 0xe2, 0x56, 0x3e, 0xe3, 0x25, 0xcf, 0x20,
-	0xbc, 0x89, 0x3b, 0xa0, 0x55, 0x2e, 0xcb, 0x35, 0xb4, 0x81, 0x7a, 0x8b, 0xcc, 0xaf, 0x42, 0x86,
-	0x3b, 0x85, 0xdb, 0xb1, 0x36, 0xb7, 0x81, 0x7a, 0x4b, 0x83, 0x7b, 0xa4, 0x4d, 0x4b, 0x64, 0x46,
-	0xdf, 0x5d, 0x38, 0xfc, 0xbd, 0x1e, 0x30, 0x4f, 0xda, 0x59, 0xf9, 0xf4, 0x63, 0x3d, 0xf8, 0x78,
-	0x7c, 0xb0, 0xe9, 0x8b, 0x74, 0x6f, 0xe1, 0xd5, 0x86, 0x0f, 0x93, 0x50, 0x98, 0x1c, 0x64, 0xf7,
-	0x1b, 0xc2, 0xe1, 0x10, 0xd4, 0xd0, 0x8c, 0xaa, 0x3d, 0xf9, 0xbc, 0x92, 0xe5, 0xfe, 0x0b, 0xfe,
-	0x56, 0x5e, 0xaa, 0xfb, 0x1a, 0x5f, 0x2f, 0xe5, 0xbb, 0x4a, 0x82, 0xad, 0x85, 0xe7, 0x7b, 0x4b,
-	0x83, 0x9d, 0x76, 0xc2, 0xae, 0x04, 0x9b, 0x21, 0xbc, 0xf6, 0x29, 0xf1, 0xa2, 0x38, 0xc3, 0xd1,
-	0x45, 0xb9, 0x13, 0xf7, 0x5a, 0x32, 0x95, 0x5a, 0xa5, 0xd6, 0x49, 0x2e, 0x30, 0xbf, 0x0a, 0x6f,
-	0xe3, 0xc5, 0xd2, 0xef, 0x99, 0x59, 0x2e, 0xb3, 0xb3, 0x17, 0x83, 0x9f, 0x73, 0x78, 0x7e, 0x08,
-	0x2a, 0xfc, 0x8a, 0xf0, 0xf2, 0xb9, 0x23, 0x7a, 0xd8, 0xae, 0x93, 0xc6, 0x1f, 0x8d, 0x1e, 0x5f,
-	0x29, 0x7e, 0xda, 0xd4, 0xf7, 0xfa, 0xf2, 0x34, 0x4e, 0xe3, 0x51, 0x6b, 0x74, 0x83, 0x10, 0x3d,
-	0xb9, 0x2a, 0xe1, 0xc4, 0x2f, 0xba, 0xf6, 0xe1, 0xf8, 0x60, 0x13, 0xed, 0x8e, 0x0e, 0x27, 0x31,
-	0x3a, 0x9a, 0xc4, 0xe8, 0xcf, 0x24, 0x46, 0x5f, 0xa6, 0x71, 0x70, 0x34, 0x8d, 0x83, 0x5f, 0xd3,
-	0x38, 0x78, 0xf5, 0x54, 0x69, 0x9b, 0x56, 0x09, 0x11, 0x26, 0xa3, 0x7e, 0xb4, 0x74, 0x22, 0xfa,
-	0xca, 0xd0, 0xf1, 0x03, 0x9a, 0x39, 0x2a, 0xd4, 0x63, 0x05, 0x74, 0xb0, 0xdd, 0x3f, 0x93, 0xe8,
-	0x9f, 0x9f, 0x28, 0xbb, 0x5f, 0x48, 0x48, 0x3a, 0x6e, 0xa0, 0xee, 0xfe, 0x0d, 0x00, 0x00, 0xff,
-	0xff, 0x04, 0x3c, 0x7a, 0x90, 0x1f, 0x04, 0x00, 0x00,
+	// 615 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0xb6, 0x4d, 0xcd, 0x34, 0x52, 0x58, 0x25, 0x49, 0x17, 0xd9, 0xc6, 0xf4, 0x12,
+	0x8a, 0xd9, 0xa5, 0x51, 0x29, 0x16, 0x14, 0x2d, 0x8a, 0x56, 0x5c, 0xd4, 0x2d, 0x5e, 0x44, 0x28,
+	0xbb, 0xb3, 0xe3, 0x64, 0x20, 0xbb, 0xb3, 0xee, 0x9b, 0x44, 0x7b, 0x13, 0x4f, 0x9e, 0xc4, 0x83,
+	0x37, 0x15, 0x3c, 0xe8, 0xc1, 0x5b, 0xf1, 0xaf, 0xe8, 0xb1, 0x47, 0x4f, 0x22, 0xc9, 0xa1, 0xff,
+	0x86, 0xec, 0x66, 0x93, 0x98, 0x5f, 0xe0, 0xd2, 0xde, 0xf6, 0xcd, 0xcc, 0xfb, 0xbe, 0xcf, 0x77,
+	0xe7, 0x0d, 0x0f, 0x5f, 0xe3, 0x0e, 0x31, 0xec, 0x20, 0x68, 0x72, 0x62, 0x4b, 0x2e, 0x7c, 0x30,
+	0xb8, 0x2f, 0x69, 0x48, 0x1a, 0x36, 0xf7, 0xf7, 0x6c, 0x42, 0x44, 0xcb, 0x97, 0x60, 0x34, 0x04,
+	0x48, 0xa3, 0xbd, 0x61, 0xc8, 0xd7, 0x7a, 0x10, 0x0a, 0x29, 0x94, 0xcb, 0xdc, 0x21, 0xfa, 0xbf,
+	0x69, 0xfa, 0x94, 0x34, 0x3d, 0x4a, 0xd3, 0xdb, 0x1b, 0xea, 0x05, 0x26, 0x98, 0x88, 0x13, 0x8d,
+	0xe8, 0xab, 0xa7, 0xa1, 0x16, 0x89, 0x00, 0x4f, 0x80, 0xe1, 0x01, 0x8b, 0xb4, 0x3d, 0x60, 0xc9,
+	0xc6, 0x66, 0x2a, 0xa6, 0xb8, 0x48, 0x9c, 0x58, 0x79, 0x8f, 0xf0, 0xb2, 0x09, 0xec, 0x69, 0xe0,
+	0xda, 0x92, 0x3e, 0xb6, 0x43, 0xdb, 0x03, 0xa5, 0x80, 0xb3, 0xc0, 0x99, 0x4f, 0xc3, 0x12, 0x2a,
+	0xa3, 0x6a, 0xce, 0x4a, 0x22, 0xc5, 0xc2, 0xd9, 0x20, 0x3e, 0x51, 0x3a, 0x53, 0x46, 0xd5, 0xa5,
+	0xfa, 0x55, 0x3d, 0x8d, 0x25, 0xbd, 0xa7, 0xbe, 0x3d, 0x7f, 0xf8, 0x7b, 0x35, 0x63, 0x25, 0x4a,
+	0x5b, 0xcb, 0xef, 0xbe, 0xae, 0x66, 0xde, 0x1e, 0x1f, 0xac, 0x27, 0x45, 0x2a, 0x2b, 0xb8, 0x38,
+	0xc6, 0x63, 0x51, 0x08, 0x84, 0x0f, 0xb4, 0xf2, 0x09, 0x61, 0xc5, 0x04, 0x66, 0x0a, 0xb7, 0xd5,
+	0xa4, 0x4f, 0x5a, 0x34, 0xdc, 0xdf, 0xb5, 0x5f, 0xd0, 0x99, 0xb8, 0xcf, 0xf1, 0xd9, 0x90, 0xbe,
+	0x6c, 0x51, 0x90, 0x11, 0xf0, 0x5c, 0x75, 0xa9, 0xbe, 0x95, 0x0e, 0x38, 0x2e, 0x61, 0xf5, 0x24,
+	0x12, 0xec, 0x81, 0xe2, 0x24, 0xb8, 0x85, 0xd5, 0x49, 0xb8, 0x3e, 0x7b, 0x04, 0xd9, 0xa0, 0x9c,
+	0x35, 0x64, 0x0c, 0x39, 0x6f, 0x25, 0x91, 0x72, 0x11, 0xe7, 0xc2, 0xe4, 0x4c, 0x8f, 0x32, 0x6f,
+	0x0d, 0x17, 0x2a, 0xdf, 0x11, 0x2e, 0x98, 0xc0, 0x76, 0xa9, 0x34, 0x29, 0x80, 0xcd, 0xe8, 0xed,
+	0x66, 0x53, 0xbc, 0x6a, 0x72, 0x90, 0x33, 0x5d, 0x3b, 0x38, 0x67, 0xf7, 0x0f, 0x25, 0xf7, 0x74,
+	0x33, 0x9d, 0xed, 0xf1, 0x52, 0x89, 0xf5, 0xa1, 0xec, 0xa4, 0xf7, 0x32, 0xd6, 0xa6, 0x63, 0x0e,
+	0xee, 0xee, 0x33, 0xc2, 0x2b, 0x26, 0x30, 0x8b, 0x7a, 0xa2, 0x4d, 0xff, 0xdb, 0xcc, 0x1a, 0x3e,
+	0x47, 0x84, 0xef, 0x53, 0x12, 0x51, 0xef, 0x71, 0x37, 0x36, 0x94, 0xb3, 0xf2, 0xc3, 0xc5, 0x1d,
+	0x57, 0x29, 0xe2, 0xc5, 0x40, 0x84, 0x32, 0xda, 0x9e, 0xeb, 0x65, 0x47, 0xe1, 0x8e, 0xab, 0x94,
+	0xf0, 0xa2, 0xed, 0xba, 0x21, 0x05, 0x28, 0xcd, 0xc7, 0x1b, 0xfd, 0x70, 0xd2, 0xc0, 0x1a, 0xbe,
+	0x34, 0x93, 0xae, 0xef, 0xa1, 0xfe, 0x6d, 0x01, 0xcf, 0x99, 0xc0, 0x94, 0x8f, 0x08, 0xe7, 0x47,
+	0x1e, 0xcc, 0x8d, 0x94, 0x3f, 0x78, 0xb4, 0xbf, 0xd5, 0xbb, 0x27, 0x4a, 0x1f, 0xb4, 0xd8, 0x97,
+	0xe8, 0x29, 0x8f, 0xbd, 0x8d, 0x5b, 0xa9, 0xa5, 0xc7, 0x14, 0xd4, 0xfb, 0x27, 0x55, 0x18, 0xf0,
+	0xfd, 0x40, 0xf8, 0xfc, 0xb4, 0x4e, 0xbe, 0x93, 0xba, 0xc2, 0x14, 0x15, 0xf5, 0xe1, 0x69, 0xa8,
+	0x0c, 0x58, 0x7f, 0x22, 0x5c, 0x98, 0xd1, 0xab, 0xf7, 0x52, 0x17, 0x9a, 0x2e, 0xa4, 0x3e, 0x3a,
+	0x25, 0xa1, 0x3e, 0xb4, 0xba, 0xf0, 0xe6, 0xf8, 0x60, 0x1d, 0x6d, 0xbb, 0x87, 0x1d, 0x0d, 0x1d,
+	0x75, 0x34, 0xf4, 0xa7, 0xa3, 0xa1, 0x0f, 0x5d, 0x2d, 0x73, 0xd4, 0xd5, 0x32, 0xbf, 0xba, 0x5a,
+	0xe6, 0xd9, 0x03, 0xc6, 0x65, 0xa3, 0xe5, 0xe8, 0x44, 0x78, 0x46, 0x32, 0x49, 0xb8, 0x43, 0x6a,
+	0x4c, 0x18, 0xed, 0xeb, 0x86, 0x17, 0x5f, 0x1b, 0x44, 0x53, 0x04, 0x8c, 0xfa, 0x66, 0x6d, 0xc8,
+	0x52, 0x1b, 0x1d, 0x20, 0x72, 0x3f, 0xa0, 0xe0, 0x64, 0xe3, 0xf9, 0x71, 0xe5, 0xef, 0x00, 0x15,
+	0x04, 0xe5, 0x07, 0x0e, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -265,6 +440,10 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// ModuleQuerySafe defines a rpc handler for MsgModuleQuerySafe.
 	ModuleQuerySafe(ctx context.Context, in *MsgModuleQuerySafe, opts ...grpc.CallOption) (*MsgModuleQuerySafeResponse, error)
+	// SetMessageAllowlist defines a rpc handler for MsgSetMessageAllowlist.
+	SetMessageAllowlist(ctx context.Context, in *MsgSetMessageAllowlist, opts ...grpc.CallOption) (*MsgSetMessageAllowlistResponse, error)
+	// RemoveMessageAllowlist defines a rpc handler for MsgRemoveMessageAllowlist.
+	RemoveMessageAllowlist(ctx context.Context, in *MsgRemoveMessageAllowlist, opts ...grpc.CallOption) (*MsgRemoveMessageAllowlistResponse, error)
 }
 
 type msgClient struct {
@@ -293,12 +472,34 @@ func (c *msgClient) ModuleQuerySafe(ctx context.Context, in *MsgModuleQuerySafe,
 	return out, nil
 }
 
+func (c *msgClient) SetMessageAllowlist(ctx context.Context, in *MsgSetMessageAllowlist, opts ...grpc.CallOption) (*MsgSetMessageAllowlistResponse, error) {
+	out := new(MsgSetMessageAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Msg/SetMessageAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveMessageAllowlist(ctx context.Context, in *MsgRemoveMessageAllowlist, opts ...grpc.CallOption) (*MsgRemoveMessageAllowlistResponse, error) {
+	out := new(MsgRemoveMessageAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Msg/RemoveMessageAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// ModuleQuerySafe defines a rpc handler for MsgModuleQuerySafe.
 	ModuleQuerySafe(context.Context, *MsgModuleQuerySafe) (*MsgModuleQuerySafeResponse, error)
+	// SetMessageAllowlist defines a rpc handler for MsgSetMessageAllowlist.
+	SetMessageAllowlist(context.Context, *MsgSetMessageAllowlist) (*MsgSetMessageAllowlistResponse, error)
+	// RemoveMessageAllowlist defines a rpc handler for MsgRemoveMessageAllowlist.
+	RemoveMessageAllowlist(context.Context, *MsgRemoveMessageAllowlist) (*MsgRemoveMessageAllowlistResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -311,6 +512,12 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) ModuleQuerySafe(ctx context.Context, req *MsgModuleQuerySafe) (*MsgModuleQuerySafeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModuleQuerySafe not implemented")
 }
+func (*UnimplementedMsgServer) SetMessageAllowlist(ctx context.Context, req *MsgSetMessageAllowlist) (*MsgSetMessageAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMessageAllowlist not implemented")
+}
+func (*UnimplementedMsgServer) RemoveMessageAllowlist(ctx context.Context, req *MsgRemoveMessageAllowlist) (*MsgRemoveMessageAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMessageAllowlist not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -352,6 +559,42 @@ func _Msg_ModuleQuerySafe_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetMessageAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMessageAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMessageAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Msg/SetMessageAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMessageAllowlist(ctx, req.(*MsgSetMessageAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveMessageAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMessageAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMessageAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Msg/RemoveMessageAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMessageAllowlist(ctx, req.(*MsgRemoveMessageAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.interchain_accounts.host.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -364,6 +607,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ModuleQuerySafe",
 			Handler:    _Msg_ModuleQuerySafe_Handler,
 		},
+		{
+			MethodName: "SetMessageAllowlist",
+			Handler:    _Msg_SetMessageAllowlist_Handler,
+		},
+		{
+			MethodName: "RemoveMessageAllowlist",
+			Handler:    _Msg_RemoveMessageAllowlist_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/interchain_accounts/host/v1/tx.proto",
@@ -513,6 +764,143 @@ func (m *MsgModuleQuerySafeResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetMessageAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMessageAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMessageAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Allowlist.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMessageAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMessageAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMessageAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMessageAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMessageAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMessageAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMessageAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMessageAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMessageAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -585,11 +973,69 @@ func (m *MsgModuleQuerySafeResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgSetMessageAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Allowlist.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgSetMessageAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveMessageAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveMessageAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -973,6 +1419,399 @@ func (m *MsgModuleQuerySafeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetMessageAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMessageAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMessageAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowlist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMessageAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMessageAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMessageAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMessageAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMessageAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMessageAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMessageAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMessageAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMessageAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -39,6 +39,7 @@ var (
 	_ module.HasServices         = (*AppModule)(nil)
 	_ module.HasProposalMsgs     = (*AppModule)(nil)
 	_ appmodule.AppModule        = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker    = (*AppModule)(nil)
 
 	_ porttypes.IBCModule = (*host.IBCModule)(nil)
 )
@@ -180,6 +181,15 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// EndBlock sends the interchain accounts controller transactions which are scheduled for the current block.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	if am.controllerKeeper != nil {
+		controllerkeeper.EndBlocker(ctx, *am.controllerKeeper)
+	}
+
+	return nil
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 3 }
 
@@ -27,6 +27,9 @@ func TestProposalMsgs(t *testing.T) {
 	ctx := sdk.NewContext(nil, cmtproto.Header{}, true, nil)
 	accounts := simtypes.RandomAccounts(r, 3)
 
+	controllerParams := controllertypes.DefaultParams()
+	controllerParams.ControllerEnabled = false
+
 	tests := []struct {
 		name       string
 		controller *controllerkeeper.Keeper
@@ -44,7 +47,7 @@ func TestProposalMsgs(t *testing.T) {
 				),
 				controllertypes.NewMsgUpdateParams(
 					sdk.AccAddress(address.Module("gov")).String(),
-					controllertypes.NewParams(false),
+					controllerParams,
 				),
 			},
 		},
@@ -59,7 +62,7 @@ func TestProposalMsgs(t *testing.T) {
 			expMsgs: []sdk.Msg{
 				controllertypes.NewMsgUpdateParams(
 					sdk.AccAddress(address.Module("gov")).String(),
-					controllertypes.NewParams(false),
+					controllerParams,
 				),
 			},
 		},
@@ -0,0 +1,327 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"unicode/utf8"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// CBOR major types used by the CBOR encoding format, see RFC 8949
+const (
+	cborMajorTypeByteString = 2
+	cborMajorTypeTextString = 3
+	cborMajorTypeArray      = 4
+	cborMajorTypeSimple     = 7
+
+	cborSimpleFalse = 20
+	cborSimpleTrue  = 21
+)
+
+// cborEncoder is the Encoder of the CBOR encoding format
+type cborEncoder struct{}
+
+// NewCBOREncoder returns the Encoder of the CBOR encoding format, a compact binary encoding for controllers which do
+// not implement protobuf. The messages are encoded with the deterministic encoding of RFC 8949 as follows:
+//
+//	CosmosTx:     [* [type_url: tstr, value: bstr]]
+//	CosmosQuery:  [[* [path: tstr, data: bstr]], include_height: bool]
+//
+// where the value of a message of a CosmosTx is its protobuf encoding.
+func NewCBOREncoder() Encoder {
+	return cborEncoder{}
+}
+
+// Name implements Encoder
+func (cborEncoder) Name() string { return "cbor" }
+
+// Marshal implements Encoder
+func (cborEncoder) Marshal(_ codec.Codec, msg proto.Message) ([]byte, error) {
+	var bz []byte
+
+	switch msg := msg.(type) {
+	case *CosmosTx:
+		bz = appendCBORHead(bz, cborMajorTypeArray, uint64(len(msg.Messages)))
+		for _, protoAny := range msg.Messages {
+			if protoAny == nil {
+				return nil, errors.New("message cannot be nil")
+			}
+
+			bz = appendCBORHead(bz, cborMajorTypeArray, 2)
+			bz = appendCBORString(bz, cborMajorTypeTextString, []byte(protoAny.TypeUrl))
+			bz = appendCBORString(bz, cborMajorTypeByteString, protoAny.Value)
+		}
+	case *CosmosQuery:
+		bz = appendCBORHead(bz, cborMajorTypeArray, 2)
+		bz = appendCBORHead(bz, cborMajorTypeArray, uint64(len(msg.Requests)))
+		for _, request := range msg.Requests {
+			bz = appendCBORHead(bz, cborMajorTypeArray, 2)
+			bz = appendCBORString(bz, cborMajorTypeTextString, []byte(request.Path))
+			bz = appendCBORString(bz, cborMajorTypeByteString, request.Data)
+		}
+		bz = appendCBORBool(bz, msg.IncludeHeight)
+	default:
+		return nil, fmt.Errorf("cannot encode %T with cbor", msg)
+	}
+
+	return bz, nil
+}
+
+// Unmarshal implements Encoder
+func (cborEncoder) Unmarshal(cdc codec.Codec, bz []byte, msg proto.Message) error {
+	decoder := &cborDecoder{data: bz}
+
+	switch msg := msg.(type) {
+	case *CosmosTx:
+		length, err := decoder.readArrayHeader()
+		if err != nil {
+			return err
+		}
+
+		messages := make([]*codectypes.Any, length)
+		for i := range messages {
+			typeURL, value, err := decoder.readStringBytesPair()
+			if err != nil {
+				return err
+			}
+
+			messages[i] = &codectypes.Any{TypeUrl: typeURL, Value: value}
+		}
+
+		if err := decoder.finish(); err != nil {
+			return err
+		}
+
+		msg.Messages = messages
+
+		return codectypes.UnpackInterfaces(msg, cdc.InterfaceRegistry())
+	case *CosmosQuery:
+		if err := decoder.readArrayHeaderOfLength(2); err != nil {
+			return err
+		}
+
+		length, err := decoder.readArrayHeader()
+		if err != nil {
+			return err
+		}
+
+		requests := make([]QueryRequest, length)
+		for i := range requests {
+			requests[i].Path, requests[i].Data, err = decoder.readStringBytesPair()
+			if err != nil {
+				return err
+			}
+		}
+
+		includeHeight, err := decoder.readBool()
+		if err != nil {
+			return err
+		}
+
+		if err := decoder.finish(); err != nil {
+			return err
+		}
+
+		msg.Requests = requests
+		msg.IncludeHeight = includeHeight
+
+		return nil
+	default:
+		return fmt.Errorf("cannot decode %T with cbor", msg)
+	}
+}
+
+// appendCBORHead appends the head of a data item of the given major type and argument, encoded in its shortest form
+func appendCBORHead(bz []byte, majorType byte, argument uint64) []byte {
+	initialByte := majorType << 5
+
+	switch {
+	case argument < 24:
+		return append(bz, initialByte|byte(argument))
+	case argument <= math.MaxUint8:
+		return append(bz, initialByte|24, byte(argument))
+	case argument <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(bz, initialByte|25), uint16(argument))
+	case argument <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(bz, initialByte|26), uint32(argument))
+	default:
+		return binary.BigEndian.AppendUint64(append(bz, initialByte|27), argument)
+	}
+}
+
+// appendCBORString appends a byte string or a text string
+func appendCBORString(bz []byte, majorType byte, str []byte) []byte {
+	bz = appendCBORHead(bz, majorType, uint64(len(str)))
+	return append(bz, str...)
+}
+
+// appendCBORBool appends a boolean simple value
+func appendCBORBool(bz []byte, value bool) []byte {
+	if value {
+		return appendCBORHead(bz, cborMajorTypeSimple, cborSimpleTrue)
+	}
+
+	return appendCBORHead(bz, cborMajorTypeSimple, cborSimpleFalse)
+}
+
+// cborDecoder decodes the data items of the CBOR encoding format. Only definite lengths encoded in their shortest form
+// are accepted, so that every message has a single encoding.
+type cborDecoder struct {
+	data   []byte
+	offset int
+}
+
+// remaining returns the number of bytes left to decode
+func (d *cborDecoder) remaining() uint64 {
+	return uint64(len(d.data) - d.offset)
+}
+
+// readHead reads the head of the next data item and returns its major type and argument
+func (d *cborDecoder) readHead() (byte, uint64, error) {
+	if d.remaining() == 0 {
+		return 0, 0, errors.New("unexpected end of cbor data")
+	}
+
+	initialByte := d.data[d.offset]
+	d.offset++
+
+	majorType, additionalInfo := initialByte>>5, initialByte&0x1f
+
+	var size uint64
+	switch {
+	case additionalInfo < 24:
+		return majorType, uint64(additionalInfo), nil
+	case additionalInfo <= 27:
+		size = 1 << (additionalInfo - 24)
+	default:
+		return 0, 0, fmt.Errorf("unsupported cbor additional information %d", additionalInfo)
+	}
+
+	if d.remaining() < size {
+		return 0, 0, errors.New("unexpected end of cbor data")
+	}
+
+	var argument uint64
+	for _, b := range d.data[d.offset : d.offset+int(size)] {
+		argument = argument<<8 | uint64(b)
+	}
+	d.offset += int(size)
+
+	// the argument must not fit in a shorter head
+	if (size == 1 && argument < 24) || (size > 1 && argument < 1<<(4*size)) {
+		return 0, 0, fmt.Errorf("cbor argument %d is not encoded in its shortest form", argument)
+	}
+
+	return majorType, argument, nil
+}
+
+// readArrayHeader reads the head of an array and returns its length
+func (d *cborDecoder) readArrayHeader() (uint64, error) {
+	majorType, length, err := d.readHead()
+	if err != nil {
+		return 0, err
+	}
+
+	if majorType != cborMajorTypeArray {
+		return 0, fmt.Errorf("expected cbor array, got major type %d", majorType)
+	}
+
+	// every element of the array takes at least one byte
+	if length > d.remaining() {
+		return 0, fmt.Errorf("cbor array length %d exceeds the remaining data", length)
+	}
+
+	return length, nil
+}
+
+// readArrayHeaderOfLength reads the head of an array of the given length
+func (d *cborDecoder) readArrayHeaderOfLength(expLength uint64) error {
+	length, err := d.readArrayHeader()
+	if err != nil {
+		return err
+	}
+
+	if length != expLength {
+		return fmt.Errorf("expected cbor array of length %d, got %d", expLength, length)
+	}
+
+	return nil
+}
+
+// readString reads a byte string or a text string of the given major type
+func (d *cborDecoder) readString(expMajorType byte) ([]byte, error) {
+	majorType, length, err := d.readHead()
+	if err != nil {
+		return nil, err
+	}
+
+	if majorType != expMajorType {
+		return nil, fmt.Errorf("expected cbor major type %d, got %d", expMajorType, majorType)
+	}
+
+	if length > d.remaining() {
+		return nil, fmt.Errorf("cbor string length %d exceeds the remaining data", length)
+	}
+
+	if length == 0 {
+		return nil, nil
+	}
+
+	str := make([]byte, length)
+	copy(str, d.data[d.offset:])
+	d.offset += int(length)
+
+	return str, nil
+}
+
+// readStringBytesPair reads an array made of a text string and a byte string
+func (d *cborDecoder) readStringBytesPair() (string, []byte, error) {
+	if err := d.readArrayHeaderOfLength(2); err != nil {
+		return "", nil, err
+	}
+
+	text, err := d.readString(cborMajorTypeTextString)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if !utf8.Valid(text) {
+		return "", nil, errors.New("cbor text string is not valid utf-8")
+	}
+
+	bz, err := d.readString(cborMajorTypeByteString)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return string(text), bz, nil
+}
+
+// readBool reads a boolean simple value
+func (d *cborDecoder) readBool() (bool, error) {
+	majorType, value, err := d.readHead()
+	if err != nil {
+		return false, err
+	}
+
+	if majorType != cborMajorTypeSimple || (value != cborSimpleFalse && value != cborSimpleTrue) {
+		return false, errors.New("expected cbor boolean")
+	}
+
+	return value == cborSimpleTrue, nil
+}
+
+// finish returns an error if data is left after the decoded message
+func (d *cborDecoder) finish() error {
+	if d.remaining() != 0 {
+		return fmt.Errorf("%d bytes of cbor data left after the decoded message", d.remaining())
+	}
+
+	return nil
+}
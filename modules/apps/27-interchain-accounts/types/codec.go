@@ -9,8 +9,6 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-
-	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 )
 
 // ModuleCdc references the global interchain accounts module codec. Note, the codec
@@ -27,17 +25,46 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*authtypes.GenesisAccount)(nil), &InterchainAccount{})
 }
 
+// defaultEncodingRegistry supports the protobuf and proto3 JSON encoding formats
+var defaultEncodingRegistry = NewEncodingRegistry()
+
 // SerializeCosmosTx serializes a slice of sdk.Msg's using the CosmosTx type. The sdk.Msg's are
 // packed into Any's and inserted into the Messages field of a CosmosTx. The CosmosTx is marshaled
 // depending on the encoding type passed in. The marshaled bytes are returned. Only the ProtoCodec
 // is supported for serializing messages. Both protobuf and proto3 JSON are supported.
 func SerializeCosmosTx(cdc codec.Codec, msgs []proto.Message, encoding string) ([]byte, error) {
+	return defaultEncodingRegistry.SerializeCosmosTx(cdc, msgs, encoding)
+}
+
+// DeserializeCosmosTx unmarshals and unpacks a slice of transaction bytes into a slice of sdk.Msg's.
+// The transaction bytes are unmarshaled depending on the encoding type passed in. The sdk.Msg's are
+// unpacked from Any's and returned. Only the ProtoCodec is supported for serializing messages. Both
+// protobuf and proto3 JSON are supported.
+func DeserializeCosmosTx(cdc codec.Codec, data []byte, encoding string) ([]sdk.Msg, error) {
+	return defaultEncodingRegistry.DeserializeCosmosTx(cdc, data, encoding)
+}
+
+// SerializeCosmosQuery serializes a slice of query requests using the CosmosQuery type. The CosmosQuery is marshaled
+// depending on the encoding type passed in and the marshaled bytes are returned. Only the ProtoCodec is supported for
+// serializing queries. Both protobuf and proto3 JSON are supported.
+func SerializeCosmosQuery(cdc codec.Codec, requests []QueryRequest, includeHeight bool, encoding string) ([]byte, error) {
+	return defaultEncodingRegistry.SerializeCosmosQuery(cdc, requests, includeHeight, encoding)
+}
+
+// DeserializeCosmosQuery unmarshals query bytes into a CosmosQuery depending on the encoding type passed in. Only the
+// ProtoCodec is supported for deserializing queries. Both protobuf and proto3 JSON are supported.
+func DeserializeCosmosQuery(cdc codec.Codec, data []byte, encoding string) (CosmosQuery, error) {
+	return defaultEncodingRegistry.DeserializeCosmosQuery(cdc, data, encoding)
+}
+
+// SerializeCosmosTx serializes a slice of sdk.Msg's using the CosmosTx type, as SerializeCosmosTx does, with any of
+// the encoding formats registered.
+func (r EncodingRegistry) SerializeCosmosTx(cdc codec.Codec, msgs []proto.Message, encoding string) ([]byte, error) {
 	// this is a defensive check to ensure only the ProtoCodec is used for message serialization
 	if _, ok := cdc.(*codec.ProtoCodec); !ok {
 		return nil, errorsmod.Wrap(ErrInvalidCodec, "only the ProtoCodec may be used for receiving messages on the host chain")
 	}
 
-	var bz []byte
 	var err error
 
 	msgAnys := make([]*codectypes.Any, len(msgs))
@@ -53,47 +80,20 @@ func SerializeCosmosTx(cdc codec.Codec, msgs []proto.Message, encoding string) (
 		Messages: msgAnys,
 	}
 
-	switch encoding {
-	case EncodingProtobuf:
-		bz, err = cdc.Marshal(cosmosTx)
-		if err != nil {
-			return nil, errorsmod.Wrapf(err, "cannot marshal CosmosTx with protobuf")
-		}
-	case EncodingProto3JSON:
-		bz, err = cdc.MarshalJSON(cosmosTx)
-		if err != nil {
-			return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot marshal CosmosTx with proto3 json")
-		}
-	default:
-		return nil, errorsmod.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
-	}
-
-	return bz, nil
+	return r.marshal(cdc, cosmosTx, "CosmosTx", encoding)
 }
 
-// DeserializeCosmosTx unmarshals and unpacks a slice of transaction bytes into a slice of sdk.Msg's.
-// The transaction bytes are unmarshaled depending on the encoding type passed in. The sdk.Msg's are
-// unpacked from Any's and returned. Only the ProtoCodec is supported for serializing messages. Both
-// protobuf and proto3 JSON are supported.
-func DeserializeCosmosTx(cdc codec.Codec, data []byte, encoding string) ([]sdk.Msg, error) {
+// DeserializeCosmosTx unmarshals and unpacks a slice of transaction bytes into a slice of sdk.Msg's, as
+// DeserializeCosmosTx does, with any of the encoding formats registered.
+func (r EncodingRegistry) DeserializeCosmosTx(cdc codec.Codec, data []byte, encoding string) ([]sdk.Msg, error) {
 	// this is a defensive check to ensure only the ProtoCodec is used for message deserialization
 	if _, ok := cdc.(*codec.ProtoCodec); !ok {
 		return nil, errorsmod.Wrap(ErrInvalidCodec, "only the ProtoCodec may be used for receiving messages on the host chain")
 	}
 
 	var cosmosTx CosmosTx
-
-	switch encoding {
-	case EncodingProtobuf:
-		if err := cdc.Unmarshal(data, &cosmosTx); err != nil {
-			return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal CosmosTx with protobuf: %v", err)
-		}
-	case EncodingProto3JSON:
-		if err := cdc.UnmarshalJSON(data, &cosmosTx); err != nil {
-			return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal CosmosTx with proto3 json: %v", err)
-		}
-	default:
-		return nil, errorsmod.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	if err := r.unmarshal(cdc, data, &cosmosTx, "CosmosTx", encoding); err != nil {
+		return nil, err
 	}
 
 	msgs := make([]sdk.Msg, len(cosmosTx.Messages))
@@ -109,3 +109,35 @@ func DeserializeCosmosTx(cdc codec.Codec, data []byte, encoding string) ([]sdk.M
 
 	return msgs, nil
 }
+
+// SerializeCosmosQuery serializes a slice of query requests using the CosmosQuery type, as SerializeCosmosQuery does,
+// with any of the encoding formats registered.
+func (r EncodingRegistry) SerializeCosmosQuery(cdc codec.Codec, requests []QueryRequest, includeHeight bool, encoding string) ([]byte, error) {
+	// this is a defensive check to ensure only the ProtoCodec is used for query serialization
+	if _, ok := cdc.(*codec.ProtoCodec); !ok {
+		return nil, errorsmod.Wrap(ErrInvalidCodec, "only the ProtoCodec may be used for receiving queries on the host chain")
+	}
+
+	cosmosQuery := &CosmosQuery{
+		Requests:      requests,
+		IncludeHeight: includeHeight,
+	}
+
+	return r.marshal(cdc, cosmosQuery, "CosmosQuery", encoding)
+}
+
+// DeserializeCosmosQuery unmarshals query bytes into a CosmosQuery, as DeserializeCosmosQuery does, with any of the
+// encoding formats registered.
+func (r EncodingRegistry) DeserializeCosmosQuery(cdc codec.Codec, data []byte, encoding string) (CosmosQuery, error) {
+	// this is a defensive check to ensure only the ProtoCodec is used for query deserialization
+	if _, ok := cdc.(*codec.ProtoCodec); !ok {
+		return CosmosQuery{}, errorsmod.Wrap(ErrInvalidCodec, "only the ProtoCodec may be used for receiving queries on the host chain")
+	}
+
+	var cosmosQuery CosmosQuery
+	if err := r.unmarshal(cdc, data, &cosmosQuery, "CosmosQuery", encoding); err != nil {
+		return CosmosQuery{}, err
+	}
+
+	return cosmosQuery, nil
+}
@@ -470,3 +470,38 @@ func (suite *TypesTestSuite) TestUnsupportedEncodingType() {
 	_, err = types.DeserializeCosmosTx(suite.chainA.Codec, data, types.EncodingProtobuf)
 	suite.Require().NoError(err)
 }
+
+func (suite *TypesTestSuite) TestSerializeAndDeserializeCosmosQuery() {
+	requests := []types.QueryRequest{
+		{
+			Path: "/cosmos.bank.v1beta1.Query/Balance",
+			Data: []byte("balance query"),
+		},
+		{
+			Path: "/cosmos.staking.v1beta1.Query/Params",
+		},
+	}
+
+	for _, encoding := range []string{types.EncodingProtobuf, types.EncodingProto3JSON} {
+		for _, includeHeight := range []bool{false, true} {
+			bz, err := types.SerializeCosmosQuery(suite.chainA.Codec, requests, includeHeight, encoding)
+			suite.Require().NoError(err)
+
+			query, err := types.DeserializeCosmosQuery(suite.chainA.Codec, bz, encoding)
+			suite.Require().NoError(err)
+			suite.Require().Equal(requests[0], query.Requests[0])
+			suite.Require().Equal(requests[1].Path, query.Requests[1].Path)
+			suite.Require().Empty(query.Requests[1].Data)
+			suite.Require().Equal(includeHeight, query.IncludeHeight)
+		}
+	}
+
+	_, err := types.SerializeCosmosQuery(suite.chainA.Codec, requests, false, "unsupported")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+
+	_, err = types.DeserializeCosmosQuery(suite.chainA.Codec, []byte("query"), "unsupported")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+
+	_, err = types.DeserializeCosmosQuery(suite.chainA.Codec, []byte("invalid query"), types.EncodingProto3JSON)
+	suite.Require().ErrorIs(err, ibcerrors.ErrInvalidType)
+}
@@ -0,0 +1,177 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// Encoder defines the interface used to marshal and unmarshal the CosmosTx and CosmosQuery of interchain accounts
+// packet data in an encoding format negotiated in the ICS27 Metadata.
+type Encoder interface {
+	// Name returns the human readable name of the encoding format
+	Name() string
+	// Marshal encodes the message, which is either a CosmosTx or a CosmosQuery
+	Marshal(cdc codec.Codec, msg proto.Message) ([]byte, error)
+	// Unmarshal decodes the bytes into the message, which is either a CosmosTx or a CosmosQuery
+	Unmarshal(cdc codec.Codec, bz []byte, msg proto.Message) error
+}
+
+// EncodingRegistry maps the encoding formats which may be negotiated in the ICS27 Metadata to their Encoder. Copies of
+// an EncodingRegistry share the encoding formats registered with any of them.
+type EncodingRegistry struct {
+	encoders map[string]Encoder
+}
+
+// NewEncodingRegistry creates and returns a new EncodingRegistry supporting the protobuf and proto3 JSON encoding
+// formats.
+func NewEncodingRegistry() EncodingRegistry {
+	registry := EncodingRegistry{
+		encoders: make(map[string]Encoder),
+	}
+
+	registry.RegisterEncoding(EncodingProtobuf, protobufEncoder{})
+	registry.RegisterEncoding(EncodingProto3JSON, proto3JSONEncoder{})
+
+	return registry
+}
+
+// RegisterEncoding registers the encoder of the given encoding format. It panics if the encoding format is invalid or
+// already registered, or if the encoder is nil.
+func (r EncodingRegistry) RegisterEncoding(encoding string, encoder Encoder) {
+	if strings.TrimSpace(encoding) == "" {
+		panic(errors.New("encoding format cannot be empty"))
+	}
+
+	if encoder == nil {
+		panic(fmt.Errorf("encoder of encoding format %s cannot be nil", encoding))
+	}
+
+	if _, found := r.encoders[encoding]; found {
+		panic(fmt.Errorf("encoding format %s is already registered", encoding))
+	}
+
+	r.encoders[encoding] = encoder
+}
+
+// GetEncoder returns the encoder of the given encoding format, if it is registered
+func (r EncodingRegistry) GetEncoder(encoding string) (Encoder, bool) {
+	encoder, found := r.encoders[encoding]
+	return encoder, found
+}
+
+// IsSupportedEncoding returns true if the provided encoding format is registered, otherwise false
+func (r EncodingRegistry) IsSupportedEncoding(encoding string) bool {
+	_, found := r.encoders[encoding]
+	return found
+}
+
+// SupportedEncodings returns the registered encoding formats in lexicographic order
+func (r EncodingRegistry) SupportedEncodings() []string {
+	encodings := make([]string, 0, len(r.encoders))
+	for encoding := range r.encoders {
+		encodings = append(encodings, encoding)
+	}
+
+	sort.Strings(encodings)
+	return encodings
+}
+
+// marshal encodes the CosmosTx or CosmosQuery with the encoder of the given encoding format
+func (r EncodingRegistry) marshal(cdc codec.Codec, msg proto.Message, msgName, encoding string) ([]byte, error) {
+	encoder, found := r.GetEncoder(encoding)
+	if !found {
+		return nil, errorsmod.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+
+	bz, err := encoder.Marshal(cdc, msg)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot marshal %s with %s: %v", msgName, encoder.Name(), err)
+	}
+
+	return bz, nil
+}
+
+// unmarshal decodes the bytes into the CosmosTx or CosmosQuery with the encoder of the given encoding format
+func (r EncodingRegistry) unmarshal(cdc codec.Codec, bz []byte, msg proto.Message, msgName, encoding string) error {
+	encoder, found := r.GetEncoder(encoding)
+	if !found {
+		return errorsmod.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+
+	if err := encoder.Unmarshal(cdc, bz, msg); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal %s with %s: %v", msgName, encoder.Name(), err)
+	}
+
+	return nil
+}
+
+// protobufEncoder is the Encoder of the protocol buffers proto3 encoding format
+type protobufEncoder struct{}
+
+// Name implements Encoder
+func (protobufEncoder) Name() string { return "protobuf" }
+
+// Marshal implements Encoder
+func (protobufEncoder) Marshal(cdc codec.Codec, msg proto.Message) ([]byte, error) {
+	return cdc.Marshal(msg)
+}
+
+// Unmarshal implements Encoder
+func (protobufEncoder) Unmarshal(cdc codec.Codec, bz []byte, msg proto.Message) error {
+	return cdc.Unmarshal(bz, msg)
+}
+
+// proto3JSONEncoder is the Encoder of the proto3 JSON encoding format
+type proto3JSONEncoder struct{}
+
+// Name implements Encoder
+func (proto3JSONEncoder) Name() string { return "proto3 json" }
+
+// Marshal implements Encoder
+func (proto3JSONEncoder) Marshal(cdc codec.Codec, msg proto.Message) ([]byte, error) {
+	return cdc.MarshalJSON(msg)
+}
+
+// Unmarshal implements Encoder
+func (proto3JSONEncoder) Unmarshal(cdc codec.Codec, bz []byte, msg proto.Message) error {
+	return cdc.UnmarshalJSON(bz, msg)
+}
+
+// aminoJSONEncoder is the Encoder of the Amino JSON encoding format
+type aminoJSONEncoder struct {
+	legacyAmino *codec.LegacyAmino
+}
+
+// NewAminoJSONEncoder returns the Encoder of the Amino JSON encoding format, which encodes the messages of a CosmosTx
+// with their Amino type name and JSON representation, as signed by legacy Amino signers. Only the messages registered
+// on the provided LegacyAmino codec may be encoded.
+func NewAminoJSONEncoder(legacyAmino *codec.LegacyAmino) Encoder {
+	if legacyAmino == nil {
+		panic(errors.New("legacy amino codec cannot be nil"))
+	}
+
+	return aminoJSONEncoder{legacyAmino: legacyAmino}
+}
+
+// Name implements Encoder
+func (aminoJSONEncoder) Name() string { return "amino json" }
+
+// Marshal implements Encoder
+func (e aminoJSONEncoder) Marshal(_ codec.Codec, msg proto.Message) ([]byte, error) {
+	return e.legacyAmino.MarshalJSON(msg)
+}
+
+// Unmarshal implements Encoder
+func (e aminoJSONEncoder) Unmarshal(_ codec.Codec, bz []byte, msg proto.Message) error {
+	return e.legacyAmino.UnmarshalJSON(bz, msg)
+}
@@ -0,0 +1,148 @@
+package types_test
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+func (suite *TypesTestSuite) TestEncodingRegistry() {
+	registry := types.NewEncodingRegistry()
+	suite.Require().Equal([]string{types.EncodingProtobuf, types.EncodingProto3JSON}, registry.SupportedEncodings())
+	suite.Require().False(registry.IsSupportedEncoding(types.EncodingCBOR))
+
+	// copies of the registry share the registered encoding formats
+	registryCopy := registry
+	registryCopy.RegisterEncoding(types.EncodingCBOR, types.NewCBOREncoder())
+	suite.Require().True(registry.IsSupportedEncoding(types.EncodingCBOR))
+	suite.Require().Equal([]string{types.EncodingCBOR, types.EncodingProtobuf, types.EncodingProto3JSON}, registry.SupportedEncodings())
+
+	encoder, found := registry.GetEncoder(types.EncodingCBOR)
+	suite.Require().True(found)
+	suite.Require().Equal("cbor", encoder.Name())
+
+	suite.Require().Panics(func() { registry.RegisterEncoding(types.EncodingProtobuf, types.NewCBOREncoder()) })
+	suite.Require().Panics(func() { registry.RegisterEncoding(" ", types.NewCBOREncoder()) })
+	suite.Require().Panics(func() { registry.RegisterEncoding(types.EncodingAminoJSON, nil) })
+	suite.Require().Panics(func() { types.NewAminoJSONEncoder(nil) })
+}
+
+func (suite *TypesTestSuite) TestSerializeAndDeserializeRegisteredEncodings() {
+	registry := types.NewEncodingRegistry()
+	registry.RegisterEncoding(types.EncodingCBOR, types.NewCBOREncoder())
+	registry.RegisterEncoding(types.EncodingAminoJSON, types.NewAminoJSONEncoder(suite.chainA.GetSimApp().LegacyAmino()))
+
+	msgs := []proto.Message{
+		&banktypes.MsgSend{
+			FromAddress: TestOwnerAddress,
+			ToAddress:   TestOwnerAddress,
+			Amount:      sdk.NewCoins(sdk.NewCoin("bananas", sdkmath.NewInt(100))),
+		},
+		&stakingtypes.MsgDelegate{
+			DelegatorAddress: TestOwnerAddress,
+			ValidatorAddress: TestOwnerAddress,
+			Amount:           sdk.NewCoin("atom", sdkmath.NewInt(100)),
+		},
+	}
+
+	requests := []types.QueryRequest{
+		{
+			Path: "/cosmos.bank.v1beta1.Query/Balance",
+			Data: []byte("balance query"),
+		},
+		{
+			Path: "/cosmos.staking.v1beta1.Query/Params",
+		},
+	}
+
+	for _, encoding := range []string{types.EncodingCBOR, types.EncodingAminoJSON} {
+		bz, err := registry.SerializeCosmosTx(suite.chainA.Codec, msgs, encoding)
+		suite.Require().NoError(err, encoding)
+
+		deserializedMsgs, err := registry.DeserializeCosmosTx(suite.chainA.Codec, bz, encoding)
+		suite.Require().NoError(err, encoding)
+		suite.Require().Len(deserializedMsgs, len(msgs))
+		for i, msg := range msgs {
+			suite.Require().Equal(proto.CompactTextString(msg), proto.CompactTextString(deserializedMsgs[i]), encoding)
+		}
+
+		_, err = registry.DeserializeCosmosTx(suite.chainA.Codec, []byte("invalid"), encoding)
+		suite.Require().ErrorIs(err, ibcerrors.ErrInvalidType, encoding)
+
+		bz, err = registry.SerializeCosmosQuery(suite.chainA.Codec, requests, true, encoding)
+		suite.Require().NoError(err, encoding)
+
+		query, err := registry.DeserializeCosmosQuery(suite.chainA.Codec, bz, encoding)
+		suite.Require().NoError(err, encoding)
+		suite.Require().Equal(requests[0], query.Requests[0])
+		suite.Require().Equal(requests[1].Path, query.Requests[1].Path)
+		suite.Require().Empty(query.Requests[1].Data)
+		suite.Require().True(query.IncludeHeight)
+
+		// the encoding formats are not supported by the package level functions
+		_, err = types.SerializeCosmosTx(suite.chainA.Codec, msgs, encoding)
+		suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+	}
+}
+
+func (suite *TypesTestSuite) TestCBOREncoding() {
+	registry := types.NewEncodingRegistry()
+	registry.RegisterEncoding(types.EncodingCBOR, types.NewCBOREncoder())
+
+	msg := &banktypes.MsgSend{
+		FromAddress: TestOwnerAddress,
+		ToAddress:   TestOwnerAddress,
+		Amount:      sdk.NewCoins(sdk.NewCoin("bananas", sdkmath.NewInt(100))),
+	}
+
+	msgBz, err := proto.Marshal(msg)
+	suite.Require().NoError(err)
+
+	typeURL := sdk.MsgTypeURL(msg)
+
+	// [[type_url, value]], with type_url and value shorter than 256 bytes
+	expCosmosTx := append([]byte{0x81, 0x82, 0x78, byte(len(typeURL))}, typeURL...)
+	expCosmosTx = append(append(expCosmosTx, 0x58, byte(len(msgBz))), msgBz...)
+
+	bz, err := registry.SerializeCosmosTx(suite.chainA.Codec, []proto.Message{msg}, types.EncodingCBOR)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expCosmosTx, bz)
+
+	// [[[path, data]], include_height]
+	expCosmosQuery := []byte{0x82, 0x81, 0x82, 0x61, 'p', 0x41, 'd', 0xf4}
+
+	bz, err = registry.SerializeCosmosQuery(suite.chainA.Codec, []types.QueryRequest{{Path: "p", Data: []byte("d")}}, false, types.EncodingCBOR)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expCosmosQuery, bz)
+
+	testCases := []struct {
+		name string
+		bz   []byte
+	}{
+		{"empty data", []byte{}},
+		{"trailing data", append(expCosmosQuery, 0x00)},
+		{"truncated data", expCosmosQuery[:len(expCosmosQuery)-1]},
+		{"argument not in its shortest form", []byte{0x98, 0x02, 0x81, 0x82, 0x61, 'p', 0x41, 'd', 0xf4}},
+		{"indefinite length array", []byte{0x9f, 0x81, 0x82, 0x61, 'p', 0x41, 'd', 0xf4, 0xff}},
+		{"array length exceeding the data", []byte{0x82, 0x9a, 0xff, 0xff, 0xff, 0xff, 0xf4}},
+		{"unexpected major type", []byte{0x82, 0x81, 0x82, 0x41, 'p', 0x41, 'd', 0xf4}},
+		{"invalid boolean", []byte{0x82, 0x81, 0x82, 0x61, 'p', 0x41, 'd', 0xf6}},
+		{"invalid utf-8 path", []byte{0x82, 0x81, 0x82, 0x61, 0xff, 0x41, 'd', 0xf4}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			_, err := registry.DeserializeCosmosQuery(suite.chainA.Codec, tc.bz, types.EncodingCBOR)
+			suite.Require().ErrorIs(err, ibcerrors.ErrInvalidType)
+		})
+	}
+}
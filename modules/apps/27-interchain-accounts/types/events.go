@@ -2,10 +2,24 @@ package types
 
 // ICS27 Interchain Accounts events
 const (
-	EventTypePacket = "ics27_packet"
+	EventTypePacket               = "ics27_packet"
+	EventTypeGasUsage             = "ics27_gas_usage"
+	EventTypeScheduledTxSent      = "ics27_scheduled_tx_sent"
+	EventTypeChannelReopenInit    = "ics27_channel_reopen_init"
+	EventTypeChannelReopened      = "ics27_channel_reopened"
+	EventTypeOwnershipTransferred = "ics27_ownership_transferred"
 
 	AttributeKeyAckError            = "error"
 	AttributeKeyHostChannelID       = "host_channel_id"
 	AttributeKeyControllerChannelID = "controller_channel_id"
 	AttributeKeyAckSuccess          = "success"
+	AttributeKeyControllerPortID    = "controller_port_id"
+	AttributeKeyGasUsed             = "gas_used"
+	AttributeKeyGasLimit            = "gas_limit"
+	AttributeKeyScheduledTxID       = "scheduled_tx_id"
+	AttributeKeyOwner               = "owner"
+	AttributeKeyConnectionID        = "connection_id"
+	AttributeKeySequence            = "sequence"
+	AttributeKeyClosedChannelID     = "closed_channel_id"
+	AttributeKeyNewOwner            = "new_owner"
 )
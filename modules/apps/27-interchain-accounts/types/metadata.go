@@ -7,6 +7,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	connectiontypes "github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 )
 
@@ -15,6 +16,10 @@ const (
 	EncodingProtobuf = "proto3"
 	// EncodingProto3JSON defines the proto3 JSON encoding format
 	EncodingProto3JSON = "proto3json"
+	// EncodingCBOR defines the CBOR encoding format, supported once its encoder is registered
+	EncodingCBOR = "cbor"
+	// EncodingAminoJSON defines the Amino JSON encoding format, supported once its encoder is registered
+	EncodingAminoJSON = "aminojson"
 
 	// TxTypeSDKMultiMsg defines the multi message transaction type supported by the Cosmos SDK
 	TxTypeSDKMultiMsg = "sdk_multi_msg"
@@ -79,9 +84,10 @@ func IsPreviousMetadataEqual(previousVersion string, metadata Metadata) bool {
 }
 
 // ValidateControllerMetadata performs validation of the provided ICS27 controller metadata parameters as well
-// as the connection params against the provided metadata
-func ValidateControllerMetadata(ctx context.Context, channelKeeper ChannelKeeper, connectionHops []string, metadata Metadata) error {
-	if !isSupportedEncoding(metadata.Encoding) {
+// as the connection params against the provided metadata. The encoding format must be registered in the provided
+// encoding registry.
+func ValidateControllerMetadata(ctx context.Context, channelKeeper ChannelKeeper, encodingRegistry EncodingRegistry, connectionHops []string, metadata Metadata) error {
+	if !encodingRegistry.IsSupportedEncoding(metadata.Encoding) {
 		return errorsmod.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", metadata.Encoding)
 	}
 
@@ -104,16 +110,17 @@ func ValidateControllerMetadata(ctx context.Context, channelKeeper ChannelKeeper
 		}
 	}
 
-	if metadata.Version != Version {
+	if !isSupportedVersion(metadata.Version) {
 		return errorsmod.Wrapf(ErrInvalidVersion, "expected %s, got %s", Version, metadata.Version)
 	}
 
 	return nil
 }
 
-// ValidateHostMetadata performs validation of the provided ICS27 host metadata parameters
-func ValidateHostMetadata(ctx context.Context, channelKeeper ChannelKeeper, connectionHops []string, metadata Metadata) error {
-	if !isSupportedEncoding(metadata.Encoding) {
+// ValidateHostMetadata performs validation of the provided ICS27 host metadata parameters. The encoding format must be
+// registered in the provided encoding registry.
+func ValidateHostMetadata(ctx context.Context, channelKeeper ChannelKeeper, encodingRegistry EncodingRegistry, connectionHops []string, metadata Metadata) error {
+	if !encodingRegistry.IsSupportedEncoding(metadata.Encoding) {
 		return errorsmod.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", metadata.Encoding)
 	}
 
@@ -136,21 +143,24 @@ func ValidateHostMetadata(ctx context.Context, channelKeeper ChannelKeeper, conn
 		}
 	}
 
-	if metadata.Version != Version {
+	if !isSupportedVersion(metadata.Version) {
 		return errorsmod.Wrapf(ErrInvalidVersion, "expected %s, got %s", Version, metadata.Version)
 	}
 
 	return nil
 }
 
-// isSupportedEncoding returns true if the provided encoding is supported, otherwise false
-func isSupportedEncoding(encoding string) bool {
-	return slices.Contains(getSupportedEncoding(), encoding)
+// HasStructuredErrors returns true if the metadata negotiates the use of structured error acknowledgements.
+func (metadata Metadata) HasStructuredErrors() bool {
+	_, structuredErrors := channeltypes.ParseStructuredErrorsVersion(metadata.Version)
+	return structuredErrors
 }
 
-// getSupportedEncoding returns a string slice of supported encoding formats
-func getSupportedEncoding() []string {
-	return []string{EncodingProtobuf, EncodingProto3JSON}
+// isSupportedVersion returns true if the provided version is the ICS27 version, optionally
+// negotiating the use of structured error acknowledgements, otherwise false
+func isSupportedVersion(version string) bool {
+	version, _ = channeltypes.ParseStructuredErrorsVersion(version)
+	return version == Version
 }
 
 // isSupportedTxType returns true if the provided transaction type is supported, otherwise false
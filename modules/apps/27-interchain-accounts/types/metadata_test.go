@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 )
 
@@ -137,7 +138,10 @@ func (suite *TypesTestSuite) TestIsPreviousMetadataEqual() {
 }
 
 func (suite *TypesTestSuite) TestValidateControllerMetadata() {
-	var metadata types.Metadata
+	var (
+		metadata         types.Metadata
+		encodingRegistry types.EncodingRegistry
+	)
 
 	testCases := []struct {
 		name     string
@@ -163,6 +167,13 @@ func (suite *TypesTestSuite) TestValidateControllerMetadata() {
 			},
 			true,
 		},
+		{
+			"success with structured errors",
+			func() {
+				metadata.Version = channeltypes.NewStructuredErrorsVersion(types.Version)
+			},
+			true,
+		},
 		{
 			"success with EncodingProto3JSON",
 			func() {
@@ -177,6 +188,21 @@ func (suite *TypesTestSuite) TestValidateControllerMetadata() {
 			},
 			true,
 		},
+		{
+			"success with registered encoding format",
+			func() {
+				encodingRegistry.RegisterEncoding(types.EncodingCBOR, types.NewCBOREncoder())
+				metadata.Encoding = types.EncodingCBOR
+			},
+			true,
+		},
+		{
+			"encoding format not registered",
+			func() {
+				metadata.Encoding = types.EncodingCBOR
+			},
+			false,
+		},
 		{
 			"unsupported encoding format",
 			func() {
@@ -273,11 +299,14 @@ func (suite *TypesTestSuite) TestValidateControllerMetadata() {
 
 			metadata = types.NewMetadata(types.Version, ibctesting.FirstConnectionID, ibctesting.FirstConnectionID, TestOwnerAddress, types.EncodingProtobuf, types.TxTypeSDKMultiMsg)
 
+			encodingRegistry = types.NewEncodingRegistry()
+
 			tc.malleate() // malleate mutates test data
 
 			err := types.ValidateControllerMetadata(
 				suite.chainA.GetContext(),
 				suite.chainA.App.GetIBCKeeper().ChannelKeeper,
+				encodingRegistry,
 				[]string{ibctesting.FirstConnectionID},
 				metadata,
 			)
@@ -292,7 +321,10 @@ func (suite *TypesTestSuite) TestValidateControllerMetadata() {
 }
 
 func (suite *TypesTestSuite) TestValidateHostMetadata() {
-	var metadata types.Metadata
+	var (
+		metadata         types.Metadata
+		encodingRegistry types.EncodingRegistry
+	)
 
 	testCases := []struct {
 		name     string
@@ -318,6 +350,13 @@ func (suite *TypesTestSuite) TestValidateHostMetadata() {
 			},
 			true,
 		},
+		{
+			"success with structured errors",
+			func() {
+				metadata.Version = channeltypes.NewStructuredErrorsVersion(types.Version)
+			},
+			true,
+		},
 		{
 			"success with EncodingProto3JSON",
 			func() {
@@ -332,6 +371,21 @@ func (suite *TypesTestSuite) TestValidateHostMetadata() {
 			},
 			true,
 		},
+		{
+			"success with registered encoding format",
+			func() {
+				encodingRegistry.RegisterEncoding(types.EncodingCBOR, types.NewCBOREncoder())
+				metadata.Encoding = types.EncodingCBOR
+			},
+			true,
+		},
+		{
+			"encoding format not registered",
+			func() {
+				metadata.Encoding = types.EncodingCBOR
+			},
+			false,
+		},
 		{
 			"unsupported encoding format",
 			func() {
@@ -428,11 +482,14 @@ func (suite *TypesTestSuite) TestValidateHostMetadata() {
 
 			metadata = types.NewMetadata(types.Version, ibctesting.FirstConnectionID, ibctesting.FirstConnectionID, TestOwnerAddress, types.EncodingProtobuf, types.TxTypeSDKMultiMsg)
 
+			encodingRegistry = types.NewEncodingRegistry()
+
 			tc.malleate() // malleate mutates test data
 
 			err := types.ValidateHostMetadata(
 				suite.chainA.GetContext(),
 				suite.chainA.App.GetIBCKeeper().ChannelKeeper,
+				encodingRegistry,
 				[]string{ibctesting.FirstConnectionID},
 				metadata,
 			)
@@ -445,3 +502,11 @@ func (suite *TypesTestSuite) TestValidateHostMetadata() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestHasStructuredErrors() {
+	metadata := types.NewMetadata(types.Version, ibctesting.FirstConnectionID, ibctesting.FirstConnectionID, TestOwnerAddress, types.EncodingProtobuf, types.TxTypeSDKMultiMsg)
+	suite.Require().False(metadata.HasStructuredErrors())
+
+	metadata.Version = channeltypes.NewStructuredErrorsVersion(types.Version)
+	suite.Require().True(metadata.HasStructuredErrors())
+}
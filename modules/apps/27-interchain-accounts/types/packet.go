@@ -4,11 +4,15 @@ import (
 	"encoding/json"
 	"strings"
 
+	"github.com/cosmos/gogoproto/proto"
+
 	errorsmod "cosmossdk.io/errors"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 	ibcexported "github.com/cosmos/ibc-go/v9/modules/core/exported"
 )
 
@@ -20,6 +24,46 @@ var (
 // MaxMemoCharLength defines the maximum length for the InterchainAccountPacketData memo field
 const MaxMemoCharLength = 32768
 
+// legacyInterchainAccountPacketData is the interchain account packet data as defined before the execution mode
+// and gas limit fields were added. It mirrors the tags of the corresponding InterchainAccountPacketData fields,
+// so that it is JSON marshalled by the module codec exactly as older controller chains marshal packet data.
+type legacyInterchainAccountPacketData struct {
+	Type Type   `protobuf:"varint,1,opt,name=type,proto3,enum=ibc.applications.interchain_accounts.v1.Type" json:"type,omitempty"`
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Memo string `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+// Reset implements proto.Message.
+func (lpd *legacyInterchainAccountPacketData) Reset() { *lpd = legacyInterchainAccountPacketData{} }
+
+// String implements proto.Message.
+func (lpd *legacyInterchainAccountPacketData) String() string { return proto.CompactTextString(lpd) }
+
+// ProtoMessage implements proto.Message.
+func (*legacyInterchainAccountPacketData) ProtoMessage() {}
+
+// noGasLimitInterchainAccountPacketData is the interchain account packet data as defined before the gas limit
+// field was added, marshalled as by controller chains which support execution modes but not gas limits.
+type noGasLimitInterchainAccountPacketData struct {
+	Type          Type          `protobuf:"varint,1,opt,name=type,proto3,enum=ibc.applications.interchain_accounts.v1.Type" json:"type,omitempty"`
+	Data          []byte        `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Memo          string        `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+	ExecutionMode ExecutionMode `protobuf:"varint,4,opt,name=execution_mode,json=executionMode,proto3,enum=ibc.applications.interchain_accounts.v1.ExecutionMode" json:"execution_mode,omitempty"`
+}
+
+// Reset implements proto.Message.
+func (npd *noGasLimitInterchainAccountPacketData) Reset() {
+	*npd = noGasLimitInterchainAccountPacketData{}
+}
+
+// String implements proto.Message.
+func (npd *noGasLimitInterchainAccountPacketData) String() string {
+	return proto.CompactTextString(npd)
+}
+
+// ProtoMessage implements proto.Message.
+func (*noGasLimitInterchainAccountPacketData) ProtoMessage() {}
+
 // ValidateBasic performs basic validation of the interchain account packet data.
 // The memo may be empty.
 func (iapd InterchainAccountPacketData) ValidateBasic() error {
@@ -35,12 +79,35 @@ func (iapd InterchainAccountPacketData) ValidateBasic() error {
 		return errorsmod.Wrapf(ErrInvalidOutgoingData, "packet data memo cannot be greater than %d characters", MaxMemoCharLength)
 	}
 
+	if _, found := ExecutionMode_name[int32(iapd.ExecutionMode)]; !found {
+		return errorsmod.Wrapf(ErrInvalidOutgoingData, "invalid execution mode %d", iapd.ExecutionMode)
+	}
+
 	return nil
 }
 
 // GetBytes returns the JSON marshalled interchain account packet data.
+// Packet data without a gas limit is marshalled without the gas limit field, and packet data of transactions
+// executed atomically without a gas limit is marshalled without the execution mode field either, so that it
+// remains decodable by host chains which do not support these fields.
 func (iapd InterchainAccountPacketData) GetBytes() []byte {
-	return ModuleCdc.MustMarshalJSON(&iapd)
+	switch {
+	case iapd.GasLimit != 0:
+		return ModuleCdc.MustMarshalJSON(&iapd)
+	case iapd.ExecutionMode == ATOMIC:
+		return ModuleCdc.MustMarshalJSON(&legacyInterchainAccountPacketData{
+			Type: iapd.Type,
+			Data: iapd.Data,
+			Memo: iapd.Memo,
+		})
+	default:
+		return ModuleCdc.MustMarshalJSON(&noGasLimitInterchainAccountPacketData{
+			Type:          iapd.Type,
+			Data:          iapd.Data,
+			Memo:          iapd.Memo,
+			ExecutionMode: iapd.ExecutionMode,
+		})
+	}
 }
 
 // UnmarshalJSON unmarshals raw JSON bytes into an InterchainAccountPacketData.
@@ -48,6 +115,31 @@ func (iapd *InterchainAccountPacketData) UnmarshalJSON(bz []byte) error {
 	return ModuleCdc.UnmarshalJSON(bz, iapd)
 }
 
+// IsSuccess returns true if the message was executed successfully.
+func (r MsgResult) IsSuccess() bool {
+	return r.Error == nil
+}
+
+// DecodeQueryAcknowledgement decodes the JSON encoded acknowledgement of a query packet into the CosmosQueryResponse
+// it contains. An error is returned if the acknowledgement is an error acknowledgement.
+func DecodeQueryAcknowledgement(acknowledgement []byte) (CosmosQueryResponse, error) {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return CosmosQueryResponse{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-27 query packet acknowledgement: %v", err)
+	}
+
+	if !ack.Success() {
+		return CosmosQueryResponse{}, errorsmod.Wrapf(channeltypes.ErrInvalidAcknowledgement, "query packet failed: %s", ack.GetError())
+	}
+
+	var response CosmosQueryResponse
+	if err := proto.Unmarshal(ack.GetResult(), &response); err != nil {
+		return CosmosQueryResponse{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal CosmosQueryResponse: %v", err)
+	}
+
+	return response, nil
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (ct CosmosTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	for _, protoAny := range ct.Messages {
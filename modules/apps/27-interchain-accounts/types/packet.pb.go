@@ -8,6 +8,7 @@ import (
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	types1 "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -33,16 +34,20 @@ const (
 	UNSPECIFIED Type = 0
 	// Execute a transaction on an interchain accounts host chain
 	EXECUTE_TX Type = 1
+	// Execute module safe queries on an interchain accounts host chain, without changing its state
+	QUERY Type = 2
 )
 
 var Type_name = map[int32]string{
 	0: "TYPE_UNSPECIFIED",
 	1: "TYPE_EXECUTE_TX",
+	2: "TYPE_QUERY",
 }
 
 var Type_value = map[string]int32{
 	"TYPE_UNSPECIFIED": 0,
 	"TYPE_EXECUTE_TX":  1,
+	"TYPE_QUERY":       2,
 }
 
 func (x Type) String() string {
@@ -53,11 +58,44 @@ func (Type) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_89a080d7401cd393, []int{0}
 }
 
-// InterchainAccountPacketData is comprised of a raw transaction, type of transaction and optional memo field.
+// ExecutionMode defines how the messages of a transaction are executed on an interchain accounts host chain
+type ExecutionMode int32
+
+const (
+	// Execute the messages atomically, reverting the state changes of every message if a single message fails
+	ATOMIC ExecutionMode = 0
+	// Execute the messages independently, reverting only the state changes of the messages which fail
+	BEST_EFFORT ExecutionMode = 1
+)
+
+var ExecutionMode_name = map[int32]string{
+	0: "EXECUTION_MODE_ATOMIC",
+	1: "EXECUTION_MODE_BEST_EFFORT",
+}
+
+var ExecutionMode_value = map[string]int32{
+	"EXECUTION_MODE_ATOMIC":      0,
+	"EXECUTION_MODE_BEST_EFFORT": 1,
+}
+
+func (x ExecutionMode) String() string {
+	return proto.EnumName(ExecutionMode_name, int32(x))
+}
+
+func (ExecutionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{1}
+}
+
+// InterchainAccountPacketData is comprised of a raw transaction, type of transaction, optional memo field,
+// the mode in which the messages of the transaction are executed and an optional gas limit for its execution.
 type InterchainAccountPacketData struct {
-	Type Type   `protobuf:"varint,1,opt,name=type,proto3,enum=ibc.applications.interchain_accounts.v1.Type" json:"type,omitempty"`
-	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	Memo string `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+	Type          Type          `protobuf:"varint,1,opt,name=type,proto3,enum=ibc.applications.interchain_accounts.v1.Type" json:"type,omitempty"`
+	Data          []byte        `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Memo          string        `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+	ExecutionMode ExecutionMode `protobuf:"varint,4,opt,name=execution_mode,json=executionMode,proto3,enum=ibc.applications.interchain_accounts.v1.ExecutionMode" json:"execution_mode,omitempty"`
+	// gas_limit defines the maximum amount of gas which the execution of the transaction may consume on the host
+	// chain. A value of zero leaves the execution limited only by the host chain parameters.
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
 func (m *InterchainAccountPacketData) Reset()         { *m = InterchainAccountPacketData{} }
@@ -114,6 +152,20 @@ func (m *InterchainAccountPacketData) GetMemo() string {
 	return ""
 }
 
+func (m *InterchainAccountPacketData) GetExecutionMode() ExecutionMode {
+	if m != nil {
+		return m.ExecutionMode
+	}
+	return ATOMIC
+}
+
+func (m *InterchainAccountPacketData) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
 // CosmosTx contains a list of sdk.Msg's. It should be used when sending transactions to an SDK host chain.
 type CosmosTx struct {
 	Messages []*types.Any `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
@@ -159,10 +211,284 @@ func (m *CosmosTx) GetMessages() []*types.Any {
 	return nil
 }
 
+// CosmosQuery contains a list of module safe queries. It should be used when sending query packets to an SDK host
+// chain.
+type CosmosQuery struct {
+	Requests []QueryRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests"`
+	// include_height requests the host chain to include the height at which the queries were executed in the response.
+	IncludeHeight bool `protobuf:"varint,2,opt,name=include_height,json=includeHeight,proto3" json:"include_height,omitempty"`
+}
+
+func (m *CosmosQuery) Reset()         { *m = CosmosQuery{} }
+func (m *CosmosQuery) String() string { return proto.CompactTextString(m) }
+func (*CosmosQuery) ProtoMessage()    {}
+func (*CosmosQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{2}
+}
+func (m *CosmosQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CosmosQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CosmosQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CosmosQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CosmosQuery.Merge(m, src)
+}
+func (m *CosmosQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *CosmosQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_CosmosQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CosmosQuery proto.InternalMessageInfo
+
+func (m *CosmosQuery) GetRequests() []QueryRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *CosmosQuery) GetIncludeHeight() bool {
+	if m != nil {
+		return m.IncludeHeight
+	}
+	return false
+}
+
+// QueryRequest defines a module safe query executed by the host chain.
+type QueryRequest struct {
+	// path defines the path of the query request as defined by ADR-021.
+	// https://github.com/cosmos/cosmos-sdk/blob/main/docs/architecture/adr-021-protobuf-query-encoding.md#custom-query-registration-and-routing
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// data defines the payload of the query request as defined by ADR-021.
+	// https://github.com/cosmos/cosmos-sdk/blob/main/docs/architecture/adr-021-protobuf-query-encoding.md#custom-query-registration-and-routing
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
+func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequest) ProtoMessage()    {}
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{3}
+}
+func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequest.Merge(m, src)
+}
+func (m *QueryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequest proto.InternalMessageInfo
+
+func (m *QueryRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *QueryRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// CosmosQueryResponse is the result of the acknowledgement of a query packet. It contains the protobuf encoded
+// response of every query, in the order of the queries.
+type CosmosQueryResponse struct {
+	Responses [][]byte `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	// height is the height at which the queries were executed, set if it was requested.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *CosmosQueryResponse) Reset()         { *m = CosmosQueryResponse{} }
+func (m *CosmosQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*CosmosQueryResponse) ProtoMessage()    {}
+func (*CosmosQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{4}
+}
+func (m *CosmosQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CosmosQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CosmosQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CosmosQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CosmosQueryResponse.Merge(m, src)
+}
+func (m *CosmosQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CosmosQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CosmosQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CosmosQueryResponse proto.InternalMessageInfo
+
+func (m *CosmosQueryResponse) GetResponses() [][]byte {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *CosmosQueryResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// MsgResult defines the result of the execution of a single message of a transaction executed in best-effort mode.
+type MsgResult struct {
+	// response is the response of the message, set if the message was executed successfully.
+	Response *types.Any `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	// error identifies the error of the message, set if the message failed.
+	Error *types1.ErrorAcknowledgement `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *MsgResult) Reset()         { *m = MsgResult{} }
+func (m *MsgResult) String() string { return proto.CompactTextString(m) }
+func (*MsgResult) ProtoMessage()    {}
+func (*MsgResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{5}
+}
+func (m *MsgResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResult.Merge(m, src)
+}
+func (m *MsgResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResult proto.InternalMessageInfo
+
+func (m *MsgResult) GetResponse() *types.Any {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *MsgResult) GetError() *types1.ErrorAcknowledgement {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+// BestEffortTxResponse is the result of the acknowledgement of a transaction executed in best-effort mode.
+// It contains the result of every message of the transaction, in the order of the messages.
+type BestEffortTxResponse struct {
+	Results []MsgResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *BestEffortTxResponse) Reset()         { *m = BestEffortTxResponse{} }
+func (m *BestEffortTxResponse) String() string { return proto.CompactTextString(m) }
+func (*BestEffortTxResponse) ProtoMessage()    {}
+func (*BestEffortTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{6}
+}
+func (m *BestEffortTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BestEffortTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BestEffortTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BestEffortTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BestEffortTxResponse.Merge(m, src)
+}
+func (m *BestEffortTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BestEffortTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BestEffortTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BestEffortTxResponse proto.InternalMessageInfo
+
+func (m *BestEffortTxResponse) GetResults() []MsgResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ibc.applications.interchain_accounts.v1.Type", Type_name, Type_value)
+	proto.RegisterEnum("ibc.applications.interchain_accounts.v1.ExecutionMode", ExecutionMode_name, ExecutionMode_value)
 	proto.RegisterType((*InterchainAccountPacketData)(nil), "ibc.applications.interchain_accounts.v1.InterchainAccountPacketData")
 	proto.RegisterType((*CosmosTx)(nil), "ibc.applications.interchain_accounts.v1.CosmosTx")
+	proto.RegisterType((*CosmosQuery)(nil), "ibc.applications.interchain_accounts.v1.CosmosQuery")
+	proto.RegisterType((*QueryRequest)(nil), "ibc.applications.interchain_accounts.v1.QueryRequest")
+	proto.RegisterType((*CosmosQueryResponse)(nil), "ibc.applications.interchain_accounts.v1.CosmosQueryResponse")
+	proto.RegisterType((*MsgResult)(nil), "ibc.applications.interchain_accounts.v1.MsgResult")
+	proto.RegisterType((*BestEffortTxResponse)(nil), "ibc.applications.interchain_accounts.v1.BestEffortTxResponse")
 }
 
 func init() {
@@ -170,32 +496,54 @@ func init() {
 }
 
 var fileDescriptor_89a080d7401cd393 = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xc1, 0xaa, 0xd3, 0x40,
-	0x14, 0xcd, 0xf8, 0x82, 0x3c, 0xe7, 0xc9, 0x7b, 0x25, 0xbc, 0x45, 0x8c, 0x10, 0xc2, 0x13, 0x31,
-	0x08, 0x99, 0xb1, 0x55, 0x10, 0xc1, 0x4d, 0x6d, 0x23, 0x74, 0x23, 0x25, 0xa6, 0x50, 0xdd, 0x84,
-	0xc9, 0x74, 0x4c, 0x07, 0x9b, 0x4c, 0xe8, 0x4c, 0x8a, 0xf9, 0x83, 0xd2, 0x95, 0x3f, 0xd0, 0x95,
-	0x3f, 0xe3, 0xb2, 0x4b, 0x97, 0xd2, 0xfe, 0x88, 0x64, 0x82, 0x6d, 0x17, 0x2e, 0xdc, 0x1d, 0x0e,
-	0xf7, 0x9c, 0x7b, 0xcf, 0x3d, 0xf0, 0x15, 0x4f, 0x29, 0x26, 0x65, 0xb9, 0xe0, 0x94, 0x28, 0x2e,
-	0x0a, 0x89, 0x79, 0xa1, 0xd8, 0x92, 0xce, 0x09, 0x2f, 0x12, 0x42, 0xa9, 0xa8, 0x0a, 0x25, 0xf1,
-	0xaa, 0x8b, 0x4b, 0x42, 0xbf, 0x32, 0x85, 0xca, 0xa5, 0x50, 0xc2, 0x7a, 0xc6, 0x53, 0x8a, 0xce,
-	0x55, 0xe8, 0x1f, 0x2a, 0xb4, 0xea, 0x3a, 0x8f, 0x32, 0x21, 0xb2, 0x05, 0xc3, 0x5a, 0x96, 0x56,
-	0x5f, 0x30, 0x29, 0xea, 0xd6, 0xc3, 0xb9, 0xcd, 0x44, 0x26, 0x34, 0xc4, 0x0d, 0x6a, 0xd9, 0xbb,
-	0x35, 0x80, 0x8f, 0x47, 0x47, 0xaf, 0x7e, 0x6b, 0x35, 0xd6, 0xbb, 0x87, 0x44, 0x11, 0xab, 0x0f,
-	0x4d, 0x55, 0x97, 0xcc, 0x06, 0x1e, 0xf0, 0xaf, 0x7b, 0x01, 0xfa, 0xcf, 0x43, 0x50, 0x5c, 0x97,
-	0x2c, 0xd2, 0x52, 0xcb, 0x82, 0xe6, 0x8c, 0x28, 0x62, 0xdf, 0xf3, 0x80, 0xff, 0x30, 0xd2, 0xb8,
-	0xe1, 0x72, 0x96, 0x0b, 0xfb, 0xc2, 0x03, 0xfe, 0x83, 0x48, 0xe3, 0xbb, 0xb7, 0xf0, 0x72, 0x20,
-	0x64, 0x2e, 0x64, 0xfc, 0xcd, 0x7a, 0x01, 0x2f, 0x73, 0x26, 0x25, 0xc9, 0x98, 0xb4, 0x81, 0x77,
-	0xe1, 0x5f, 0xf5, 0x6e, 0x51, 0x1b, 0x0d, 0xfd, 0x8d, 0x86, 0xfa, 0x45, 0x1d, 0x1d, 0xa7, 0x9e,
-	0x4f, 0xa1, 0xd9, 0xec, 0xb4, 0x9e, 0xc2, 0x4e, 0xfc, 0x69, 0x1c, 0x26, 0x93, 0x0f, 0x1f, 0xc7,
-	0xe1, 0x60, 0xf4, 0x7e, 0x14, 0x0e, 0x3b, 0x86, 0x73, 0xb3, 0xd9, 0x7a, 0x57, 0x67, 0x94, 0xf5,
-	0x04, 0xde, 0xe8, 0xb1, 0x70, 0x1a, 0x0e, 0x26, 0x71, 0x98, 0xc4, 0xd3, 0x0e, 0x70, 0xae, 0x37,
-	0x5b, 0x0f, 0x9e, 0x18, 0xc7, 0x5c, 0xff, 0x70, 0x8d, 0x77, 0xc9, 0xcf, 0xbd, 0x0b, 0x76, 0x7b,
-	0x17, 0xfc, 0xde, 0xbb, 0xe0, 0xfb, 0xc1, 0x35, 0x76, 0x07, 0xd7, 0xf8, 0x75, 0x70, 0x8d, 0xcf,
-	0x61, 0xc6, 0xd5, 0xbc, 0x4a, 0x11, 0x15, 0x39, 0xa6, 0xfa, 0x74, 0xcc, 0x53, 0x1a, 0x64, 0x02,
-	0xaf, 0xde, 0xe0, 0x5c, 0xcc, 0xaa, 0x05, 0x93, 0x4d, 0xd9, 0x12, 0xf7, 0x5e, 0x07, 0xa7, 0x47,
-	0x05, 0xc7, 0x9e, 0x9b, 0xff, 0xc8, 0xf4, 0xbe, 0x8e, 0xf4, 0xf2, 0x4f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x67, 0x47, 0x70, 0x6e, 0x1c, 0x02, 0x00, 0x00,
+	// 750 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x51, 0x4f, 0xdb, 0x56,
+	0x14, 0x8e, 0xc1, 0xb0, 0xe4, 0x06, 0x42, 0x74, 0xc7, 0xa6, 0x60, 0xa6, 0xcc, 0xcb, 0x84, 0x96,
+	0x21, 0xc5, 0x1e, 0xd9, 0xc6, 0x34, 0x69, 0xd2, 0x94, 0x04, 0xa3, 0x45, 0x6b, 0x08, 0x5c, 0x8c,
+	0x0a, 0x95, 0x2a, 0xcb, 0x71, 0x2e, 0x8e, 0x8b, 0xed, 0xeb, 0xfa, 0x5e, 0x53, 0xf2, 0xd2, 0xa7,
+	0x3e, 0x54, 0x91, 0x2a, 0xf5, 0x0f, 0xe4, 0xa9, 0x7f, 0x86, 0x47, 0x1e, 0xfb, 0x54, 0x55, 0xf0,
+	0x47, 0x2a, 0x5f, 0xc7, 0x21, 0xad, 0xa8, 0x94, 0xb7, 0x73, 0x3f, 0x9d, 0xef, 0x3b, 0xe7, 0x7c,
+	0xe7, 0xe8, 0x82, 0x3f, 0x9c, 0x9e, 0xa5, 0x9a, 0x41, 0xe0, 0x3a, 0x96, 0xc9, 0x1c, 0xe2, 0x53,
+	0xd5, 0xf1, 0x19, 0x0e, 0xad, 0x81, 0xe9, 0xf8, 0x86, 0x69, 0x59, 0x24, 0xf2, 0x19, 0x55, 0x2f,
+	0x77, 0xd4, 0xc0, 0xb4, 0x2e, 0x30, 0x53, 0x82, 0x90, 0x30, 0x02, 0x7f, 0x71, 0x7a, 0x96, 0x32,
+	0xcb, 0x52, 0x1e, 0x60, 0x29, 0x97, 0x3b, 0xd2, 0x86, 0x4d, 0x88, 0xed, 0x62, 0x95, 0xd3, 0x7a,
+	0xd1, 0xb9, 0x6a, 0xfa, 0xc3, 0x44, 0x43, 0x5a, 0xb7, 0x89, 0x4d, 0x78, 0xa8, 0xc6, 0xd1, 0x04,
+	0xfd, 0x29, 0xee, 0xc7, 0x22, 0x21, 0x56, 0xad, 0x81, 0xe9, 0xfb, 0xd8, 0x8d, 0x6b, 0x4f, 0xc2,
+	0x24, 0xa5, 0xf2, 0x6a, 0x01, 0x6c, 0xb6, 0xa7, 0xe5, 0x1a, 0x49, 0xb5, 0x43, 0xde, 0xde, 0x9e,
+	0xc9, 0x4c, 0xd8, 0x00, 0x22, 0x1b, 0x06, 0xb8, 0x24, 0xc8, 0x42, 0xb5, 0x50, 0xaf, 0x29, 0x73,
+	0xf6, 0xaa, 0xe8, 0xc3, 0x00, 0x23, 0x4e, 0x85, 0x10, 0x88, 0x7d, 0x93, 0x99, 0xa5, 0x05, 0x59,
+	0xa8, 0xae, 0x20, 0x1e, 0xc7, 0x98, 0x87, 0x3d, 0x52, 0x5a, 0x94, 0x85, 0x6a, 0x0e, 0xf1, 0x18,
+	0x3e, 0x05, 0x05, 0x7c, 0x85, 0xad, 0x28, 0xd6, 0x35, 0x3c, 0xd2, 0xc7, 0x25, 0x91, 0x17, 0xdd,
+	0x9d, 0xbb, 0xa8, 0x96, 0xd2, 0x3b, 0xa4, 0x8f, 0xd1, 0x2a, 0x9e, 0x7d, 0xc2, 0x4d, 0x90, 0xb3,
+	0x4d, 0x6a, 0xb8, 0x8e, 0xe7, 0xb0, 0xd2, 0x92, 0x2c, 0x54, 0x45, 0x94, 0xb5, 0x4d, 0xfa, 0x28,
+	0x7e, 0x57, 0xfe, 0x01, 0xd9, 0x16, 0xa1, 0x1e, 0xa1, 0xfa, 0x15, 0xfc, 0x0d, 0x64, 0x3d, 0x4c,
+	0xa9, 0x69, 0x63, 0x5a, 0x12, 0xe4, 0xc5, 0x6a, 0xbe, 0xbe, 0xae, 0x24, 0xce, 0x2b, 0xa9, 0xf3,
+	0x4a, 0xc3, 0x1f, 0xa2, 0x69, 0x56, 0xe5, 0x8d, 0x00, 0xf2, 0x09, 0xfd, 0x28, 0xc2, 0xe1, 0x10,
+	0x3e, 0x06, 0xd9, 0x10, 0x3f, 0x8f, 0x30, 0x65, 0xa9, 0xc2, 0x9f, 0x73, 0xcf, 0xc0, 0x15, 0x50,
+	0xc2, 0x6e, 0x8a, 0xd7, 0x1f, 0x7e, 0xcc, 0xa0, 0xa9, 0x18, 0xdc, 0x02, 0x05, 0xc7, 0xb7, 0xdc,
+	0xa8, 0x8f, 0x8d, 0x01, 0x76, 0xec, 0x01, 0xe3, 0xa6, 0x66, 0xd1, 0xea, 0x04, 0xfd, 0x8f, 0x83,
+	0x95, 0x5d, 0xb0, 0x32, 0x2b, 0x13, 0xbb, 0x1d, 0x98, 0x6c, 0xc0, 0x97, 0x98, 0x43, 0x3c, 0x7e,
+	0x68, 0x2b, 0x95, 0xff, 0xc1, 0xb7, 0x33, 0x63, 0x20, 0x4c, 0x03, 0xe2, 0x53, 0x0c, 0x7f, 0x00,
+	0xb9, 0x70, 0x12, 0x27, 0xf3, 0xac, 0xa0, 0x7b, 0x00, 0x7e, 0x0f, 0x96, 0x67, 0x7a, 0x11, 0xd1,
+	0xe4, 0x55, 0x79, 0x09, 0x72, 0x1d, 0x6a, 0x23, 0x4c, 0x23, 0x97, 0xc5, 0x9e, 0xa6, 0x0c, 0xde,
+	0xc5, 0x57, 0x3d, 0x4d, 0xb3, 0xe0, 0xbf, 0x60, 0x09, 0x87, 0x21, 0x09, 0xb9, 0x6a, 0xbe, 0xfe,
+	0x2b, 0x37, 0x30, 0xbe, 0x65, 0x25, 0x3d, 0xe0, 0x78, 0xe1, 0x71, 0x46, 0xc3, 0xba, 0xf0, 0xc9,
+	0x0b, 0x17, 0xf7, 0x6d, 0xec, 0x61, 0x9f, 0xa1, 0x84, 0x57, 0x79, 0x06, 0xd6, 0x9b, 0x98, 0x32,
+	0xed, 0xfc, 0x9c, 0x84, 0x4c, 0xbf, 0x9a, 0x4e, 0x83, 0xc0, 0x37, 0x21, 0x6f, 0x2a, 0xdd, 0x4d,
+	0x7d, 0xee, 0xdd, 0x4c, 0xe7, 0x99, 0x2c, 0x26, 0x15, 0xda, 0xa6, 0x40, 0x8c, 0x0f, 0x1e, 0x6e,
+	0x81, 0xa2, 0x7e, 0x76, 0xa8, 0x19, 0x27, 0x07, 0xc7, 0x87, 0x5a, 0xab, 0xbd, 0xdf, 0xd6, 0xf6,
+	0x8a, 0x19, 0x69, 0x6d, 0x34, 0x96, 0xf3, 0x33, 0x10, 0xfc, 0x19, 0xac, 0xf1, 0x34, 0xed, 0x54,
+	0x6b, 0x9d, 0xe8, 0x9a, 0xa1, 0x9f, 0x16, 0x05, 0xa9, 0x30, 0x1a, 0xcb, 0xe0, 0x1e, 0x81, 0x1b,
+	0x00, 0xf0, 0xa4, 0xa3, 0x13, 0x0d, 0x9d, 0x15, 0x17, 0xa4, 0xdc, 0x68, 0x2c, 0x2f, 0xf1, 0x87,
+	0x24, 0xbe, 0x7e, 0x57, 0xce, 0x6c, 0x7b, 0x60, 0xf5, 0xb3, 0x83, 0x87, 0x5b, 0xe0, 0xbb, 0x84,
+	0xdf, 0xee, 0x1e, 0x18, 0x9d, 0xee, 0x9e, 0x66, 0x34, 0xf4, 0x6e, 0xa7, 0xdd, 0x2a, 0x66, 0x24,
+	0x30, 0x1a, 0xcb, 0xcb, 0xc9, 0x0b, 0xaa, 0x40, 0xfa, 0x22, 0xad, 0xa9, 0x1d, 0xeb, 0x86, 0xb6,
+	0xbf, 0xdf, 0x45, 0x7a, 0x51, 0x48, 0xda, 0x9d, 0x81, 0x92, 0x72, 0x4d, 0xe3, 0xfa, 0xb6, 0x2c,
+	0xdc, 0xdc, 0x96, 0x85, 0x8f, 0xb7, 0x65, 0xe1, 0xed, 0x5d, 0x39, 0x73, 0x73, 0x57, 0xce, 0xbc,
+	0xbf, 0x2b, 0x67, 0x9e, 0x68, 0xb6, 0xc3, 0x06, 0x51, 0x4f, 0xb1, 0x88, 0xa7, 0x5a, 0xfc, 0x7e,
+	0x54, 0xa7, 0x67, 0xd5, 0x6c, 0xa2, 0x5e, 0xfe, 0xad, 0x7a, 0xa4, 0x1f, 0xb9, 0x98, 0xc6, 0xdf,
+	0x22, 0x55, 0xeb, 0x7f, 0xd5, 0xee, 0xad, 0xad, 0x4d, 0x7f, 0xc4, 0xf8, 0x9b, 0xa0, 0xbd, 0x65,
+	0x7e, 0x09, 0xbf, 0x7f, 0x1a, 0x00, 0x97, 0xd3, 0xa0, 0xe5, 0x46, 0x05, 0x00, 0x00,
 }
 
 func (m *InterchainAccountPacketData) Marshal() (dAtA []byte, err error) {
@@ -218,6 +566,16 @@ func (m *InterchainAccountPacketData) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExecutionMode != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ExecutionMode))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Memo) > 0 {
 		i -= len(m.Memo)
 		copy(dAtA[i:], m.Memo)
@@ -277,63 +635,720 @@ func (m *CosmosTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPacket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CosmosQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *InterchainAccountPacketData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovPacket(uint64(m.Type))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
-	}
-	l = len(m.Memo)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
-	}
-	return n
+
+func (m *CosmosQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CosmosTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CosmosQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 1 + l + sovPacket(uint64(l))
+	if m.IncludeHeight {
+		i--
+		if m.IncludeHeight {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovPacket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozPacket(x uint64) (n int) {
-	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+
+func (m *QueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CosmosQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CosmosQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CosmosQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Responses[iNdEx])
+			copy(dAtA[i:], m.Responses[iNdEx])
+			i = encodeVarintPacket(dAtA, i, uint64(len(m.Responses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BestEffortTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BestEffortTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BestEffortTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InterchainAccountPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPacket(uint64(m.Type))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.ExecutionMode != 0 {
+		n += 1 + sovPacket(uint64(m.ExecutionMode))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovPacket(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *CosmosTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CosmosQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	if m.IncludeHeight {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *CosmosQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for _, b := range m.Responses {
+			l = len(b)
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovPacket(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MsgResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *BestEffortTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainAccountPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainAccountPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionMode", wireType)
+			}
+			m.ExecutionMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionMode |= ExecutionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CosmosTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosmosTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosmosTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CosmosQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosmosQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosmosQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, QueryRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeHeight", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeHeight = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -352,17 +1367,17 @@ func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InterchainAccountPacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterchainAccountPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -372,11 +1387,24 @@ func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= Type(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
@@ -411,11 +1439,61 @@ func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CosmosQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosmosQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosmosQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -425,23 +1503,164 @@ func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPacket
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPacket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
+			m.Responses = append(m.Responses, make([]byte, postIndex-iNdEx))
+			copy(m.Responses[len(m.Responses)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &types.Any{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &types1.ErrorAcknowledgement{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -464,7 +1683,7 @@ func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CosmosTx) Unmarshal(dAtA []byte) error {
+func (m *BestEffortTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -487,15 +1706,15 @@ func (m *CosmosTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CosmosTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: BestEffortTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CosmosTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BestEffortTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -522,8 +1741,8 @@ func (m *CosmosTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, &types.Any{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, MsgResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1,9 +1,12 @@
 package types_test
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 )
 
@@ -30,6 +33,15 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 			},
 			true,
 		},
+		{
+			"success, best-effort execution mode",
+			types.InterchainAccountPacketData{
+				Type:          types.EXECUTE_TX,
+				Data:          []byte("data"),
+				ExecutionMode: types.BEST_EFFORT,
+			},
+			true,
+		},
 		{
 			"type unspecified",
 			types.InterchainAccountPacketData{
@@ -57,6 +69,15 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 			},
 			false,
 		},
+		{
+			"invalid execution mode",
+			types.InterchainAccountPacketData{
+				Type:          types.EXECUTE_TX,
+				Data:          []byte("data"),
+				ExecutionMode: types.ExecutionMode(2),
+			},
+			false,
+		},
 		{
 			"memo too large",
 			types.InterchainAccountPacketData{
@@ -84,6 +105,60 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 	}
 }
 
+func (suite *TypesTestSuite) TestPacketDataGetBytes() {
+	testCases := []struct {
+		name          string
+		executionMode types.ExecutionMode
+		gasLimit      uint64
+		expBytes      string
+	}{
+		{
+			"atomic execution mode and zero gas limit are omitted",
+			types.ATOMIC,
+			0,
+			`{"type":"TYPE_EXECUTE_TX","data":"ZGF0YQ==","memo":"memo"}`,
+		},
+		{
+			"best-effort execution mode is included",
+			types.BEST_EFFORT,
+			0,
+			`{"type":"TYPE_EXECUTE_TX","data":"ZGF0YQ==","memo":"memo","execution_mode":"EXECUTION_MODE_BEST_EFFORT"}`,
+		},
+		{
+			"gas limit is included",
+			types.ATOMIC,
+			100000,
+			`{"type":"TYPE_EXECUTE_TX","data":"ZGF0YQ==","memo":"memo","execution_mode":"EXECUTION_MODE_ATOMIC","gas_limit":"100000"}`,
+		},
+		{
+			"best-effort execution mode and gas limit are included",
+			types.BEST_EFFORT,
+			100000,
+			`{"type":"TYPE_EXECUTE_TX","data":"ZGF0YQ==","memo":"memo","execution_mode":"EXECUTION_MODE_BEST_EFFORT","gas_limit":"100000"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			packetData := types.InterchainAccountPacketData{
+				Type:          types.EXECUTE_TX,
+				Data:          []byte("data"),
+				Memo:          "memo",
+				ExecutionMode: tc.executionMode,
+				GasLimit:      tc.gasLimit,
+			}
+
+			bz := packetData.GetBytes()
+			suite.Require().Equal(tc.expBytes, string(bz))
+
+			var decoded types.InterchainAccountPacketData
+			suite.Require().NoError(decoded.UnmarshalJSON(bz))
+			suite.Require().Equal(packetData, decoded)
+		})
+	}
+}
+
 func (suite *TypesTestSuite) TestGetPacketSender() {
 	testCases := []struct {
 		name      string
@@ -203,3 +278,55 @@ func (suite *TypesTestSuite) TestPacketDataUnmarshalerInterface() {
 	suite.Require().Error(err)
 	suite.Require().Equal(types.InterchainAccountPacketData{}, invalidPacketData)
 }
+
+func (suite *TypesTestSuite) TestDecodeQueryAcknowledgement() {
+	expResponse := types.CosmosQueryResponse{
+		Responses: [][]byte{[]byte("response")},
+		Height:    10,
+	}
+
+	bz, err := expResponse.Marshal()
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name   string
+		ack    []byte
+		expErr error
+	}{
+		{
+			"success",
+			channeltypes.NewResultAcknowledgement(bz).Acknowledgement(),
+			nil,
+		},
+		{
+			"failure: error acknowledgement",
+			channeltypes.NewErrorAcknowledgement(errors.New("query failed")).Acknowledgement(),
+			channeltypes.ErrInvalidAcknowledgement,
+		},
+		{
+			"failure: invalid acknowledgement",
+			[]byte("invalid acknowledgement"),
+			ibcerrors.ErrInvalidType,
+		},
+		{
+			"failure: invalid query response",
+			channeltypes.NewResultAcknowledgement([]byte("invalid response")).Acknowledgement(),
+			ibcerrors.ErrInvalidType,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			response, err := types.DecodeQueryAcknowledgement(tc.ack)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResponse, response)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
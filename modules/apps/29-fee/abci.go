@@ -0,0 +1,13 @@
+package fee
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/29-fee/keeper"
+)
+
+// BeginBlocker is used to advance the relayer reward epoch with the block height and to prune the per-epoch relayer
+// rewards which are no longer retained
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.AdvanceRewardEpoch(ctx)
+}
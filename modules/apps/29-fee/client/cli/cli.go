@@ -26,6 +26,17 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdCounterpartyPayee(),
 		GetCmdFeeEnabledChannel(),
 		GetCmdFeeEnabledChannels(),
+		GetCmdFeePreferences(),
+		GetCmdFeePreferencesForChannel(),
+		GetCmdChannelMinimumFee(),
+		GetCmdIncentivizedPacketsForPayer(),
+		GetCmdParams(),
+		GetCmdRelayerRewards(),
+		GetCmdAllRelayerRewards(),
+		GetCmdEpochRelayerRewards(),
+		GetCmdCurrentRewardEpoch(),
+		GetCmdFeeSponsorPool(),
+		GetCmdFeeSponsorPoolsForChannel(),
 	)
 
 	return queryCmd
@@ -45,6 +56,13 @@ func NewTxCmd() *cobra.Command {
 		NewRegisterPayeeCmd(),
 		NewRegisterCounterpartyPayeeCmd(),
 		NewPayPacketFeeAsyncTxCmd(),
+		NewRegisterFeePreferencesCmd(),
+		NewRefundPacketFeeTxCmd(),
+		NewRefundSponsoredPacketFeeTxCmd(),
+		NewRegisterFeeSponsorPoolCmd(),
+		NewFundFeeSponsorPoolCmd(),
+		NewWithdrawFeeSponsorPoolCmd(),
+		NewDeleteFeeSponsorPoolCmd(),
 	)
 
 	return txCmd
@@ -437,3 +437,433 @@ func GetCmdIncentivizedPacketsForChannel() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdFeePreferences returns the command handler for the Query/FeePreferences rpc.
+func GetCmdFeePreferences() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-preferences [port-id] [channel-id] [payee]",
+		Short:   "Query the fee preferences registered by a payee on a given channel",
+		Long:    "Query the fee denominations accepted by a payee and its fallback address on a given channel",
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s query ibc-fee fee-preferences transfer channel-5 cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[2]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryFeePreferencesRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Payee:     args[2],
+			}
+
+			res, err := queryClient.FeePreferences(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdFeePreferencesForChannel returns the command handler for the Query/FeePreferencesForChannel rpc.
+func GetCmdFeePreferencesForChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-preferences-for-channel [port-id] [channel-id]",
+		Short:   "Query the fee preferences registered by all payees on a given channel",
+		Long:    "Query the fee denominations accepted by all payees and their fallback addresses on a given channel",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc-fee fee-preferences-for-channel transfer channel-5", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFeePreferencesForChannelRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeePreferencesForChannel(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "fee-preferences-for-channel")
+
+	return cmd
+}
+
+// GetCmdChannelMinimumFee returns the command handler for the Query/ChannelMinimumFee rpc.
+func GetCmdChannelMinimumFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-minimum-fee [port-id] [channel-id]",
+		Short:   "Query the minimum fee required to incentivize a packet on a channel",
+		Long:    "Query the minimum recv, ack and timeout fees required to incentivize a packet on a channel. Empty fees impose no minimum.",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc-fee channel-minimum-fee transfer channel-6", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryChannelMinimumFeeRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChannelMinimumFee(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdIncentivizedPacketsForPayer returns all of the unrelayed incentivized packets for which a payer has escrowed fees
+func GetCmdIncentivizedPacketsForPayer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packets-for-payer [payer]",
+		Short:   "Query for all of the unrelayed incentivized packets for which a payer has escrowed fees",
+		Long:    "Query for all of the unrelayed incentivized packets across all channels for which a payer has escrowed fees. Only the fees escrowed by the payer are returned.",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query ibc-fee packets-for-payer cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryIncentivizedPacketsForPayerRequest{
+				Payer:      args[0],
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IncentivizedPacketsForPayer(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "packets-for-payer")
+
+	return cmd
+}
+
+// GetCmdParams returns the command handler for the fee middleware parameter querying
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current fee middleware parameters",
+		Long:    "Query the current fee middleware parameters",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee params", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRelayerRewards returns the command handler for the lifetime relayer rewards of a payee query
+func GetCmdRelayerRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "relayer-rewards [payee]",
+		Short:   "Query the lifetime rewards paid out to a payee address",
+		Long:    "Query the lifetime rewards paid out to a payee address on each channel, broken down into recv, ack and timeout rewards",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query ibc-fee relayer-rewards cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRelayerRewardsRequest{
+				Payee:      args[0],
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RelayerRewards(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "relayer-rewards")
+
+	return cmd
+}
+
+// GetCmdAllRelayerRewards returns the command handler for the lifetime relayer rewards of all payees query
+func GetCmdAllRelayerRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "all-relayer-rewards",
+		Short:   "Query the lifetime rewards paid out to all payee addresses",
+		Long:    "Query the lifetime rewards paid out to all payee addresses on each channel, broken down into recv, ack and timeout rewards",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee all-relayer-rewards", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAllRelayerRewardsRequest{
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllRelayerRewards(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all-relayer-rewards")
+
+	return cmd
+}
+
+// GetCmdEpochRelayerRewards returns the command handler for the relayer rewards of a reward epoch query
+func GetCmdEpochRelayerRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "epoch-relayer-rewards [epoch]",
+		Short:   "Query the rewards paid out to payee addresses during a reward epoch",
+		Long:    "Query the rewards paid out to payee addresses on each channel during a reward epoch, optionally restricted to a single payee address",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query ibc-fee epoch-relayer-rewards 5 --%s cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh", version.AppName, flagPayee),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epoch, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			payee, err := cmd.Flags().GetString(flagPayee)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryEpochRelayerRewardsRequest{
+				Epoch:      epoch,
+				Payee:      payee,
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EpochRelayerRewards(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagPayee, "", "Restrict the results to a single payee address")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "epoch-relayer-rewards")
+
+	return cmd
+}
+
+// GetCmdCurrentRewardEpoch returns the command handler for the current relayer reward epoch query
+func GetCmdCurrentRewardEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "current-reward-epoch",
+		Short:   "Query the current relayer reward epoch",
+		Long:    "Query the current relayer reward epoch number and the block height at which it started",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee current-reward-epoch", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CurrentRewardEpoch(cmd.Context(), &types.QueryCurrentRewardEpochRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.RewardEpoch)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdFeeSponsorPool returns the command handler for the fee sponsor pool query
+func GetCmdFeeSponsorPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-sponsor-pool [port-id] [channel-id] [sponsor]",
+		Short:   "Query the fee sponsor pool of a sponsor on a given channel",
+		Long:    "Query the senders allowed to pay packet fees from the fee sponsor pool of a sponsor on a given channel, along with the address and the balance of the pool",
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s query ibc-fee fee-sponsor-pool transfer channel-5 cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFeeSponsorPoolRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sponsor:   args[2],
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeSponsorPool(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdFeeSponsorPoolsForChannel returns the command handler for the fee sponsor pools for channel query
+func GetCmdFeeSponsorPoolsForChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-sponsor-pools-for-channel [port-id] [channel-id]",
+		Short:   "Query the fee sponsor pools of all sponsors on a given channel",
+		Long:    "Query the fee sponsor pools of all sponsors on a given channel and the senders allowed to pay packet fees from them",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc-fee fee-sponsor-pools-for-channel transfer channel-5", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFeeSponsorPoolsForChannelRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeSponsorPoolsForChannel(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "fee-sponsor-pools-for-channel")
+
+	return cmd
+}
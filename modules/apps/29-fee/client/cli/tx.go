@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -21,6 +22,13 @@ const (
 	flagRecvFee    = "recv-fee"
 	flagAckFee     = "ack-fee"
 	flagTimeoutFee = "timeout-fee"
+
+	flagFallbackAddress = "fallback-address"
+	flagPayee           = "payee"
+
+	flagFeeSponsor = "fee-sponsor"
+	flagSpendLimit = "spend-limit"
+	flagExpiration = "expiration"
 )
 
 // NewRegisterPayeeCmd returns the command to create a MsgRegisterPayee
@@ -73,6 +81,43 @@ func NewRegisterCounterpartyPayeeCmd() *cobra.Command {
 	return cmd
 }
 
+// NewRegisterFeePreferencesCmd returns the command to create a MsgRegisterFeePreferences
+func NewRegisterFeePreferencesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-fee-preferences [port-id] [channel-id] [accepted-denoms]",
+		Short: "Register the fee denominations accepted by the payee on a given channel.",
+		Long: strings.TrimSpace(`Register the comma-separated fee denominations accepted by the payee, the sender of the transaction, on a given channel.
+Packet fees in other denominations are sent to the fallback address, if any, or refunded. The fee preferences are removed if no fee denominations are provided.`),
+		Example: fmt.Sprintf("%s tx ibc-fee register-fee-preferences transfer channel-0 stake,uatom --fallback-address cosmos153lf4zntqt33a4v0sm5cytrxyqn78q7kz8j8x5", version.AppName),
+		Args:    cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var acceptedDenoms []string
+			if len(args) == 3 {
+				acceptedDenoms = strings.Split(args[2], ",")
+			}
+
+			fallbackAddr, err := cmd.Flags().GetString(flagFallbackAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterFeePreferences(args[0], args[1], clientCtx.GetFromAddress().String(), acceptedDenoms, fallbackAddr)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagFallbackAddress, "", "Address to which the packet fees in denominations not accepted are sent")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewPayPacketFeeAsyncTxCmd returns the command to create a MsgPayPacketFeeAsync
 func NewPayPacketFeeAsyncTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -137,6 +182,11 @@ func NewPayPacketFeeAsyncTxCmd() *cobra.Command {
 			packetFee := types.NewPacketFee(fee, sender, relayers)
 			msg := types.NewMsgPayPacketFeeAsync(packetID, packetFee)
 
+			msg.FeeSponsor, err = cmd.Flags().GetString(flagFeeSponsor)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -144,6 +194,213 @@ func NewPayPacketFeeAsyncTxCmd() *cobra.Command {
 	cmd.Flags().String(flagRecvFee, "", "Fee paid to a relayer for relaying a packet receive.")
 	cmd.Flags().String(flagAckFee, "", "Fee paid to a relayer for relaying a packet acknowledgement.")
 	cmd.Flags().String(flagTimeoutFee, "", "Fee paid to a relayer for relaying a packet timeout.")
+	cmd.Flags().String(flagFeeSponsor, "", "Address of the fee sponsor whose pool on the channel pays the fee on behalf of the sender.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRefundPacketFeeTxCmd returns the command to refund the fees escrowed by the sender for an existing IBC packet
+func NewRefundPacketFeeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "refund-packet-fee [src-port] [src-channel] [sequence]",
+		Short:   "Refund the fees escrowed by the sender for an existing IBC packet",
+		Long:    strings.TrimSpace(`Refund all the fees escrowed by the sender for an existing IBC packet, once the refund grace period has elapsed since the sender last escrowed a fee for the packet.`),
+		Example: fmt.Sprintf("%s tx ibc-fee refund-packet-fee transfer channel-0 1", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			packetID := channeltypes.NewPacketID(args[0], args[1], seq)
+			msg := types.NewMsgRefundPacketFee(packetID, clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRefundSponsoredPacketFeeTxCmd returns the command to refund the fees escrowed from the fee sponsor pool of the sender for an existing IBC packet
+func NewRefundSponsoredPacketFeeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "refund-sponsored-packet-fee [src-port] [src-channel] [sequence]",
+		Short:   "Refund the fees escrowed from the fee sponsor pool of the sender for an existing IBC packet",
+		Long:    strings.TrimSpace(`Refund all the fees escrowed from the fee sponsor pool of the sponsor, the sender of the transaction, for an existing IBC packet to the pool, once the refund grace period has elapsed since a fee was last escrowed from the pool for the packet.`),
+		Example: fmt.Sprintf("%s tx ibc-fee refund-sponsored-packet-fee transfer channel-0 1", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			packetID := channeltypes.NewPacketID(args[0], args[1], seq)
+			msg := types.NewMsgRefundSponsoredPacketFee(packetID, clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRegisterFeeSponsorPoolCmd returns the command to create a MsgRegisterFeeSponsorPool
+func NewRegisterFeeSponsorPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-fee-sponsor-pool [port-id] [channel-id] [allowed-senders]",
+		Short: "Register a fee sponsor pool on a given channel.",
+		Long: strings.TrimSpace(`Register the fee sponsor pool of the sponsor, the sender of the transaction, on a given channel.
+The comma-separated allowed senders may pay packet fees on the channel from the pool funds, each up to the optional spend limit and until the optional expiration time (RFC3339).
+Registering the pool again replaces its allowances.`),
+		Example: fmt.Sprintf("%s tx ibc-fee register-fee-sponsor-pool transfer channel-0 cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh,cosmos153lf4zntqt33a4v0sm5cytrxyqn78q7kz8j8x5 --spend-limit 1000stake --expiration 2025-01-01T00:00:00Z", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spendLimitStr, err := cmd.Flags().GetString(flagSpendLimit)
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := sdk.ParseCoinsNormalized(spendLimitStr)
+			if err != nil {
+				return err
+			}
+
+			expirationStr, err := cmd.Flags().GetString(flagExpiration)
+			if err != nil {
+				return err
+			}
+
+			var expiration *time.Time
+			if expirationStr != "" {
+				expirationTime, err := time.Parse(time.RFC3339, expirationStr)
+				if err != nil {
+					return err
+				}
+
+				expiration = &expirationTime
+			}
+
+			var allowances []types.FeeSponsorAllowance
+			for _, sender := range strings.Split(args[2], ",") {
+				allowances = append(allowances, types.NewFeeSponsorAllowance(sender, spendLimit, expiration))
+			}
+
+			msg := types.NewMsgRegisterFeeSponsorPool(args[0], args[1], clientCtx.GetFromAddress().String(), allowances)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagSpendLimit, "", "Amount each allowed sender may draw from the pool, not limited if empty")
+	cmd.Flags().String(flagExpiration, "", "Time (RFC3339) after which the allowed senders may no longer draw from the pool")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewFundFeeSponsorPoolCmd returns the command to create a MsgFundFeeSponsorPool
+func NewFundFeeSponsorPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fund-fee-sponsor-pool [port-id] [channel-id] [sponsor] [amount]",
+		Short:   "Deposit funds into a fee sponsor pool on a given channel.",
+		Long:    strings.TrimSpace(`Deposit funds from the sender of the transaction into the fee sponsor pool of a sponsor on a given channel.`),
+		Example: fmt.Sprintf("%s tx ibc-fee fund-fee-sponsor-pool transfer channel-0 cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh 1000stake", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundFeeSponsorPool(args[0], args[1], args[2], clientCtx.GetFromAddress().String(), amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewWithdrawFeeSponsorPoolCmd returns the command to create a MsgWithdrawFeeSponsorPool
+func NewWithdrawFeeSponsorPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdraw-fee-sponsor-pool [port-id] [channel-id] [recipient] [amount]",
+		Short:   "Withdraw funds from a fee sponsor pool on a given channel.",
+		Long:    strings.TrimSpace(`Withdraw funds from the fee sponsor pool of the sponsor, the sender of the transaction, on a given channel to the recipient address.`),
+		Example: fmt.Sprintf("%s tx ibc-fee withdraw-fee-sponsor-pool transfer channel-0 cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh 1000stake", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawFeeSponsorPool(args[0], args[1], clientCtx.GetFromAddress().String(), args[2], amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewDeleteFeeSponsorPoolCmd returns the command to create a MsgDeleteFeeSponsorPool
+func NewDeleteFeeSponsorPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete-fee-sponsor-pool [port-id] [channel-id] [recipient]",
+		Short:   "Delete a fee sponsor pool on a given channel.",
+		Long:    strings.TrimSpace(`Delete the fee sponsor pool of the sponsor, the sender of the transaction, on a given channel and send the remaining pool funds to the recipient address.`),
+		Example: fmt.Sprintf("%s tx ibc-fee delete-fee-sponsor-pool transfer channel-0 cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteFeeSponsorPool(args[0], args[1], clientCtx.GetFromAddress().String(), args[2])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -26,6 +26,12 @@ func (k Keeper) escrowPacketFee(ctx context.Context, packetID channeltypes.Packe
 		return errorsmod.Wrapf(types.ErrRefundAccNotFound, "account with address: %s not found", packetFee.RefundAddress)
 	}
 
+	if minimumFee, found := k.GetChannelMinimumFee(ctx, packetID.PortId, packetID.ChannelId); found {
+		if err := packetFee.Fee.ValidateMinimum(minimumFee); err != nil {
+			return errorsmod.Wrapf(err, "port ID (%s) channel ID (%s)", packetID.PortId, packetID.ChannelId)
+		}
+	}
+
 	coins := packetFee.Fee.Total()
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, refundAddr, types.ModuleName, coins); err != nil {
 		return err
@@ -41,7 +47,123 @@ func (k Keeper) escrowPacketFee(ctx context.Context, packetID channeltypes.Packe
 	packetFees := types.NewPacketFees(fees)
 	k.SetFeesInEscrow(ctx, packetID, packetFees)
 
+	// the refund grace period of the payer starts from its most recently escrowed fee
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/7223
+	k.SetFeeEscrowTime(ctx, packetID, packetFee.RefundAddress, sdkCtx.BlockTime())
+
+	emitIncentivizedPacketEvent(ctx, packetID, packetFees)
+
+	return nil
+}
+
+// drawFeeSponsorPool draws the fee from the allowance of the sender in the fee sponsor pool of the sponsor on the given
+// port and channel, and returns the address of the pool from which the fee is escrowed and to which it is refunded.
+// The allowance is removed from the pool once its spend limit is used up.
+func (k Keeper) drawFeeSponsorPool(ctx context.Context, portID, channelID, sponsorAddr, senderAddr string, fee sdk.Coins) (sdk.AccAddress, error) {
+	feeSponsorPool, found := k.GetFeeSponsorPool(ctx, portID, channelID, sponsorAddr)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrFeeSponsorPoolNotFound, "sponsor (%s) port ID (%s) channel ID (%s)", sponsorAddr, portID, channelID)
+	}
+
+	allowance, found := feeSponsorPool.GetAllowance(senderAddr)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrSenderNotAllowed, "sender (%s) sponsor (%s)", senderAddr, sponsorAddr)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/7223
+	remove, err := allowance.Accept(sdkCtx.BlockTime(), fee)
+	if err != nil {
+		return nil, err
+	}
+
+	if remove {
+		feeSponsorPool.RemoveAllowance(senderAddr)
+	} else {
+		feeSponsorPool.SetAllowance(allowance)
+	}
+
+	k.SetFeeSponsorPool(ctx, feeSponsorPool)
+
+	return types.GetFeeSponsorPoolAddress(portID, channelID, sponsorAddr), nil
+}
+
+// refundPacketFeeAfterGracePeriod refunds the fees escrowed by the refund address for the given packetID once the
+// refund grace period has elapsed since the refund address last escrowed a fee for the packet.
+func (k Keeper) refundPacketFeeAfterGracePeriod(ctx context.Context, packetID channeltypes.PacketId, refundAddr sdk.AccAddress) error {
+	if k.IsLocked(ctx) {
+		return types.ErrFeeModuleLocked
+	}
+
+	params := k.GetParams(ctx)
+	if !params.IsRefundEnabled() {
+		return errorsmod.Wrap(types.ErrUnsupportedAction, "packet fee refunds are disabled")
+	}
+
+	// fees escrowed before escrow times were recorded have no escrow time, so the grace period cannot be enforced
+	escrowTime, found := k.GetFeeEscrowTime(ctx, packetID, refundAddr.String())
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnsupportedAction, "packet fee escrowed by %s has no escrow time and cannot be refunded", refundAddr)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/7223
+	refundTime := escrowTime.Add(params.RefundGracePeriod)
+	if sdkCtx.BlockTime().Before(refundTime) {
+		return errorsmod.Wrapf(types.ErrRefundGracePeriodNotElapsed, "packet fee may be refunded from %s", refundTime)
+	}
+
+	return k.refundPacketFee(ctx, packetID, refundAddr)
+}
+
+// refundPacketFee refunds all the fees escrowed by the refund address for the given packetID. The fees escrowed by
+// other payers are kept in escrow. If the escrow account has insufficient balance the fee module is locked and no
+// refund is performed.
+func (k Keeper) refundPacketFee(ctx context.Context, packetID channeltypes.PacketId, refundAddr sdk.AccAddress) error {
+	feesInEscrow, found := k.GetFeesInEscrow(ctx, packetID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrFeeNotFound, "channel: %s, port: %s, sequence: %d", packetID.ChannelId, packetID.PortId, packetID.Sequence)
+	}
+
+	var (
+		refundCoins   sdk.Coins
+		remainingFees []types.PacketFee
+	)
+
+	for _, packetFee := range feesInEscrow.PacketFees {
+		if packetFee.RefundAddress == refundAddr.String() {
+			refundCoins = refundCoins.Add(packetFee.Fee.Total()...)
+		} else {
+			remainingFees = append(remainingFees, packetFee)
+		}
+	}
+
+	if refundCoins.IsZero() {
+		return errorsmod.Wrapf(types.ErrFeeNotFound, "no fees escrowed by %s for packet with sequence %d on channel %s", refundAddr, packetID.Sequence, packetID.ChannelId)
+	}
+
+	if !k.EscrowAccountHasBalance(ctx, refundCoins) {
+		// if the escrow account does not have sufficient funds then there must exist a severe bug
+		// the fee module should be locked until manual intervention fixes the issue
+		// NOTE: a nil error is returned by the caller so that the state changes from locking the fee module are persisted
+		k.lockFeeModule(ctx)
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, refundAddr, refundCoins); err != nil {
+		return err
+	}
+
+	packetFees := types.NewPacketFees(remainingFees)
+	if len(remainingFees) > 0 {
+		k.SetFeesInEscrow(ctx, packetID, packetFees)
+		k.deletePayerFeesInEscrow(ctx, refundAddr.String(), packetID)
+		k.DeleteFeeEscrowTime(ctx, packetID, refundAddr.String())
+	} else {
+		k.DeleteFeesInEscrow(ctx, packetID)
+	}
+
+	// the incentivized packet event reports the fees which remain escrowed for the packet
 	emitIncentivizedPacketEvent(ctx, packetID, packetFees)
+	emitRefundPacketFeeEvent(ctx, packetID, refundAddr.String(), refundCoins)
 
 	return nil
 }
@@ -74,7 +196,7 @@ func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx context.Context, forwa
 			panic(fmt.Errorf("could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
 		}
 
-		k.distributePacketFeeOnAcknowledgement(cacheCtx, refundAddr, forwardAddr, reverseRelayer, packetFee)
+		k.distributePacketFeeOnAcknowledgement(cacheCtx, packetID.PortId, packetID.ChannelId, refundAddr, forwardAddr, reverseRelayer, packetFee)
 	}
 
 	// write the cache
@@ -86,18 +208,20 @@ func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx context.Context, forwa
 
 // distributePacketFeeOnAcknowledgement pays the receive fee for a given packetID while refunding the timeout fee to the refund account associated with the Fee.
 // If there was no forward relayer or the associated forward relayer address is blocked, the receive fee is refunded.
-func (k Keeper) distributePacketFeeOnAcknowledgement(ctx context.Context, refundAddr, forwardRelayer, reverseRelayer sdk.AccAddress, packetFee types.PacketFee) {
+func (k Keeper) distributePacketFeeOnAcknowledgement(ctx context.Context, portID, channelID string, refundAddr, forwardRelayer, reverseRelayer sdk.AccAddress, packetFee types.PacketFee) {
 	// distribute fee to valid forward relayer address otherwise refund the fee
 	if !forwardRelayer.Empty() && !k.bankKeeper.BlockedAddr(forwardRelayer) {
 		// distribute fee for forward relaying
-		k.distributeFee(ctx, forwardRelayer, refundAddr, packetFee.Fee.RecvFee)
+		recvRewards := k.distributeRelayerFee(ctx, portID, channelID, forwardRelayer, refundAddr, packetFee.Fee.RecvFee)
+		k.addRelayerRewards(ctx, channelID, forwardRelayer, types.NewFee(recvRewards, nil, nil))
 	} else {
 		// refund onRecv fee as forward relayer is not valid address
 		k.distributeFee(ctx, refundAddr, refundAddr, packetFee.Fee.RecvFee)
 	}
 
 	// distribute fee for reverse relaying
-	k.distributeFee(ctx, reverseRelayer, refundAddr, packetFee.Fee.AckFee)
+	ackRewards := k.distributeRelayerFee(ctx, portID, channelID, reverseRelayer, refundAddr, packetFee.Fee.AckFee)
+	k.addRelayerRewards(ctx, channelID, reverseRelayer, types.NewFee(nil, ackRewards, nil))
 
 	// refund unused amount from the escrowed fee
 	refundCoins := packetFee.Fee.Total().Sub(packetFee.Fee.RecvFee...).Sub(packetFee.Fee.AckFee...)
@@ -129,7 +253,7 @@ func (k Keeper) DistributePacketFeesOnTimeout(ctx context.Context, timeoutRelaye
 			panic(fmt.Errorf("could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
 		}
 
-		k.distributePacketFeeOnTimeout(cacheCtx, refundAddr, timeoutRelayer, packetFee)
+		k.distributePacketFeeOnTimeout(cacheCtx, packetID.PortId, packetID.ChannelId, refundAddr, timeoutRelayer, packetFee)
 	}
 
 	// write the cache
@@ -140,19 +264,74 @@ func (k Keeper) DistributePacketFeesOnTimeout(ctx context.Context, timeoutRelaye
 }
 
 // distributePacketFeeOnTimeout pays the timeout fee to the timeout relayer and refunds the acknowledgement & receive fee.
-func (k Keeper) distributePacketFeeOnTimeout(ctx context.Context, refundAddr, timeoutRelayer sdk.AccAddress, packetFee types.PacketFee) {
+func (k Keeper) distributePacketFeeOnTimeout(ctx context.Context, portID, channelID string, refundAddr, timeoutRelayer sdk.AccAddress, packetFee types.PacketFee) {
 	// distribute fee for timeout relaying
-	k.distributeFee(ctx, timeoutRelayer, refundAddr, packetFee.Fee.TimeoutFee)
+	timeoutRewards := k.distributeRelayerFee(ctx, portID, channelID, timeoutRelayer, refundAddr, packetFee.Fee.TimeoutFee)
+	k.addRelayerRewards(ctx, channelID, timeoutRelayer, types.NewFee(nil, nil, timeoutRewards))
 
 	// refund unused amount from the escrowed fee
 	refundCoins := packetFee.Fee.Total().Sub(packetFee.Fee.TimeoutFee...)
 	k.distributeFee(ctx, refundAddr, refundAddr, refundCoins)
 }
 
+// distributeRelayerFee distributes the fee earned by a relayer to its payee address, according to the fee preferences
+// registered by the payee for the port and channel, if any. The coins with a denomination not accepted by the payee are sent to
+// its fallback address, if any, otherwise they are refunded. Only the coins paid out to the payee address are returned,
+// the coins sent to the fallback address are not rewards of the payee.
+func (k Keeper) distributeRelayerFee(ctx context.Context, portID, channelID string, payee, refundAccAddress sdk.AccAddress, fee sdk.Coins) sdk.Coins {
+	feePreferences, found := k.GetFeePreferences(ctx, portID, channelID, payee.String())
+	if !found {
+		if k.distributeFee(ctx, payee, refundAccAddress, fee) {
+			return fee
+		}
+
+		return nil
+	}
+
+	var distributed sdk.Coins
+
+	accepted, notAccepted := feePreferences.SplitFee(fee)
+	if !accepted.IsZero() && k.distributeFee(ctx, payee, refundAccAddress, accepted) {
+		distributed = accepted
+	}
+
+	if !notAccepted.IsZero() {
+		receiver := refundAccAddress
+		if feePreferences.FallbackAddress != "" {
+			receiver = sdk.MustAccAddressFromBech32(feePreferences.FallbackAddress)
+		}
+
+		k.distributeFee(ctx, receiver, refundAccAddress, notAccepted)
+	}
+
+	return distributed
+}
+
+// addRelayerRewards adds the fees paid out to a payee on a channel to its lifetime rewards and, if per-epoch relayer
+// reward accounting is enabled, to its rewards of the current epoch.
+func (k Keeper) addRelayerRewards(ctx context.Context, channelID string, payee sdk.AccAddress, rewards types.Fee) {
+	if rewards.IsEmpty() {
+		return
+	}
+
+	lifetimeRewards, _ := k.GetRelayerRewards(ctx, payee.String(), channelID)
+	k.SetRelayerRewards(ctx, types.NewRelayerRewards(payee.String(), channelID, lifetimeRewards.Add(rewards)))
+
+	if !k.GetParams(ctx).IsRewardEpochEnabled() {
+		return
+	}
+
+	// the stored epoch is advanced in case per-epoch relayer reward accounting was enabled during the current block
+	rewardEpoch := k.AdvanceRewardEpoch(ctx)
+
+	epochRewards, _ := k.GetEpochRelayerRewards(ctx, rewardEpoch.Number, payee.String(), channelID)
+	k.SetEpochRelayerRewards(ctx, types.NewEpochRelayerRewards(rewardEpoch.Number, types.NewRelayerRewards(payee.String(), channelID, epochRewards.Add(rewards))))
+}
+
 // distributeFee will attempt to distribute the escrowed fee to the receiver address.
 // If the distribution fails for any reason (such as the receiving address being blocked),
-// the state changes will be discarded.
-func (k Keeper) distributeFee(ctx context.Context, receiver, refundAccAddress sdk.AccAddress, fee sdk.Coins) {
+// the state changes will be discarded. It returns true if the fee was distributed to the receiver.
+func (k Keeper) distributeFee(ctx context.Context, receiver, refundAccAddress sdk.AccAddress, fee sdk.Coins) bool {
 	// cache context before trying to distribute fees
 	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/7223
 	cacheCtx, writeFn := sdkCtx.CacheContext()
@@ -161,7 +340,7 @@ func (k Keeper) distributeFee(ctx context.Context, receiver, refundAccAddress sd
 	if err != nil {
 		if bytes.Equal(receiver, refundAccAddress) {
 			k.Logger(ctx).Error("error distributing fee", "receiver address", receiver, "fee", fee)
-			return // if sending to the refund address already failed, then return (no-op)
+			return false // if sending to the refund address already failed, then return (no-op)
 		}
 
 		// if an error is returned from x/bank and the receiver is not the refundAccAddress
@@ -169,7 +348,7 @@ func (k Keeper) distributeFee(ctx context.Context, receiver, refundAccAddress sd
 		err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, refundAccAddress, fee)
 		if err != nil {
 			k.Logger(ctx).Error("error refunding fee to the original sender", "refund address", refundAccAddress, "fee", fee)
-			return // if sending to the refund address fails, no-op
+			return false // if sending to the refund address fails, no-op
 		}
 
 		emitDistributeFeeEvent(ctx, refundAccAddress.String(), fee)
@@ -179,6 +358,9 @@ func (k Keeper) distributeFee(ctx context.Context, receiver, refundAccAddress sd
 
 	// write the cache
 	writeFn()
+
+	// the fee was refunded if the distribution to the receiver failed
+	return err == nil
 }
 
 // RefundFeesOnChannelClosure will refund all fees associated with the given port and channel identifiers.
@@ -22,6 +22,7 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 		reverseRelayerBal sdk.Coin
 		refundAcc         sdk.AccAddress
 		refundAccBal      sdk.Coin
+		fallbackAcc       sdk.AccAddress
 		packetFee         types.PacketFee
 		packetFees        []types.PacketFee
 		fee               types.Fee
@@ -56,6 +57,15 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), forward, sdk.DefaultBondDenom)
 				suite.Require().Equal(expectedForwardAccBal, balance)
 
+				// check if the relayer rewards are recorded
+				rewards, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerRewards(suite.chainA.GetContext(), forwardRelayer, suite.path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewFee(defaultRecvFee.Add(defaultRecvFee...), nil, nil), rewards)
+
+				rewards, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerRewards(suite.chainA.GetContext(), reverseRelayer.String(), suite.path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewFee(nil, defaultAckFee.Add(defaultAckFee...), nil), rewards)
+
 				// check if the refund amount is zero
 				expectedRefundAccBal := refundAccBal
 				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
@@ -127,6 +137,86 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 				suite.Require().Equal(expectedRefundAccBal, balance)
 			},
 		},
+		{
+			"success: fee denominations accepted by the reverse relayer",
+			func() {
+				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
+				packetFees = []types.PacketFee{packetFee, packetFee}
+
+				feePreferences := types.NewRegisteredFeePreferences(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, reverseRelayer.String(), []string{sdk.DefaultBondDenom}, fallbackAcc.String())
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeePreferences(suite.chainA.GetContext(), feePreferences)
+			},
+			func() {
+				// check if the reverse relayer is paid
+				expectedReverseAccBal := reverseRelayerBal.Add(defaultAckFee[0]).Add(defaultAckFee[0])
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedReverseAccBal, balance)
+
+				// check if the fallback address is not paid
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), fallbackAcc, sdk.DefaultBondDenom)
+				suite.Require().True(balance.IsZero())
+			},
+		},
+		{
+			"success: fee denominations not accepted by the reverse relayer are sent to its fallback address",
+			func() {
+				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
+				packetFees = []types.PacketFee{packetFee, packetFee}
+
+				feePreferences := types.NewRegisteredFeePreferences(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, reverseRelayer.String(), []string{ibctesting.SecondaryDenom}, fallbackAcc.String())
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeePreferences(suite.chainA.GetContext(), feePreferences)
+			},
+			func() {
+				// check if the reverse relayer is not paid
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(reverseRelayerBal, balance)
+
+				// check if the fallback address is paid the ack fees
+				expectedFallbackAccBal := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.ZeroInt()).Add(defaultAckFee[0]).Add(defaultAckFee[0])
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), fallbackAcc, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedFallbackAccBal, balance)
+
+				// check if the fees sent to the fallback address are not recorded as rewards of the reverse relayer
+				_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerRewards(suite.chainA.GetContext(), reverseRelayer.String(), suite.path.EndpointA.ChannelID)
+				suite.Require().False(found)
+
+				// check if the forward relayer is paid
+				forward, err := sdk.AccAddressFromBech32(forwardRelayer)
+				suite.Require().NoError(err)
+
+				expectedForwardAccBal := forwardRelayerBal.Add(defaultRecvFee[0]).Add(defaultRecvFee[0])
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), forward, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedForwardAccBal, balance)
+			},
+		},
+		{
+			"success: fee denominations not accepted by the reverse relayer are refunded without a fallback address",
+			func() {
+				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
+				packetFees = []types.PacketFee{packetFee, packetFee}
+
+				feePreferences := types.NewRegisteredFeePreferences(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, reverseRelayer.String(), []string{ibctesting.SecondaryDenom}, "")
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeePreferences(suite.chainA.GetContext(), feePreferences)
+			},
+			func() {
+				// check if the reverse relayer is not paid
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(reverseRelayerBal, balance)
+
+				// check if the refund acc has been refunded the ackFee
+				expectedRefundAccBal := refundAccBal.Add(defaultAckFee[0]).Add(defaultAckFee[0])
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedRefundAccBal, balance)
+
+				// check if no rewards are recorded for the reverse relayer
+				_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerRewards(suite.chainA.GetContext(), reverseRelayer.String(), suite.path.EndpointA.ChannelID)
+				suite.Require().False(found)
+
+				// check the module acc wallet is now empty
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
+				suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(0)), balance)
+			},
+		},
 		{
 			"escrow account out of balance, fee module becomes locked - no distribution", func() {
 				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
@@ -190,6 +280,10 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 				expectedRefundAccBal := refundAccBal.Add(defaultAckFee[0]).Add(defaultAckFee[0])
 				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
 				suite.Require().Equal(expectedRefundAccBal, balance)
+
+				// check if no rewards are recorded for the reverse relayer
+				_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerRewards(suite.chainA.GetContext(), reverseRelayer.String(), suite.path.EndpointA.ChannelID)
+				suite.Require().False(found)
 			},
 		},
 		{
@@ -225,6 +319,7 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 			forwardRelayer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
 			reverseRelayer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 			refundAcc = suite.chainA.SenderAccount.GetAddress()
+			fallbackAcc = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 
 			packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
 			fee = types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
@@ -273,6 +368,11 @@ func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
 				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), timeoutRelayer, sdk.DefaultBondDenom)
 				suite.Require().Equal(expectedTimeoutAccBal, balance)
 
+				// check if the relayer rewards are recorded
+				rewards, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerRewards(suite.chainA.GetContext(), timeoutRelayer.String(), suite.path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewFee(nil, nil, defaultTimeoutFee.Add(defaultTimeoutFee...)), rewards)
+
 				// check if the refund amount is zero
 				expectedRefundAccBal := refundAccBal
 				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
@@ -335,6 +435,10 @@ func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
 				expectedRefundAccBal := sdk.Coins{refundAccBal}.Add(packetFee.Fee.Total()...).Add(packetFee.Fee.Total()...)[0]
 				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
 				suite.Require().Equal(expectedRefundAccBal, balance)
+
+				// check if no rewards are recorded for the timeout relayer
+				_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerRewards(suite.chainA.GetContext(), timeoutRelayer.String(), suite.path.EndpointA.ChannelID)
+				suite.Require().False(found)
 			},
 		},
 		{
@@ -394,6 +498,60 @@ func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestRelayerRewardEpochs() {
+	suite.path.Setup()
+
+	timeoutRelayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	refundAcc := suite.chainA.SenderAccount.GetAddress()
+	channelID := suite.path.EndpointA.ChannelID
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	params := types.NewParams(0, 10, types.DefaultRewardEpochsRetained)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	// distributeAtHeight escrows a packet fee and distributes it to the timeout relayer at the given block height
+	distributeAtHeight := func(height int64, sequence uint64) {
+		ctx := suite.chainA.GetContext().WithBlockHeight(height)
+		packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, channelID, sequence)
+		packetFees := []types.PacketFee{types.NewPacketFee(fee, refundAcc.String(), nil)}
+
+		suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(ctx, packetID, types.NewPacketFees(packetFees))
+		err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(ctx, refundAcc, types.ModuleName, fee.Total())
+		suite.Require().NoError(err)
+
+		suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnTimeout(ctx, timeoutRelayer, packetFees, packetID)
+	}
+
+	// the first epoch starts with the first distribution
+	distributeAtHeight(100, 1)
+	distributeAtHeight(109, 2)
+	suite.Require().Equal(types.NewRewardEpoch(1, 100), suite.chainA.GetSimApp().IBCFeeKeeper.GetRewardEpoch(suite.chainA.GetContext()))
+
+	// the third epoch starts at height 120, no rewards are recorded for the second epoch
+	distributeAtHeight(125, 3)
+	suite.Require().Equal(types.NewRewardEpoch(3, 120), suite.chainA.GetSimApp().IBCFeeKeeper.GetRewardEpoch(suite.chainA.GetContext()))
+
+	epochRewards, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetEpochRelayerRewards(suite.chainA.GetContext(), 1, timeoutRelayer.String(), channelID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewFee(nil, nil, defaultTimeoutFee.Add(defaultTimeoutFee...)), epochRewards)
+
+	_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetEpochRelayerRewards(suite.chainA.GetContext(), 2, timeoutRelayer.String(), channelID)
+	suite.Require().False(found)
+
+	epochRewards, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetEpochRelayerRewards(suite.chainA.GetContext(), 3, timeoutRelayer.String(), channelID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewFee(nil, nil, defaultTimeoutFee), epochRewards)
+
+	// the lifetime rewards include the rewards of all epochs
+	lifetimeRewards, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerRewards(suite.chainA.GetContext(), timeoutRelayer.String(), channelID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewFee(nil, nil, defaultTimeoutFee.Add(defaultTimeoutFee...).Add(defaultTimeoutFee...)), lifetimeRewards)
+
+	// the current epoch is derived from the block height until the stored epoch is advanced at the beginning of the block
+	ctx := suite.chainA.GetContext().WithBlockHeight(151)
+	suite.Require().Equal(types.NewRewardEpoch(6, 150), suite.chainA.GetSimApp().IBCFeeKeeper.GetCurrentRewardEpoch(ctx))
+}
+
 func (suite *KeeperTestSuite) TestRefundFeesOnChannelClosure() {
 	var (
 		expIdentifiedPacketFees []types.IdentifiedPacketFees
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -94,3 +95,141 @@ func emitDistributeFeeEvent(ctx context.Context, receiver string, fee sdk.Coins)
 		),
 	})
 }
+
+// emitRegisterFeePreferencesEvent emits an event containing information of the fee preferences registered by a payee on a particular channel
+func emitRegisterFeePreferencesEvent(ctx context.Context, payee string, acceptedDenoms []string, fallbackAddr, portID, channelID string) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/7223
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterFeePreferences,
+			sdk.NewAttribute(types.AttributeKeyPayee, payee),
+			sdk.NewAttribute(types.AttributeKeyAcceptedDenoms, strings.Join(acceptedDenoms, ",")),
+			sdk.NewAttribute(types.AttributeKeyFallbackAddress, fallbackAddr),
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// emitUpdateChannelMinimumFeeEvent emits an event containing the minimum fee set for a particular channel
+func emitUpdateChannelMinimumFeeEvent(ctx context.Context, portID, channelID string, minimumFee types.Fee) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/7223
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateChannelMinimumFee,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyRecvFee, minimumFee.RecvFee.String()),
+			sdk.NewAttribute(types.AttributeKeyAckFee, minimumFee.AckFee.String()),
+			sdk.NewAttribute(types.AttributeKeyTimeoutFee, minimumFee.TimeoutFee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// emitRefundPacketFeeEvent emits an event containing the fees refunded to a payer for a particular packet
+func emitRefundPacketFeeEvent(ctx context.Context, packetID channeltypes.PacketId, refundAddr string, fee sdk.Coins) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/7223
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRefundPacketFee,
+			sdk.NewAttribute(channeltypes.AttributeKeyPortID, packetID.PortId),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, packetID.ChannelId),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, fmt.Sprint(packetID.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyRefundAddress, refundAddr),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// emitRegisterFeeSponsorPoolEvent emits an event containing information about a registered fee sponsor pool
+func emitRegisterFeeSponsorPoolEvent(ctx context.Context, feeSponsorPool types.FeeSponsorPool) {
+	allowedSenders := make([]string, len(feeSponsorPool.Allowances))
+	for i, allowance := range feeSponsorPool.Allowances {
+		allowedSenders[i] = allowance.Sender
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/7223
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterFeeSponsorPool,
+			sdk.NewAttribute(types.AttributeKeyPortID, feeSponsorPool.PortId),
+			sdk.NewAttribute(types.AttributeKeyChannelID, feeSponsorPool.ChannelId),
+			sdk.NewAttribute(types.AttributeKeySponsor, feeSponsorPool.Sponsor),
+			sdk.NewAttribute(types.AttributeKeyAllowedSenders, strings.Join(allowedSenders, ",")),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// emitFundFeeSponsorPoolEvent emits an event containing information about funds deposited into a fee sponsor pool
+func emitFundFeeSponsorPoolEvent(ctx context.Context, portID, channelID, sponsorAddr, depositorAddr string, amount sdk.Coins) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/7223
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFundFeeSponsorPool,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeySponsor, sponsorAddr),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositorAddr),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// emitWithdrawFeeSponsorPoolEvent emits an event containing information about funds withdrawn from a fee sponsor pool
+func emitWithdrawFeeSponsorPoolEvent(ctx context.Context, portID, channelID, sponsorAddr, recipientAddr string, amount sdk.Coins) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/7223
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawFeeSponsorPool,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeySponsor, sponsorAddr),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipientAddr),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// emitDeleteFeeSponsorPoolEvent emits an event containing information about a deleted fee sponsor pool and the
+// remaining funds withdrawn from it
+func emitDeleteFeeSponsorPoolEvent(ctx context.Context, portID, channelID, sponsorAddr, recipientAddr string, amount sdk.Coins) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/7223
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDeleteFeeSponsorPool,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeySponsor, sponsorAddr),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipientAddr),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
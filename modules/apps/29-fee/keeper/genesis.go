@@ -27,6 +27,34 @@ func (k Keeper) InitGenesis(ctx context.Context, state types.GenesisState) {
 	for _, enabledChan := range state.FeeEnabledChannels {
 		k.SetFeeEnabled(ctx, enabledChan.PortId, enabledChan.ChannelId)
 	}
+
+	for _, feePreferences := range state.RegisteredFeePreferences {
+		k.SetFeePreferences(ctx, feePreferences)
+	}
+
+	for _, channelMinimumFee := range state.ChannelMinimumFees {
+		k.SetChannelMinimumFee(ctx, channelMinimumFee.PortId, channelMinimumFee.ChannelId, channelMinimumFee.MinimumFee)
+	}
+
+	k.SetParams(ctx, state.Params)
+
+	for _, escrowTime := range state.PacketFeeEscrowTimes {
+		k.SetFeeEscrowTime(ctx, escrowTime.PacketId, escrowTime.RefundAddress, escrowTime.EscrowTime)
+	}
+
+	for _, relayerRewards := range state.RelayerRewards {
+		k.SetRelayerRewards(ctx, relayerRewards)
+	}
+
+	for _, epochRelayerRewards := range state.EpochRelayerRewards {
+		k.SetEpochRelayerRewards(ctx, epochRelayerRewards)
+	}
+
+	k.SetRewardEpoch(ctx, state.RewardEpoch)
+
+	for _, feeSponsorPool := range state.FeeSponsorPools {
+		k.SetFeeSponsorPool(ctx, feeSponsorPool)
+	}
 }
 
 // ExportGenesis returns the fee middleware application exported genesis
@@ -37,5 +65,13 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		RegisteredPayees:             k.GetAllPayees(ctx),
 		RegisteredCounterpartyPayees: k.GetAllCounterpartyPayees(ctx),
 		ForwardRelayers:              k.GetAllForwardRelayerAddresses(ctx),
+		RegisteredFeePreferences:     k.GetAllFeePreferences(ctx),
+		ChannelMinimumFees:           k.GetAllChannelMinimumFees(ctx),
+		Params:                       k.GetParams(ctx),
+		PacketFeeEscrowTimes:         k.GetAllFeeEscrowTimes(ctx),
+		RelayerRewards:               k.GetAllRelayerRewards(ctx),
+		EpochRelayerRewards:          k.GetAllEpochRelayerRewards(ctx),
+		RewardEpoch:                  k.GetRewardEpoch(ctx),
+		FeeSponsorPools:              k.GetAllFeeSponsorPools(ctx),
 	}
 }
@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-go/v9/modules/apps/29-fee/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
@@ -42,6 +46,26 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 				ChannelId:         ibctesting.FirstChannelID,
 			},
 		},
+		RegisteredFeePreferences: []types.RegisteredFeePreferences{
+			types.NewRegisteredFeePreferences(ibctesting.MockFeePort, ibctesting.FirstChannelID, suite.chainB.SenderAccount.GetAddress().String(), []string{sdk.DefaultBondDenom}, ""),
+		},
+		ChannelMinimumFees: []types.ChannelMinimumFee{
+			types.NewChannelMinimumFee(ibctesting.MockFeePort, ibctesting.FirstChannelID, types.NewFee(defaultRecvFee, defaultAckFee, nil)),
+		},
+		Params: types.NewParams(time.Hour, 10, types.DefaultRewardEpochsRetained),
+		PacketFeeEscrowTimes: []types.PacketFeeEscrowTime{
+			types.NewPacketFeeEscrowTime(packetID, suite.chainA.SenderAccount.GetAddress().String(), time.Unix(1700000000, 0).UTC()),
+		},
+		RelayerRewards: []types.RelayerRewards{
+			types.NewRelayerRewards(suite.chainB.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID, types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)),
+		},
+		EpochRelayerRewards: []types.EpochRelayerRewards{
+			types.NewEpochRelayerRewards(2, types.NewRelayerRewards(suite.chainB.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID, types.NewFee(defaultRecvFee, nil, nil))),
+		},
+		RewardEpoch: types.NewRewardEpoch(2, 10),
+		FeeSponsorPools: []types.FeeSponsorPool{
+			types.NewFeeSponsorPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, suite.chainA.SenderAccount.GetAddress().String(), []types.FeeSponsorAllowance{types.NewFeeSponsorAllowance(suite.chainB.SenderAccount.GetAddress().String(), nil, nil)}),
+		},
 	}
 
 	suite.chainA.GetSimApp().IBCFeeKeeper.InitGenesis(suite.chainA.GetContext(), genesisState)
@@ -64,6 +88,42 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	counterpartyPayeeAddr, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetCounterpartyPayeeAddress(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID)
 	suite.Require().True(found)
 	suite.Require().Equal(genesisState.RegisteredCounterpartyPayees[0].CounterpartyPayee, counterpartyPayeeAddr)
+
+	// check fee preferences
+	feePreferences, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeePreferences(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, suite.chainB.SenderAccount.GetAddress().String())
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.RegisteredFeePreferences[0], feePreferences)
+
+	// check channel minimum fee
+	minimumFee, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetChannelMinimumFee(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.ChannelMinimumFees[0].MinimumFee, minimumFee)
+
+	// check params
+	suite.Require().Equal(genesisState.Params, suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(suite.chainA.GetContext()))
+
+	// check fee escrow time
+	escrowTime, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeEscrowTime(suite.chainA.GetContext(), packetID, suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.PacketFeeEscrowTimes[0].EscrowTime, escrowTime)
+
+	// check relayer rewards
+	rewards, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerRewards(suite.chainA.GetContext(), suite.chainB.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.RelayerRewards[0].Rewards, rewards)
+
+	// check epoch relayer rewards
+	rewards, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetEpochRelayerRewards(suite.chainA.GetContext(), 2, suite.chainB.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.EpochRelayerRewards[0].RelayerRewards.Rewards, rewards)
+
+	// check reward epoch
+	suite.Require().Equal(genesisState.RewardEpoch, suite.chainA.GetSimApp().IBCFeeKeeper.GetRewardEpoch(suite.chainA.GetContext()))
+
+	// check fee sponsor pool
+	feeSponsorPool, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeSponsorPool(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.FeeSponsorPools[0], feeSponsorPool)
 }
 
 func (suite *KeeperTestSuite) TestExportGenesis() {
@@ -94,6 +154,38 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 		ibctesting.FirstChannelID,
 	)
 
+	// set fee preferences
+	feePreferences := types.NewRegisteredFeePreferences(ibctesting.MockFeePort, ibctesting.FirstChannelID, suite.chainB.SenderAccount.GetAddress().String(), []string{sdk.DefaultBondDenom}, "")
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeePreferences(suite.chainA.GetContext(), feePreferences)
+
+	// set channel minimum fee
+	minimumFee := types.NewFee(defaultRecvFee, defaultAckFee, nil)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetChannelMinimumFee(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, minimumFee)
+
+	// set params
+	params := types.NewParams(time.Hour, 0, types.DefaultRewardEpochsRetained)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	// set fee escrow time
+	escrowTime := suite.chainA.GetContext().BlockTime()
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEscrowTime(suite.chainA.GetContext(), packetID, refundAcc.String(), escrowTime)
+
+	// set relayer rewards
+	relayerRewards := types.NewRelayerRewards(suite.chainB.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID, fee)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerRewards(suite.chainA.GetContext(), relayerRewards)
+
+	// set epoch relayer rewards
+	epochRelayerRewards := types.NewEpochRelayerRewards(1, relayerRewards)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetEpochRelayerRewards(suite.chainA.GetContext(), epochRelayerRewards)
+
+	// set reward epoch
+	rewardEpoch := types.NewRewardEpoch(1, 5)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetRewardEpoch(suite.chainA.GetContext(), rewardEpoch)
+
+	// set fee sponsor pool
+	feeSponsorPool := types.NewFeeSponsorPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, refundAcc.String(), []types.FeeSponsorAllowance{types.NewFeeSponsorAllowance(suite.chainB.SenderAccount.GetAddress().String(), nil, nil)})
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeSponsorPool(suite.chainA.GetContext(), feeSponsorPool)
+
 	// set forward relayer address
 	suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerAddressForAsyncAck(suite.chainA.GetContext(), packetID, suite.chainA.SenderAccount.GetAddress().String())
 
@@ -123,4 +215,24 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	suite.Require().Equal(suite.chainA.SenderAccount.GetAddress().String(), genesisState.RegisteredCounterpartyPayees[0].Relayer)
 	suite.Require().Equal(suite.chainB.SenderAccount.GetAddress().String(), genesisState.RegisteredCounterpartyPayees[0].CounterpartyPayee)
 	suite.Require().Equal(ibctesting.FirstChannelID, genesisState.RegisteredCounterpartyPayees[0].ChannelId)
+
+	// check registered fee preferences
+	suite.Require().Equal([]types.RegisteredFeePreferences{feePreferences}, genesisState.RegisteredFeePreferences)
+
+	// check channel minimum fees
+	suite.Require().Equal([]types.ChannelMinimumFee{types.NewChannelMinimumFee(ibctesting.MockFeePort, ibctesting.FirstChannelID, minimumFee)}, genesisState.ChannelMinimumFees)
+
+	// check params
+	suite.Require().Equal(params, genesisState.Params)
+
+	// check fee escrow times
+	suite.Require().Equal([]types.PacketFeeEscrowTime{types.NewPacketFeeEscrowTime(packetID, refundAcc.String(), escrowTime)}, genesisState.PacketFeeEscrowTimes)
+
+	// check relayer rewards
+	suite.Require().Equal([]types.RelayerRewards{relayerRewards}, genesisState.RelayerRewards)
+	suite.Require().Equal([]types.EpochRelayerRewards{epochRelayerRewards}, genesisState.EpochRelayerRewards)
+	suite.Require().Equal(rewardEpoch, genesisState.RewardEpoch)
+
+	// check fee sponsor pools
+	suite.Require().Equal([]types.FeeSponsorPool{feeSponsorPool}, genesisState.FeeSponsorPools)
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/ibc-go/v9/internal/validate"
 	"github.com/cosmos/ibc-go/v9/modules/apps/29-fee/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
@@ -287,3 +288,306 @@ func (k Keeper) FeeEnabledChannel(goCtx context.Context, req *types.QueryFeeEnab
 		FeeEnabled: isFeeEnabled,
 	}, nil
 }
+
+// FeePreferences implements the Query/FeePreferences gRPC method and returns the fee preferences registered by a payee for a channel
+func (k Keeper) FeePreferences(goCtx context.Context, req *types.QueryFeePreferencesRequest) (*types.QueryFeePreferencesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	feePreferences, found := k.GetFeePreferences(ctx, req.PortId, req.ChannelId, req.Payee)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "fee preferences not found for address: %s on port: %s, channel: %s", req.Payee, req.PortId, req.ChannelId)
+	}
+
+	return &types.QueryFeePreferencesResponse{
+		FeePreferences: feePreferences,
+	}, nil
+}
+
+// FeePreferencesForChannel implements the Query/FeePreferencesForChannel gRPC method and returns the fee preferences
+// registered by all payees for a channel
+func (k Keeper) FeePreferencesForChannel(ctx context.Context, req *types.QueryFeePreferencesForChannelRequest) (*types.QueryFeePreferencesForChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	var feePreferences []types.RegisteredFeePreferences
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.KeyFeePreferencesChannelPrefix(req.PortId, req.ChannelId))
+	pagination, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var preferences types.RegisteredFeePreferences
+		if err := k.cdc.Unmarshal(value, &preferences); err != nil {
+			return err
+		}
+
+		feePreferences = append(feePreferences, preferences)
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryFeePreferencesForChannelResponse{
+		FeePreferences: feePreferences,
+		Pagination:     pagination,
+	}, nil
+}
+
+// ChannelMinimumFee implements the Query/ChannelMinimumFee gRPC method and returns the minimum fee required to
+// incentivize a packet on a channel, empty if the channel imposes no minimum
+func (k Keeper) ChannelMinimumFee(goCtx context.Context, req *types.QueryChannelMinimumFeeRequest) (*types.QueryChannelMinimumFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	minimumFee, _ := k.GetChannelMinimumFee(ctx, req.PortId, req.ChannelId)
+
+	return &types.QueryChannelMinimumFeeResponse{
+		MinimumFee: minimumFee,
+	}, nil
+}
+
+// IncentivizedPacketsForPayer implements the Query/IncentivizedPacketsForPayer gRPC method and returns the incentivized
+// packets across all channels for which the payer has escrowed fees, containing only the packet fees of the payer
+func (k Keeper) IncentivizedPacketsForPayer(ctx context.Context, req *types.QueryIncentivizedPacketsForPayerRequest) (*types.QueryIncentivizedPacketsForPayerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Payer); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var identifiedPackets []types.IdentifiedPacketFees
+
+	payerPrefix := types.KeyPayerFeesInEscrowPrefix(req.Payer)
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), payerPrefix)
+	pagination, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		_, packetID, err := types.ParseKeyPayerFeesInEscrow(string(payerPrefix) + string(key))
+		if err != nil {
+			return err
+		}
+
+		feesInEscrow, found := k.GetFeesInEscrow(ctx, packetID)
+		if !found {
+			return errorsmod.Wrapf(types.ErrFeeNotFound, "channel: %s, port: %s, sequence: %d", packetID.ChannelId, packetID.PortId, packetID.Sequence)
+		}
+
+		var payerFees []types.PacketFee
+		for _, packetFee := range feesInEscrow.PacketFees {
+			if packetFee.RefundAddress == req.Payer {
+				payerFees = append(payerFees, packetFee)
+			}
+		}
+
+		identifiedPackets = append(identifiedPackets, types.NewIdentifiedPacketFees(packetID, payerFees))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryIncentivizedPacketsForPayerResponse{
+		IncentivizedPackets: identifiedPackets,
+		Pagination:          pagination,
+	}, nil
+}
+
+// Params implements the Query/Params gRPC method and returns the fee middleware parameters
+func (k Keeper) Params(goCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: &params,
+	}, nil
+}
+
+// RelayerRewards implements the Query/RelayerRewards gRPC method and returns the lifetime rewards paid out to a payee
+// on each channel
+func (k Keeper) RelayerRewards(ctx context.Context, req *types.QueryRelayerRewardsRequest) (*types.QueryRelayerRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Payee); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	keyPrefix := types.KeyRelayerRewardsPayeePrefix(req.Payee)
+	relayerRewards, pagination, err := k.paginateRelayerRewards(ctx, keyPrefix, req.Pagination, func(key []byte) (types.RelayerRewards, error) {
+		payeeAddr, channelID, err := types.ParseKeyRelayerRewards(string(keyPrefix) + string(key))
+		return types.RelayerRewards{Payee: payeeAddr, ChannelId: channelID}, err
+	})
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryRelayerRewardsResponse{
+		RelayerRewards: relayerRewards,
+		Pagination:     pagination,
+	}, nil
+}
+
+// AllRelayerRewards implements the Query/AllRelayerRewards gRPC method and returns the lifetime rewards paid out to
+// all payees on each channel
+func (k Keeper) AllRelayerRewards(ctx context.Context, req *types.QueryAllRelayerRewardsRequest) (*types.QueryAllRelayerRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	keyPrefix := []byte(types.RelayerRewardsKeyPrefix + "/")
+	relayerRewards, pagination, err := k.paginateRelayerRewards(ctx, keyPrefix, req.Pagination, func(key []byte) (types.RelayerRewards, error) {
+		payeeAddr, channelID, err := types.ParseKeyRelayerRewards(string(keyPrefix) + string(key))
+		return types.RelayerRewards{Payee: payeeAddr, ChannelId: channelID}, err
+	})
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryAllRelayerRewardsResponse{
+		RelayerRewards: relayerRewards,
+		Pagination:     pagination,
+	}, nil
+}
+
+// EpochRelayerRewards implements the Query/EpochRelayerRewards gRPC method and returns the rewards paid out to payees
+// on each channel during an epoch, optionally restricted to a single payee
+func (k Keeper) EpochRelayerRewards(ctx context.Context, req *types.QueryEpochRelayerRewardsRequest) (*types.QueryEpochRelayerRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Epoch == 0 {
+		return nil, status.Error(codes.InvalidArgument, "relayer reward epoch cannot be zero")
+	}
+
+	keyPrefix := types.KeyEpochRelayerRewardsPrefix(req.Epoch)
+	if req.Payee != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Payee); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		keyPrefix = types.KeyEpochRelayerRewardsPayeePrefix(req.Epoch, req.Payee)
+	}
+
+	relayerRewards, pagination, err := k.paginateRelayerRewards(ctx, keyPrefix, req.Pagination, func(key []byte) (types.RelayerRewards, error) {
+		_, payeeAddr, channelID, err := types.ParseKeyEpochRelayerRewards(string(keyPrefix) + string(key))
+		return types.RelayerRewards{Payee: payeeAddr, ChannelId: channelID}, err
+	})
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryEpochRelayerRewardsResponse{
+		RelayerRewards: relayerRewards,
+		Pagination:     pagination,
+	}, nil
+}
+
+// CurrentRewardEpoch implements the Query/CurrentRewardEpoch gRPC method and returns the current relayer reward epoch
+func (k Keeper) CurrentRewardEpoch(goCtx context.Context, _ *types.QueryCurrentRewardEpochRequest) (*types.QueryCurrentRewardEpochResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryCurrentRewardEpochResponse{
+		RewardEpoch: k.GetCurrentRewardEpoch(ctx),
+	}, nil
+}
+
+// paginateRelayerRewards paginates over the relayer rewards stored under the given key prefix. The payee address and
+// channel identifier of each entry are parsed from its key with the provided function.
+func (k Keeper) paginateRelayerRewards(
+	ctx context.Context, keyPrefix []byte, pageReq *query.PageRequest, parseKey func(key []byte) (types.RelayerRewards, error),
+) ([]types.RelayerRewards, *query.PageResponse, error) {
+	var relayerRewards []types.RelayerRewards
+
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+	pagination, err := query.Paginate(store, pageReq, func(key, value []byte) error {
+		rewards, err := parseKey(key)
+		if err != nil {
+			return err
+		}
+
+		if err := k.cdc.Unmarshal(value, &rewards.Rewards); err != nil {
+			return err
+		}
+
+		relayerRewards = append(relayerRewards, rewards)
+		return nil
+	})
+
+	return relayerRewards, pagination, err
+}
+
+// FeeSponsorPool implements the Query/FeeSponsorPool gRPC method and returns the fee sponsor pool of a sponsor on a
+// channel, along with the address holding its funds and its balance
+func (k Keeper) FeeSponsorPool(goCtx context.Context, req *types.QueryFeeSponsorPoolRequest) (*types.QueryFeeSponsorPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	feeSponsorPool, found := k.GetFeeSponsorPool(ctx, req.PortId, req.ChannelId, req.Sponsor)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "fee sponsor pool not found for sponsor: %s on port: %s and channel: %s", req.Sponsor, req.PortId, req.ChannelId)
+	}
+
+	poolAddr := types.GetFeeSponsorPoolAddress(req.PortId, req.ChannelId, req.Sponsor)
+
+	return &types.QueryFeeSponsorPoolResponse{
+		FeeSponsorPool: feeSponsorPool,
+		Address:        poolAddr.String(),
+		Balance:        k.bankKeeper.GetAllBalances(ctx, poolAddr),
+	}, nil
+}
+
+// FeeSponsorPoolsForChannel implements the Query/FeeSponsorPoolsForChannel gRPC method and returns the fee sponsor
+// pools of all sponsors on a channel
+func (k Keeper) FeeSponsorPoolsForChannel(ctx context.Context, req *types.QueryFeeSponsorPoolsForChannelRequest) (*types.QueryFeeSponsorPoolsForChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var feeSponsorPools []types.FeeSponsorPool
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.KeyFeeSponsorPoolChannelPrefix(req.PortId, req.ChannelId))
+	pagination, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var feeSponsorPool types.FeeSponsorPool
+		if err := k.cdc.Unmarshal(value, &feeSponsorPool); err != nil {
+			return err
+		}
+
+		feeSponsorPools = append(feeSponsorPools, feeSponsorPool)
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryFeeSponsorPoolsForChannelResponse{
+		FeeSponsorPools: feeSponsorPools,
+		Pagination:      pagination,
+	}, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -879,3 +880,853 @@ func (suite *KeeperTestSuite) TestQueryFeeEnabledChannel() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryFeePreferences() {
+	var (
+		req               *types.QueryFeePreferencesRequest
+		expFeePreferences types.RegisteredFeePreferences
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		errMsg   string
+	}{
+		{
+			"success",
+			func() {},
+			"",
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			"InvalidArgument",
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			"InvalidArgument",
+		},
+		{
+			"fee preferences not found: invalid channel",
+			func() {
+				req.ChannelId = "invalid-channel-id"
+			},
+			"NotFound",
+		},
+		{
+			"fee preferences not found: other port",
+			func() {
+				req.PortId = ibctesting.TransferPort
+			},
+			"NotFound",
+		},
+		{
+			"fee preferences not found: invalid payee address",
+			func() {
+				req.Payee = "invalid-addr"
+			},
+			"NotFound",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.path.Setup()
+
+			fallbackAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			expFeePreferences = types.NewRegisteredFeePreferences(
+				suite.path.EndpointA.ChannelConfig.PortID,
+				suite.path.EndpointA.ChannelID,
+				suite.chainA.SenderAccount.GetAddress().String(),
+				[]string{sdk.DefaultBondDenom, ibctesting.SecondaryDenom},
+				fallbackAddr.String(),
+			)
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeePreferences(suite.chainA.GetContext(), expFeePreferences)
+
+			req = &types.QueryFeePreferencesRequest{
+				PortId:    suite.path.EndpointA.ChannelConfig.PortID,
+				ChannelId: suite.path.EndpointA.ChannelID,
+				Payee:     suite.chainA.SenderAccount.GetAddress().String(),
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.FeePreferences(ctx, req)
+
+			if tc.errMsg == "" {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expFeePreferences, res.FeePreferences)
+			} else {
+				suite.Require().ErrorContains(err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryFeePreferencesForChannel() {
+	var (
+		req               *types.QueryFeePreferencesForChannelRequest
+		expFeePreferences []types.RegisteredFeePreferences
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		errMsg   string
+	}{
+		{
+			"success",
+			func() {},
+			"",
+		},
+		{
+			"success: empty pagination",
+			func() {
+				req.Pagination = nil
+			},
+			"",
+		},
+		{
+			"success: with pagination limit",
+			func() {
+				req.Pagination = &query.PageRequest{
+					Limit: 1,
+				}
+
+				expFeePreferences = expFeePreferences[:1]
+			},
+			"",
+		},
+		{
+			"empty response",
+			func() {
+				req.ChannelId = ibctesting.InvalidID
+				expFeePreferences = nil
+			},
+			"",
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			"InvalidArgument",
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			"InvalidArgument",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.path.Setup()
+
+			for _, senderAccount := range suite.chainA.SenderAccounts[:3] {
+				feePreferences := types.NewRegisteredFeePreferences(
+					suite.path.EndpointA.ChannelConfig.PortID,
+					suite.path.EndpointA.ChannelID,
+					senderAccount.SenderAccount.GetAddress().String(),
+					[]string{sdk.DefaultBondDenom},
+					"",
+				)
+
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeePreferences(suite.chainA.GetContext(), feePreferences)
+			}
+
+			// fee preferences on other channels or ports are not returned
+			feePreferences := types.NewRegisteredFeePreferences(suite.path.EndpointA.ChannelConfig.PortID, "channel-100", suite.chainA.SenderAccount.GetAddress().String(), []string{sdk.DefaultBondDenom}, "")
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeePreferences(suite.chainA.GetContext(), feePreferences)
+
+			feePreferences = types.NewRegisteredFeePreferences(ibctesting.TransferPort, suite.path.EndpointA.ChannelID, suite.chainA.SenderAccount.GetAddress().String(), []string{sdk.DefaultBondDenom}, "")
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeePreferences(suite.chainA.GetContext(), feePreferences)
+
+			// fee preferences are returned in store order
+			expFeePreferences = suite.chainA.GetSimApp().IBCFeeKeeper.GetFeePreferencesForChannel(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+
+			req = &types.QueryFeePreferencesForChannelRequest{
+				PortId:    suite.path.EndpointA.ChannelConfig.PortID,
+				ChannelId: suite.path.EndpointA.ChannelID,
+				Pagination: &query.PageRequest{
+					Limit: 5,
+				},
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.FeePreferencesForChannel(ctx, req)
+
+			if tc.errMsg == "" {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expFeePreferences, res.FeePreferences)
+			} else {
+				suite.Require().ErrorContains(err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelMinimumFee() {
+	var (
+		req           *types.QueryChannelMinimumFeeRequest
+		expMinimumFee types.Fee
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		errMsg   string
+	}{
+		{
+			"success",
+			func() {},
+			"",
+		},
+		{
+			"success: no minimum fee",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteChannelMinimumFee(suite.chainA.GetContext(), req.PortId, req.ChannelId)
+
+				expMinimumFee = types.Fee{}
+			},
+			"",
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			"InvalidArgument",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.path.Setup()
+
+			expMinimumFee = types.NewFee(defaultRecvFee, nil, defaultTimeoutFee)
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetChannelMinimumFee(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, expMinimumFee)
+
+			req = &types.QueryChannelMinimumFeeRequest{
+				PortId:    suite.path.EndpointA.ChannelConfig.PortID,
+				ChannelId: suite.path.EndpointA.ChannelID,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.ChannelMinimumFee(ctx, req)
+
+			if tc.errMsg == "" {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expMinimumFee, res.MinimumFee)
+			} else {
+				suite.Require().ErrorContains(err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryIncentivizedPacketsForPayer() {
+	var (
+		req             *types.QueryIncentivizedPacketsForPayerRequest
+		expectedPackets []types.IdentifiedPacketFees
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		errMsg   string
+	}{
+		{
+			"success",
+			func() {},
+			"",
+		},
+		{
+			"success: no fees escrowed by payer",
+			func() {
+				req.Payer = suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String()
+				expectedPackets = nil
+			},
+			"",
+		},
+		{
+			"success: with pagination",
+			func() {
+				req.Pagination.Limit = 1
+				expectedPackets = expectedPackets[:1]
+			},
+			"",
+		},
+		{
+			"success: fees in escrow deleted",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeesInEscrow(suite.chainA.GetContext(), expectedPackets[0].PacketId)
+				expectedPackets = expectedPackets[1:]
+			},
+			"",
+		},
+		{
+			"invalid payer address",
+			func() {
+				req.Payer = ibctesting.InvalidID
+			},
+			"InvalidArgument",
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			"InvalidArgument",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			payer := suite.chainA.SenderAccount.GetAddress().String()
+			otherPayer := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			packetFee := types.NewPacketFee(fee, payer, nil)
+			otherPacketFee := types.NewPacketFee(fee, otherPayer, nil)
+
+			expectedPackets = nil
+			for i, channelID := range []string{ibctesting.FirstChannelID, "channel-1"} {
+				// escrow packet fees of both payers for a packet on each channel
+				packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, channelID, uint64(i+1))
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{otherPacketFee, packetFee}))
+
+				expectedPackets = append(expectedPackets, types.NewIdentifiedPacketFees(packetID, []types.PacketFee{packetFee}))
+			}
+
+			// escrow a packet fee of the other payer only
+			packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 3)
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{otherPacketFee}))
+
+			req = &types.QueryIncentivizedPacketsForPayerRequest{
+				Payer: payer,
+				Pagination: &query.PageRequest{
+					Limit:      5,
+					CountTotal: false,
+				},
+			}
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.IncentivizedPacketsForPayer(ctx, req)
+
+			if tc.errMsg == "" {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expectedPackets, res.IncentivizedPackets)
+			} else {
+				suite.Require().ErrorContains(err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	ctx := suite.chainA.GetContext()
+	expParams := types.NewParams(time.Hour, 0, types.DefaultRewardEpochsRetained)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(ctx, expParams)
+
+	res, err := suite.chainA.GetSimApp().IBCFeeKeeper.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&expParams, res.Params)
+}
+
+func (suite *KeeperTestSuite) TestQueryRelayerRewards() {
+	var (
+		req             *types.QueryRelayerRewardsRequest
+		expectedRewards []types.RelayerRewards
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		errMsg   string
+	}{
+		{
+			"success",
+			func() {},
+			"",
+		},
+		{
+			"success: no rewards paid out to payee",
+			func() {
+				req.Payee = suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String()
+				expectedRewards = nil
+			},
+			"",
+		},
+		{
+			"invalid payee address",
+			func() {
+				req.Payee = ibctesting.InvalidID
+			},
+			"InvalidArgument",
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			"InvalidArgument",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			payee := suite.chainA.SenderAccount.GetAddress().String()
+			otherPayee := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+
+			expectedRewards = nil
+			for _, channelID := range []string{ibctesting.FirstChannelID, "channel-1"} {
+				relayerRewards := types.NewRelayerRewards(payee, channelID, types.NewFee(defaultRecvFee, defaultAckFee, nil))
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerRewards(suite.chainA.GetContext(), relayerRewards)
+
+				expectedRewards = append(expectedRewards, relayerRewards)
+			}
+
+			// set rewards for the other payee
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerRewards(suite.chainA.GetContext(), types.NewRelayerRewards(otherPayee, ibctesting.FirstChannelID, types.NewFee(nil, nil, defaultTimeoutFee)))
+
+			req = &types.QueryRelayerRewardsRequest{
+				Payee: payee,
+				Pagination: &query.PageRequest{
+					Limit:      5,
+					CountTotal: false,
+				},
+			}
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.RelayerRewards(ctx, req)
+
+			if tc.errMsg == "" {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expectedRewards, res.RelayerRewards)
+			} else {
+				suite.Require().ErrorContains(err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryAllRelayerRewards() {
+	var (
+		req             *types.QueryAllRelayerRewardsRequest
+		expectedRewards []types.RelayerRewards
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		errMsg   string
+	}{
+		{
+			"success",
+			func() {},
+			"",
+		},
+		{
+			"success: no rewards paid out",
+			func() {
+				suite.SetupTest() // reset state
+				expectedRewards = nil
+			},
+			"",
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			"InvalidArgument",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			expectedRewards = nil
+			for _, payee := range []string{suite.chainA.SenderAccount.GetAddress().String(), suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()} {
+				for _, channelID := range []string{ibctesting.FirstChannelID, "channel-1"} {
+					relayerRewards := types.NewRelayerRewards(payee, channelID, types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee))
+					suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerRewards(suite.chainA.GetContext(), relayerRewards)
+
+					expectedRewards = append(expectedRewards, relayerRewards)
+				}
+			}
+
+			req = &types.QueryAllRelayerRewardsRequest{
+				Pagination: &query.PageRequest{
+					Limit:      5,
+					CountTotal: false,
+				},
+			}
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.AllRelayerRewards(ctx, req)
+
+			if tc.errMsg == "" {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().ElementsMatch(expectedRewards, res.RelayerRewards)
+			} else {
+				suite.Require().ErrorContains(err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryEpochRelayerRewards() {
+	var (
+		req             *types.QueryEpochRelayerRewardsRequest
+		payees          []string
+		expectedRewards []types.RelayerRewards
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		errMsg   string
+	}{
+		{
+			"success",
+			func() {},
+			"",
+		},
+		{
+			"success: with payee",
+			func() {
+				req.Payee = payees[0]
+				expectedRewards = expectedRewards[:1]
+			},
+			"",
+		},
+		{
+			"success: no rewards paid out during epoch",
+			func() {
+				req.Epoch = 3
+				expectedRewards = nil
+			},
+			"",
+		},
+		{
+			"invalid epoch",
+			func() {
+				req.Epoch = 0
+			},
+			"InvalidArgument",
+		},
+		{
+			"invalid payee address",
+			func() {
+				req.Payee = ibctesting.InvalidID
+			},
+			"InvalidArgument",
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			"InvalidArgument",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// sort the payees in store key order
+			payees = []string{suite.chainA.SenderAccount.GetAddress().String(), suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()}
+			if payees[0] > payees[1] {
+				payees[0], payees[1] = payees[1], payees[0]
+			}
+
+			expectedRewards = nil
+			for _, payee := range payees {
+				relayerRewards := types.NewRelayerRewards(payee, ibctesting.FirstChannelID, types.NewFee(defaultRecvFee, nil, nil))
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetEpochRelayerRewards(suite.chainA.GetContext(), types.NewEpochRelayerRewards(2, relayerRewards))
+
+				expectedRewards = append(expectedRewards, relayerRewards)
+			}
+
+			// set rewards for a different epoch
+			relayerRewards := types.NewRelayerRewards(payees[0], ibctesting.FirstChannelID, types.NewFee(nil, defaultAckFee, nil))
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetEpochRelayerRewards(suite.chainA.GetContext(), types.NewEpochRelayerRewards(1, relayerRewards))
+
+			req = &types.QueryEpochRelayerRewardsRequest{
+				Epoch: 2,
+				Pagination: &query.PageRequest{
+					Limit:      5,
+					CountTotal: false,
+				},
+			}
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.EpochRelayerRewards(ctx, req)
+
+			if tc.errMsg == "" {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expectedRewards, res.RelayerRewards)
+			} else {
+				suite.Require().ErrorContains(err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryCurrentRewardEpoch() {
+	ctx := suite.chainA.GetContext()
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(ctx, types.NewParams(0, 10, types.DefaultRewardEpochsRetained))
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetRewardEpoch(ctx, types.NewRewardEpoch(2, 10))
+
+	res, err := suite.chainA.GetSimApp().IBCFeeKeeper.CurrentRewardEpoch(ctx.WithBlockHeight(35), &types.QueryCurrentRewardEpochRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewRewardEpoch(4, 30), res.RewardEpoch)
+}
+
+func (suite *KeeperTestSuite) TestQueryFeeSponsorPool() {
+	var (
+		req        *types.QueryFeeSponsorPoolRequest
+		expBalance sdk.Coins
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		errMsg   string
+	}{
+		{
+			"success",
+			func() {},
+			"",
+		},
+		{
+			"success: empty pool balance",
+			func() {
+				sponsor := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+				feeSponsorPool := types.NewFeeSponsorPool(req.PortId, req.ChannelId, sponsor, []types.FeeSponsorAllowance{types.NewFeeSponsorAllowance(suite.chainA.SenderAccount.GetAddress().String(), nil, nil)})
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeSponsorPool(suite.chainA.GetContext(), feeSponsorPool)
+
+				req.Sponsor = sponsor
+				expBalance = sdk.Coins{}
+			},
+			"",
+		},
+		{
+			"fee sponsor pool not found",
+			func() {
+				req.ChannelId = ibctesting.InvalidID
+			},
+			"NotFound",
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			"InvalidArgument",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.path.Setup()
+
+			sponsor := suite.chainA.SenderAccount.GetAddress()
+			feeSponsorPool := types.NewFeeSponsorPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sponsor.String(), []types.FeeSponsorAllowance{types.NewFeeSponsorAllowance(suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), nil, nil)})
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeSponsorPool(suite.chainA.GetContext(), feeSponsorPool)
+
+			expBalance = defaultRecvFee
+			poolAddr := types.GetFeeSponsorPoolAddress(feeSponsorPool.PortId, feeSponsorPool.ChannelId, feeSponsorPool.Sponsor)
+			err := suite.chainA.GetSimApp().BankKeeper.SendCoins(suite.chainA.GetContext(), sponsor, poolAddr, expBalance)
+			suite.Require().NoError(err)
+
+			req = &types.QueryFeeSponsorPoolRequest{
+				PortId:    feeSponsorPool.PortId,
+				ChannelId: feeSponsorPool.ChannelId,
+				Sponsor:   feeSponsorPool.Sponsor,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.FeeSponsorPool(ctx, req)
+
+			if tc.errMsg == "" {
+				suite.Require().NoError(err)
+				suite.Require().Equal(req.Sponsor, res.FeeSponsorPool.Sponsor)
+				suite.Require().Equal(types.GetFeeSponsorPoolAddress(req.PortId, req.ChannelId, req.Sponsor).String(), res.Address)
+				suite.Require().Equal(expBalance, res.Balance)
+			} else {
+				suite.Require().ErrorContains(err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryFeeSponsorPoolsForChannel() {
+	var (
+		req                *types.QueryFeeSponsorPoolsForChannelRequest
+		expFeeSponsorPools []types.FeeSponsorPool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		errMsg   string
+	}{
+		{
+			"success",
+			func() {},
+			"",
+		},
+		{
+			"success: empty pagination",
+			func() {
+				req.Pagination = nil
+			},
+			"",
+		},
+		{
+			"success: with pagination limit",
+			func() {
+				req.Pagination = &query.PageRequest{
+					Limit: 1,
+				}
+
+				expFeeSponsorPools = expFeeSponsorPools[:1]
+			},
+			"",
+		},
+		{
+			"empty response",
+			func() {
+				req.ChannelId = ibctesting.InvalidID
+				expFeeSponsorPools = nil
+			},
+			"",
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			"InvalidArgument",
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			"InvalidArgument",
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			"InvalidArgument",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.path.Setup()
+
+			portID := suite.path.EndpointA.ChannelConfig.PortID
+			allowances := []types.FeeSponsorAllowance{types.NewFeeSponsorAllowance(suite.chainA.SenderAccount.GetAddress().String(), nil, nil)}
+
+			for _, senderAccount := range suite.chainA.SenderAccounts[:3] {
+				feeSponsorPool := types.NewFeeSponsorPool(portID, suite.path.EndpointA.ChannelID, senderAccount.SenderAccount.GetAddress().String(), allowances)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeSponsorPool(suite.chainA.GetContext(), feeSponsorPool)
+			}
+
+			// fee sponsor pools on other channels are not returned
+			feeSponsorPool := types.NewFeeSponsorPool(portID, "channel-100", suite.chainA.SenderAccount.GetAddress().String(), allowances)
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeSponsorPool(suite.chainA.GetContext(), feeSponsorPool)
+
+			// fee sponsor pools are returned in store order
+			expFeeSponsorPools = nil
+			for _, feeSponsorPool := range suite.chainA.GetSimApp().IBCFeeKeeper.GetAllFeeSponsorPools(suite.chainA.GetContext()) {
+				if feeSponsorPool.ChannelId == suite.path.EndpointA.ChannelID {
+					expFeeSponsorPools = append(expFeeSponsorPools, feeSponsorPool)
+				}
+			}
+
+			req = &types.QueryFeeSponsorPoolsForChannelRequest{
+				PortId:    portID,
+				ChannelId: suite.path.EndpointA.ChannelID,
+				Pagination: &query.PageRequest{
+					Limit: 5,
+				},
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.FeeSponsorPoolsForChannel(ctx, req)
+
+			if tc.errMsg == "" {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expFeeSponsorPools, res.FeeSponsorPools)
+			} else {
+				suite.Require().ErrorContains(err, tc.errMsg)
+			}
+		})
+	}
+}
@@ -2,6 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
 
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
@@ -30,6 +33,10 @@ type Keeper struct {
 	ics4Wrapper   porttypes.ICS4Wrapper
 	channelKeeper types.ChannelKeeper
 	bankKeeper    types.BankKeeper
+
+	// the address capable of executing MsgUpdateChannelMinimumFee and MsgUpdateParams messages. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper creates a new 29-fee Keeper instance
@@ -37,7 +44,12 @@ func NewKeeper(
 	cdc codec.BinaryCodec, storeService corestore.KVStoreService,
 	ics4Wrapper porttypes.ICS4Wrapper, channelKeeper types.ChannelKeeper,
 	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper,
+	authority string,
 ) Keeper {
+	if strings.TrimSpace(authority) == "" {
+		panic(errors.New("authority must be non-empty"))
+	}
+
 	return Keeper{
 		cdc:           cdc,
 		storeService:  storeService,
@@ -45,6 +57,7 @@ func NewKeeper(
 		channelKeeper: channelKeeper,
 		authKeeper:    authKeeper,
 		bankKeeper:    bankKeeper,
+		authority:     authority,
 	}
 }
 
@@ -60,6 +73,11 @@ func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
 	return k.ics4Wrapper
 }
 
+// GetAuthority returns the 29-fee module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (Keeper) Logger(ctx context.Context) log.Logger {
 	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
@@ -358,22 +376,45 @@ func (k Keeper) HasFeesInEscrow(ctx context.Context, packetID channeltypes.Packe
 	return has
 }
 
-// SetFeesInEscrow sets the given packet fees in escrow keyed by the packetID
+// SetFeesInEscrow sets the given packet fees in escrow keyed by the packetID and indexes the packet by the refund
+// addresses of the packet fees
 func (k Keeper) SetFeesInEscrow(ctx context.Context, packetID channeltypes.PacketId, fees types.PacketFees) {
 	store := k.storeService.OpenKVStore(ctx)
 	bz := k.MustMarshalFees(fees)
 	if err := store.Set(types.KeyFeesInEscrow(packetID), bz); err != nil {
 		panic(err)
 	}
+
+	for _, packetFee := range fees.PacketFees {
+		if err := store.Set(types.KeyPayerFeesInEscrow(packetFee.RefundAddress, packetID), []byte{1}); err != nil {
+			panic(err)
+		}
+	}
 }
 
-// DeleteFeesInEscrow deletes the fee associated with the given packetID
+// DeleteFeesInEscrow deletes the fee associated with the given packetID, its payer index entries and the escrow times of its payers
 func (k Keeper) DeleteFeesInEscrow(ctx context.Context, packetID channeltypes.PacketId) {
+	if feesInEscrow, found := k.GetFeesInEscrow(ctx, packetID); found {
+		for _, packetFee := range feesInEscrow.PacketFees {
+			k.deletePayerFeesInEscrow(ctx, packetFee.RefundAddress, packetID)
+		}
+	}
+
 	store := k.storeService.OpenKVStore(ctx)
 	key := types.KeyFeesInEscrow(packetID)
 	if err := store.Delete(key); err != nil {
 		panic(err)
 	}
+
+	k.deleteFeeEscrowTimes(ctx, packetID)
+}
+
+// deletePayerFeesInEscrow deletes the payer index entry of the given packetID
+func (k Keeper) deletePayerFeesInEscrow(ctx context.Context, payerAddr string, packetID channeltypes.PacketId) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.KeyPayerFeesInEscrow(payerAddr, packetID)); err != nil {
+		panic(err)
+	}
 }
 
 // GetIdentifiedPacketFeesForChannel returns all the currently escrowed fees on a given channel.
@@ -438,3 +479,456 @@ func (k Keeper) MustUnmarshalFees(bz []byte) types.PacketFees {
 	k.cdc.MustUnmarshal(bz, &fees)
 	return fees
 }
+
+// GetFeePreferences retrieves the fee preferences registered by the payee for the given port and channel
+func (k Keeper) GetFeePreferences(ctx context.Context, portID, channelID, payeeAddr string) (types.RegisteredFeePreferences, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyFeePreferences(portID, channelID, payeeAddr))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return types.RegisteredFeePreferences{}, false
+	}
+
+	var feePreferences types.RegisteredFeePreferences
+	k.cdc.MustUnmarshal(bz, &feePreferences)
+
+	return feePreferences, true
+}
+
+// SetFeePreferences stores the fee preferences keyed by their port and channel identifiers and payee address
+func (k Keeper) SetFeePreferences(ctx context.Context, feePreferences types.RegisteredFeePreferences) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&feePreferences)
+	if err := store.Set(types.KeyFeePreferences(feePreferences.PortId, feePreferences.ChannelId, feePreferences.Payee), bz); err != nil {
+		panic(err)
+	}
+}
+
+// DeleteFeePreferences deletes the fee preferences registered by the payee for the given port and channel
+func (k Keeper) DeleteFeePreferences(ctx context.Context, portID, channelID, payeeAddr string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.KeyFeePreferences(portID, channelID, payeeAddr)); err != nil {
+		panic(err)
+	}
+}
+
+// GetFeePreferencesForChannel returns the fee preferences registered by all payees for the given port and channel
+func (k Keeper) GetFeePreferencesForChannel(ctx context.Context, portID, channelID string) []types.RegisteredFeePreferences {
+	return k.getFeePreferences(ctx, types.KeyFeePreferencesChannelPrefix(portID, channelID))
+}
+
+// GetAllFeePreferences returns all the fee preferences stored in state
+func (k Keeper) GetAllFeePreferences(ctx context.Context) []types.RegisteredFeePreferences {
+	return k.getFeePreferences(ctx, []byte(types.FeePreferencesKeyPrefix+"/"))
+}
+
+// getFeePreferences returns the fee preferences stored under the given key prefix
+func (k Keeper) getFeePreferences(ctx context.Context, keyPrefix []byte) []types.RegisteredFeePreferences {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var feePreferences []types.RegisteredFeePreferences
+	for ; iterator.Valid(); iterator.Next() {
+		var preferences types.RegisteredFeePreferences
+		k.cdc.MustUnmarshal(iterator.Value(), &preferences)
+
+		feePreferences = append(feePreferences, preferences)
+	}
+
+	return feePreferences
+}
+
+// GetChannelMinimumFee retrieves the minimum fee of the given port and channel identifiers
+func (k Keeper) GetChannelMinimumFee(ctx context.Context, portID, channelID string) (types.Fee, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyChannelMinimumFee(portID, channelID))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return types.Fee{}, false
+	}
+
+	var minimumFee types.Fee
+	k.cdc.MustUnmarshal(bz, &minimumFee)
+
+	return minimumFee, true
+}
+
+// SetChannelMinimumFee stores the minimum fee of the given port and channel identifiers
+func (k Keeper) SetChannelMinimumFee(ctx context.Context, portID, channelID string, minimumFee types.Fee) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&minimumFee)
+	if err := store.Set(types.KeyChannelMinimumFee(portID, channelID), bz); err != nil {
+		panic(err)
+	}
+}
+
+// DeleteChannelMinimumFee deletes the minimum fee of the given port and channel identifiers
+func (k Keeper) DeleteChannelMinimumFee(ctx context.Context, portID, channelID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.KeyChannelMinimumFee(portID, channelID)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllChannelMinimumFees returns the minimum fees of all channels stored in state
+func (k Keeper) GetAllChannelMinimumFees(ctx context.Context) []types.ChannelMinimumFee {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.ChannelMinimumFeeKeyPrefix+"/"))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var channelMinimumFees []types.ChannelMinimumFee
+	for ; iterator.Valid(); iterator.Next() {
+		portID, channelID, err := types.ParseKeyChannelMinimumFee(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		var minimumFee types.Fee
+		k.cdc.MustUnmarshal(iterator.Value(), &minimumFee)
+
+		channelMinimumFees = append(channelMinimumFees, types.NewChannelMinimumFee(portID, channelID, minimumFee))
+	}
+
+	return channelMinimumFees
+}
+
+// GetParams returns the fee middleware parameters, or the default parameters if none are set
+func (k Keeper) GetParams(ctx context.Context) types.Params {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get([]byte(types.ParamsKey))
+	if err != nil {
+		panic(err)
+	}
+
+	// params are not set on chains which added the fee middleware before parameters were introduced
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the fee middleware parameters
+func (k Keeper) SetParams(ctx context.Context, params types.Params) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&params)
+	if err := store.Set([]byte(types.ParamsKey), bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetFeeEscrowTime retrieves the time at which the given refund address last escrowed a fee for the packet
+func (k Keeper) GetFeeEscrowTime(ctx context.Context, packetID channeltypes.PacketId, refundAddr string) (time.Time, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyFeeEscrowTime(packetID, refundAddr))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return time.Time{}, false
+	}
+
+	escrowTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	return escrowTime, true
+}
+
+// SetFeeEscrowTime stores the time at which the given refund address escrowed a fee for the packet
+func (k Keeper) SetFeeEscrowTime(ctx context.Context, packetID channeltypes.PacketId, refundAddr string, escrowTime time.Time) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.KeyFeeEscrowTime(packetID, refundAddr), sdk.FormatTimeBytes(escrowTime)); err != nil {
+		panic(err)
+	}
+}
+
+// DeleteFeeEscrowTime deletes the time at which the given refund address escrowed a fee for the packet
+func (k Keeper) DeleteFeeEscrowTime(ctx context.Context, packetID channeltypes.PacketId, refundAddr string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.KeyFeeEscrowTime(packetID, refundAddr)); err != nil {
+		panic(err)
+	}
+}
+
+// deleteFeeEscrowTimes deletes the fee escrow times of all payers of the given packet
+func (k Keeper) deleteFeeEscrowTimes(ctx context.Context, packetID channeltypes.PacketId) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyFeeEscrowTimePacketPrefix(packetID))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAllFeeEscrowTimes returns the fee escrow times of all payers stored in state
+func (k Keeper) GetAllFeeEscrowTimes(ctx context.Context) []types.PacketFeeEscrowTime {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.FeeEscrowTimePrefix+"/"))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var escrowTimes []types.PacketFeeEscrowTime
+	for ; iterator.Valid(); iterator.Next() {
+		packetID, refundAddr, err := types.ParseKeyFeeEscrowTime(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		escrowTime, err := sdk.ParseTimeBytes(iterator.Value())
+		if err != nil {
+			panic(err)
+		}
+
+		escrowTimes = append(escrowTimes, types.NewPacketFeeEscrowTime(packetID, refundAddr, escrowTime))
+	}
+
+	return escrowTimes
+}
+
+// GetRelayerRewards retrieves the lifetime rewards paid out to the payee on the given channel
+func (k Keeper) GetRelayerRewards(ctx context.Context, payeeAddr, channelID string) (types.Fee, bool) {
+	return k.getRewards(ctx, types.KeyRelayerRewards(payeeAddr, channelID))
+}
+
+// SetRelayerRewards stores the lifetime rewards paid out to a payee on a channel
+func (k Keeper) SetRelayerRewards(ctx context.Context, relayerRewards types.RelayerRewards) {
+	k.setRewards(ctx, types.KeyRelayerRewards(relayerRewards.Payee, relayerRewards.ChannelId), relayerRewards.Rewards)
+}
+
+// GetAllRelayerRewards returns the lifetime rewards of all payees stored in state
+func (k Keeper) GetAllRelayerRewards(ctx context.Context) []types.RelayerRewards {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.RelayerRewardsKeyPrefix+"/"))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var relayerRewards []types.RelayerRewards
+	for ; iterator.Valid(); iterator.Next() {
+		payeeAddr, channelID, err := types.ParseKeyRelayerRewards(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		var rewards types.Fee
+		k.cdc.MustUnmarshal(iterator.Value(), &rewards)
+
+		relayerRewards = append(relayerRewards, types.NewRelayerRewards(payeeAddr, channelID, rewards))
+	}
+
+	return relayerRewards
+}
+
+// GetEpochRelayerRewards retrieves the rewards paid out to the payee on the given channel during an epoch
+func (k Keeper) GetEpochRelayerRewards(ctx context.Context, epoch uint64, payeeAddr, channelID string) (types.Fee, bool) {
+	return k.getRewards(ctx, types.KeyEpochRelayerRewards(epoch, payeeAddr, channelID))
+}
+
+// SetEpochRelayerRewards stores the rewards paid out to a payee on a channel during an epoch
+func (k Keeper) SetEpochRelayerRewards(ctx context.Context, epochRelayerRewards types.EpochRelayerRewards) {
+	relayerRewards := epochRelayerRewards.RelayerRewards
+	k.setRewards(ctx, types.KeyEpochRelayerRewards(epochRelayerRewards.Epoch, relayerRewards.Payee, relayerRewards.ChannelId), relayerRewards.Rewards)
+}
+
+// GetAllEpochRelayerRewards returns the per-epoch rewards of all payees stored in state
+func (k Keeper) GetAllEpochRelayerRewards(ctx context.Context) []types.EpochRelayerRewards {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.EpochRelayerRewardsKeyPrefix+"/"))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var epochRelayerRewards []types.EpochRelayerRewards
+	for ; iterator.Valid(); iterator.Next() {
+		epoch, payeeAddr, channelID, err := types.ParseKeyEpochRelayerRewards(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		var rewards types.Fee
+		k.cdc.MustUnmarshal(iterator.Value(), &rewards)
+
+		epochRelayerRewards = append(epochRelayerRewards, types.NewEpochRelayerRewards(epoch, types.NewRelayerRewards(payeeAddr, channelID, rewards)))
+	}
+
+	return epochRelayerRewards
+}
+
+// getRewards retrieves the rewards stored under the given key
+func (k Keeper) getRewards(ctx context.Context, key []byte) (types.Fee, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(key)
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return types.Fee{}, false
+	}
+
+	var rewards types.Fee
+	k.cdc.MustUnmarshal(bz, &rewards)
+
+	return rewards, true
+}
+
+// setRewards stores the rewards under the given key
+func (k Keeper) setRewards(ctx context.Context, key []byte, rewards types.Fee) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&rewards)
+	if err := store.Set(key, bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetRewardEpoch retrieves the relayer reward epoch stored in state, a zero epoch number indicates that no epoch
+// has started
+func (k Keeper) GetRewardEpoch(ctx context.Context) types.RewardEpoch {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get([]byte(types.RewardEpochKey))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return types.RewardEpoch{}
+	}
+
+	var rewardEpoch types.RewardEpoch
+	k.cdc.MustUnmarshal(bz, &rewardEpoch)
+	return rewardEpoch
+}
+
+// SetRewardEpoch stores the relayer reward epoch
+func (k Keeper) SetRewardEpoch(ctx context.Context, rewardEpoch types.RewardEpoch) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&rewardEpoch)
+	if err := store.Set([]byte(types.RewardEpochKey), bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetCurrentRewardEpoch returns the relayer reward epoch of the current block. The stored epoch is advanced at the
+// beginning of every block, but the current epoch is derived from the stored epoch and the reward epoch length so
+// that it is also correct in the block in which per-epoch relayer reward accounting is enabled. The stored epoch is
+// returned if per-epoch relayer reward accounting is disabled.
+func (k Keeper) GetCurrentRewardEpoch(ctx context.Context) types.RewardEpoch {
+	rewardEpoch := k.GetRewardEpoch(ctx)
+
+	params := k.GetParams(ctx)
+	if !params.IsRewardEpochEnabled() {
+		return rewardEpoch
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/7223
+	return rewardEpoch.AdvanceTo(sdkCtx.BlockHeight(), params.RewardEpochBlocks)
+}
+
+// AdvanceRewardEpoch advances the stored relayer reward epoch to the epoch of the current block and returns it. When a
+// new epoch starts, the per-epoch relayer rewards of the epochs which are no longer retained are pruned. The stored
+// epoch is left unchanged if per-epoch relayer reward accounting is disabled.
+func (k Keeper) AdvanceRewardEpoch(ctx context.Context) types.RewardEpoch {
+	rewardEpoch := k.GetRewardEpoch(ctx)
+	currentEpoch := k.GetCurrentRewardEpoch(ctx)
+	if currentEpoch == rewardEpoch {
+		return currentEpoch
+	}
+
+	k.SetRewardEpoch(ctx, currentEpoch)
+
+	// the rewards of the current epoch and of the retained completed epochs are kept
+	retained := k.GetParams(ctx).RewardEpochsRetained
+	if currentEpoch.Number > retained+1 {
+		k.pruneEpochRelayerRewards(ctx, currentEpoch.Number-retained)
+	}
+
+	return currentEpoch
+}
+
+// pruneEpochRelayerRewards deletes the per-epoch rewards of all payees for the epochs before the given epoch
+func (k Keeper) pruneEpochRelayerRewards(ctx context.Context, beforeEpoch uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.EpochRelayerRewardsKeyPrefix+"/"))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		epoch, _, _, err := types.ParseKeyEpochRelayerRewards(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		if epoch < beforeEpoch {
+			keys = append(keys, iterator.Key())
+		}
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetFeeSponsorPool retrieves the fee sponsor pool of the sponsor for the given port and channel identifiers
+func (k Keeper) GetFeeSponsorPool(ctx context.Context, portID, channelID, sponsorAddr string) (types.FeeSponsorPool, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyFeeSponsorPool(portID, channelID, sponsorAddr))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return types.FeeSponsorPool{}, false
+	}
+
+	var feeSponsorPool types.FeeSponsorPool
+	k.cdc.MustUnmarshal(bz, &feeSponsorPool)
+
+	return feeSponsorPool, true
+}
+
+// SetFeeSponsorPool stores the fee sponsor pool keyed by its port and channel identifiers and sponsor address
+func (k Keeper) SetFeeSponsorPool(ctx context.Context, feeSponsorPool types.FeeSponsorPool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&feeSponsorPool)
+	if err := store.Set(types.KeyFeeSponsorPool(feeSponsorPool.PortId, feeSponsorPool.ChannelId, feeSponsorPool.Sponsor), bz); err != nil {
+		panic(err)
+	}
+}
+
+// deleteFeeSponsorPool deletes the fee sponsor pool of the sponsor for the given port and channel identifiers
+func (k Keeper) deleteFeeSponsorPool(ctx context.Context, portID, channelID, sponsorAddr string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.KeyFeeSponsorPool(portID, channelID, sponsorAddr)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllFeeSponsorPools returns the fee sponsor pools of all sponsors on all channels
+func (k Keeper) GetAllFeeSponsorPools(ctx context.Context) []types.FeeSponsorPool {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.FeeSponsorPoolKeyPrefix+"/"))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var feeSponsorPools []types.FeeSponsorPool
+	for ; iterator.Valid(); iterator.Next() {
+		var feeSponsorPool types.FeeSponsorPool
+		k.cdc.MustUnmarshal(iterator.Value(), &feeSponsorPool)
+
+		feeSponsorPools = append(feeSponsorPools, feeSponsorPool)
+	}
+
+	return feeSponsorPools
+}
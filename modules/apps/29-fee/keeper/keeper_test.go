@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	testifysuite "github.com/stretchr/testify/suite"
 
@@ -115,10 +116,112 @@ func (suite *KeeperTestSuite) TestFeesInEscrow() {
 	suite.Require().True(found)
 	suite.Require().Len(feesInEscrow.PacketFees, 5, fmt.Sprintf("expected length 5, but got %d", len(feesInEscrow.PacketFees)))
 
+	// set the escrow time of the refund address
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEscrowTime(suite.chainA.GetContext(), packetID, packetFee.RefundAddress, suite.chainA.GetContext().BlockTime())
+	escrowTime, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeEscrowTime(suite.chainA.GetContext(), packetID, packetFee.RefundAddress)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.chainA.GetContext().BlockTime(), escrowTime)
+
 	// delete fees for packet sequence 1
 	suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeesInEscrow(suite.chainA.GetContext(), packetID)
 	hasFeesInEscrow := suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID)
 	suite.Require().False(hasFeesInEscrow)
+
+	// the escrow time is deleted together with the fees
+	_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeEscrowTime(suite.chainA.GetContext(), packetID, packetFee.RefundAddress)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestGetSetParams() {
+	// default params are returned if none are set
+	params := suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().Equal(types.DefaultParams(), params)
+
+	expParams := types.NewParams(time.Hour, 0, types.DefaultRewardEpochsRetained)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), expParams)
+
+	params = suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().Equal(expParams, params)
+}
+
+func (suite *KeeperTestSuite) TestAdvanceRewardEpoch() {
+	var (
+		params      types.Params
+		height      int64
+		expEpoch    types.RewardEpoch
+		expRetained []uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: epoch not advanced within the current epoch",
+			func() {
+				height = 129
+				expEpoch = types.NewRewardEpoch(3, 120)
+				expRetained = []uint64{1, 2, 3}
+			},
+		},
+		{
+			"success: new epoch prunes the rewards of the epochs which are no longer retained",
+			func() {
+				height = 130
+				expEpoch = types.NewRewardEpoch(4, 130)
+				expRetained = []uint64{3}
+			},
+		},
+		{
+			"success: rewards of all the retained epochs are kept",
+			func() {
+				params.RewardEpochsRetained = 3
+				height = 130
+				expEpoch = types.NewRewardEpoch(4, 130)
+				expRetained = []uint64{1, 2, 3}
+			},
+		},
+		{
+			"success: epoch not advanced if per-epoch relayer reward accounting is disabled",
+			func() {
+				params.RewardEpochBlocks = 0
+				height = 150
+				expEpoch = types.NewRewardEpoch(3, 120)
+				expRetained = []uint64{1, 2, 3}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			payee := suite.chainA.SenderAccount.GetAddress().String()
+			rewards := types.NewFee(nil, nil, defaultTimeoutFee)
+
+			params = types.NewParams(0, 10, 1)
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetRewardEpoch(suite.chainA.GetContext(), types.NewRewardEpoch(3, 120))
+			for epoch := uint64(1); epoch <= 3; epoch++ {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetEpochRelayerRewards(suite.chainA.GetContext(), types.NewEpochRelayerRewards(epoch, types.NewRelayerRewards(payee, ibctesting.FirstChannelID, rewards)))
+			}
+
+			tc.malleate()
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			ctx := suite.chainA.GetContext().WithBlockHeight(height)
+			suite.Require().Equal(expEpoch, suite.chainA.GetSimApp().IBCFeeKeeper.AdvanceRewardEpoch(ctx))
+			suite.Require().Equal(expEpoch, suite.chainA.GetSimApp().IBCFeeKeeper.GetRewardEpoch(ctx))
+
+			var retained []uint64
+			for _, epochRelayerRewards := range suite.chainA.GetSimApp().IBCFeeKeeper.GetAllEpochRelayerRewards(ctx) {
+				retained = append(retained, epochRelayerRewards.Epoch)
+			}
+			suite.Require().Equal(expRetained, retained)
+		})
+	}
 }
 
 func (suite *KeeperTestSuite) TestIsLocked() {
@@ -46,6 +46,16 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return nil
 }
 
+// Migrate2to3 migrates ibc-fee module from ConsensusVersion 2 to 3
+// by indexing the packets with fees in escrow by the refund addresses of their packet fees.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	for _, identifiedFees := range m.keeper.GetAllIdentifiedPacketFees(ctx) {
+		m.keeper.SetFeesInEscrow(ctx, identifiedFees.PacketId, types.NewPacketFees(identifiedFees.PacketFees))
+	}
+
+	return nil
+}
+
 // legacyTotal returns the legacy total amount for a given Fee
 // The total amount is the RecvFee + AckFee + TimeoutFee
 func legacyTotal(f types.Fee) sdk.Coins {
@@ -199,3 +199,31 @@ func (suite *KeeperTestSuite) TestMigrate1to2() {
 		tc.assert(err)
 	}
 }
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	suite.path.Setup()
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	refundAcc := suite.chainA.SenderAccount.GetAddress()
+	otherRefundAcc := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+	packetFees := types.NewPacketFees([]types.PacketFee{
+		types.NewPacketFee(fee, refundAcc.String(), nil),
+		types.NewPacketFee(fee, otherRefundAcc.String(), nil),
+	})
+
+	// store the fees in escrow without the payer index, as they were stored before the migration
+	store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+	store.Set(types.KeyFeesInEscrow(packetID), suite.chainA.GetSimApp().IBCFeeKeeper.MustMarshalFees(packetFees))
+	suite.Require().False(store.Has(types.KeyPayerFeesInEscrow(refundAcc.String(), packetID)))
+
+	migrator := keeper.NewMigrator(suite.chainA.GetSimApp().IBCFeeKeeper)
+	err := migrator.Migrate2to3(suite.chainA.GetContext())
+	suite.Require().NoError(err)
+
+	for _, packetFee := range packetFees.PacketFees {
+		res, err := suite.chainA.GetSimApp().IBCFeeKeeper.IncentivizedPacketsForPayer(suite.chainA.GetContext(), &types.QueryIncentivizedPacketsForPayerRequest{Payer: packetFee.RefundAddress})
+		suite.Require().NoError(err)
+		suite.Require().Equal([]types.IdentifiedPacketFees{types.NewIdentifiedPacketFees(packetID, []types.PacketFee{packetFee})}, res.IncentivizedPackets)
+	}
+}
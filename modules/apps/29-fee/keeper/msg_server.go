@@ -94,6 +94,14 @@ func (k Keeper) PayPacketFee(goCtx context.Context, msg *types.MsgPayPacketFee)
 		return nil, err
 	}
 
+	// a sponsored fee is paid from the fee sponsor pool on the source channel, to which unspent fees are refunded
+	if msg.FeeSponsor != "" {
+		refundAcc, err = k.drawFeeSponsorPool(ctx, msg.SourcePortId, msg.SourceChannelId, msg.FeeSponsor, msg.Signer, msg.Fee.Total())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if err := k.bankKeeper.IsSendEnabledCoins(ctx, msg.Fee.Total()...); err != nil {
 		return nil, err
 	}
@@ -109,7 +117,7 @@ func (k Keeper) PayPacketFee(goCtx context.Context, msg *types.MsgPayPacketFee)
 	}
 
 	packetID := channeltypes.NewPacketID(msg.SourcePortId, msg.SourceChannelId, sequence)
-	packetFee := types.NewPacketFee(msg.Fee, msg.Signer, msg.Relayers)
+	packetFee := types.NewPacketFee(msg.Fee, refundAcc.String(), msg.Relayers)
 
 	if err := k.escrowPacketFee(ctx, packetID, packetFee); err != nil {
 		return nil, err
@@ -139,6 +147,17 @@ func (k Keeper) PayPacketFeeAsync(goCtx context.Context, msg *types.MsgPayPacket
 		return nil, err
 	}
 
+	// a sponsored fee is paid from the fee sponsor pool on the packet channel, to which unspent fees are refunded
+	packetFee := msg.PacketFee
+	if msg.FeeSponsor != "" {
+		refundAcc, err = k.drawFeeSponsorPool(ctx, msg.PacketId.PortId, msg.PacketId.ChannelId, msg.FeeSponsor, msg.PacketFee.RefundAddress, msg.PacketFee.Fee.Total())
+		if err != nil {
+			return nil, err
+		}
+
+		packetFee = types.NewPacketFee(msg.PacketFee.Fee, refundAcc.String(), msg.PacketFee.Relayers)
+	}
+
 	if err := k.bankKeeper.IsSendEnabledCoins(ctx, msg.PacketFee.Fee.Total()...); err != nil {
 		return nil, err
 	}
@@ -162,9 +181,262 @@ func (k Keeper) PayPacketFeeAsync(goCtx context.Context, msg *types.MsgPayPacket
 		return nil, errorsmod.Wrapf(channeltypes.ErrPacketCommitmentNotFound, "packet has already been acknowledged or timed out")
 	}
 
-	if err := k.escrowPacketFee(ctx, msg.PacketId, msg.PacketFee); err != nil {
+	if err := k.escrowPacketFee(ctx, msg.PacketId, packetFee); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgPayPacketFeeAsyncResponse{}, nil
 }
+
+// RegisterFeePreferences defines a rpc handler method for MsgRegisterFeePreferences
+// RegisterFeePreferences is called by the address to which packet fees are paid out on a channelEnd, either a relayer
+// or a registered payee, and allows them to set the fee denominations they accept. Packet fees in other denominations
+// are sent to the fallback address, if any, or refunded. This function may be called more than once, in which case,
+// the latest fee preferences are always used. The fee preferences are removed if no fee denominations are accepted.
+func (k Keeper) RegisterFeePreferences(goCtx context.Context, msg *types.MsgRegisterFeePreferences) (*types.MsgRegisterFeePreferencesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.FallbackAddress != "" {
+		fallbackAddr, err := sdk.AccAddressFromBech32(msg.FallbackAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		if k.bankKeeper.BlockedAddr(fallbackAddr) {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not authorized to be a fallback address", fallbackAddr)
+		}
+	}
+
+	// only register fee preferences if the channel exists and is fee enabled
+	if _, found := k.channelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId); !found {
+		return nil, channeltypes.ErrChannelNotFound
+	}
+
+	if !k.IsFeeEnabled(ctx, msg.PortId, msg.ChannelId) {
+		return nil, types.ErrFeeNotEnabled
+	}
+
+	if len(msg.AcceptedDenoms) == 0 {
+		k.DeleteFeePreferences(ctx, msg.PortId, msg.ChannelId, msg.Payee)
+	} else {
+		k.SetFeePreferences(ctx, types.NewRegisteredFeePreferences(msg.PortId, msg.ChannelId, msg.Payee, msg.AcceptedDenoms, msg.FallbackAddress))
+	}
+
+	k.Logger(ctx).Info("registering fee preferences for payee", "payee", msg.Payee, "accepted denoms", msg.AcceptedDenoms, "fallback address", msg.FallbackAddress, "port", msg.PortId, "channel", msg.ChannelId)
+
+	emitRegisterFeePreferencesEvent(ctx, msg.Payee, msg.AcceptedDenoms, msg.FallbackAddress, msg.PortId, msg.ChannelId)
+
+	return &types.MsgRegisterFeePreferencesResponse{}, nil
+}
+
+// UpdateChannelMinimumFee defines a rpc handler method for MsgUpdateChannelMinimumFee
+// UpdateChannelMinimumFee is called by the module authority (i.e. governance) and sets the minimum receive,
+// acknowledgement and timeout fees which must be escrowed for a packet on a fee enabled channel. An empty minimum fee
+// removes the minimum fee of the channel.
+func (k Keeper) UpdateChannelMinimumFee(goCtx context.Context, msg *types.MsgUpdateChannelMinimumFee) (*types.MsgUpdateChannelMinimumFeeResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// only set the minimum fee if the channel exists and is fee enabled
+	if _, found := k.channelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId); !found {
+		return nil, channeltypes.ErrChannelNotFound
+	}
+
+	if !k.IsFeeEnabled(ctx, msg.PortId, msg.ChannelId) {
+		return nil, types.ErrFeeNotEnabled
+	}
+
+	if msg.MinimumFee.IsEmpty() {
+		k.DeleteChannelMinimumFee(ctx, msg.PortId, msg.ChannelId)
+	} else {
+		k.SetChannelMinimumFee(ctx, msg.PortId, msg.ChannelId, msg.MinimumFee)
+	}
+
+	k.Logger(ctx).Info("updating channel minimum fee", "port", msg.PortId, "channel", msg.ChannelId, "minimum fee", msg.MinimumFee)
+
+	emitUpdateChannelMinimumFeeEvent(ctx, msg.PortId, msg.ChannelId, msg.MinimumFee)
+
+	return &types.MsgUpdateChannelMinimumFeeResponse{}, nil
+}
+
+// RefundPacketFee defines a rpc handler method for MsgRefundPacketFee
+// RefundPacketFee is called by a payer to withdraw all the fees it escrowed for a packet, once the refund grace
+// period has elapsed since the payer last escrowed a fee for the packet. Refunds are disabled if the refund grace
+// period is zero.
+func (k Keeper) RefundPacketFee(goCtx context.Context, msg *types.MsgRefundPacketFee) (*types.MsgRefundPacketFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	refundAddr, err := sdk.AccAddressFromBech32(msg.RefundAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.refundPacketFeeAfterGracePeriod(ctx, msg.PacketId, refundAddr); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("refunding packet fee", "packet ID", msg.PacketId, "refund address", msg.RefundAddress)
+
+	return &types.MsgRefundPacketFeeResponse{}, nil
+}
+
+// RefundSponsoredPacketFee defines a rpc handler method for MsgRefundSponsoredPacketFee
+// RefundSponsoredPacketFee is called by a fee sponsor to return all the fees drawn from its fee sponsor pool on the
+// packet channel for a packet to the pool, under the same conditions as RefundPacketFee. The fee sponsor pool does
+// not need to be registered anymore, so that the fees drawn from a deleted pool may still be refunded and withdrawn.
+func (k Keeper) RefundSponsoredPacketFee(goCtx context.Context, msg *types.MsgRefundSponsoredPacketFee) (*types.MsgRefundSponsoredPacketFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	poolAddr := types.GetFeeSponsorPoolAddress(msg.PacketId.PortId, msg.PacketId.ChannelId, msg.Sponsor)
+	if err := k.refundPacketFeeAfterGracePeriod(ctx, msg.PacketId, poolAddr); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("refunding sponsored packet fee", "packet ID", msg.PacketId, "sponsor", msg.Sponsor, "refund address", poolAddr)
+
+	return &types.MsgRefundSponsoredPacketFeeResponse{}, nil
+}
+
+// UpdateParams defines a rpc handler method for MsgUpdateParams
+// UpdateParams is called by the module authority (i.e. governance) and updates the fee middleware parameters.
+func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// RegisterFeeSponsorPool defines a rpc handler method for MsgRegisterFeeSponsorPool
+// RegisterFeeSponsorPool is called by a fee sponsor to create or update its fee sponsor pool on a channelEnd. The
+// senders allowed by the pool may pay packet fees on the channel from the pool funds, without paying the fees
+// themselves. This function may be called more than once, in which case, the latest allowed senders are always used.
+func (k Keeper) RegisterFeeSponsorPool(goCtx context.Context, msg *types.MsgRegisterFeeSponsorPool) (*types.MsgRegisterFeeSponsorPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// only register fee sponsor pools if the channel exists and is fee enabled
+	if _, found := k.channelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId); !found {
+		return nil, channeltypes.ErrChannelNotFound
+	}
+
+	if !k.IsFeeEnabled(ctx, msg.PortId, msg.ChannelId) {
+		return nil, types.ErrFeeNotEnabled
+	}
+
+	for _, allowance := range msg.Allowances {
+		if allowance.Expiration != nil && !allowance.Expiration.After(ctx.BlockTime()) {
+			return nil, errorsmod.Wrapf(types.ErrFeeSponsorAllowanceExpired, "allowance of sender %s expires at %s", allowance.Sender, allowance.Expiration)
+		}
+	}
+
+	feeSponsorPool := types.NewFeeSponsorPool(msg.PortId, msg.ChannelId, msg.Sponsor, msg.Allowances)
+	k.SetFeeSponsorPool(ctx, feeSponsorPool)
+
+	k.Logger(ctx).Info("registering fee sponsor pool", "sponsor", msg.Sponsor, "allowances", msg.Allowances, "port", msg.PortId, "channel", msg.ChannelId)
+
+	emitRegisterFeeSponsorPoolEvent(ctx, feeSponsorPool)
+
+	return &types.MsgRegisterFeeSponsorPoolResponse{}, nil
+}
+
+// FundFeeSponsorPool defines a rpc handler method for MsgFundFeeSponsorPool
+// FundFeeSponsorPool is called by any account, such as a protocol treasury, to deposit funds into a registered fee
+// sponsor pool
+func (k Keeper) FundFeeSponsorPool(goCtx context.Context, msg *types.MsgFundFeeSponsorPool) (*types.MsgFundFeeSponsorPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetFeeSponsorPool(ctx, msg.PortId, msg.ChannelId, msg.Sponsor); !found {
+		return nil, errorsmod.Wrapf(types.ErrFeeSponsorPoolNotFound, "sponsor (%s) port ID (%s) channel ID (%s)", msg.Sponsor, msg.PortId, msg.ChannelId)
+	}
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	poolAddr := types.GetFeeSponsorPoolAddress(msg.PortId, msg.ChannelId, msg.Sponsor)
+	if err := k.bankKeeper.SendCoins(ctx, depositor, poolAddr, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("funding fee sponsor pool", "sponsor", msg.Sponsor, "depositor", msg.Depositor, "amount", msg.Amount, "port", msg.PortId, "channel", msg.ChannelId)
+
+	emitFundFeeSponsorPoolEvent(ctx, msg.PortId, msg.ChannelId, msg.Sponsor, msg.Depositor, msg.Amount)
+
+	return &types.MsgFundFeeSponsorPoolResponse{}, nil
+}
+
+// WithdrawFeeSponsorPool defines a rpc handler method for MsgWithdrawFeeSponsorPool
+// WithdrawFeeSponsorPool is called by a fee sponsor to withdraw funds from its fee sponsor pool. The fees already
+// escrowed from the pool are not affected. Funds may also be withdrawn from a deleted pool, to which the fees drawn
+// from the pool before its deletion are refunded.
+func (k Keeper) WithdrawFeeSponsorPool(goCtx context.Context, msg *types.MsgWithdrawFeeSponsorPool) (*types.MsgWithdrawFeeSponsorPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipient)
+	}
+
+	poolAddr := types.GetFeeSponsorPoolAddress(msg.PortId, msg.ChannelId, msg.Sponsor)
+	if err := k.bankKeeper.SendCoins(ctx, poolAddr, recipient, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("withdrawing from fee sponsor pool", "sponsor", msg.Sponsor, "recipient", msg.Recipient, "amount", msg.Amount, "port", msg.PortId, "channel", msg.ChannelId)
+
+	emitWithdrawFeeSponsorPoolEvent(ctx, msg.PortId, msg.ChannelId, msg.Sponsor, msg.Recipient, msg.Amount)
+
+	return &types.MsgWithdrawFeeSponsorPoolResponse{}, nil
+}
+
+// DeleteFeeSponsorPool defines a rpc handler method for MsgDeleteFeeSponsorPool
+// DeleteFeeSponsorPool is called by a fee sponsor to delete its fee sponsor pool on a channelEnd and send the
+// remaining pool funds to the recipient. The fees already escrowed from the pool are still refunded to the pool, from
+// which they may be withdrawn with WithdrawFeeSponsorPool.
+func (k Keeper) DeleteFeeSponsorPool(goCtx context.Context, msg *types.MsgDeleteFeeSponsorPool) (*types.MsgDeleteFeeSponsorPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetFeeSponsorPool(ctx, msg.PortId, msg.ChannelId, msg.Sponsor); !found {
+		return nil, errorsmod.Wrapf(types.ErrFeeSponsorPoolNotFound, "sponsor (%s) port ID (%s) channel ID (%s)", msg.Sponsor, msg.PortId, msg.ChannelId)
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipient)
+	}
+
+	poolAddr := types.GetFeeSponsorPoolAddress(msg.PortId, msg.ChannelId, msg.Sponsor)
+	amount := k.bankKeeper.GetAllBalances(ctx, poolAddr)
+	if !amount.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, poolAddr, recipient, amount); err != nil {
+			return nil, err
+		}
+	}
+
+	k.deleteFeeSponsorPool(ctx, msg.PortId, msg.ChannelId, msg.Sponsor)
+
+	k.Logger(ctx).Info("deleting fee sponsor pool", "sponsor", msg.Sponsor, "recipient", msg.Recipient, "amount", amount, "port", msg.PortId, "channel", msg.ChannelId)
+
+	emitDeleteFeeSponsorPoolEvent(ctx, msg.PortId, msg.ChannelId, msg.Sponsor, msg.Recipient, amount)
+
+	return &types.MsgDeleteFeeSponsorPoolResponse{}, nil
+}
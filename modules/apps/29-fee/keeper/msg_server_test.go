@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -205,13 +207,231 @@ func (suite *KeeperTestSuite) TestRegisterCounterpartyPayee() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestRegisterFeePreferences() {
+	var msg *types.MsgRegisterFeePreferences
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: without fallback address",
+			func() {
+				msg.FallbackAddress = ""
+			},
+			nil,
+		},
+		{
+			"success: overwrite existing fee preferences",
+			func() {
+				feePreferences := types.NewRegisteredFeePreferences(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, msg.Payee, []string{ibctesting.SecondaryDenom}, "")
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeePreferences(suite.chainA.GetContext(), feePreferences)
+			},
+			nil,
+		},
+		{
+			"success: remove fee preferences",
+			func() {
+				feePreferences := types.NewRegisteredFeePreferences(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, msg.Payee, []string{ibctesting.SecondaryDenom}, "")
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeePreferences(suite.chainA.GetContext(), feePreferences)
+
+				msg.AcceptedDenoms = nil
+				msg.FallbackAddress = ""
+			},
+			nil,
+		},
+		{
+			"channel does not exist",
+			func() {
+				msg.ChannelId = "channel-100"
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+		{
+			"channel is not fee enabled",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			},
+			types.ErrFeeNotEnabled,
+		},
+		{
+			"fallback address is a blocked address",
+			func() {
+				msg.FallbackAddress = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(transfertypes.ModuleName).String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.path.Setup()
+
+			msg = types.NewMsgRegisterFeePreferences(
+				suite.path.EndpointA.ChannelConfig.PortID,
+				suite.path.EndpointA.ChannelID,
+				suite.chainA.SenderAccounts[0].SenderAccount.GetAddress().String(),
+				[]string{sdk.DefaultBondDenom},
+				suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(),
+			)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.RegisterFeePreferences(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				feePreferences, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeePreferences(suite.chainA.GetContext(), msg.PortId, msg.ChannelId, msg.Payee)
+				if len(msg.AcceptedDenoms) == 0 {
+					suite.Require().False(found)
+				} else {
+					suite.Require().True(found)
+					suite.Require().Equal(types.NewRegisteredFeePreferences(msg.PortId, msg.ChannelId, msg.Payee, msg.AcceptedDenoms, msg.FallbackAddress), feePreferences)
+				}
+
+				expectedEvents := sdk.Events{
+					sdk.NewEvent(
+						types.EventTypeRegisterFeePreferences,
+						sdk.NewAttribute(types.AttributeKeyPayee, msg.Payee),
+						sdk.NewAttribute(types.AttributeKeyAcceptedDenoms, strings.Join(msg.AcceptedDenoms, ",")),
+						sdk.NewAttribute(types.AttributeKeyFallbackAddress, msg.FallbackAddress),
+						sdk.NewAttribute(types.AttributeKeyPortID, suite.path.EndpointA.ChannelConfig.PortID),
+						sdk.NewAttribute(types.AttributeKeyChannelID, suite.path.EndpointA.ChannelID),
+					),
+				}.ToABCIEvents()
+
+				expectedEvents = sdk.MarkEventsToIndex(expectedEvents, map[string]struct{}{})
+				ibctesting.AssertEvents(&suite.Suite, expectedEvents, ctx.EventManager().Events().ToABCIEvents())
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateChannelMinimumFee() {
+	var msg *types.MsgUpdateChannelMinimumFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: overwrite existing minimum fee",
+			func() {
+				minimumFee := types.NewFee(defaultRecvFee, nil, nil)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetChannelMinimumFee(suite.chainA.GetContext(), msg.PortId, msg.ChannelId, minimumFee)
+			},
+			nil,
+		},
+		{
+			"success: remove minimum fee",
+			func() {
+				minimumFee := types.NewFee(defaultRecvFee, nil, nil)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetChannelMinimumFee(suite.chainA.GetContext(), msg.PortId, msg.ChannelId, minimumFee)
+
+				msg.MinimumFee = types.Fee{}
+			},
+			nil,
+		},
+		{
+			"invalid authority",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"channel does not exist",
+			func() {
+				msg.ChannelId = "channel-100"
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+		{
+			"channel is not fee enabled",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			},
+			types.ErrFeeNotEnabled,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.path.Setup()
+
+			msg = types.NewMsgUpdateChannelMinimumFee(
+				suite.chainA.GetSimApp().IBCFeeKeeper.GetAuthority(),
+				suite.path.EndpointA.ChannelConfig.PortID,
+				suite.path.EndpointA.ChannelID,
+				types.NewFee(defaultRecvFee, defaultAckFee, nil),
+			)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.UpdateChannelMinimumFee(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				minimumFee, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetChannelMinimumFee(suite.chainA.GetContext(), msg.PortId, msg.ChannelId)
+				suite.Require().Equal(!msg.MinimumFee.IsEmpty(), found)
+				if found {
+					suite.Require().Equal(msg.MinimumFee, minimumFee)
+				}
+
+				expectedEvents := sdk.Events{
+					sdk.NewEvent(
+						types.EventTypeUpdateChannelMinimumFee,
+						sdk.NewAttribute(types.AttributeKeyPortID, msg.PortId),
+						sdk.NewAttribute(types.AttributeKeyChannelID, msg.ChannelId),
+						sdk.NewAttribute(types.AttributeKeyRecvFee, msg.MinimumFee.RecvFee.String()),
+						sdk.NewAttribute(types.AttributeKeyAckFee, msg.MinimumFee.AckFee.String()),
+						sdk.NewAttribute(types.AttributeKeyTimeoutFee, msg.MinimumFee.TimeoutFee.String()),
+					),
+				}.ToABCIEvents()
+
+				expectedEvents = sdk.MarkEventsToIndex(expectedEvents, map[string]struct{}{})
+				ibctesting.AssertEvents(&suite.Suite, expectedEvents, ctx.EventManager().Events().ToABCIEvents())
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestPayPacketFee() {
 	var (
-		expEscrowBalance sdk.Coins
-		expFeesInEscrow  []types.PacketFee
-		msg              *types.MsgPayPacketFee
-		fee              types.Fee
-		eventFee         types.Fee
+		expEscrowBalance  sdk.Coins
+		expFeesInEscrow   []types.PacketFee
+		msg               *types.MsgPayPacketFee
+		fee               types.Fee
+		eventFee          types.Fee
+		expFeeSponsorPool *types.FeeSponsorPool
 	)
 
 	testCases := []struct {
@@ -266,6 +486,156 @@ func (suite *KeeperTestSuite) TestPayPacketFee() {
 			},
 			nil,
 		},
+		{
+			"success with fee denominations not accepted by any payee",
+			func() {
+				// fee preferences only apply when the fees are distributed
+				feePreferences := types.NewRegisteredFeePreferences(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), []string{ibctesting.SecondaryDenom}, "")
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeePreferences(suite.chainA.GetContext(), feePreferences)
+			},
+			nil,
+		},
+		{
+			"success with fee meeting the channel minimum fee",
+			func() {
+				minimumFee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetChannelMinimumFee(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, minimumFee)
+			},
+			nil,
+		},
+		{
+			"success with fee paid from a fee sponsor pool",
+			func() {
+				sponsor := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+				feeSponsorPool := types.NewFeeSponsorPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sponsor.String(), []types.FeeSponsorAllowance{types.NewFeeSponsorAllowance(msg.Signer, nil, nil)})
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeSponsorPool(suite.chainA.GetContext(), feeSponsorPool)
+
+				poolAddr := types.GetFeeSponsorPoolAddress(feeSponsorPool.PortId, feeSponsorPool.ChannelId, feeSponsorPool.Sponsor)
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoins(suite.chainA.GetContext(), sponsor, poolAddr, fee.Total())
+				suite.Require().NoError(err)
+
+				msg.FeeSponsor = sponsor.String()
+				expFeesInEscrow = []types.PacketFee{types.NewPacketFee(fee, poolAddr.String(), nil)}
+				expFeeSponsorPool = &feeSponsorPool
+			},
+			nil,
+		},
+		{
+			"success with fee drawn from the spend limit of a fee sponsor allowance",
+			func() {
+				sponsor := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+				expiration := suite.chainA.GetContext().BlockTime().Add(time.Hour)
+				allowance := types.NewFeeSponsorAllowance(msg.Signer, fee.Total().Add(defaultRecvFee...), &expiration)
+				feeSponsorPool := types.NewFeeSponsorPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sponsor.String(), []types.FeeSponsorAllowance{allowance})
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeSponsorPool(suite.chainA.GetContext(), feeSponsorPool)
+
+				poolAddr := types.GetFeeSponsorPoolAddress(feeSponsorPool.PortId, feeSponsorPool.ChannelId, feeSponsorPool.Sponsor)
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoins(suite.chainA.GetContext(), sponsor, poolAddr, fee.Total())
+				suite.Require().NoError(err)
+
+				msg.FeeSponsor = sponsor.String()
+				expFeesInEscrow = []types.PacketFee{types.NewPacketFee(fee, poolAddr.String(), nil)}
+
+				feeSponsorPool.Allowances[0].SpendLimit = defaultRecvFee
+				expFeeSponsorPool = &feeSponsorPool
+			},
+			nil,
+		},
+		{
+			"success with the spend limit of a fee sponsor allowance used up",
+			func() {
+				sponsor := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+				allowance := types.NewFeeSponsorAllowance(msg.Signer, fee.Total(), nil)
+				feeSponsorPool := types.NewFeeSponsorPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sponsor.String(), []types.FeeSponsorAllowance{allowance})
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeSponsorPool(suite.chainA.GetContext(), feeSponsorPool)
+
+				poolAddr := types.GetFeeSponsorPoolAddress(feeSponsorPool.PortId, feeSponsorPool.ChannelId, feeSponsorPool.Sponsor)
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoins(suite.chainA.GetContext(), sponsor, poolAddr, fee.Total())
+				suite.Require().NoError(err)
+
+				msg.FeeSponsor = sponsor.String()
+				expFeesInEscrow = []types.PacketFee{types.NewPacketFee(fee, poolAddr.String(), nil)}
+
+				// the allowance is removed from the pool once its spend limit is used up
+				feeSponsorPool.Allowances = nil
+				expFeeSponsorPool = &feeSponsorPool
+			},
+			nil,
+		},
+		{
+			"fee sponsor pool not found",
+			func() {
+				msg.FeeSponsor = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			},
+			types.ErrFeeSponsorPoolNotFound,
+		},
+		{
+			"sender not allowed by the fee sponsor pool",
+			func() {
+				sponsor := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+				feeSponsorPool := types.NewFeeSponsorPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sponsor, []types.FeeSponsorAllowance{types.NewFeeSponsorAllowance(suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String(), nil, nil)})
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeSponsorPool(suite.chainA.GetContext(), feeSponsorPool)
+
+				msg.FeeSponsor = sponsor
+			},
+			types.ErrSenderNotAllowed,
+		},
+		{
+			"fee sponsor pool balance insufficient",
+			func() {
+				sponsor := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+				feeSponsorPool := types.NewFeeSponsorPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sponsor.String(), []types.FeeSponsorAllowance{types.NewFeeSponsorAllowance(msg.Signer, nil, nil)})
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeSponsorPool(suite.chainA.GetContext(), feeSponsorPool)
+
+				poolAddr := types.GetFeeSponsorPoolAddress(feeSponsorPool.PortId, feeSponsorPool.ChannelId, feeSponsorPool.Sponsor)
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoins(suite.chainA.GetContext(), sponsor, poolAddr, defaultRecvFee)
+				suite.Require().NoError(err)
+
+				msg.FeeSponsor = sponsor.String()
+			},
+			sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			"fee exceeds the spend limit of the fee sponsor allowance",
+			func() {
+				sponsor := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+				allowance := types.NewFeeSponsorAllowance(msg.Signer, defaultRecvFee, nil)
+				feeSponsorPool := types.NewFeeSponsorPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sponsor.String(), []types.FeeSponsorAllowance{allowance})
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeSponsorPool(suite.chainA.GetContext(), feeSponsorPool)
+
+				poolAddr := types.GetFeeSponsorPoolAddress(feeSponsorPool.PortId, feeSponsorPool.ChannelId, feeSponsorPool.Sponsor)
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoins(suite.chainA.GetContext(), sponsor, poolAddr, fee.Total())
+				suite.Require().NoError(err)
+
+				msg.FeeSponsor = sponsor.String()
+			},
+			types.ErrFeeSponsorSpendLimitExceeded,
+		},
+		{
+			"fee sponsor allowance expired",
+			func() {
+				sponsor := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+				expiration := suite.chainA.GetContext().BlockTime().Add(-time.Second)
+				allowance := types.NewFeeSponsorAllowance(msg.Signer, nil, &expiration)
+				feeSponsorPool := types.NewFeeSponsorPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sponsor.String(), []types.FeeSponsorAllowance{allowance})
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeSponsorPool(suite.chainA.GetContext(), feeSponsorPool)
+
+				poolAddr := types.GetFeeSponsorPoolAddress(feeSponsorPool.PortId, feeSponsorPool.ChannelId, feeSponsorPool.Sponsor)
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoins(suite.chainA.GetContext(), sponsor, poolAddr, fee.Total())
+				suite.Require().NoError(err)
+
+				msg.FeeSponsor = sponsor.String()
+			},
+			types.ErrFeeSponsorAllowanceExpired,
+		},
+		{
+			"fee below the channel minimum fee",
+			func() {
+				minimumFee := types.NewFee(nil, defaultAckFee.Add(defaultAckFee...), nil)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetChannelMinimumFee(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, minimumFee)
+			},
+			types.ErrFeeBelowMinimum,
+		},
 		{
 			"fee module is locked",
 			func() {
@@ -358,6 +728,7 @@ func (suite *KeeperTestSuite) TestPayPacketFee() {
 			expPacketFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
 			expFeesInEscrow = []types.PacketFee{expPacketFee}
 			eventFee = fee
+			expFeeSponsorPool = nil
 
 			tc.malleate()
 
@@ -375,6 +746,12 @@ func (suite *KeeperTestSuite) TestPayPacketFee() {
 				escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
 				suite.Require().Equal(expEscrowBalance.AmountOf(sdk.DefaultBondDenom), escrowBalance.Amount)
 
+				if expFeeSponsorPool != nil {
+					feeSponsorPool, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeSponsorPool(suite.chainA.GetContext(), expFeeSponsorPool.PortId, expFeeSponsorPool.ChannelId, expFeeSponsorPool.Sponsor)
+					suite.Require().True(found)
+					suite.Require().Equal(*expFeeSponsorPool, feeSponsorPool)
+				}
+
 				expectedEvents := sdk.Events{
 					sdk.NewEvent(
 						types.EventTypeIncentivizedPacket,
@@ -448,6 +825,42 @@ func (suite *KeeperTestSuite) TestPayPacketFeeAsync() {
 			},
 			nil,
 		},
+		{
+			"success with fee paid from a fee sponsor pool",
+			func() {
+				sponsor := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+				allowance := types.NewFeeSponsorAllowance(msg.PacketFee.RefundAddress, msg.PacketFee.Fee.Total(), nil)
+				feeSponsorPool := types.NewFeeSponsorPool(msg.PacketId.PortId, msg.PacketId.ChannelId, sponsor.String(), []types.FeeSponsorAllowance{allowance})
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeSponsorPool(suite.chainA.GetContext(), feeSponsorPool)
+
+				poolAddr := types.GetFeeSponsorPoolAddress(feeSponsorPool.PortId, feeSponsorPool.ChannelId, feeSponsorPool.Sponsor)
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoins(suite.chainA.GetContext(), sponsor, poolAddr, msg.PacketFee.Fee.Total())
+				suite.Require().NoError(err)
+
+				msg.FeeSponsor = sponsor.String()
+				expFeesInEscrow = []types.PacketFee{types.NewPacketFee(msg.PacketFee.Fee, poolAddr.String(), nil)}
+			},
+			nil,
+		},
+		{
+			"fee sponsor pool not found",
+			func() {
+				msg.FeeSponsor = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			},
+			types.ErrFeeSponsorPoolNotFound,
+		},
+		{
+			"fee exceeds the spend limit of the fee sponsor allowance",
+			func() {
+				sponsor := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+				allowance := types.NewFeeSponsorAllowance(msg.PacketFee.RefundAddress, defaultRecvFee, nil)
+				feeSponsorPool := types.NewFeeSponsorPool(msg.PacketId.PortId, msg.PacketId.ChannelId, sponsor.String(), []types.FeeSponsorAllowance{allowance})
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeSponsorPool(suite.chainA.GetContext(), feeSponsorPool)
+
+				msg.FeeSponsor = sponsor.String()
+			},
+			types.ErrFeeSponsorSpendLimitExceeded,
+		},
 		{
 			"fee module is locked",
 			func() {
@@ -601,6 +1014,10 @@ func (suite *KeeperTestSuite) TestPayPacketFeeAsync() {
 				suite.Require().True(found)
 				suite.Require().Equal(expFeesInEscrow, feesInEscrow.PacketFees)
 
+				escrowTime, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeEscrowTime(suite.chainA.GetContext(), packetID, expFeesInEscrow[len(expFeesInEscrow)-1].RefundAddress)
+				suite.Require().True(found)
+				suite.Require().Equal(suite.chainA.GetContext().BlockTime(), escrowTime)
+
 				escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
 				suite.Require().Equal(expEscrowBalance.AmountOf(sdk.DefaultBondDenom), escrowBalance.Amount)
 			} else {
@@ -612,3 +1029,708 @@ func (suite *KeeperTestSuite) TestPayPacketFeeAsync() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestRefundPacketFee() {
+	var (
+		msg             *types.MsgRefundPacketFee
+		expFeesInEscrow []types.PacketFee
+	)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: fees escrowed by other payers are kept in escrow",
+			func() {
+				otherPayer := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+				otherPacketFee := types.NewPacketFee(fee, otherPayer.String(), nil)
+
+				feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), msg.PacketId)
+				suite.Require().True(found)
+
+				feesInEscrow.PacketFees = append(feesInEscrow.PacketFees, otherPacketFee)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), msg.PacketId, feesInEscrow)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEscrowTime(suite.chainA.GetContext(), msg.PacketId, otherPayer.String(), suite.chainA.GetContext().BlockTime())
+
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), otherPayer, types.ModuleName, fee.Total())
+				suite.Require().NoError(err)
+
+				expFeesInEscrow = []types.PacketFee{otherPacketFee}
+			},
+			nil,
+		},
+		{
+			"fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+			types.ErrFeeModuleLocked,
+		},
+		{
+			"refunds are disabled",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), types.DefaultParams())
+			},
+			types.ErrUnsupportedAction,
+		},
+		{
+			"refund grace period has not elapsed",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEscrowTime(suite.chainA.GetContext(), msg.PacketId, msg.RefundAddress, suite.chainA.GetContext().BlockTime())
+			},
+			types.ErrRefundGracePeriodNotElapsed,
+		},
+		{
+			"fee escrowed without an escrow time",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEscrowTime(suite.chainA.GetContext(), msg.PacketId, msg.RefundAddress)
+			},
+			types.ErrUnsupportedAction,
+		},
+		{
+			"no fees escrowed for the packet",
+			func() {
+				msg.PacketId.Sequence++
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEscrowTime(suite.chainA.GetContext(), msg.PacketId, msg.RefundAddress, suite.chainA.GetContext().BlockTime().Add(-2*time.Hour))
+			},
+			types.ErrFeeNotFound,
+		},
+		{
+			"no fees escrowed by the refund address",
+			func() {
+				msg.RefundAddress = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEscrowTime(suite.chainA.GetContext(), msg.PacketId, msg.RefundAddress, suite.chainA.GetContext().BlockTime().Add(-2*time.Hour))
+			},
+			types.ErrFeeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.path.Setup()
+
+			expFeesInEscrow = nil
+
+			refundAddr := suite.chainA.SenderAccount.GetAddress()
+			packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+			packetFee := types.NewPacketFee(fee, refundAddr.String(), nil)
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(time.Hour, 0, types.DefaultRewardEpochsRetained))
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEscrowTime(suite.chainA.GetContext(), packetID, refundAddr.String(), suite.chainA.GetContext().BlockTime().Add(-2*time.Hour))
+
+			err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), refundAddr, types.ModuleName, fee.Total())
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgRefundPacketFee(packetID, refundAddr.String())
+
+			tc.malleate()
+
+			balanceBefore := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAddr, sdk.DefaultBondDenom)
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.RefundPacketFee(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				balanceAfter := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAddr, sdk.DefaultBondDenom)
+				suite.Require().Equal(balanceBefore.Add(fee.Total()[0]), balanceAfter)
+
+				feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+				suite.Require().Equal(len(expFeesInEscrow) > 0, found)
+				suite.Require().Equal(expFeesInEscrow, feesInEscrow.PacketFees)
+
+				_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeEscrowTime(suite.chainA.GetContext(), packetID, refundAddr.String())
+				suite.Require().False(found)
+
+				expectedEvents := sdk.Events{
+					sdk.NewEvent(
+						types.EventTypeRefundPacketFee,
+						sdk.NewAttribute(channeltypes.AttributeKeyPortID, packetID.PortId),
+						sdk.NewAttribute(channeltypes.AttributeKeyChannelID, packetID.ChannelId),
+						sdk.NewAttribute(channeltypes.AttributeKeySequence, fmt.Sprint(packetID.Sequence)),
+						sdk.NewAttribute(types.AttributeKeyRefundAddress, refundAddr.String()),
+						sdk.NewAttribute(types.AttributeKeyFee, fee.Total().String()),
+					),
+				}.ToABCIEvents()
+
+				expectedEvents = sdk.MarkEventsToIndex(expectedEvents, map[string]struct{}{})
+				ibctesting.AssertEvents(&suite.Suite, expectedEvents, ctx.EventManager().Events().ToABCIEvents())
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+
+				balanceAfter := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAddr, sdk.DefaultBondDenom)
+				suite.Require().Equal(balanceBefore, balanceAfter)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundPacketFeeInsufficientEscrowBalance() {
+	suite.path.Setup()
+
+	refundAddr := suite.chainA.SenderAccount.GetAddress()
+	packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+	packetFee := types.NewPacketFee(types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), refundAddr.String(), nil)
+
+	// escrow the packet fee in state without funding the escrow account
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(time.Hour, 0, types.DefaultRewardEpochsRetained))
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEscrowTime(suite.chainA.GetContext(), packetID, refundAddr.String(), suite.chainA.GetContext().BlockTime().Add(-2*time.Hour))
+
+	balanceBefore := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAddr, sdk.DefaultBondDenom)
+
+	res, err := suite.chainA.GetSimApp().IBCFeeKeeper.RefundPacketFee(suite.chainA.GetContext(), types.NewMsgRefundPacketFee(packetID, refundAddr.String()))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	// the fee module is locked and the fees are kept in escrow
+	suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+	suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+
+	balanceAfter := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAddr, sdk.DefaultBondDenom)
+	suite.Require().Equal(balanceBefore, balanceAfter)
+}
+
+func (suite *KeeperTestSuite) TestRefundSponsoredPacketFee() {
+	var msg *types.MsgRefundSponsoredPacketFee
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: fee sponsor pool deleted",
+			func() {
+				_, err := suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeSponsorPool(suite.chainA.GetContext(), types.NewMsgDeleteFeeSponsorPool(msg.PacketId.PortId, msg.PacketId.ChannelId, msg.Sponsor, msg.Sponsor))
+				suite.Require().NoError(err)
+			},
+			nil,
+		},
+		{
+			"fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+			types.ErrFeeModuleLocked,
+		},
+		{
+			"refund grace period has not elapsed",
+			func() {
+				poolAddr := types.GetFeeSponsorPoolAddress(msg.PacketId.PortId, msg.PacketId.ChannelId, msg.Sponsor)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEscrowTime(suite.chainA.GetContext(), msg.PacketId, poolAddr.String(), suite.chainA.GetContext().BlockTime())
+			},
+			types.ErrRefundGracePeriodNotElapsed,
+		},
+		{
+			"no fees escrowed from the fee sponsor pool of the sponsor",
+			func() {
+				msg.Sponsor = suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String()
+			},
+			types.ErrUnsupportedAction,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.path.Setup()
+
+			sponsor := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+
+			feeSponsorPool := types.NewFeeSponsorPool(packetID.PortId, packetID.ChannelId, sponsor.String(), []types.FeeSponsorAllowance{types.NewFeeSponsorAllowance(suite.chainA.SenderAccount.GetAddress().String(), nil, nil)})
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeSponsorPool(suite.chainA.GetContext(), feeSponsorPool)
+
+			poolAddr := types.GetFeeSponsorPoolAddress(packetID.PortId, packetID.ChannelId, sponsor.String())
+			packetFee := types.NewPacketFee(fee, poolAddr.String(), nil)
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(time.Hour, 0, types.DefaultRewardEpochsRetained))
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEscrowTime(suite.chainA.GetContext(), packetID, poolAddr.String(), suite.chainA.GetContext().BlockTime().Add(-2*time.Hour))
+
+			err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), sponsor, types.ModuleName, fee.Total())
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgRefundSponsoredPacketFee(packetID, sponsor.String())
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.RefundSponsoredPacketFee(suite.chainA.GetContext(), msg)
+
+			poolBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), poolAddr)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				// the fees are refunded to the fee sponsor pool, from which the sponsor may withdraw them
+				suite.Require().Equal(fee.Total(), poolBalance)
+				suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+				suite.Require().True(poolBalance.IsZero())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	validAuthority := suite.chainA.GetSimApp().IBCFeeKeeper.GetAuthority()
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateParams
+		expErr error
+	}{
+		{
+			"success",
+			types.NewMsgUpdateParams(validAuthority, types.NewParams(time.Hour, 0, types.DefaultRewardEpochsRetained)),
+			nil,
+		},
+		{
+			"invalid authority",
+			types.NewMsgUpdateParams(suite.chainA.SenderAccount.GetAddress().String(), types.NewParams(time.Hour, 0, types.DefaultRewardEpochsRetained)),
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.UpdateParams(ctx, tc.msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(tc.msg.Params, suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(ctx))
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterFeeSponsorPool() {
+	var msg *types.MsgRegisterFeeSponsorPool
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: overwrite existing fee sponsor pool",
+			func() {
+				feeSponsorPool := types.NewFeeSponsorPool(msg.PortId, msg.ChannelId, msg.Sponsor, []types.FeeSponsorAllowance{types.NewFeeSponsorAllowance(suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String(), nil, nil)})
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeSponsorPool(suite.chainA.GetContext(), feeSponsorPool)
+			},
+			nil,
+		},
+		{
+			"success: allowance expiring after the current block time",
+			func() {
+				expiration := suite.chainA.GetContext().BlockTime().Add(time.Hour)
+				msg.Allowances[0].Expiration = &expiration
+			},
+			nil,
+		},
+		{
+			"allowance already expired",
+			func() {
+				expiration := suite.chainA.GetContext().BlockTime()
+				msg.Allowances[0].Expiration = &expiration
+			},
+			types.ErrFeeSponsorAllowanceExpired,
+		},
+		{
+			"channel does not exist",
+			func() {
+				msg.ChannelId = "channel-100"
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+		{
+			"channel is not fee enabled",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			},
+			types.ErrFeeNotEnabled,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.path.Setup()
+
+			msg = types.NewMsgRegisterFeeSponsorPool(
+				suite.path.EndpointA.ChannelConfig.PortID,
+				suite.path.EndpointA.ChannelID,
+				suite.chainA.SenderAccounts[0].SenderAccount.GetAddress().String(),
+				[]types.FeeSponsorAllowance{types.NewFeeSponsorAllowance(suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), defaultRecvFee, nil)},
+			)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.RegisterFeeSponsorPool(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				feeSponsorPool, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeSponsorPool(suite.chainA.GetContext(), msg.PortId, msg.ChannelId, msg.Sponsor)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewFeeSponsorPool(msg.PortId, msg.ChannelId, msg.Sponsor, msg.Allowances), feeSponsorPool)
+
+				expectedEvents := sdk.Events{
+					sdk.NewEvent(
+						types.EventTypeRegisterFeeSponsorPool,
+						sdk.NewAttribute(types.AttributeKeyPortID, msg.PortId),
+						sdk.NewAttribute(types.AttributeKeyChannelID, msg.ChannelId),
+						sdk.NewAttribute(types.AttributeKeySponsor, msg.Sponsor),
+						sdk.NewAttribute(types.AttributeKeyAllowedSenders, msg.Allowances[0].Sender),
+					),
+				}.ToABCIEvents()
+
+				expectedEvents = sdk.MarkEventsToIndex(expectedEvents, map[string]struct{}{})
+				ibctesting.AssertEvents(&suite.Suite, expectedEvents, ctx.EventManager().Events().ToABCIEvents())
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestFundFeeSponsorPool() {
+	var msg *types.MsgFundFeeSponsorPool
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: depositor is not the sponsor",
+			func() {
+				msg.Depositor = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			},
+			nil,
+		},
+		{
+			"fee sponsor pool not found",
+			func() {
+				msg.ChannelId = "channel-100"
+			},
+			types.ErrFeeSponsorPoolNotFound,
+		},
+		{
+			"bank send disabled for denom",
+			func() {
+				err := suite.chainA.GetSimApp().BankKeeper.SetParams(suite.chainA.GetContext(),
+					banktypes.Params{
+						SendEnabled: []*banktypes.SendEnabled{{Denom: sdk.DefaultBondDenom, Enabled: false}},
+					},
+				)
+				suite.Require().NoError(err)
+			},
+			banktypes.ErrSendDisabled,
+		},
+		{
+			"depositor balance insufficient",
+			func() {
+				msg.Amount = invalidCoins
+			},
+			sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.path.Setup()
+
+			sponsor := suite.chainA.SenderAccount.GetAddress().String()
+			feeSponsorPool := types.NewFeeSponsorPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sponsor, []types.FeeSponsorAllowance{types.NewFeeSponsorAllowance(suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String(), nil, nil)})
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeSponsorPool(suite.chainA.GetContext(), feeSponsorPool)
+
+			msg = types.NewMsgFundFeeSponsorPool(feeSponsorPool.PortId, feeSponsorPool.ChannelId, sponsor, sponsor, defaultRecvFee)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.FundFeeSponsorPool(ctx, msg)
+
+			poolAddr := types.GetFeeSponsorPoolAddress(feeSponsorPool.PortId, feeSponsorPool.ChannelId, sponsor)
+			poolBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), poolAddr)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(msg.Amount, poolBalance)
+
+				expectedEvents := sdk.Events{
+					sdk.NewEvent(
+						types.EventTypeFundFeeSponsorPool,
+						sdk.NewAttribute(types.AttributeKeyPortID, msg.PortId),
+						sdk.NewAttribute(types.AttributeKeyChannelID, msg.ChannelId),
+						sdk.NewAttribute(types.AttributeKeySponsor, msg.Sponsor),
+						sdk.NewAttribute(types.AttributeKeyDepositor, msg.Depositor),
+						sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+					),
+				}.ToABCIEvents()
+
+				expectedEvents = sdk.MarkEventsToIndex(expectedEvents, map[string]struct{}{})
+				ibctesting.AssertEvents(&suite.Suite, expectedEvents, ctx.EventManager().Events().ToABCIEvents())
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+				suite.Require().True(poolBalance.IsZero())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestWithdrawFeeSponsorPool() {
+	var msg *types.MsgWithdrawFeeSponsorPool
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: fee sponsor pool deleted",
+			func() {
+				_, err := suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeSponsorPool(suite.chainA.GetContext(), types.NewMsgDeleteFeeSponsorPool(msg.PortId, msg.ChannelId, msg.Sponsor, msg.Sponsor))
+				suite.Require().NoError(err)
+
+				// fees drawn from the pool before its deletion are refunded to the pool
+				poolAddr := types.GetFeeSponsorPoolAddress(msg.PortId, msg.ChannelId, msg.Sponsor)
+				err = suite.chainA.GetSimApp().BankKeeper.SendCoins(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), poolAddr, msg.Amount)
+				suite.Require().NoError(err)
+			},
+			nil,
+		},
+		{
+			"recipient is a blocked address",
+			func() {
+				msg.Recipient = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(transfertypes.ModuleName).String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"fee sponsor pool balance insufficient",
+			func() {
+				msg.Amount = msg.Amount.Add(msg.Amount...)
+			},
+			sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.path.Setup()
+
+			sponsor := suite.chainA.SenderAccount.GetAddress()
+			recipient := suite.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+
+			feeSponsorPool := types.NewFeeSponsorPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sponsor.String(), []types.FeeSponsorAllowance{types.NewFeeSponsorAllowance(recipient.String(), nil, nil)})
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeSponsorPool(suite.chainA.GetContext(), feeSponsorPool)
+
+			poolAddr := types.GetFeeSponsorPoolAddress(feeSponsorPool.PortId, feeSponsorPool.ChannelId, sponsor.String())
+			err := suite.chainA.GetSimApp().BankKeeper.SendCoins(suite.chainA.GetContext(), sponsor, poolAddr, defaultRecvFee)
+			suite.Require().NoError(err)
+
+			recipientBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), recipient, sdk.DefaultBondDenom)
+
+			msg = types.NewMsgWithdrawFeeSponsorPool(feeSponsorPool.PortId, feeSponsorPool.ChannelId, sponsor.String(), recipient.String(), defaultRecvFee)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.WithdrawFeeSponsorPool(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				poolBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), poolAddr)
+				suite.Require().True(poolBalance.IsZero())
+
+				expRecipientBalance := recipientBalance.Add(defaultRecvFee[0])
+				suite.Require().Equal(expRecipientBalance, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), recipient, sdk.DefaultBondDenom))
+
+				expectedEvents := sdk.Events{
+					sdk.NewEvent(
+						types.EventTypeWithdrawFeeSponsorPool,
+						sdk.NewAttribute(types.AttributeKeyPortID, msg.PortId),
+						sdk.NewAttribute(types.AttributeKeyChannelID, msg.ChannelId),
+						sdk.NewAttribute(types.AttributeKeySponsor, msg.Sponsor),
+						sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+						sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+					),
+				}.ToABCIEvents()
+
+				expectedEvents = sdk.MarkEventsToIndex(expectedEvents, map[string]struct{}{})
+				ibctesting.AssertEvents(&suite.Suite, expectedEvents, ctx.EventManager().Events().ToABCIEvents())
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDeleteFeeSponsorPool() {
+	var (
+		msg       *types.MsgDeleteFeeSponsorPool
+		expAmount sdk.Coins
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: empty fee sponsor pool",
+			func() {
+				poolAddr := types.GetFeeSponsorPoolAddress(msg.PortId, msg.ChannelId, msg.Sponsor)
+				_, err := suite.chainA.GetSimApp().IBCFeeKeeper.WithdrawFeeSponsorPool(suite.chainA.GetContext(), types.NewMsgWithdrawFeeSponsorPool(msg.PortId, msg.ChannelId, msg.Sponsor, msg.Sponsor, suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), poolAddr)))
+				suite.Require().NoError(err)
+
+				expAmount = sdk.NewCoins()
+			},
+			nil,
+		},
+		{
+			"fee sponsor pool not found",
+			func() {
+				msg.Sponsor = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			},
+			types.ErrFeeSponsorPoolNotFound,
+		},
+		{
+			"recipient is a blocked address",
+			func() {
+				msg.Recipient = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(transfertypes.ModuleName).String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.path.Setup()
+
+			sponsor := suite.chainA.SenderAccount.GetAddress()
+			recipient := suite.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+
+			feeSponsorPool := types.NewFeeSponsorPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sponsor.String(), []types.FeeSponsorAllowance{types.NewFeeSponsorAllowance(recipient.String(), nil, nil)})
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeSponsorPool(suite.chainA.GetContext(), feeSponsorPool)
+
+			poolAddr := types.GetFeeSponsorPoolAddress(feeSponsorPool.PortId, feeSponsorPool.ChannelId, sponsor.String())
+			err := suite.chainA.GetSimApp().BankKeeper.SendCoins(suite.chainA.GetContext(), sponsor, poolAddr, defaultRecvFee)
+			suite.Require().NoError(err)
+
+			expAmount = defaultRecvFee
+			msg = types.NewMsgDeleteFeeSponsorPool(feeSponsorPool.PortId, feeSponsorPool.ChannelId, sponsor.String(), recipient.String())
+
+			tc.malleate()
+
+			recipientBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), recipient)
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeSponsorPool(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeSponsorPool(suite.chainA.GetContext(), msg.PortId, msg.ChannelId, msg.Sponsor)
+				suite.Require().False(found)
+
+				poolBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), poolAddr)
+				suite.Require().True(poolBalance.IsZero())
+				suite.Require().Equal(recipientBalance.Add(expAmount...), suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), recipient))
+
+				expectedEvents := sdk.Events{
+					sdk.NewEvent(
+						types.EventTypeDeleteFeeSponsorPool,
+						sdk.NewAttribute(types.AttributeKeyPortID, msg.PortId),
+						sdk.NewAttribute(types.AttributeKeyChannelID, msg.ChannelId),
+						sdk.NewAttribute(types.AttributeKeySponsor, msg.Sponsor),
+						sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+						sdk.NewAttribute(types.AttributeKeyAmount, expAmount.String()),
+					),
+				}.ToABCIEvents()
+
+				expectedEvents = sdk.MarkEventsToIndex(expectedEvents, map[string]struct{}{})
+				ibctesting.AssertEvents(&suite.Suite, expectedEvents, ctx.EventManager().Events().ToABCIEvents())
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+
+				_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeSponsorPool(suite.chainA.GetContext(), feeSponsorPool.PortId, feeSponsorPool.ChannelId, feeSponsorPool.Sponsor)
+				suite.Require().True(found)
+			}
+		})
+	}
+}
@@ -15,6 +15,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgPayPacketFeeAsync{}, "cosmos-sdk/MsgPayPacketFeeAsync")
 	legacy.RegisterAminoMsg(cdc, &MsgRegisterPayee{}, "cosmos-sdk/MsgRegisterPayee")
 	legacy.RegisterAminoMsg(cdc, &MsgRegisterCounterpartyPayee{}, "cosmos-sdk/MsgRegisterCounterpartyPayee")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterFeePreferences{}, "cosmos-sdk/MsgRegisterFeePreferences")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateChannelMinimumFee{}, "cosmos-sdk/MsgUpdateChannelMinimumFee")
+	legacy.RegisterAminoMsg(cdc, &MsgRefundPacketFee{}, "cosmos-sdk/MsgRefundPacketFee")
+	legacy.RegisterAminoMsg(cdc, &MsgRefundSponsoredPacketFee{}, "cosmos-sdk/MsgRefundSponsoredPacketFee")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/MsgFeeUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterFeeSponsorPool{}, "cosmos-sdk/MsgRegisterFeeSponsorPool")
+	legacy.RegisterAminoMsg(cdc, &MsgFundFeeSponsorPool{}, "cosmos-sdk/MsgFundFeeSponsorPool")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawFeeSponsorPool{}, "cosmos-sdk/MsgWithdrawFeeSponsorPool")
+	legacy.RegisterAminoMsg(cdc, &MsgDeleteFeeSponsorPool{}, "cosmos-sdk/MsgDeleteFeeSponsorPool")
 }
 
 // RegisterInterfaces register the 29-fee module interfaces to protobuf
@@ -26,6 +35,15 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgPayPacketFeeAsync{},
 		&MsgRegisterPayee{},
 		&MsgRegisterCounterpartyPayee{},
+		&MsgRegisterFeePreferences{},
+		&MsgUpdateChannelMinimumFee{},
+		&MsgRefundPacketFee{},
+		&MsgRefundSponsoredPacketFee{},
+		&MsgUpdateParams{},
+		&MsgRegisterFeeSponsorPool{},
+		&MsgFundFeeSponsorPool{},
+		&MsgWithdrawFeeSponsorPool{},
+		&MsgDeleteFeeSponsorPool{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -40,6 +40,51 @@ func TestCodecTypeRegistration(t *testing.T) {
 			sdk.MsgTypeURL(&types.MsgRegisterCounterpartyPayee{}),
 			nil,
 		},
+		{
+			"success: MsgRegisterFeePreferences",
+			sdk.MsgTypeURL(&types.MsgRegisterFeePreferences{}),
+			nil,
+		},
+		{
+			"success: MsgUpdateChannelMinimumFee",
+			sdk.MsgTypeURL(&types.MsgUpdateChannelMinimumFee{}),
+			nil,
+		},
+		{
+			"success: MsgRefundPacketFee",
+			sdk.MsgTypeURL(&types.MsgRefundPacketFee{}),
+			nil,
+		},
+		{
+			"success: MsgRefundSponsoredPacketFee",
+			sdk.MsgTypeURL(&types.MsgRefundSponsoredPacketFee{}),
+			nil,
+		},
+		{
+			"success: MsgUpdateParams",
+			sdk.MsgTypeURL(&types.MsgUpdateParams{}),
+			nil,
+		},
+		{
+			"success: MsgRegisterFeeSponsorPool",
+			sdk.MsgTypeURL(&types.MsgRegisterFeeSponsorPool{}),
+			nil,
+		},
+		{
+			"success: MsgFundFeeSponsorPool",
+			sdk.MsgTypeURL(&types.MsgFundFeeSponsorPool{}),
+			nil,
+		},
+		{
+			"success: MsgWithdrawFeeSponsorPool",
+			sdk.MsgTypeURL(&types.MsgWithdrawFeeSponsorPool{}),
+			nil,
+		},
+		{
+			"success: MsgDeleteFeeSponsorPool",
+			sdk.MsgTypeURL(&types.MsgDeleteFeeSponsorPool{}),
+			nil,
+		},
 		{
 			"type not registered on codec",
 			"ibc.invalid.MsgTypeURL",
@@ -17,4 +17,12 @@ var (
 	ErrRelayerNotFoundForAsyncAck    = errorsmod.Register(ModuleName, 10, "relayer address must be stored for async WriteAcknowledgement")
 	ErrFeeModuleLocked               = errorsmod.Register(ModuleName, 11, "the fee module is currently locked, a severe bug has been detected")
 	ErrUnsupportedAction             = errorsmod.Register(ModuleName, 12, "unsupported action")
+	ErrInvalidFeePreferences         = errorsmod.Register(ModuleName, 13, "invalid fee preferences")
+	ErrFeeBelowMinimum               = errorsmod.Register(ModuleName, 14, "fee is below the minimum fee of the channel")
+	ErrRefundGracePeriodNotElapsed   = errorsmod.Register(ModuleName, 15, "the refund grace period of the packet fee has not elapsed")
+	ErrInvalidFeeSponsorPool         = errorsmod.Register(ModuleName, 16, "invalid fee sponsor pool")
+	ErrFeeSponsorPoolNotFound        = errorsmod.Register(ModuleName, 17, "fee sponsor pool not found")
+	ErrSenderNotAllowed              = errorsmod.Register(ModuleName, 18, "sender is not allowed to pay packet fees from the fee sponsor pool")
+	ErrFeeSponsorAllowanceExpired    = errorsmod.Register(ModuleName, 19, "fee sponsor allowance has expired")
+	ErrFeeSponsorSpendLimitExceeded  = errorsmod.Register(ModuleName, 20, "fee exceeds the spend limit of the fee sponsor allowance")
 )
@@ -6,14 +6,30 @@ const (
 	EventTypeRegisterPayee             = "register_payee"
 	EventTypeRegisterCounterpartyPayee = "register_counterparty_payee"
 	EventTypeDistributeFee             = "distribute_fee"
+	EventTypeRegisterFeePreferences    = "register_fee_preferences"
+	EventTypeUpdateChannelMinimumFee   = "update_channel_minimum_fee"
+	EventTypeRefundPacketFee           = "refund_packet_fee"
+	EventTypeRegisterFeeSponsorPool    = "register_fee_sponsor_pool"
+	EventTypeFundFeeSponsorPool        = "fund_fee_sponsor_pool"
+	EventTypeWithdrawFeeSponsorPool    = "withdraw_fee_sponsor_pool"
+	EventTypeDeleteFeeSponsorPool      = "delete_fee_sponsor_pool"
 
 	AttributeKeyRecvFee           = "recv_fee"
 	AttributeKeyAckFee            = "ack_fee"
 	AttributeKeyTimeoutFee        = "timeout_fee"
+	AttributeKeyPortID            = "port_id"
 	AttributeKeyChannelID         = "channel_id"
 	AttributeKeyRelayer           = "relayer"
 	AttributeKeyPayee             = "payee"
 	AttributeKeyCounterpartyPayee = "counterparty_payee"
 	AttributeKeyReceiver          = "receiver"
 	AttributeKeyFee               = "fee"
+	AttributeKeyAcceptedDenoms    = "accepted_denoms"
+	AttributeKeyFallbackAddress   = "fallback_address"
+	AttributeKeyRefundAddress     = "refund_address"
+	AttributeKeySponsor           = "sponsor"
+	AttributeKeyAllowedSenders    = "allowed_senders"
+	AttributeKeyDepositor         = "depositor"
+	AttributeKeyRecipient         = "recipient"
+	AttributeKeyAmount            = "amount"
 )
@@ -67,6 +67,11 @@ func (f Fee) Total() sdk.Coins {
 	return f.RecvFee.Add(f.AckFee...).Max(f.TimeoutFee)
 }
 
+// Add returns the sum of the receive, acknowledgement and timeout fees of both fees
+func (f Fee) Add(fee Fee) Fee {
+	return NewFee(f.RecvFee.Add(fee.RecvFee...), f.AckFee.Add(fee.AckFee...), f.TimeoutFee.Add(fee.TimeoutFee...))
+}
+
 // Validate asserts that each Fee is valid and all three Fees are not empty or zero
 func (f Fee) Validate() error {
 	var errFees []string
@@ -11,16 +11,20 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types1 "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -257,50 +261,124 @@ func (m *IdentifiedPacketFees) GetPacketFees() []PacketFee {
 	return nil
 }
 
+// Params defines the set of ICS29 fee middleware parameters.
+type Params struct {
+	// the duration a payer must wait after escrowing a packet fee before the unspent fee may be refunded
+	// with MsgRefundPacketFee, refunds are disabled if zero
+	RefundGracePeriod time.Duration `protobuf:"bytes,1,opt,name=refund_grace_period,json=refundGracePeriod,proto3,stdduration" json:"refund_grace_period"`
+	// the number of blocks of a relayer reward epoch, per-epoch relayer reward accounting is disabled if zero
+	RewardEpochBlocks uint64 `protobuf:"varint,2,opt,name=reward_epoch_blocks,json=rewardEpochBlocks,proto3" json:"reward_epoch_blocks,omitempty"`
+	// the number of completed relayer reward epochs for which the per-epoch relayer rewards are retained, the per-epoch
+	// relayer rewards of older epochs are pruned when a new epoch starts
+	RewardEpochsRetained uint64 `protobuf:"varint,3,opt,name=reward_epochs_retained,json=rewardEpochsRetained,proto3" json:"reward_epochs_retained,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{4}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRefundGracePeriod() time.Duration {
+	if m != nil {
+		return m.RefundGracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetRewardEpochBlocks() uint64 {
+	if m != nil {
+		return m.RewardEpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetRewardEpochsRetained() uint64 {
+	if m != nil {
+		return m.RewardEpochsRetained
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Fee)(nil), "ibc.applications.fee.v1.Fee")
 	proto.RegisterType((*PacketFee)(nil), "ibc.applications.fee.v1.PacketFee")
 	proto.RegisterType((*PacketFees)(nil), "ibc.applications.fee.v1.PacketFees")
 	proto.RegisterType((*IdentifiedPacketFees)(nil), "ibc.applications.fee.v1.IdentifiedPacketFees")
+	proto.RegisterType((*Params)(nil), "ibc.applications.fee.v1.Params")
 }
 
 func init() { proto.RegisterFile("ibc/applications/fee/v1/fee.proto", fileDescriptor_cb3319f1af2a53e5) }
 
 var fileDescriptor_cb3319f1af2a53e5 = []byte{
-	// 518 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xe3, 0x04, 0xb5, 0xcd, 0x05, 0x90, 0x30, 0x95, 0x5a, 0x2a, 0x70, 0x8b, 0x25, 0xa4,
-	0xa8, 0x52, 0xee, 0x94, 0x00, 0x43, 0x99, 0x68, 0x90, 0x22, 0x65, 0x02, 0x65, 0x41, 0x62, 0x89,
-	0xce, 0xe7, 0x17, 0xf7, 0x14, 0xdb, 0x67, 0xf9, 0xec, 0xa0, 0x0c, 0x2c, 0x7c, 0x02, 0x56, 0x58,
-	0xd9, 0x98, 0xfa, 0x31, 0x3a, 0x76, 0x64, 0x02, 0x94, 0x0c, 0xfd, 0x02, 0x7c, 0x00, 0xf4, 0xce,
-	0x87, 0x55, 0x15, 0x75, 0x61, 0xe8, 0xe2, 0xbb, 0x77, 0xef, 0xdd, 0xfb, 0xfd, 0xef, 0xdd, 0xf3,
-	0x91, 0xc7, 0x32, 0x10, 0x8c, 0x67, 0x59, 0x2c, 0x05, 0x2f, 0xa4, 0x4a, 0x35, 0x9b, 0x01, 0xb0,
-	0x45, 0x1f, 0x07, 0x9a, 0xe5, 0xaa, 0x50, 0xee, 0x8e, 0x0c, 0x04, 0xbd, 0x1c, 0x42, 0xd1, 0xb7,
-	0xe8, 0xef, 0xdd, 0xe3, 0x89, 0x4c, 0x15, 0x33, 0xdf, 0x2a, 0x76, 0xcf, 0x13, 0x4a, 0x27, 0x4a,
-	0xb3, 0x80, 0x6b, 0xcc, 0x12, 0x40, 0xc1, 0xfb, 0x4c, 0x28, 0x99, 0x5a, 0xff, 0x76, 0xa4, 0x22,
-	0x65, 0xa6, 0x0c, 0x67, 0x76, 0xd5, 0x88, 0x10, 0x2a, 0x07, 0x26, 0x4e, 0x78, 0x9a, 0x42, 0x8c,
-	0x02, 0xec, 0xd4, 0x86, 0xec, 0xd8, 0xc4, 0x89, 0x8e, 0xd0, 0x99, 0xe8, 0xa8, 0x72, 0xf8, 0xbf,
-	0x9b, 0xa4, 0x35, 0x02, 0x70, 0xdf, 0x93, 0xad, 0x1c, 0xc4, 0x62, 0x3a, 0x03, 0xd8, 0x75, 0x0e,
-	0x5a, 0xdd, 0xce, 0xe0, 0x01, 0xad, 0xf6, 0x50, 0x14, 0x43, 0xad, 0x18, 0xfa, 0x4a, 0xc9, 0x74,
-	0x78, 0x7c, 0xf6, 0x63, 0xbf, 0xf1, 0xed, 0xe7, 0x7e, 0x37, 0x92, 0xc5, 0x49, 0x19, 0x50, 0xa1,
-	0x12, 0x66, 0x01, 0xd5, 0xd0, 0xd3, 0xe1, 0x9c, 0x15, 0xcb, 0x0c, 0xb4, 0xd9, 0xa0, 0xbf, 0x5c,
-	0x9c, 0x1e, 0xde, 0x8e, 0x21, 0xe2, 0x62, 0x39, 0xc5, 0xe3, 0xe8, 0xc9, 0x26, 0xd2, 0x10, 0x5c,
-	0x92, 0x4d, 0x2e, 0xe6, 0x86, 0xdb, 0xbc, 0x01, 0xee, 0x06, 0x17, 0x73, 0xc4, 0x7e, 0x20, 0x9d,
-	0x42, 0x26, 0xa0, 0xca, 0xc2, 0xa0, 0x5b, 0x37, 0x80, 0x26, 0x16, 0x38, 0x02, 0xf0, 0x3f, 0x3b,
-	0xa4, 0xfd, 0x86, 0x8b, 0x39, 0xa0, 0xe5, 0x3e, 0x23, 0xad, 0xaa, 0xee, 0x4e, 0xb7, 0x33, 0x78,
-	0x48, 0xaf, 0x69, 0x18, 0x3a, 0x02, 0x18, 0xde, 0x42, 0x1d, 0x13, 0x0c, 0x77, 0x9f, 0x90, 0xbb,
-	0x39, 0xcc, 0xca, 0x34, 0x9c, 0xf2, 0x30, 0xcc, 0x41, 0xeb, 0xdd, 0xe6, 0x81, 0xd3, 0x6d, 0x4f,
-	0xee, 0x54, 0xab, 0xc7, 0xd5, 0xa2, 0xbb, 0x87, 0x37, 0x1b, 0xf3, 0x25, 0xe4, 0xda, 0x1c, 0xb3,
-	0x3d, 0xa9, 0xed, 0x17, 0xf7, 0x3f, 0x5e, 0x9c, 0x1e, 0x5e, 0xc9, 0xe2, 0xbf, 0x25, 0xa4, 0x96,
-	0xa6, 0xdd, 0x31, 0xe9, 0x64, 0xc6, 0xc2, 0x3a, 0x69, 0xdb, 0x1b, 0xfe, 0xb5, 0x1a, 0xeb, 0x9d,
-	0x56, 0x29, 0xc9, 0xea, 0x54, 0xfe, 0x57, 0x87, 0x6c, 0x8f, 0x43, 0x48, 0x0b, 0x39, 0x93, 0x10,
-	0x5e, 0x62, 0xbc, 0x24, 0x6d, 0xcb, 0x90, 0xa1, 0xad, 0xc2, 0x23, 0x43, 0xc0, 0xa6, 0xa6, 0x7f,
-	0x3b, 0xb9, 0xce, 0x3e, 0x0e, 0x6d, 0xf2, 0xad, 0xcc, 0xda, 0x57, 0x55, 0x36, 0xff, 0x5f, 0xe5,
-	0xf0, 0xf5, 0xd9, 0xca, 0x73, 0xce, 0x57, 0x9e, 0xf3, 0x6b, 0xe5, 0x39, 0x9f, 0xd6, 0x5e, 0xe3,
-	0x7c, 0xed, 0x35, 0xbe, 0xaf, 0xbd, 0xc6, 0xbb, 0xe7, 0xff, 0xde, 0xbd, 0x0c, 0x44, 0x2f, 0x52,
-	0x6c, 0x71, 0xc4, 0x12, 0x15, 0x96, 0x31, 0x68, 0x7c, 0x0c, 0x34, 0x1b, 0x1c, 0xf5, 0xf0, 0x1d,
-	0x30, 0xed, 0x10, 0x6c, 0x98, 0x3f, 0xed, 0xe9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6a, 0x44,
-	0xf0, 0xac, 0x2c, 0x04, 0x00, 0x00,
+	// 630 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0x8f, 0x93, 0xaa, 0x6d, 0x2e, 0x80, 0x54, 0xb7, 0xa2, 0x7f, 0x04, 0x6e, 0x89, 0x84, 0x14,
+	0x55, 0xea, 0x9d, 0x5a, 0xca, 0x50, 0x26, 0x1a, 0xa0, 0xa8, 0x13, 0x95, 0x19, 0x90, 0x58, 0xac,
+	0xf3, 0xf9, 0xc5, 0x3d, 0xc5, 0xf6, 0x59, 0x77, 0x76, 0xaa, 0x0e, 0x2c, 0x7c, 0x02, 0x46, 0x60,
+	0x65, 0x63, 0xea, 0x77, 0x60, 0xe9, 0xd8, 0x91, 0x89, 0xa2, 0x64, 0xe8, 0x17, 0xe0, 0x03, 0xa0,
+	0x3b, 0x5f, 0xa2, 0xa8, 0xa8, 0x13, 0x52, 0x17, 0xfb, 0x9e, 0x7f, 0xef, 0xf7, 0x7e, 0xbf, 0x7b,
+	0x7e, 0x77, 0xe8, 0x11, 0x0f, 0x19, 0xa1, 0x79, 0x9e, 0x70, 0x46, 0x0b, 0x2e, 0x32, 0x45, 0x7a,
+	0x00, 0x64, 0xb0, 0xad, 0x5f, 0x38, 0x97, 0xa2, 0x10, 0xee, 0x32, 0x0f, 0x19, 0x9e, 0x4e, 0xc1,
+	0x1a, 0x1b, 0x6c, 0xaf, 0x2d, 0xd0, 0x94, 0x67, 0x82, 0x98, 0x67, 0x95, 0xbb, 0xe6, 0x31, 0xa1,
+	0x52, 0xa1, 0x48, 0x48, 0x95, 0xae, 0x12, 0x42, 0x41, 0xb7, 0x09, 0x13, 0x3c, 0xb3, 0xf8, 0x52,
+	0x2c, 0x62, 0x61, 0x96, 0x44, 0xaf, 0xc6, 0xac, 0x58, 0x88, 0x38, 0x01, 0x62, 0xa2, 0xb0, 0xec,
+	0x91, 0xa8, 0x94, 0x46, 0xca, 0xe2, 0xc6, 0x24, 0x13, 0x12, 0x08, 0x3b, 0xa6, 0x59, 0x06, 0x89,
+	0x36, 0x68, 0x97, 0x36, 0x65, 0xd9, 0x0a, 0xa7, 0x2a, 0xd6, 0x60, 0xaa, 0xe2, 0x0a, 0x68, 0xff,
+	0xa9, 0xa3, 0xc6, 0x01, 0x80, 0x7b, 0x82, 0xe6, 0x25, 0xb0, 0x41, 0xd0, 0x03, 0x58, 0x71, 0x36,
+	0x1a, 0x9d, 0xd6, 0xce, 0x2a, 0xae, 0x38, 0x58, 0x9b, 0xc5, 0xd6, 0x2c, 0x7e, 0x21, 0x78, 0xd6,
+	0xdd, 0x3f, 0xff, 0xb5, 0x5e, 0xfb, 0x7e, 0xb9, 0xde, 0x89, 0x79, 0x71, 0x5c, 0x86, 0x98, 0x89,
+	0x94, 0x58, 0x81, 0xea, 0xb5, 0xa5, 0xa2, 0x3e, 0x29, 0x4e, 0x73, 0x50, 0x86, 0xa0, 0xbe, 0x5e,
+	0x9d, 0x6d, 0xde, 0x49, 0x20, 0xa6, 0xec, 0x34, 0xd0, 0xdb, 0x55, 0xfe, 0x9c, 0x56, 0xd3, 0xc2,
+	0x25, 0x9a, 0xa3, 0xac, 0x6f, 0x74, 0xeb, 0xb7, 0xa0, 0x3b, 0x4b, 0x59, 0x5f, 0xcb, 0x7e, 0x40,
+	0xad, 0x82, 0xa7, 0x20, 0xca, 0xc2, 0x48, 0x37, 0x6e, 0x41, 0x1a, 0x59, 0xc1, 0x03, 0x80, 0xf6,
+	0x17, 0x07, 0x35, 0x8f, 0x28, 0xeb, 0x83, 0x8e, 0xdc, 0x5d, 0xd4, 0xa8, 0xfa, 0xee, 0x74, 0x5a,
+	0x3b, 0x0f, 0xf0, 0x0d, 0x03, 0x85, 0x0f, 0x00, 0xba, 0x33, 0xda, 0x87, 0xaf, 0xd3, 0xdd, 0xc7,
+	0xe8, 0x9e, 0x84, 0x5e, 0x99, 0x45, 0x01, 0x8d, 0x22, 0x09, 0x4a, 0xad, 0xd4, 0x37, 0x9c, 0x4e,
+	0xd3, 0xbf, 0x5b, 0x7d, 0xdd, 0xaf, 0x3e, 0xba, 0x6b, 0xfa, 0xcf, 0x26, 0xf4, 0x14, 0xa4, 0x32,
+	0xdb, 0x6c, 0xfa, 0x93, 0xf8, 0xd9, 0xe2, 0xc7, 0xab, 0xb3, 0xcd, 0x6b, 0x55, 0xda, 0xef, 0x10,
+	0x9a, 0x58, 0x53, 0xee, 0x21, 0x6a, 0xe5, 0x26, 0xd2, 0x7d, 0x52, 0x76, 0x36, 0xda, 0x37, 0x7a,
+	0x9c, 0x30, 0xad, 0x53, 0x94, 0x4f, 0x4a, 0xb5, 0xbf, 0x39, 0x68, 0xe9, 0x30, 0x82, 0xac, 0xe0,
+	0x3d, 0x0e, 0xd1, 0x94, 0xc6, 0x73, 0xd4, 0xb4, 0x1a, 0x3c, 0xb2, 0x5d, 0x78, 0x68, 0x14, 0xf4,
+	0x50, 0xe3, 0xf1, 0x24, 0x4f, 0xaa, 0x1f, 0x46, 0xb6, 0xf8, 0x7c, 0x6e, 0xe3, 0xeb, 0x2e, 0xeb,
+	0xff, 0xe1, 0xf2, 0x87, 0x83, 0x66, 0x8f, 0xa8, 0xa4, 0xa9, 0x72, 0xdf, 0xa2, 0x45, 0xdb, 0x9b,
+	0x58, 0x52, 0x06, 0x41, 0x0e, 0x92, 0x8b, 0xb1, 0xc3, 0x55, 0x5c, 0x1d, 0x4b, 0x3c, 0x3e, 0x96,
+	0xf8, 0xa5, 0x3d, 0x96, 0xdd, 0x79, 0x5d, 0xf4, 0xf3, 0xe5, 0xba, 0xe3, 0x2f, 0x54, 0xfc, 0xd7,
+	0x9a, 0x7e, 0x64, 0xd8, 0x2e, 0xd6, 0x45, 0x4f, 0xa8, 0x8c, 0x02, 0xc8, 0x05, 0x3b, 0x0e, 0xc2,
+	0x44, 0xb0, 0x7e, 0xf5, 0xef, 0x66, 0xfc, 0x85, 0x0a, 0x7a, 0xa5, 0x91, 0xae, 0x01, 0xdc, 0x5d,
+	0x74, 0x7f, 0x3a, 0x5f, 0x05, 0x12, 0x0a, 0xca, 0x33, 0x88, 0x56, 0x1a, 0x86, 0xb2, 0x34, 0x45,
+	0x51, 0xbe, 0xc5, 0xba, 0x6f, 0xce, 0x87, 0x9e, 0x73, 0x31, 0xf4, 0x9c, 0xdf, 0x43, 0xcf, 0xf9,
+	0x34, 0xf2, 0x6a, 0x17, 0x23, 0xaf, 0xf6, 0x73, 0xe4, 0xd5, 0xde, 0x3f, 0xfd, 0x77, 0x82, 0x79,
+	0xc8, 0xb6, 0x62, 0x41, 0x06, 0x7b, 0x24, 0x15, 0x51, 0x99, 0x80, 0xd2, 0x57, 0x9e, 0x22, 0x3b,
+	0x7b, 0x5b, 0xfa, 0xb6, 0x33, 0x43, 0x1d, 0xce, 0x9a, 0x6d, 0x3e, 0xf9, 0x3b, 0x00, 0xf0, 0xb8,
+	0xed, 0x0c, 0x12, 0x05, 0x00, 0x00,
 }
 
 func (m *Fee) Marshal() (dAtA []byte, err error) {
@@ -501,6 +579,47 @@ func (m *IdentifiedPacketFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RewardEpochsRetained != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.RewardEpochsRetained))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RewardEpochBlocks != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.RewardEpochBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RefundGracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RefundGracePeriod):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintFee(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFee(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFee(v)
 	base := offset
@@ -592,6 +711,23 @@ func (m *IdentifiedPacketFees) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RefundGracePeriod)
+	n += 1 + l + sovFee(uint64(l))
+	if m.RewardEpochBlocks != 0 {
+		n += 1 + sovFee(uint64(m.RewardEpochBlocks))
+	}
+	if m.RewardEpochsRetained != 0 {
+		n += 1 + sovFee(uint64(m.RewardEpochsRetained))
+	}
+	return n
+}
+
 func sovFee(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1098,6 +1234,127 @@ func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RefundGracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEpochBlocks", wireType)
+			}
+			m.RewardEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEpochsRetained", wireType)
+			}
+			m.RewardEpochsRetained = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardEpochsRetained |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipFee(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
+// NewRegisteredFeePreferences creates and returns a new RegisteredFeePreferences instance
+func NewRegisteredFeePreferences(portID, channelID, payeeAddr string, acceptedDenoms []string, fallbackAddr string) RegisteredFeePreferences {
+	return RegisteredFeePreferences{
+		PortId:          portID,
+		ChannelId:       channelID,
+		Payee:           payeeAddr,
+		AcceptedDenoms:  acceptedDenoms,
+		FallbackAddress: fallbackAddr,
+	}
+}
+
+// Validate performs basic validation of the fee preferences
+func (p RegisteredFeePreferences) Validate() error {
+	if err := host.PortIdentifierValidator(p.PortId); err != nil {
+		return errorsmod.Wrapf(err, "invalid port identifier: %s", p.PortId)
+	}
+
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid channel identifier: %s", p.ChannelId)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.Payee); err != nil {
+		return errorsmod.Wrap(err, "failed to convert payee address into sdk.AccAddress")
+	}
+
+	return ValidateFeePreferences(p.AcceptedDenoms, p.FallbackAddress)
+}
+
+// IsDenomAccepted returns true if the fee denomination is accepted by the payee
+func (p RegisteredFeePreferences) IsDenomAccepted(denom string) bool {
+	return slices.Contains(p.AcceptedDenoms, denom)
+}
+
+// SplitFee splits the fee into the coins with a denomination accepted by the payee and the other coins
+func (p RegisteredFeePreferences) SplitFee(fee sdk.Coins) (accepted sdk.Coins, notAccepted sdk.Coins) {
+	for _, coin := range fee {
+		if p.IsDenomAccepted(coin.Denom) {
+			accepted = accepted.Add(coin)
+		} else {
+			notAccepted = notAccepted.Add(coin)
+		}
+	}
+
+	return accepted, notAccepted
+}
+
+// ValidateFeePreferences validates the accepted fee denominations, which must be a non-empty list of unique valid
+// denominations, and the optional fallback address.
+func ValidateFeePreferences(acceptedDenoms []string, fallbackAddr string) error {
+	if len(acceptedDenoms) == 0 {
+		return errorsmod.Wrap(ErrInvalidFeePreferences, "accepted fee denominations cannot be empty")
+	}
+
+	seen := make(map[string]struct{}, len(acceptedDenoms))
+	for _, denom := range acceptedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errorsmod.Wrapf(ErrInvalidFeePreferences, "invalid accepted fee denomination %s: %v", denom, err)
+		}
+
+		if _, found := seen[denom]; found {
+			return errorsmod.Wrapf(ErrInvalidFeePreferences, "duplicate accepted fee denomination %s", denom)
+		}
+
+		seen[denom] = struct{}{}
+	}
+
+	if fallbackAddr != "" {
+		if _, err := sdk.AccAddressFromBech32(fallbackAddr); err != nil {
+			return errorsmod.Wrap(err, "failed to convert fallback address into sdk.AccAddress")
+		}
+	}
+
+	return nil
+}
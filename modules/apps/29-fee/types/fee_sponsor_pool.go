@@ -0,0 +1,124 @@
+package types
+
+import (
+	"slices"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
+// NewFeeSponsorPool creates and returns a new FeeSponsorPool instance
+func NewFeeSponsorPool(portID, channelID, sponsorAddr string, allowances []FeeSponsorAllowance) FeeSponsorPool {
+	return FeeSponsorPool{
+		PortId:     portID,
+		ChannelId:  channelID,
+		Sponsor:    sponsorAddr,
+		Allowances: allowances,
+	}
+}
+
+// Validate performs basic validation of the fee sponsor pool. A pool may have no allowances left once the spend
+// limits of all its allowances have been drawn.
+func (p FeeSponsorPool) Validate() error {
+	if err := host.PortIdentifierValidator(p.PortId); err != nil {
+		return errorsmod.Wrapf(err, "invalid port identifier: %s", p.PortId)
+	}
+
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid channel identifier: %s", p.ChannelId)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.Sponsor); err != nil {
+		return errorsmod.Wrap(err, "failed to convert sponsor address into sdk.AccAddress")
+	}
+
+	seen := make(map[string]struct{}, len(p.Allowances))
+	for _, allowance := range p.Allowances {
+		if err := allowance.Validate(); err != nil {
+			return err
+		}
+
+		if _, found := seen[allowance.Sender]; found {
+			return errorsmod.Wrapf(ErrInvalidFeeSponsorPool, "duplicate allowance for sender address %s", allowance.Sender)
+		}
+
+		seen[allowance.Sender] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetAllowance returns the allowance of the sender in the fee sponsor pool, if the sender is allowed to pay packet
+// fees from the pool
+func (p FeeSponsorPool) GetAllowance(senderAddr string) (FeeSponsorAllowance, bool) {
+	idx := slices.IndexFunc(p.Allowances, func(allowance FeeSponsorAllowance) bool { return allowance.Sender == senderAddr })
+	if idx == -1 {
+		return FeeSponsorAllowance{}, false
+	}
+
+	return p.Allowances[idx], true
+}
+
+// SetAllowance replaces the allowance of its sender in the fee sponsor pool
+func (p *FeeSponsorPool) SetAllowance(allowance FeeSponsorAllowance) {
+	idx := slices.IndexFunc(p.Allowances, func(a FeeSponsorAllowance) bool { return a.Sender == allowance.Sender })
+	if idx == -1 {
+		p.Allowances = append(p.Allowances, allowance)
+		return
+	}
+
+	p.Allowances[idx] = allowance
+}
+
+// RemoveAllowance removes the allowance of the sender from the fee sponsor pool
+func (p *FeeSponsorPool) RemoveAllowance(senderAddr string) {
+	p.Allowances = slices.DeleteFunc(p.Allowances, func(allowance FeeSponsorAllowance) bool { return allowance.Sender == senderAddr })
+}
+
+// NewFeeSponsorAllowance creates and returns a new FeeSponsorAllowance instance. An empty spend limit does not limit
+// the fees the sender may draw and a nil expiration never expires.
+func NewFeeSponsorAllowance(senderAddr string, spendLimit sdk.Coins, expiration *time.Time) FeeSponsorAllowance {
+	return FeeSponsorAllowance{
+		Sender:     senderAddr,
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}
+}
+
+// Validate performs basic validation of the fee sponsor allowance
+func (a FeeSponsorAllowance) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Sender); err != nil {
+		return errorsmod.Wrapf(ErrInvalidFeeSponsorPool, "invalid allowed sender address %s: %v", a.Sender, err)
+	}
+
+	if !a.SpendLimit.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidFeeSponsorPool, "invalid spend limit %s of allowed sender address %s", a.SpendLimit, a.Sender)
+	}
+
+	return nil
+}
+
+// Accept draws the fee from the allowance at the given block time, reducing its spend limit, if any. It returns
+// true if the allowance is used up and should be removed from the fee sponsor pool.
+func (a *FeeSponsorAllowance) Accept(blockTime time.Time, fee sdk.Coins) (bool, error) {
+	if a.Expiration != nil && a.Expiration.Before(blockTime) {
+		return true, errorsmod.Wrapf(ErrFeeSponsorAllowanceExpired, "allowance of sender %s expired at %s", a.Sender, a.Expiration)
+	}
+
+	if a.SpendLimit.Empty() {
+		return false, nil
+	}
+
+	left, isNeg := a.SpendLimit.SafeSub(fee...)
+	if isNeg {
+		return false, errorsmod.Wrapf(ErrFeeSponsorSpendLimitExceeded, "fee %s, spend limit %s", fee, a.SpendLimit)
+	}
+
+	a.SpendLimit = left
+
+	return left.IsZero(), nil
+}
@@ -0,0 +1,229 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/29-fee/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func TestFeeSponsorPoolValidate(t *testing.T) {
+	var feeSponsorPool types.FeeSponsorPool
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: multiple allowances",
+			func() {
+				feeSponsorPool.Allowances = append(feeSponsorPool.Allowances, types.NewFeeSponsorAllowance(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(), defaultRecvFee, nil))
+			},
+			nil,
+		},
+		{
+			"success: empty allowances",
+			func() {
+				feeSponsorPool.Allowances = nil
+			},
+			nil,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				feeSponsorPool.PortId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				feeSponsorPool.ChannelId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid sponsor address",
+			func() {
+				feeSponsorPool.Sponsor = ibctesting.InvalidID
+			},
+			errors.New("failed to convert sponsor address into sdk.AccAddress"),
+		},
+		{
+			"invalid allowed sender address",
+			func() {
+				feeSponsorPool.Allowances[0].Sender = ibctesting.InvalidID
+			},
+			types.ErrInvalidFeeSponsorPool,
+		},
+		{
+			"invalid spend limit",
+			func() {
+				feeSponsorPool.Allowances[0].SpendLimit = invalidFee
+			},
+			types.ErrInvalidFeeSponsorPool,
+		},
+		{
+			"duplicate allowed sender address",
+			func() {
+				feeSponsorPool.Allowances = append(feeSponsorPool.Allowances, types.NewFeeSponsorAllowance(defaultAccAddress, nil, nil))
+			},
+			types.ErrInvalidFeeSponsorPool,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			allowance := types.NewFeeSponsorAllowance(defaultAccAddress, defaultRecvFee, nil)
+			feeSponsorPool = types.NewFeeSponsorPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAccAddress, []types.FeeSponsorAllowance{allowance})
+
+			tc.malleate()
+
+			err := feeSponsorPool.Validate()
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				ibctesting.RequireErrorIsOrContains(t, err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func TestFeeSponsorPoolAllowances(t *testing.T) {
+	otherAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	feeSponsorPool := types.NewFeeSponsorPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAccAddress, []types.FeeSponsorAllowance{types.NewFeeSponsorAllowance(defaultAccAddress, nil, nil)})
+
+	allowance, found := feeSponsorPool.GetAllowance(defaultAccAddress)
+	require.True(t, found)
+	require.Equal(t, defaultAccAddress, allowance.Sender)
+
+	_, found = feeSponsorPool.GetAllowance(otherAddress)
+	require.False(t, found)
+
+	feeSponsorPool.SetAllowance(types.NewFeeSponsorAllowance(otherAddress, defaultRecvFee, nil))
+	allowance, found = feeSponsorPool.GetAllowance(otherAddress)
+	require.True(t, found)
+	require.Equal(t, defaultRecvFee, allowance.SpendLimit)
+
+	feeSponsorPool.SetAllowance(types.NewFeeSponsorAllowance(otherAddress, defaultAckFee, nil))
+	allowance, found = feeSponsorPool.GetAllowance(otherAddress)
+	require.True(t, found)
+	require.Equal(t, defaultAckFee, allowance.SpendLimit)
+	require.Len(t, feeSponsorPool.Allowances, 2)
+
+	feeSponsorPool.RemoveAllowance(defaultAccAddress)
+	_, found = feeSponsorPool.GetAllowance(defaultAccAddress)
+	require.False(t, found)
+	require.Len(t, feeSponsorPool.Allowances, 1)
+}
+
+func TestFeeSponsorAllowanceAccept(t *testing.T) {
+	var (
+		allowance types.FeeSponsorAllowance
+		fee       sdk.Coins
+	)
+
+	blockTime := time.Unix(1_000_000, 0).UTC()
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expRemove    bool
+		expSpendLeft sdk.Coins
+		expErr       error
+	}{
+		{
+			"success: spend limit not reached",
+			func() {},
+			false,
+			defaultRecvFee,
+			nil,
+		},
+		{
+			"success: spend limit reached",
+			func() {
+				fee = fee.Add(defaultRecvFee...)
+			},
+			true,
+			sdk.Coins{},
+			nil,
+		},
+		{
+			"success: no spend limit",
+			func() {
+				allowance.SpendLimit = nil
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"success: not yet expired",
+			func() {
+				expiration := blockTime.Add(time.Second)
+				allowance.Expiration = &expiration
+			},
+			false,
+			defaultRecvFee,
+			nil,
+		},
+		{
+			"spend limit exceeded",
+			func() {
+				fee = fee.Add(defaultRecvFee...).Add(defaultRecvFee...)
+			},
+			false,
+			defaultRecvFee.Add(defaultRecvFee...),
+			types.ErrFeeSponsorSpendLimitExceeded,
+		},
+		{
+			"allowance expired",
+			func() {
+				expiration := blockTime.Add(-time.Second)
+				allowance.Expiration = &expiration
+			},
+			true,
+			defaultRecvFee.Add(defaultRecvFee...),
+			types.ErrFeeSponsorAllowanceExpired,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			allowance = types.NewFeeSponsorAllowance(defaultAccAddress, defaultRecvFee.Add(defaultRecvFee...), nil)
+			fee = defaultRecvFee
+
+			tc.malleate()
+
+			remove, err := allowance.Accept(blockTime, fee)
+
+			require.Equal(t, tc.expRemove, remove)
+			require.Equal(t, tc.expSpendLeft, allowance.SpendLimit)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
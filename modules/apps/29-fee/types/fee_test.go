@@ -128,6 +128,20 @@ func TestFeeTotal(t *testing.T) {
 	}
 }
 
+func TestFeeAdd(t *testing.T) {
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, nil)
+	other := types.NewFee(defaultRecvFee, nil, defaultTimeoutFee)
+
+	expFee := types.NewFee(defaultRecvFee.Add(defaultRecvFee...), defaultAckFee, defaultTimeoutFee)
+	require.Equal(t, expFee, fee.Add(other))
+
+	// adding an empty fee leaves the fee unchanged
+	sum := fee.Add(types.Fee{})
+	require.True(t, fee.RecvFee.Equal(sum.RecvFee))
+	require.True(t, fee.AckFee.Equal(sum.AckFee))
+	require.True(t, sum.TimeoutFee.Empty())
+}
+
 func TestPacketFeeValidation(t *testing.T) {
 	var packetFee types.PacketFee
 
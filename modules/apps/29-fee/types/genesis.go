@@ -2,11 +2,13 @@ package types
 
 import (
 	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 )
@@ -18,6 +20,14 @@ func NewGenesisState(
 	registeredPayees []RegisteredPayee,
 	registeredCounterpartyPayees []RegisteredCounterpartyPayee,
 	forwardRelayers []ForwardRelayerAddress,
+	registeredFeePreferences []RegisteredFeePreferences,
+	channelMinimumFees []ChannelMinimumFee,
+	params Params,
+	packetFeeEscrowTimes []PacketFeeEscrowTime,
+	relayerRewards []RelayerRewards,
+	epochRelayerRewards []EpochRelayerRewards,
+	rewardEpoch RewardEpoch,
+	feeSponsorPools []FeeSponsorPool,
 ) *GenesisState {
 	return &GenesisState{
 		IdentifiedFees:               identifiedFees,
@@ -25,6 +35,14 @@ func NewGenesisState(
 		RegisteredPayees:             registeredPayees,
 		RegisteredCounterpartyPayees: registeredCounterpartyPayees,
 		ForwardRelayers:              forwardRelayers,
+		RegisteredFeePreferences:     registeredFeePreferences,
+		ChannelMinimumFees:           channelMinimumFees,
+		Params:                       params,
+		PacketFeeEscrowTimes:         packetFeeEscrowTimes,
+		RelayerRewards:               relayerRewards,
+		EpochRelayerRewards:          epochRelayerRewards,
+		RewardEpoch:                  rewardEpoch,
+		FeeSponsorPools:              feeSponsorPools,
 	}
 }
 
@@ -36,6 +54,14 @@ func DefaultGenesisState() *GenesisState {
 		FeeEnabledChannels:           []FeeEnabledChannel{},
 		RegisteredPayees:             []RegisteredPayee{},
 		RegisteredCounterpartyPayees: []RegisteredCounterpartyPayee{},
+		RegisteredFeePreferences:     []RegisteredFeePreferences{},
+		ChannelMinimumFees:           []ChannelMinimumFee{},
+		Params:                       DefaultParams(),
+		PacketFeeEscrowTimes:         []PacketFeeEscrowTime{},
+		RelayerRewards:               []RelayerRewards{},
+		EpochRelayerRewards:          []EpochRelayerRewards{},
+		RewardEpoch:                  RewardEpoch{},
+		FeeSponsorPools:              []FeeSponsorPool{},
 	}
 }
 
@@ -110,5 +136,74 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// Validate RegisteredFeePreferences
+	for _, feePreferences := range gs.RegisteredFeePreferences {
+		if err := feePreferences.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate ChannelMinimumFees
+	for _, channelMinimumFee := range gs.ChannelMinimumFees {
+		if err := channelMinimumFee.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate Params
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	// Validate PacketFeeEscrowTimes
+	for _, escrowTime := range gs.PacketFeeEscrowTimes {
+		if err := escrowTime.PacketId.Validate(); err != nil {
+			return err
+		}
+
+		if _, err := sdk.AccAddressFromBech32(escrowTime.RefundAddress); err != nil {
+			return errorsmod.Wrap(err, "failed to convert refund address into sdk.AccAddress")
+		}
+	}
+
+	// Validate RelayerRewards
+	for _, relayerRewards := range gs.RelayerRewards {
+		if err := relayerRewards.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate EpochRelayerRewards
+	for _, epochRelayerRewards := range gs.EpochRelayerRewards {
+		if err := epochRelayerRewards.Validate(); err != nil {
+			return err
+		}
+
+		if epochRelayerRewards.Epoch > gs.RewardEpoch.Number {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "relayer reward epoch %d is after the current reward epoch %d", epochRelayerRewards.Epoch, gs.RewardEpoch.Number)
+		}
+	}
+
+	// Validate RewardEpoch
+	if err := gs.RewardEpoch.Validate(); err != nil {
+		return err
+	}
+
+	// Validate FeeSponsorPools
+	for _, feeSponsorPool := range gs.FeeSponsorPools {
+		if err := feeSponsorPool.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// NewPacketFeeEscrowTime creates and returns a new PacketFeeEscrowTime instance
+func NewPacketFeeEscrowTime(packetID channeltypes.PacketId, refundAddr string, escrowTime time.Time) PacketFeeEscrowTime {
+	return PacketFeeEscrowTime{
+		PacketId:      packetID,
+		RefundAddress: refundAddr,
+		EscrowTime:    escrowTime,
+	}
+}
@@ -5,18 +5,24 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -36,6 +42,22 @@ type GenesisState struct {
 	RegisteredCounterpartyPayees []RegisteredCounterpartyPayee `protobuf:"bytes,4,rep,name=registered_counterparty_payees,json=registeredCounterpartyPayees,proto3" json:"registered_counterparty_payees"`
 	// list of forward relayer addresses
 	ForwardRelayers []ForwardRelayerAddress `protobuf:"bytes,5,rep,name=forward_relayers,json=forwardRelayers,proto3" json:"forward_relayers"`
+	// list of registered fee preferences
+	RegisteredFeePreferences []RegisteredFeePreferences `protobuf:"bytes,6,rep,name=registered_fee_preferences,json=registeredFeePreferences,proto3" json:"registered_fee_preferences"`
+	// list of channel minimum fees
+	ChannelMinimumFees []ChannelMinimumFee `protobuf:"bytes,7,rep,name=channel_minimum_fees,json=channelMinimumFees,proto3" json:"channel_minimum_fees"`
+	// the fee middleware parameters
+	Params Params `protobuf:"bytes,8,opt,name=params,proto3" json:"params"`
+	// list of packet fee escrow times
+	PacketFeeEscrowTimes []PacketFeeEscrowTime `protobuf:"bytes,9,rep,name=packet_fee_escrow_times,json=packetFeeEscrowTimes,proto3" json:"packet_fee_escrow_times"`
+	// list of lifetime relayer rewards
+	RelayerRewards []RelayerRewards `protobuf:"bytes,10,rep,name=relayer_rewards,json=relayerRewards,proto3" json:"relayer_rewards"`
+	// list of per-epoch relayer rewards
+	EpochRelayerRewards []EpochRelayerRewards `protobuf:"bytes,11,rep,name=epoch_relayer_rewards,json=epochRelayerRewards,proto3" json:"epoch_relayer_rewards"`
+	// the current relayer reward epoch
+	RewardEpoch RewardEpoch `protobuf:"bytes,12,opt,name=reward_epoch,json=rewardEpoch,proto3" json:"reward_epoch"`
+	// list of fee sponsor pools
+	FeeSponsorPools []FeeSponsorPool `protobuf:"bytes,13,rep,name=fee_sponsor_pools,json=feeSponsorPools,proto3" json:"fee_sponsor_pools"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,6 +128,62 @@ func (m *GenesisState) GetForwardRelayers() []ForwardRelayerAddress {
 	return nil
 }
 
+func (m *GenesisState) GetRegisteredFeePreferences() []RegisteredFeePreferences {
+	if m != nil {
+		return m.RegisteredFeePreferences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelMinimumFees() []ChannelMinimumFee {
+	if m != nil {
+		return m.ChannelMinimumFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetPacketFeeEscrowTimes() []PacketFeeEscrowTime {
+	if m != nil {
+		return m.PacketFeeEscrowTimes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRelayerRewards() []RelayerRewards {
+	if m != nil {
+		return m.RelayerRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochRelayerRewards() []EpochRelayerRewards {
+	if m != nil {
+		return m.EpochRelayerRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardEpoch() RewardEpoch {
+	if m != nil {
+		return m.RewardEpoch
+	}
+	return RewardEpoch{}
+}
+
+func (m *GenesisState) GetFeeSponsorPools() []FeeSponsorPool {
+	if m != nil {
+		return m.FeeSponsorPools
+	}
+	return nil
+}
+
 // FeeEnabledChannel contains the PortID & ChannelID for a fee enabled channel
 type FeeEnabledChannel struct {
 	// unique port identifier
@@ -290,26 +368,33 @@ func (m *RegisteredCounterpartyPayee) GetCounterpartyPayee() string {
 	return ""
 }
 
-// ForwardRelayerAddress contains the forward relayer address and PacketId used for async acknowledgements
-type ForwardRelayerAddress struct {
-	// the forward relayer address
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// unique packet identifier comprised of the channel ID, port ID and sequence
-	PacketId types.PacketId `protobuf:"bytes,2,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
+// RegisteredFeePreferences contains the fee denominations accepted by a payee for a specific channel and the fallback
+// address to which the packet fees paid out to the payee in other denominations are sent
+type RegisteredFeePreferences struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the address to which packet fees are paid out, either a relayer or a registered payee
+	Payee string `protobuf:"bytes,3,opt,name=payee,proto3" json:"payee,omitempty"`
+	// list of fee denominations accepted by the payee
+	AcceptedDenoms []string `protobuf:"bytes,4,rep,name=accepted_denoms,json=acceptedDenoms,proto3" json:"accepted_denoms,omitempty"`
+	// the address to which the packet fees in other denominations are sent, if empty these fees are refunded
+	FallbackAddress string `protobuf:"bytes,5,opt,name=fallback_address,json=fallbackAddress,proto3" json:"fallback_address,omitempty"`
 }
 
-func (m *ForwardRelayerAddress) Reset()         { *m = ForwardRelayerAddress{} }
-func (m *ForwardRelayerAddress) String() string { return proto.CompactTextString(m) }
-func (*ForwardRelayerAddress) ProtoMessage()    {}
-func (*ForwardRelayerAddress) Descriptor() ([]byte, []int) {
+func (m *RegisteredFeePreferences) Reset()         { *m = RegisteredFeePreferences{} }
+func (m *RegisteredFeePreferences) String() string { return proto.CompactTextString(m) }
+func (*RegisteredFeePreferences) ProtoMessage()    {}
+func (*RegisteredFeePreferences) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7191992e856dff95, []int{4}
 }
-func (m *ForwardRelayerAddress) XXX_Unmarshal(b []byte) error {
+func (m *RegisteredFeePreferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ForwardRelayerAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RegisteredFeePreferences) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ForwardRelayerAddress.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RegisteredFeePreferences.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -319,472 +404,2982 @@ func (m *ForwardRelayerAddress) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *ForwardRelayerAddress) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ForwardRelayerAddress.Merge(m, src)
+func (m *RegisteredFeePreferences) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisteredFeePreferences.Merge(m, src)
 }
-func (m *ForwardRelayerAddress) XXX_Size() int {
+func (m *RegisteredFeePreferences) XXX_Size() int {
 	return m.Size()
 }
-func (m *ForwardRelayerAddress) XXX_DiscardUnknown() {
-	xxx_messageInfo_ForwardRelayerAddress.DiscardUnknown(m)
+func (m *RegisteredFeePreferences) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisteredFeePreferences.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ForwardRelayerAddress proto.InternalMessageInfo
+var xxx_messageInfo_RegisteredFeePreferences proto.InternalMessageInfo
 
-func (m *ForwardRelayerAddress) GetAddress() string {
+func (m *RegisteredFeePreferences) GetPortId() string {
 	if m != nil {
-		return m.Address
+		return m.PortId
 	}
 	return ""
 }
 
-func (m *ForwardRelayerAddress) GetPacketId() types.PacketId {
+func (m *RegisteredFeePreferences) GetChannelId() string {
 	if m != nil {
-		return m.PacketId
+		return m.ChannelId
 	}
-	return types.PacketId{}
-}
-
-func init() {
-	proto.RegisterType((*GenesisState)(nil), "ibc.applications.fee.v1.GenesisState")
-	proto.RegisterType((*FeeEnabledChannel)(nil), "ibc.applications.fee.v1.FeeEnabledChannel")
-	proto.RegisterType((*RegisteredPayee)(nil), "ibc.applications.fee.v1.RegisteredPayee")
-	proto.RegisterType((*RegisteredCounterpartyPayee)(nil), "ibc.applications.fee.v1.RegisteredCounterpartyPayee")
-	proto.RegisterType((*ForwardRelayerAddress)(nil), "ibc.applications.fee.v1.ForwardRelayerAddress")
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("ibc/applications/fee/v1/genesis.proto", fileDescriptor_7191992e856dff95)
+func (m *RegisteredFeePreferences) GetPayee() string {
+	if m != nil {
+		return m.Payee
+	}
+	return ""
 }
 
-var fileDescriptor_7191992e856dff95 = []byte{
-	// 550 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0xf6, 0x93, 0x7a, 0x88, 0xae, 0x56, 0xd1, 0xa2, 0xc1, 0xc2, 0xa8, 0x84, 0x54, 0x21,
-	0x35, 0x51, 0x0b, 0x1c, 0x76, 0x03, 0x26, 0x86, 0x2a, 0x0e, 0x4c, 0xe5, 0x06, 0x48, 0x21, 0xb1,
-	0x5f, 0x32, 0x8b, 0x36, 0x8e, 0x6c, 0xb7, 0xa8, 0x37, 0x2e, 0xdc, 0xe1, 0xbf, 0xda, 0x71, 0x47,
-	0x4e, 0x08, 0xb5, 0xff, 0x08, 0xb2, 0xe3, 0x8c, 0xae, 0x23, 0x08, 0x71, 0xf3, 0x7b, 0xef, 0xfb,
-	0xde, 0x67, 0x7f, 0x4f, 0xcf, 0xe8, 0x01, 0x8b, 0x49, 0x10, 0xe5, 0xf9, 0x88, 0x91, 0x48, 0x31,
-	0x9e, 0xc9, 0x20, 0x01, 0x08, 0xa6, 0xbd, 0x20, 0x85, 0x0c, 0x24, 0x93, 0x7e, 0x2e, 0xb8, 0xe2,
-	0x78, 0x8f, 0xc5, 0xc4, 0x5f, 0x86, 0xf9, 0x09, 0x80, 0x3f, 0xed, 0xed, 0xb7, 0x52, 0x9e, 0x72,
-	0x83, 0x09, 0xf4, 0xa9, 0x80, 0xef, 0xdf, 0xaf, 0xea, 0xaa, 0x59, 0x4b, 0x10, 0xc2, 0x05, 0x04,
-	0xe4, 0x2c, 0xca, 0x32, 0x18, 0xe9, 0xb2, 0x3d, 0x16, 0x90, 0xf6, 0xb7, 0x0d, 0x74, 0xf3, 0x65,
-	0x71, 0x8d, 0x37, 0x2a, 0x52, 0x80, 0xdf, 0xa3, 0x06, 0xa3, 0x90, 0x29, 0x96, 0x30, 0xa0, 0x61,
-	0x02, 0x20, 0x5d, 0xe7, 0x70, 0xbd, 0xb3, 0xd3, 0xef, 0xfa, 0x15, 0xf7, 0xf3, 0x07, 0x97, 0xf8,
-	0xd3, 0x88, 0x7c, 0x04, 0x75, 0x02, 0x20, 0x9f, 0x6f, 0x9c, 0xff, 0xb8, 0x57, 0x1b, 0xde, 0xfa,
-	0xdd, 0x4b, 0x67, 0x71, 0x8c, 0x5a, 0x09, 0x40, 0x08, 0x59, 0x14, 0x8f, 0x80, 0x86, 0xf6, 0x2e,
-	0xd2, 0x5d, 0x33, 0x12, 0x0f, 0x2b, 0x25, 0x4e, 0x00, 0x5e, 0x14, 0x9c, 0xe3, 0x82, 0x62, 0xfb,
-	0xe3, 0x64, 0xb5, 0x20, 0xf1, 0x3b, 0xd4, 0x14, 0x90, 0x32, 0xa9, 0x40, 0x00, 0x0d, 0xf3, 0x68,
-	0xa6, 0xdf, 0xb0, 0x6e, 0x04, 0x3a, 0x95, 0x02, 0xc3, 0x4b, 0xc6, 0xa9, 0x26, 0xd8, 0xf6, 0xbb,
-	0xe2, 0x6a, 0x5a, 0xe2, 0xcf, 0x0e, 0xf2, 0x96, 0xba, 0x13, 0x3e, 0xc9, 0x14, 0x88, 0x3c, 0x12,
-	0x6a, 0x56, 0x4a, 0x6d, 0x18, 0xa9, 0xc7, 0xff, 0x20, 0x75, 0xbc, 0xc4, 0x5e, 0x96, 0xbd, 0x2b,
-	0xaa, 0x21, 0x12, 0x87, 0x68, 0x37, 0xe1, 0xe2, 0x53, 0x24, 0x68, 0x28, 0x60, 0x14, 0xcd, 0x40,
-	0x48, 0x77, 0xd3, 0x68, 0xfa, 0xd5, 0xfe, 0x15, 0x84, 0x61, 0x81, 0x7f, 0x46, 0xa9, 0x00, 0x59,
-	0xce, 0xa8, 0x91, 0x5c, 0x29, 0xca, 0xf6, 0x2b, 0xd4, 0xbc, 0xe6, 0x37, 0xde, 0x43, 0xdb, 0x39,
-	0x17, 0x2a, 0x64, 0xd4, 0x75, 0x0e, 0x9d, 0x4e, 0x7d, 0xb8, 0xa5, 0xc3, 0x01, 0xc5, 0x07, 0x08,
-	0xd9, 0x31, 0xea, 0xda, 0x9a, 0xa9, 0xd5, 0x6d, 0x66, 0x40, 0xdb, 0x1f, 0x50, 0x63, 0xc5, 0xdb,
-	0x15, 0x86, 0xb3, 0xc2, 0xc0, 0x2e, 0xda, 0xb6, 0xef, 0xb2, 0xdd, 0xca, 0x10, 0xb7, 0xd0, 0xa6,
-	0xf1, 0xd8, 0x5d, 0x37, 0xf9, 0x22, 0x68, 0x7f, 0x71, 0xd0, 0x9d, 0xbf, 0x78, 0xfa, 0xff, 0x72,
-	0x5d, 0x84, 0xaf, 0xcf, 0xd7, 0x6a, 0x37, 0xc9, 0xaa, 0x4e, 0x5b, 0xa2, 0xdb, 0x7f, 0xb4, 0x59,
-	0x2b, 0x44, 0xc5, 0xd1, 0xaa, 0x97, 0x21, 0x7e, 0x8a, 0xea, 0xb9, 0x59, 0x99, 0xd2, 0xba, 0x9d,
-	0xfe, 0x81, 0x99, 0xa1, 0x5e, 0x5a, 0xbf, 0xdc, 0xd4, 0x69, 0xcf, 0x2f, 0x16, 0x6b, 0x40, 0xed,
-	0xc8, 0x6e, 0xe4, 0x65, 0xfc, 0xfa, 0x7c, 0xee, 0x39, 0x17, 0x73, 0xcf, 0xf9, 0x39, 0xf7, 0x9c,
-	0xaf, 0x0b, 0xaf, 0x76, 0xb1, 0xf0, 0x6a, 0xdf, 0x17, 0x5e, 0xed, 0xed, 0x93, 0x94, 0xa9, 0xb3,
-	0x49, 0xec, 0x13, 0x3e, 0x0e, 0x08, 0x97, 0x63, 0x2e, 0x03, 0x16, 0x93, 0x6e, 0xca, 0x83, 0xe9,
-	0x51, 0x30, 0xe6, 0x74, 0x32, 0x02, 0xa9, 0xff, 0x0f, 0x19, 0xf4, 0x8f, 0xba, 0xfa, 0xeb, 0x50,
-	0xb3, 0x1c, 0x64, 0xbc, 0x65, 0xfe, 0x85, 0x47, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x42, 0x7b,
-	0x2e, 0xd1, 0xb5, 0x04, 0x00, 0x00,
+func (m *RegisteredFeePreferences) GetAcceptedDenoms() []string {
+	if m != nil {
+		return m.AcceptedDenoms
+	}
+	return nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RegisteredFeePreferences) GetFallbackAddress() string {
+	if m != nil {
+		return m.FallbackAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ChannelMinimumFee contains the minimum fee required to incentivize a packet on a fee enabled channel
+type ChannelMinimumFee struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the minimum receive, acknowledgement and timeout fees, an empty fee imposes no minimum
+	MinimumFee Fee `protobuf:"bytes,3,opt,name=minimum_fee,json=minimumFee,proto3" json:"minimum_fee"`
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ForwardRelayers) > 0 {
-		for iNdEx := len(m.ForwardRelayers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ForwardRelayers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.RegisteredCounterpartyPayees) > 0 {
-		for iNdEx := len(m.RegisteredCounterpartyPayees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RegisteredCounterpartyPayees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.RegisteredPayees) > 0 {
-		for iNdEx := len(m.RegisteredPayees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RegisteredPayees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.FeeEnabledChannels) > 0 {
-		for iNdEx := len(m.FeeEnabledChannels) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeEnabledChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.IdentifiedFees) > 0 {
-		for iNdEx := len(m.IdentifiedFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.IdentifiedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ChannelMinimumFee) Reset()         { *m = ChannelMinimumFee{} }
+func (m *ChannelMinimumFee) String() string { return proto.CompactTextString(m) }
+func (*ChannelMinimumFee) ProtoMessage()    {}
+func (*ChannelMinimumFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7191992e856dff95, []int{5}
+}
+func (m *ChannelMinimumFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelMinimumFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelMinimumFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *FeeEnabledChannel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ChannelMinimumFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelMinimumFee.Merge(m, src)
 }
-
-func (m *FeeEnabledChannel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ChannelMinimumFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelMinimumFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelMinimumFee.DiscardUnknown(m)
 }
 
-func (m *FeeEnabledChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_ChannelMinimumFee proto.InternalMessageInfo
+
+func (m *ChannelMinimumFee) GetPortId() string {
+	if m != nil {
+		return m.PortId
 	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ChannelMinimumFee) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *RegisteredPayee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ChannelMinimumFee) GetMinimumFee() Fee {
+	if m != nil {
+		return m.MinimumFee
 	}
-	return dAtA[:n], nil
+	return Fee{}
 }
 
-func (m *RegisteredPayee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PacketFeeEscrowTime contains the time at which a payer last escrowed a fee for the given packet
+type PacketFeeEscrowTime struct {
+	// unique packet identifier comprised of the channel ID, port ID and sequence
+	PacketId types.PacketId `protobuf:"bytes,1,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
+	// the refund address of the packet fee
+	RefundAddress string `protobuf:"bytes,2,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+	// the block time at which the packet fee was escrowed
+	EscrowTime time.Time `protobuf:"bytes,3,opt,name=escrow_time,json=escrowTime,proto3,stdtime" json:"escrow_time"`
 }
 
-func (m *RegisteredPayee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Payee) > 0 {
-		i -= len(m.Payee)
-		copy(dAtA[i:], m.Payee)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Payee)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Relayer) > 0 {
-		i -= len(m.Relayer)
-		copy(dAtA[i:], m.Relayer)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Relayer)))
-		i--
-		dAtA[i] = 0x12
+func (m *PacketFeeEscrowTime) Reset()         { *m = PacketFeeEscrowTime{} }
+func (m *PacketFeeEscrowTime) String() string { return proto.CompactTextString(m) }
+func (*PacketFeeEscrowTime) ProtoMessage()    {}
+func (*PacketFeeEscrowTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7191992e856dff95, []int{6}
+}
+func (m *PacketFeeEscrowTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketFeeEscrowTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketFeeEscrowTime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *PacketFeeEscrowTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketFeeEscrowTime.Merge(m, src)
+}
+func (m *PacketFeeEscrowTime) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketFeeEscrowTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketFeeEscrowTime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketFeeEscrowTime proto.InternalMessageInfo
+
+func (m *PacketFeeEscrowTime) GetPacketId() types.PacketId {
+	if m != nil {
+		return m.PacketId
 	}
-	return len(dAtA) - i, nil
+	return types.PacketId{}
 }
 
-func (m *RegisteredCounterpartyPayee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PacketFeeEscrowTime) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RegisteredCounterpartyPayee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PacketFeeEscrowTime) GetEscrowTime() time.Time {
+	if m != nil {
+		return m.EscrowTime
+	}
+	return time.Time{}
 }
 
-func (m *RegisteredCounterpartyPayee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CounterpartyPayee) > 0 {
-		i -= len(m.CounterpartyPayee)
-		copy(dAtA[i:], m.CounterpartyPayee)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CounterpartyPayee)))
-		i--
-		dAtA[i] = 0x1a
+// FeeSponsorPool contains the senders allowed to draw packet fees on a channel from the pool of a fee sponsor
+type FeeSponsorPool struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the address managing the pool
+	Sponsor string `protobuf:"bytes,3,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// list of the allowances of the senders allowed to draw packet fees from the pool
+	Allowances []FeeSponsorAllowance `protobuf:"bytes,4,rep,name=allowances,proto3" json:"allowances"`
+}
+
+func (m *FeeSponsorPool) Reset()         { *m = FeeSponsorPool{} }
+func (m *FeeSponsorPool) String() string { return proto.CompactTextString(m) }
+func (*FeeSponsorPool) ProtoMessage()    {}
+func (*FeeSponsorPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7191992e856dff95, []int{7}
+}
+func (m *FeeSponsorPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSponsorPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSponsorPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Relayer) > 0 {
-		i -= len(m.Relayer)
-		copy(dAtA[i:], m.Relayer)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Relayer)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *FeeSponsorPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSponsorPool.Merge(m, src)
+}
+func (m *FeeSponsorPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSponsorPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSponsorPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSponsorPool proto.InternalMessageInfo
+
+func (m *FeeSponsorPool) GetPortId() string {
+	if m != nil {
+		return m.PortId
 	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *FeeSponsorPool) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ForwardRelayerAddress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FeeSponsorPool) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ForwardRelayerAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FeeSponsorPool) GetAllowances() []FeeSponsorAllowance {
+	if m != nil {
+		return m.Allowances
+	}
+	return nil
 }
 
-func (m *ForwardRelayerAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+// FeeSponsorAllowance contains the packet fees a sender may draw from a fee sponsor pool
+type FeeSponsorAllowance struct {
+	// the sender address allowed to draw packet fees from the pool
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// the remaining amount the sender may draw from the pool, reduced by every packet fee drawn, the amount is not
+	// limited if empty
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// the optional time after which the sender may no longer draw packet fees from the pool
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *FeeSponsorAllowance) Reset()         { *m = FeeSponsorAllowance{} }
+func (m *FeeSponsorAllowance) String() string { return proto.CompactTextString(m) }
+func (*FeeSponsorAllowance) ProtoMessage()    {}
+func (*FeeSponsorAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7191992e856dff95, []int{8}
+}
+func (m *FeeSponsorAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSponsorAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSponsorAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *FeeSponsorAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSponsorAllowance.Merge(m, src)
+}
+func (m *FeeSponsorAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSponsorAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSponsorAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSponsorAllowance proto.InternalMessageInfo
+
+func (m *FeeSponsorAllowance) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FeeSponsorAllowance) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *FeeSponsorAllowance) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
 	}
-	var l int
-	_ = l
-	if len(m.IdentifiedFees) > 0 {
-		for _, e := range m.IdentifiedFees {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return nil
+}
+
+// RelayerRewards contains the receive, acknowledgement and timeout fees paid out to a relayer payee address on a channel
+type RelayerRewards struct {
+	// the payee address to which the fees were paid out
+	Payee string `protobuf:"bytes,1,opt,name=payee,proto3" json:"payee,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the receive, acknowledgement and timeout fees paid out to the payee
+	Rewards Fee `protobuf:"bytes,3,opt,name=rewards,proto3" json:"rewards"`
+}
+
+func (m *RelayerRewards) Reset()         { *m = RelayerRewards{} }
+func (m *RelayerRewards) String() string { return proto.CompactTextString(m) }
+func (*RelayerRewards) ProtoMessage()    {}
+func (*RelayerRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7191992e856dff95, []int{9}
+}
+func (m *RelayerRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.FeeEnabledChannels) > 0 {
-		for _, e := range m.FeeEnabledChannels {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+}
+func (m *RelayerRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerRewards.Merge(m, src)
+}
+func (m *RelayerRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerRewards proto.InternalMessageInfo
+
+func (m *RelayerRewards) GetPayee() string {
+	if m != nil {
+		return m.Payee
 	}
-	if len(m.RegisteredPayees) > 0 {
-		for _, e := range m.RegisteredPayees {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return ""
+}
+
+func (m *RelayerRewards) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	if len(m.RegisteredCounterpartyPayees) > 0 {
-		for _, e := range m.RegisteredCounterpartyPayees {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return ""
+}
+
+func (m *RelayerRewards) GetRewards() Fee {
+	if m != nil {
+		return m.Rewards
+	}
+	return Fee{}
+}
+
+// EpochRelayerRewards contains the relayer rewards paid out during a relayer reward epoch
+type EpochRelayerRewards struct {
+	// the relayer reward epoch number
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// the relayer rewards paid out during the epoch
+	RelayerRewards RelayerRewards `protobuf:"bytes,2,opt,name=relayer_rewards,json=relayerRewards,proto3" json:"relayer_rewards"`
+}
+
+func (m *EpochRelayerRewards) Reset()         { *m = EpochRelayerRewards{} }
+func (m *EpochRelayerRewards) String() string { return proto.CompactTextString(m) }
+func (*EpochRelayerRewards) ProtoMessage()    {}
+func (*EpochRelayerRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7191992e856dff95, []int{10}
+}
+func (m *EpochRelayerRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochRelayerRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochRelayerRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ForwardRelayers) > 0 {
-		for _, e := range m.ForwardRelayers {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+}
+func (m *EpochRelayerRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochRelayerRewards.Merge(m, src)
+}
+func (m *EpochRelayerRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochRelayerRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochRelayerRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochRelayerRewards proto.InternalMessageInfo
+
+func (m *EpochRelayerRewards) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochRelayerRewards) GetRelayerRewards() RelayerRewards {
+	if m != nil {
+		return m.RelayerRewards
+	}
+	return RelayerRewards{}
+}
+
+// RewardEpoch contains the number and start height of a relayer reward epoch
+type RewardEpoch struct {
+	// the relayer reward epoch number, starting from one
+	Number uint64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// the block height at which the epoch started
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+}
+
+func (m *RewardEpoch) Reset()         { *m = RewardEpoch{} }
+func (m *RewardEpoch) String() string { return proto.CompactTextString(m) }
+func (*RewardEpoch) ProtoMessage()    {}
+func (*RewardEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7191992e856dff95, []int{11}
+}
+func (m *RewardEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardEpoch.Merge(m, src)
+}
+func (m *RewardEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardEpoch proto.InternalMessageInfo
+
+func (m *RewardEpoch) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *RewardEpoch) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+// ForwardRelayerAddress contains the forward relayer address and PacketId used for async acknowledgements
+type ForwardRelayerAddress struct {
+	// the forward relayer address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// unique packet identifier comprised of the channel ID, port ID and sequence
+	PacketId types.PacketId `protobuf:"bytes,2,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
+}
+
+func (m *ForwardRelayerAddress) Reset()         { *m = ForwardRelayerAddress{} }
+func (m *ForwardRelayerAddress) String() string { return proto.CompactTextString(m) }
+func (*ForwardRelayerAddress) ProtoMessage()    {}
+func (*ForwardRelayerAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7191992e856dff95, []int{12}
+}
+func (m *ForwardRelayerAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardRelayerAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardRelayerAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardRelayerAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardRelayerAddress.Merge(m, src)
+}
+func (m *ForwardRelayerAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardRelayerAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardRelayerAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardRelayerAddress proto.InternalMessageInfo
+
+func (m *ForwardRelayerAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ForwardRelayerAddress) GetPacketId() types.PacketId {
+	if m != nil {
+		return m.PacketId
+	}
+	return types.PacketId{}
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "ibc.applications.fee.v1.GenesisState")
+	proto.RegisterType((*FeeEnabledChannel)(nil), "ibc.applications.fee.v1.FeeEnabledChannel")
+	proto.RegisterType((*RegisteredPayee)(nil), "ibc.applications.fee.v1.RegisteredPayee")
+	proto.RegisterType((*RegisteredCounterpartyPayee)(nil), "ibc.applications.fee.v1.RegisteredCounterpartyPayee")
+	proto.RegisterType((*RegisteredFeePreferences)(nil), "ibc.applications.fee.v1.RegisteredFeePreferences")
+	proto.RegisterType((*ChannelMinimumFee)(nil), "ibc.applications.fee.v1.ChannelMinimumFee")
+	proto.RegisterType((*PacketFeeEscrowTime)(nil), "ibc.applications.fee.v1.PacketFeeEscrowTime")
+	proto.RegisterType((*FeeSponsorPool)(nil), "ibc.applications.fee.v1.FeeSponsorPool")
+	proto.RegisterType((*FeeSponsorAllowance)(nil), "ibc.applications.fee.v1.FeeSponsorAllowance")
+	proto.RegisterType((*RelayerRewards)(nil), "ibc.applications.fee.v1.RelayerRewards")
+	proto.RegisterType((*EpochRelayerRewards)(nil), "ibc.applications.fee.v1.EpochRelayerRewards")
+	proto.RegisterType((*RewardEpoch)(nil), "ibc.applications.fee.v1.RewardEpoch")
+	proto.RegisterType((*ForwardRelayerAddress)(nil), "ibc.applications.fee.v1.ForwardRelayerAddress")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/fee/v1/genesis.proto", fileDescriptor_7191992e856dff95)
+}
+
+var fileDescriptor_7191992e856dff95 = []byte{
+	// 1184 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcb, 0x6f, 0xdc, 0x44,
+	0x18, 0x8f, 0x93, 0x34, 0x69, 0xbe, 0x4d, 0xb3, 0xcd, 0x24, 0xa5, 0x26, 0xb4, 0x9b, 0xd4, 0xa2,
+	0x6a, 0x40, 0xc4, 0x26, 0x01, 0x0e, 0x95, 0x40, 0x6a, 0x13, 0x12, 0x1a, 0x41, 0x45, 0xe4, 0x56,
+	0x48, 0x3c, 0x24, 0xe3, 0xc7, 0xe7, 0xcd, 0xa8, 0xb6, 0xc7, 0x9a, 0xf1, 0xb6, 0xe4, 0x56, 0x09,
+	0x71, 0xef, 0xdf, 0xc1, 0x1f, 0xc0, 0x89, 0x2b, 0x52, 0x8f, 0x3d, 0x72, 0x81, 0xa2, 0xf6, 0x1f,
+	0x41, 0xf3, 0xf0, 0x66, 0xdf, 0x89, 0x72, 0xda, 0x9d, 0xef, 0xf5, 0xfb, 0xde, 0x33, 0x86, 0xdb,
+	0x34, 0x8a, 0xbd, 0xb0, 0x2c, 0x33, 0x1a, 0x87, 0x15, 0x65, 0x85, 0xf0, 0x52, 0x44, 0xef, 0xe9,
+	0xb6, 0xd7, 0xc6, 0x02, 0x05, 0x15, 0x6e, 0xc9, 0x59, 0xc5, 0xc8, 0x75, 0x1a, 0xc5, 0x6e, 0xaf,
+	0x98, 0x9b, 0x22, 0xba, 0x4f, 0xb7, 0xd7, 0x56, 0xdb, 0xac, 0xcd, 0x94, 0x8c, 0x27, 0xff, 0x69,
+	0xf1, 0xb5, 0xf5, 0x36, 0x63, 0xed, 0x0c, 0x3d, 0x75, 0x8a, 0x3a, 0xa9, 0x57, 0xd1, 0x1c, 0x45,
+	0x15, 0xe6, 0xa5, 0x11, 0x68, 0xc5, 0x4c, 0xe4, 0x4c, 0x78, 0x51, 0x28, 0x24, 0x5a, 0x84, 0x55,
+	0xb8, 0xed, 0xc5, 0x8c, 0x16, 0x86, 0x7f, 0x6b, 0x9c, 0x5b, 0x12, 0xb6, 0x47, 0x24, 0x66, 0x1c,
+	0xbd, 0xf8, 0x38, 0x2c, 0x0a, 0xcc, 0x24, 0xdb, 0xfc, 0xd5, 0x22, 0xce, 0x73, 0x80, 0xc5, 0xaf,
+	0x74, 0x1c, 0x8f, 0xaa, 0xb0, 0x42, 0xf2, 0x13, 0x34, 0x69, 0x82, 0x45, 0x45, 0x53, 0x8a, 0x49,
+	0x90, 0x22, 0x0a, 0xdb, 0xda, 0x98, 0xd9, 0x6c, 0xec, 0x6c, 0xb9, 0x63, 0x02, 0x74, 0x0f, 0xbb,
+	0xf2, 0x47, 0x61, 0xfc, 0x04, 0xab, 0x03, 0x44, 0xb1, 0x3b, 0xfb, 0xf2, 0xdf, 0xf5, 0x29, 0x7f,
+	0xe9, 0xd4, 0x96, 0xa4, 0x92, 0x08, 0x56, 0x53, 0xc4, 0x00, 0x8b, 0x30, 0xca, 0x30, 0x09, 0x8c,
+	0x2f, 0xc2, 0x9e, 0x56, 0x10, 0x1f, 0x8e, 0x85, 0x38, 0x40, 0xdc, 0xd7, 0x3a, 0x7b, 0x5a, 0xc5,
+	0xd8, 0x27, 0xe9, 0x20, 0x43, 0x90, 0x1f, 0x61, 0x99, 0x63, 0x9b, 0x8a, 0x0a, 0x39, 0x26, 0x41,
+	0x19, 0x9e, 0xc8, 0x18, 0x66, 0x14, 0xc0, 0xe6, 0x58, 0x00, 0xbf, 0xab, 0x71, 0x24, 0x15, 0x8c,
+	0xf9, 0xab, 0xbc, 0x9f, 0x2c, 0xc8, 0x73, 0x0b, 0x5a, 0x3d, 0xd6, 0x63, 0xd6, 0x29, 0x2a, 0xe4,
+	0x65, 0xc8, 0xab, 0x93, 0x1a, 0x6a, 0x56, 0x41, 0x7d, 0x7a, 0x0e, 0xa8, 0xbd, 0x1e, 0xed, 0x5e,
+	0xd8, 0x1b, 0x7c, 0xbc, 0x88, 0x20, 0x01, 0x5c, 0x4d, 0x19, 0x7f, 0x16, 0xf2, 0x24, 0xe0, 0x98,
+	0x85, 0x27, 0xc8, 0x85, 0x7d, 0x49, 0x61, 0xba, 0xe3, 0xf3, 0xa7, 0x15, 0x7c, 0x2d, 0x7f, 0x3f,
+	0x49, 0x38, 0x8a, 0xba, 0x46, 0xcd, 0xb4, 0x8f, 0x29, 0x48, 0x07, 0xd6, 0x7a, 0x42, 0x94, 0xf5,
+	0x2a, 0x39, 0xa6, 0xc8, 0xb1, 0x88, 0x51, 0xd8, 0x73, 0x0a, 0x6a, 0xfb, 0x1c, 0xe1, 0x1d, 0x20,
+	0x1e, 0x9d, 0x2a, 0x1a, 0x34, 0x9b, 0x8f, 0xe1, 0xcb, 0xde, 0x30, 0xfd, 0x10, 0xe4, 0xb4, 0xa0,
+	0x79, 0x27, 0xd7, 0xed, 0x37, 0x7f, 0x46, 0x6f, 0x98, 0xc2, 0x3f, 0xd4, 0x3a, 0x07, 0xdd, 0x2c,
+	0x92, 0x78, 0x90, 0x21, 0xc8, 0x17, 0x30, 0x57, 0x86, 0x3c, 0xcc, 0x85, 0x7d, 0x79, 0xc3, 0xda,
+	0x6c, 0xec, 0xac, 0x8f, 0xb5, 0x7a, 0xa4, 0xc4, 0x8c, 0x29, 0xa3, 0x44, 0x28, 0x5c, 0x2f, 0x55,
+	0x8b, 0xab, 0xac, 0xa0, 0x88, 0x39, 0x7b, 0x16, 0xa8, 0xc1, 0xb5, 0x17, 0x94, 0x97, 0x1f, 0x4d,
+	0xb0, 0x67, 0x46, 0x63, 0x5f, 0x69, 0x3d, 0xa6, 0x79, 0xed, 0xe7, 0x6a, 0x39, 0xcc, 0x12, 0xe4,
+	0x3b, 0x68, 0x9a, 0xea, 0x06, 0x1c, 0x65, 0x79, 0x84, 0x0d, 0x0a, 0xe2, 0xce, 0x84, 0xcc, 0x2b,
+	0x79, 0x5f, 0x8b, 0xd7, 0x13, 0xc8, 0xfb, 0xa8, 0x24, 0x85, 0x6b, 0x58, 0xb2, 0xf8, 0x38, 0x18,
+	0xb4, 0xde, 0x38, 0x23, 0x80, 0x7d, 0xa9, 0x35, 0x12, 0x62, 0x05, 0x87, 0x59, 0xe4, 0x21, 0x2c,
+	0x6a, 0xcb, 0x81, 0xe2, 0xda, 0x8b, 0x2a, 0xdf, 0xef, 0x4f, 0x70, 0x5e, 0x0a, 0x2b, 0x10, 0x63,
+	0xb6, 0xc1, 0x4f, 0x49, 0xe4, 0x7b, 0x58, 0x96, 0x29, 0x17, 0x25, 0x2b, 0x04, 0xe3, 0x41, 0xc9,
+	0x58, 0x26, 0xec, 0x2b, 0x67, 0x24, 0xe4, 0x00, 0xf1, 0x91, 0x56, 0x38, 0x62, 0x2c, 0xeb, 0xb6,
+	0x7b, 0x1f, 0x55, 0x38, 0x5f, 0xc3, 0xf2, 0xd0, 0x7a, 0x21, 0xd7, 0x61, 0xbe, 0x64, 0xbc, 0x0a,
+	0x68, 0x62, 0x5b, 0x1b, 0xd6, 0xe6, 0x82, 0x3f, 0x27, 0x8f, 0x87, 0x09, 0xb9, 0x09, 0x50, 0x77,
+	0x29, 0x4d, 0xec, 0x69, 0xc5, 0x5b, 0x30, 0x94, 0xc3, 0xc4, 0xf9, 0x19, 0x9a, 0x03, 0xab, 0x64,
+	0x40, 0xc3, 0x1a, 0xd0, 0x20, 0x36, 0xcc, 0x9b, 0x52, 0x18, 0x6b, 0xf5, 0x91, 0xac, 0xc2, 0x25,
+	0xb5, 0x52, 0xec, 0x19, 0x45, 0xd7, 0x07, 0xe7, 0x37, 0x0b, 0xde, 0x9b, 0xb0, 0x42, 0x2e, 0x0e,
+	0xb7, 0x05, 0x64, 0x78, 0x9d, 0x19, 0xec, 0xe5, 0x78, 0x10, 0xc7, 0xf9, 0xd3, 0x02, 0x7b, 0xdc,
+	0xac, 0x5f, 0x34, 0x7d, 0xa3, 0x43, 0x26, 0x77, 0xa0, 0x19, 0xc6, 0x31, 0x96, 0x15, 0x26, 0x41,
+	0x82, 0x05, 0xcb, 0xf5, 0x92, 0x5d, 0xf0, 0x97, 0x6a, 0xf2, 0x97, 0x8a, 0x4a, 0x3e, 0x80, 0xab,
+	0x69, 0x98, 0x65, 0x51, 0x18, 0x3f, 0x09, 0x42, 0xbd, 0xe4, 0xec, 0x4b, 0xca, 0x52, 0xb3, 0xa6,
+	0x9b, 0xdd, 0xe7, 0xbc, 0xb0, 0x60, 0x79, 0x68, 0x73, 0x5c, 0xd8, 0xef, 0x3d, 0x68, 0xf4, 0xec,
+	0x2c, 0xe5, 0x7d, 0x63, 0xe7, 0xc6, 0xa4, 0xc6, 0x34, 0xdd, 0x08, 0x79, 0x17, 0xdc, 0xf9, 0xcb,
+	0x82, 0x95, 0x11, 0x6b, 0x82, 0xdc, 0x83, 0x05, 0xb3, 0x75, 0x8c, 0x5b, 0x8d, 0x9d, 0x9b, 0xca,
+	0xb4, 0xbc, 0xda, 0xdd, 0xfa, 0x3e, 0xef, 0xee, 0x98, 0xc3, 0xc4, 0xd8, 0xbe, 0x5c, 0x9a, 0x33,
+	0xb9, 0x0d, 0x4b, 0x1c, 0xd3, 0x4e, 0x91, 0x74, 0xb3, 0xa2, 0x23, 0xb8, 0xa2, 0xa9, 0x26, 0x27,
+	0x64, 0x1f, 0x1a, 0x3d, 0x3b, 0xcd, 0x44, 0xb1, 0xe6, 0xea, 0x97, 0x8a, 0x5b, 0xbf, 0x54, 0xdc,
+	0xc7, 0xf5, 0x4b, 0x65, 0xf7, 0xb2, 0xc4, 0x79, 0xf1, 0x7a, 0xdd, 0xf2, 0x01, 0xbb, 0xfe, 0x3a,
+	0x7f, 0x58, 0xb0, 0xd4, 0x3f, 0x7a, 0x17, 0xce, 0xab, 0x0d, 0xf3, 0x66, 0xe4, 0x4d, 0x47, 0xd4,
+	0x47, 0xe2, 0x03, 0x84, 0x59, 0xc6, 0x9e, 0x85, 0xea, 0x52, 0x9a, 0x3d, 0x63, 0x79, 0x9d, 0xba,
+	0x73, 0xbf, 0x56, 0xaa, 0x0b, 0x70, 0x6a, 0xc5, 0xf9, 0xc7, 0x82, 0x95, 0x11, 0x92, 0xe4, 0x1d,
+	0x98, 0x13, 0x58, 0x24, 0xc8, 0x6b, 0xe7, 0xf5, 0x89, 0x64, 0xd0, 0x10, 0x25, 0x16, 0x49, 0x90,
+	0xd1, 0x9c, 0x56, 0xe6, 0x11, 0xf3, 0xae, 0xab, 0x1f, 0x6e, 0xae, 0x7c, 0xb8, 0xb9, 0xe6, 0xe1,
+	0xe6, 0xee, 0x31, 0x5a, 0xec, 0x7e, 0x2c, 0x11, 0x7f, 0x7f, 0xbd, 0xbe, 0xd9, 0xa6, 0xd5, 0x71,
+	0x27, 0x72, 0x63, 0x96, 0x7b, 0xe6, 0x95, 0xa7, 0x7f, 0xb6, 0x44, 0xf2, 0xc4, 0xab, 0x4e, 0x4a,
+	0x14, 0x4a, 0x41, 0xf8, 0xa0, 0xec, 0x7f, 0x23, 0xcd, 0x93, 0x7b, 0x00, 0xf8, 0x4b, 0x49, 0xb9,
+	0x8a, 0xec, 0x1c, 0xc5, 0x99, 0x35, 0x85, 0xe9, 0xea, 0x38, 0xbf, 0x5a, 0xb0, 0x34, 0xb0, 0xa6,
+	0xbb, 0x03, 0x67, 0xf5, 0x0e, 0xdc, 0x19, 0x55, 0xf9, 0x5c, 0xee, 0x10, 0x7d, 0x6b, 0x9c, 0xbf,
+	0xd3, 0x6b, 0x15, 0xe9, 0xc5, 0xca, 0x88, 0xcb, 0x44, 0xba, 0xa2, 0xaf, 0x0a, 0xe9, 0xca, 0xac,
+	0xaf, 0x0f, 0xa3, 0xee, 0xc1, 0xe9, 0x0d, 0x6b, 0xe2, 0xda, 0x3f, 0xcf, 0x3d, 0xe8, 0x3c, 0x80,
+	0x46, 0xcf, 0x95, 0x23, 0x4b, 0x5c, 0x74, 0xf2, 0xc8, 0x94, 0x78, 0xd6, 0x37, 0x27, 0x72, 0x0b,
+	0x16, 0x45, 0x15, 0xf2, 0x2a, 0x38, 0x46, 0xda, 0x3e, 0xae, 0x14, 0xf6, 0x8c, 0xdf, 0x50, 0xb4,
+	0x07, 0x8a, 0xe4, 0x08, 0xb8, 0x36, 0xf2, 0x79, 0x25, 0x9b, 0xb7, 0x1e, 0x37, 0x9d, 0xdd, 0xfa,
+	0xd8, 0x3f, 0xd1, 0xd3, 0x17, 0x98, 0xe8, 0xdd, 0x6f, 0x5f, 0xbe, 0x69, 0x59, 0xaf, 0xde, 0xb4,
+	0xac, 0xff, 0xde, 0xb4, 0xac, 0x17, 0x6f, 0x5b, 0x53, 0xaf, 0xde, 0xb6, 0xa6, 0xfe, 0x7e, 0xdb,
+	0x9a, 0xfa, 0xe1, 0xb3, 0xe1, 0xe6, 0xa2, 0x51, 0xbc, 0xd5, 0x66, 0xde, 0xd3, 0xbb, 0x5e, 0xce,
+	0x92, 0x4e, 0x86, 0x42, 0x7e, 0x37, 0x08, 0x6f, 0xe7, 0xee, 0x96, 0xfc, 0x64, 0x50, 0xfd, 0x16,
+	0xcd, 0xa9, 0x0e, 0xfa, 0xe4, 0xff, 0x01, 0x00, 0xd9, 0x1b, 0x13, 0x5c, 0xee, 0x0c, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeSponsorPools) > 0 {
+		for iNdEx := len(m.FeeSponsorPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSponsorPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	{
+		size, err := m.RewardEpoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.EpochRelayerRewards) > 0 {
+		for iNdEx := len(m.EpochRelayerRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochRelayerRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.RelayerRewards) > 0 {
+		for iNdEx := len(m.RelayerRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PacketFeeEscrowTimes) > 0 {
+		for iNdEx := len(m.PacketFeeEscrowTimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketFeeEscrowTimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.ChannelMinimumFees) > 0 {
+		for iNdEx := len(m.ChannelMinimumFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelMinimumFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RegisteredFeePreferences) > 0 {
+		for iNdEx := len(m.RegisteredFeePreferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegisteredFeePreferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ForwardRelayers) > 0 {
+		for iNdEx := len(m.ForwardRelayers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForwardRelayers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RegisteredCounterpartyPayees) > 0 {
+		for iNdEx := len(m.RegisteredCounterpartyPayees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegisteredCounterpartyPayees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RegisteredPayees) > 0 {
+		for iNdEx := len(m.RegisteredPayees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegisteredPayees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FeeEnabledChannels) > 0 {
+		for iNdEx := len(m.FeeEnabledChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeEnabledChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.IdentifiedFees) > 0 {
+		for iNdEx := len(m.IdentifiedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IdentifiedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeEnabledChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeEnabledChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeEnabledChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisteredPayee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredPayee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredPayee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payee) > 0 {
+		i -= len(m.Payee)
+		copy(dAtA[i:], m.Payee)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Payee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisteredCounterpartyPayee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredCounterpartyPayee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredCounterpartyPayee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CounterpartyPayee) > 0 {
+		i -= len(m.CounterpartyPayee)
+		copy(dAtA[i:], m.CounterpartyPayee)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CounterpartyPayee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisteredFeePreferences) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredFeePreferences) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredFeePreferences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FallbackAddress) > 0 {
+		i -= len(m.FallbackAddress)
+		copy(dAtA[i:], m.FallbackAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.FallbackAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AcceptedDenoms) > 0 {
+		for iNdEx := len(m.AcceptedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AcceptedDenoms[iNdEx])
+			copy(dAtA[i:], m.AcceptedDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AcceptedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Payee) > 0 {
+		i -= len(m.Payee)
+		copy(dAtA[i:], m.Payee)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Payee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelMinimumFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelMinimumFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelMinimumFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MinimumFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketFeeEscrowTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketFeeEscrowTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketFeeEscrowTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EscrowTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EscrowTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSponsorPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSponsorPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSponsorPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSponsorAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSponsorAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSponsorAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintGenesis(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayerRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Payee) > 0 {
+		i -= len(m.Payee)
+		copy(dAtA[i:], m.Payee)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Payee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochRelayerRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochRelayerRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochRelayerRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RelayerRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Epoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Number != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardRelayerAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardRelayerAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardRelayerAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IdentifiedFees) > 0 {
+		for _, e := range m.IdentifiedFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeEnabledChannels) > 0 {
+		for _, e := range m.FeeEnabledChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RegisteredPayees) > 0 {
+		for _, e := range m.RegisteredPayees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RegisteredCounterpartyPayees) > 0 {
+		for _, e := range m.RegisteredCounterpartyPayees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ForwardRelayers) > 0 {
+		for _, e := range m.ForwardRelayers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RegisteredFeePreferences) > 0 {
+		for _, e := range m.RegisteredFeePreferences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelMinimumFees) > 0 {
+		for _, e := range m.ChannelMinimumFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PacketFeeEscrowTimes) > 0 {
+		for _, e := range m.PacketFeeEscrowTimes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RelayerRewards) > 0 {
+		for _, e := range m.RelayerRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochRelayerRewards) > 0 {
+		for _, e := range m.EpochRelayerRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.RewardEpoch.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FeeSponsorPools) > 0 {
+		for _, e := range m.FeeSponsorPools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeEnabledChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisteredPayee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisteredCounterpartyPayee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.CounterpartyPayee)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisteredFeePreferences) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.AcceptedDenoms) > 0 {
+		for _, s := range m.AcceptedDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.FallbackAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ChannelMinimumFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.MinimumFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PacketFeeEscrowTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EscrowTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *FeeSponsorPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeSponsorAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *RelayerRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Rewards.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *EpochRelayerRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovGenesis(uint64(m.Epoch))
+	}
+	l = m.RelayerRewards.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *RewardEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovGenesis(uint64(m.Number))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *ForwardRelayerAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.PacketId.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentifiedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdentifiedFees = append(m.IdentifiedFees, IdentifiedPacketFees{})
+			if err := m.IdentifiedFees[len(m.IdentifiedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeEnabledChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeEnabledChannels = append(m.FeeEnabledChannels, FeeEnabledChannel{})
+			if err := m.FeeEnabledChannels[len(m.FeeEnabledChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredPayees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredPayees = append(m.RegisteredPayees, RegisteredPayee{})
+			if err := m.RegisteredPayees[len(m.RegisteredPayees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredCounterpartyPayees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredCounterpartyPayees = append(m.RegisteredCounterpartyPayees, RegisteredCounterpartyPayee{})
+			if err := m.RegisteredCounterpartyPayees[len(m.RegisteredCounterpartyPayees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardRelayers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardRelayers = append(m.ForwardRelayers, ForwardRelayerAddress{})
+			if err := m.ForwardRelayers[len(m.ForwardRelayers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredFeePreferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredFeePreferences = append(m.RegisteredFeePreferences, RegisteredFeePreferences{})
+			if err := m.RegisteredFeePreferences[len(m.RegisteredFeePreferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelMinimumFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelMinimumFees = append(m.ChannelMinimumFees, ChannelMinimumFee{})
+			if err := m.ChannelMinimumFees[len(m.ChannelMinimumFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFeeEscrowTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketFeeEscrowTimes = append(m.PacketFeeEscrowTimes, PacketFeeEscrowTime{})
+			if err := m.PacketFeeEscrowTimes[len(m.PacketFeeEscrowTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerRewards = append(m.RelayerRewards, RelayerRewards{})
+			if err := m.RelayerRewards[len(m.RelayerRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRelayerRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochRelayerRewards = append(m.EpochRelayerRewards, EpochRelayerRewards{})
+			if err := m.EpochRelayerRewards[len(m.EpochRelayerRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSponsorPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSponsorPools = append(m.FeeSponsorPools, FeeSponsorPool{})
+			if err := m.FeeSponsorPools[len(m.FeeSponsorPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeEnabledChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeEnabledChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredPayee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredCounterpartyPayee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredCounterpartyPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisteredFeePreferences) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredFeePreferences: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredFeePreferences: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedDenoms = append(m.AcceptedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelMinimumFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelMinimumFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelMinimumFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimumFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *FeeEnabledChannel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	return n
-}
-
-func (m *RegisteredPayee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.Relayer)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.Payee)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	return n
-}
-
-func (m *RegisteredCounterpartyPayee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.Relayer)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.CounterpartyPayee)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	return n
-}
 
-func (m *ForwardRelayerAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.PacketId.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *PacketFeeEscrowTime) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -807,15 +3402,15 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PacketFeeEscrowTime: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PacketFeeEscrowTime: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdentifiedFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -842,16 +3437,130 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IdentifiedFees = append(m.IdentifiedFees, IdentifiedPacketFees{})
-			if err := m.IdentifiedFees[len(m.IdentifiedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EscrowTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSponsorPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSponsorPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSponsorPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeEnabledChannels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -861,31 +3570,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeEnabledChannels = append(m.FeeEnabledChannels, FeeEnabledChannel{})
-			if err := m.FeeEnabledChannels[len(m.FeeEnabledChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredPayees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -895,31 +3602,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RegisteredPayees = append(m.RegisteredPayees, RegisteredPayee{})
-			if err := m.RegisteredPayees[len(m.RegisteredPayees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredCounterpartyPayees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -929,29 +3634,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RegisteredCounterpartyPayees = append(m.RegisteredCounterpartyPayees, RegisteredCounterpartyPayee{})
-			if err := m.RegisteredCounterpartyPayees[len(m.RegisteredCounterpartyPayees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardRelayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -978,8 +3681,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForwardRelayers = append(m.ForwardRelayers, ForwardRelayerAddress{})
-			if err := m.ForwardRelayers[len(m.ForwardRelayers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Allowances = append(m.Allowances, FeeSponsorAllowance{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1004,7 +3707,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
+func (m *FeeSponsorAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1027,15 +3730,15 @@ func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeeEnabledChannel: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeSponsorAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeEnabledChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeSponsorAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1063,13 +3766,13 @@ func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1079,23 +3782,61 @@ func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.SpendLimit = append(m.SpendLimit, types1.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1118,7 +3859,7 @@ func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
+func (m *RelayerRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1141,15 +3882,15 @@ func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredPayee: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayerRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayerRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1177,11 +3918,11 @@ func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.Payee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1209,13 +3950,13 @@ func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1225,23 +3966,24 @@ func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payee = string(dAtA[iNdEx:postIndex])
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1264,7 +4006,7 @@ func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
+func (m *EpochRelayerRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1287,17 +4029,17 @@ func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredCounterpartyPayee: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochRelayerRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredCounterpartyPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochRelayerRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var stringLen uint64
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1307,29 +4049,16 @@ func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerRewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1339,29 +4068,80 @@ func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
+			if err := m.RelayerRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1371,24 +4151,30 @@ func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Number |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -133,6 +134,139 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			host.ErrInvalidID,
 		},
+		{
+			"invalid registered fee preferences: invalid port ID",
+			func() {
+				genState.RegisteredFeePreferences[0].PortId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid registered fee preferences: invalid channel ID",
+			func() {
+				genState.RegisteredFeePreferences[0].ChannelId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid registered fee preferences: invalid payee address",
+			func() {
+				genState.RegisteredFeePreferences[0].Payee = ""
+			},
+			errors.New("failed to convert payee address into sdk.AccAddress"),
+		},
+		{
+			"invalid registered fee preferences: empty accepted denominations",
+			func() {
+				genState.RegisteredFeePreferences[0].AcceptedDenoms = nil
+			},
+			types.ErrInvalidFeePreferences,
+		},
+		{
+			"invalid registered fee preferences: invalid fallback address",
+			func() {
+				genState.RegisteredFeePreferences[0].FallbackAddress = "invalid-address"
+			},
+			errors.New("failed to convert fallback address into sdk.AccAddress"),
+		},
+		{
+			"invalid channel minimum fee: invalid port ID",
+			func() {
+				genState.ChannelMinimumFees[0].PortId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channel minimum fee: invalid channel ID",
+			func() {
+				genState.ChannelMinimumFees[0].ChannelId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channel minimum fee: empty minimum fee",
+			func() {
+				genState.ChannelMinimumFees[0].MinimumFee = types.Fee{}
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"invalid params: negative refund grace period",
+			func() {
+				genState.Params = types.NewParams(-time.Hour, 0, types.DefaultRewardEpochsRetained)
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"invalid packet fee escrow time: invalid packet",
+			func() {
+				genState.PacketFeeEscrowTimes[0].PacketId = channeltypes.PacketId{}
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid packet fee escrow time: invalid refund address",
+			func() {
+				genState.PacketFeeEscrowTimes[0].RefundAddress = ""
+			},
+			errors.New("failed to convert refund address into sdk.AccAddress"),
+		},
+		{
+			"invalid relayer rewards: invalid payee address",
+			func() {
+				genState.RelayerRewards[0].Payee = ""
+			},
+			errors.New("failed to convert payee address into sdk.AccAddress"),
+		},
+		{
+			"invalid relayer rewards: empty rewards",
+			func() {
+				genState.RelayerRewards[0].Rewards = types.Fee{}
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"invalid epoch relayer rewards: zero epoch",
+			func() {
+				genState.EpochRelayerRewards[0].Epoch = 0
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"invalid epoch relayer rewards: epoch after the current reward epoch",
+			func() {
+				genState.EpochRelayerRewards[0].Epoch = 3
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"invalid reward epoch: negative start height",
+			func() {
+				genState.RewardEpoch.StartHeight = -1
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"invalid fee sponsor pool: invalid channel ID",
+			func() {
+				genState.FeeSponsorPools[0].ChannelId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid fee sponsor pool: invalid sponsor address",
+			func() {
+				genState.FeeSponsorPools[0].Sponsor = ""
+			},
+			errors.New("failed to convert sponsor address into sdk.AccAddress"),
+		},
+		{
+			"invalid fee sponsor pool: invalid allowed sender address",
+			func() {
+				genState.FeeSponsorPools[0].Allowances[0].Sender = ""
+			},
+			types.ErrInvalidFeeSponsorPool,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -171,6 +305,26 @@ func TestValidateGenesis(t *testing.T) {
 						ChannelId: ibctesting.FirstChannelID,
 					},
 				},
+				RegisteredFeePreferences: []types.RegisteredFeePreferences{
+					types.NewRegisteredFeePreferences(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAccAddress, []string{sdk.DefaultBondDenom}, defaultAccAddress),
+				},
+				ChannelMinimumFees: []types.ChannelMinimumFee{
+					types.NewChannelMinimumFee(ibctesting.MockFeePort, ibctesting.FirstChannelID, types.NewFee(defaultRecvFee, defaultAckFee, nil)),
+				},
+				Params: types.NewParams(time.Hour, 0, types.DefaultRewardEpochsRetained),
+				PacketFeeEscrowTimes: []types.PacketFeeEscrowTime{
+					types.NewPacketFeeEscrowTime(channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1), defaultAccAddress, time.Now()),
+				},
+				RelayerRewards: []types.RelayerRewards{
+					types.NewRelayerRewards(defaultAccAddress, ibctesting.FirstChannelID, types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)),
+				},
+				EpochRelayerRewards: []types.EpochRelayerRewards{
+					types.NewEpochRelayerRewards(2, types.NewRelayerRewards(defaultAccAddress, ibctesting.FirstChannelID, types.NewFee(defaultRecvFee, nil, nil))),
+				},
+				RewardEpoch: types.NewRewardEpoch(2, 20),
+				FeeSponsorPools: []types.FeeSponsorPool{
+					types.NewFeeSponsorPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAccAddress, []types.FeeSponsorAllowance{types.NewFeeSponsorAllowance(defaultAccAddress, defaultRecvFee, nil)}),
+				},
 			}
 
 			tc.malleate()
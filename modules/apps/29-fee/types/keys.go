@@ -1,12 +1,15 @@
 package types
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"strconv"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 )
@@ -38,8 +41,38 @@ const (
 	// FeesInEscrowPrefix is the key prefix for fee in escrow mapping
 	FeesInEscrowPrefix = "feesInEscrow"
 
+	// PayerFeesInEscrowPrefix is the key prefix for the index of the packets for which a payer has fees in escrow
+	PayerFeesInEscrowPrefix = "payerFeesInEscrow"
+
 	// ForwardRelayerPrefix is the key prefix for forward relayer addresses stored in state for async acknowledgements
 	ForwardRelayerPrefix = "forwardRelayer"
+
+	// FeePreferencesKeyPrefix is the key prefix for the fee preferences of payees stored in state
+	FeePreferencesKeyPrefix = "feePreferences"
+
+	// ChannelMinimumFeeKeyPrefix is the key prefix for the minimum fees of fee enabled channels stored in state
+	ChannelMinimumFeeKeyPrefix = "channelMinimumFee"
+
+	// FeeEscrowTimePrefix is the key prefix for the time at which packet fees were escrowed by their payers
+	FeeEscrowTimePrefix = "feeEscrowTime"
+
+	// ParamsKey is the store key for the fee middleware parameters
+	ParamsKey = "params"
+
+	// RelayerRewardsKeyPrefix is the key prefix for the lifetime rewards of relayer payees stored in state
+	RelayerRewardsKeyPrefix = "relayerRewards"
+
+	// EpochRelayerRewardsKeyPrefix is the key prefix for the per-epoch rewards of relayer payees stored in state
+	EpochRelayerRewardsKeyPrefix = "epochRelayerRewards"
+
+	// RewardEpochKey is the store key for the current relayer reward epoch
+	RewardEpochKey = "rewardEpoch"
+
+	// FeeSponsorPoolKeyPrefix is the key prefix for the fee sponsor pools stored in state
+	FeeSponsorPoolKeyPrefix = "feeSponsorPool"
+
+	// feeSponsorPoolAddressVersion should remain as ics29-sponsor-1 to derive the same fee sponsor pool addresses
+	feeSponsorPoolAddressVersion = "ics29-sponsor-1"
 )
 
 // KeyLocked returns the key used to lock and unlock the fee module. This key is used
@@ -158,3 +191,189 @@ func ParseKeyFeesInEscrow(key string) (channeltypes.PacketId, error) {
 func KeyFeesInEscrowChannelPrefix(portID, channelID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", FeesInEscrowPrefix, portID, channelID))
 }
+
+// KeyPayerFeesInEscrow returns the key indexing a packet for which the payer has fees in escrow
+func KeyPayerFeesInEscrow(payerAddr string, packetID channeltypes.PacketId) []byte {
+	return []byte(fmt.Sprintf("%s%s/%s/%d", KeyPayerFeesInEscrowPrefix(payerAddr), packetID.PortId, packetID.ChannelId, packetID.Sequence))
+}
+
+// KeyPayerFeesInEscrowPrefix returns the key prefix for the packets for which the payer has fees in escrow
+func KeyPayerFeesInEscrowPrefix(payerAddr string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", PayerFeesInEscrowPrefix, payerAddr))
+}
+
+// ParseKeyPayerFeesInEscrow parses the key indexing a packet for which a payer has fees in escrow and returns
+// the payer address and the packet id
+func ParseKeyPayerFeesInEscrow(key string) (string, channeltypes.PacketId, error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 5 {
+		return "", channeltypes.PacketId{}, errorsmod.Wrapf(
+			ibcerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 5, len(keySplit),
+		)
+	}
+
+	if keySplit[0] != PayerFeesInEscrowPrefix {
+		return "", channeltypes.PacketId{}, errorsmod.Wrapf(ibcerrors.ErrLogic, "key prefix is incorrect: expected %s, got %s", PayerFeesInEscrowPrefix, keySplit[0])
+	}
+
+	seq, err := strconv.ParseUint(keySplit[4], 10, 64)
+	if err != nil {
+		return "", channeltypes.PacketId{}, err
+	}
+
+	return keySplit[1], channeltypes.NewPacketID(keySplit[2], keySplit[3], seq), nil
+}
+
+// KeyFeePreferences returns the key for the fee preferences of a payee on the given port and channel
+func KeyFeePreferences(portID, channelID, payeeAddr string) []byte {
+	return append(KeyFeePreferencesChannelPrefix(portID, channelID), payeeAddr...)
+}
+
+// KeyFeePreferencesChannelPrefix returns the key prefix for the fee preferences of payees on the given port and channel
+func KeyFeePreferencesChannelPrefix(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/", FeePreferencesKeyPrefix, portID, channelID))
+}
+
+// KeyChannelMinimumFee returns the key for the minimum fee of the given port and channel identifiers
+func KeyChannelMinimumFee(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", ChannelMinimumFeeKeyPrefix, portID, channelID))
+}
+
+// ParseKeyChannelMinimumFee parses the key used to store the minimum fee of a channel and returns the port and channel
+// identifiers
+func ParseKeyChannelMinimumFee(key string) (portID, channelID string, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 3 {
+		return "", "", errorsmod.Wrapf(
+			ibcerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 3, len(keySplit),
+		)
+	}
+
+	if keySplit[0] != ChannelMinimumFeeKeyPrefix {
+		return "", "", errorsmod.Wrapf(ibcerrors.ErrLogic, "key prefix is incorrect: expected %s, got %s", ChannelMinimumFeeKeyPrefix, keySplit[0])
+	}
+
+	return keySplit[1], keySplit[2], nil
+}
+
+// KeyFeeEscrowTime returns the key for the time at which the given refund address last escrowed a fee for the packet
+func KeyFeeEscrowTime(packetID channeltypes.PacketId, refundAddr string) []byte {
+	return append(KeyFeeEscrowTimePacketPrefix(packetID), refundAddr...)
+}
+
+// KeyFeeEscrowTimePacketPrefix returns the key prefix for the fee escrow times of the given packet
+func KeyFeeEscrowTimePacketPrefix(packetID channeltypes.PacketId) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d/", FeeEscrowTimePrefix, packetID.PortId, packetID.ChannelId, packetID.Sequence))
+}
+
+// ParseKeyFeeEscrowTime parses the key used to store the time at which a packet fee was escrowed and returns
+// the packetID and the refund address
+func ParseKeyFeeEscrowTime(key string) (channeltypes.PacketId, string, error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 5 {
+		return channeltypes.PacketId{}, "", errorsmod.Wrapf(
+			ibcerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 5, len(keySplit),
+		)
+	}
+
+	if keySplit[0] != FeeEscrowTimePrefix {
+		return channeltypes.PacketId{}, "", errorsmod.Wrapf(ibcerrors.ErrLogic, "key prefix is incorrect: expected %s, got %s", FeeEscrowTimePrefix, keySplit[0])
+	}
+
+	seq, err := strconv.ParseUint(keySplit[3], 10, 64)
+	if err != nil {
+		return channeltypes.PacketId{}, "", err
+	}
+
+	return channeltypes.NewPacketID(keySplit[1], keySplit[2], seq), keySplit[4], nil
+}
+
+// KeyRelayerRewards returns the key for the lifetime rewards of a payee on the given channel
+func KeyRelayerRewards(payeeAddr, channelID string) []byte {
+	return append(KeyRelayerRewardsPayeePrefix(payeeAddr), channelID...)
+}
+
+// KeyRelayerRewardsPayeePrefix returns the key prefix for the lifetime rewards of a payee on all channels
+func KeyRelayerRewardsPayeePrefix(payeeAddr string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", RelayerRewardsKeyPrefix, payeeAddr))
+}
+
+// ParseKeyRelayerRewards parses the key used to store the lifetime rewards of a payee and returns the payee address
+// and channel identifier
+func ParseKeyRelayerRewards(key string) (payeeAddr, channelID string, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 3 {
+		return "", "", errorsmod.Wrapf(
+			ibcerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 3, len(keySplit),
+		)
+	}
+
+	if keySplit[0] != RelayerRewardsKeyPrefix {
+		return "", "", errorsmod.Wrapf(ibcerrors.ErrLogic, "key prefix is incorrect: expected %s, got %s", RelayerRewardsKeyPrefix, keySplit[0])
+	}
+
+	return keySplit[1], keySplit[2], nil
+}
+
+// KeyEpochRelayerRewards returns the key for the rewards of a payee on the given channel during an epoch
+func KeyEpochRelayerRewards(epoch uint64, payeeAddr, channelID string) []byte {
+	return append(KeyEpochRelayerRewardsPayeePrefix(epoch, payeeAddr), channelID...)
+}
+
+// KeyEpochRelayerRewardsPrefix returns the key prefix for the rewards of all payees during an epoch
+func KeyEpochRelayerRewardsPrefix(epoch uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%d/", EpochRelayerRewardsKeyPrefix, epoch))
+}
+
+// KeyEpochRelayerRewardsPayeePrefix returns the key prefix for the rewards of a payee on all channels during an epoch
+func KeyEpochRelayerRewardsPayeePrefix(epoch uint64, payeeAddr string) []byte {
+	return append(KeyEpochRelayerRewardsPrefix(epoch), payeeAddr+"/"...)
+}
+
+// ParseKeyEpochRelayerRewards parses the key used to store the rewards of a payee during an epoch and returns the
+// epoch, the payee address and the channel identifier
+func ParseKeyEpochRelayerRewards(key string) (epoch uint64, payeeAddr, channelID string, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 4 {
+		return 0, "", "", errorsmod.Wrapf(
+			ibcerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 4, len(keySplit),
+		)
+	}
+
+	if keySplit[0] != EpochRelayerRewardsKeyPrefix {
+		return 0, "", "", errorsmod.Wrapf(ibcerrors.ErrLogic, "key prefix is incorrect: expected %s, got %s", EpochRelayerRewardsKeyPrefix, keySplit[0])
+	}
+
+	epoch, err = strconv.ParseUint(keySplit[1], 10, 64)
+	if err != nil {
+		return 0, "", "", err
+	}
+
+	return epoch, keySplit[2], keySplit[3], nil
+}
+
+// KeyFeeSponsorPool returns the key for the fee sponsor pool of a sponsor on the given port and channel identifiers
+func KeyFeeSponsorPool(portID, channelID, sponsorAddr string) []byte {
+	return append(KeyFeeSponsorPoolChannelPrefix(portID, channelID), sponsorAddr...)
+}
+
+// KeyFeeSponsorPoolChannelPrefix returns the key prefix for the fee sponsor pools on the given port and channel
+// identifiers
+func KeyFeeSponsorPoolChannelPrefix(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/", FeeSponsorPoolKeyPrefix, portID, channelID))
+}
+
+// GetFeeSponsorPoolAddress returns the address holding the funds of the fee sponsor pool of a sponsor on the given
+// port and channel identifiers
+func GetFeeSponsorPoolAddress(portID, channelID, sponsorAddr string) sdk.AccAddress {
+	// a slash is used to create domain separation between the identifiers to prevent address collisions between
+	// the pools of different sponsors and channels
+	contents := fmt.Sprintf("%s/%s/%s", portID, channelID, sponsorAddr)
+
+	// ADR 028 AddressHash construction
+	preImage := []byte(feeSponsorPoolAddressVersion)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"testing"
@@ -63,6 +64,151 @@ func TestKeyCounterpartyPayee(t *testing.T) {
 	require.Equal(t, string(key), fmt.Sprintf("%s/%s/%s", types.CounterpartyPayeeKeyPrefix, relayerAddress, channelID))
 }
 
+func TestKeyFeePreferences(t *testing.T) {
+	key := types.KeyFeePreferences(ibctesting.MockFeePort, ibctesting.FirstChannelID, "payee-address")
+	require.Equal(t, string(key), fmt.Sprintf("%s/%s/%s/%s", types.FeePreferencesKeyPrefix, ibctesting.MockFeePort, ibctesting.FirstChannelID, "payee-address"))
+	require.True(t, bytes.HasPrefix(key, types.KeyFeePreferencesChannelPrefix(ibctesting.MockFeePort, ibctesting.FirstChannelID)))
+}
+
+func TestKeyFeeSponsorPool(t *testing.T) {
+	key := types.KeyFeeSponsorPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, "sponsor-address")
+	require.Equal(t, string(key), fmt.Sprintf("%s/%s/%s/%s", types.FeeSponsorPoolKeyPrefix, ibctesting.MockFeePort, ibctesting.FirstChannelID, "sponsor-address"))
+	require.True(t, bytes.HasPrefix(key, types.KeyFeeSponsorPoolChannelPrefix(ibctesting.MockFeePort, ibctesting.FirstChannelID)))
+}
+
+func TestGetFeeSponsorPoolAddress(t *testing.T) {
+	address := types.GetFeeSponsorPoolAddress(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAccAddress)
+	require.Len(t, address, 20)
+	require.Equal(t, address, types.GetFeeSponsorPoolAddress(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAccAddress))
+
+	// pools of the same sponsor on different channels are held at different addresses
+	require.NotEqual(t, address, types.GetFeeSponsorPoolAddress(ibctesting.MockFeePort, "channel-1", defaultAccAddress))
+}
+
+func TestParseKeyChannelMinimumFee(t *testing.T) {
+	testCases := []struct {
+		name   string
+		key    string
+		expErr error
+	}{
+		{
+			"success",
+			string(types.KeyChannelMinimumFee(ibctesting.MockFeePort, ibctesting.FirstChannelID)),
+			nil,
+		},
+		{
+			"incorrect key - key split has incorrect length",
+			string(types.KeyFeesInEscrow(validPacketID)),
+			ibcerrors.ErrLogic,
+		},
+		{
+			"incorrect key - key prefix is incorrect",
+			fmt.Sprintf("%s/%s/%s", "ownerKey", ibctesting.MockFeePort, ibctesting.FirstChannelID),
+			ibcerrors.ErrLogic,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		portID, channelID, err := types.ParseKeyChannelMinimumFee(tc.key)
+
+		if tc.expErr == nil {
+			require.NoError(t, err)
+			require.Equal(t, ibctesting.MockFeePort, portID)
+			require.Equal(t, ibctesting.FirstChannelID, channelID)
+		} else {
+			require.ErrorIs(t, err, tc.expErr)
+		}
+	}
+}
+
+func TestParseKeyFeeEscrowTime(t *testing.T) {
+	testCases := []struct {
+		name   string
+		key    string
+		expErr error
+	}{
+		{
+			"success",
+			string(types.KeyFeeEscrowTime(validPacketID, defaultAccAddress)),
+			nil,
+		},
+		{
+			"incorrect key - key split has incorrect length",
+			string(types.KeyFeesInEscrow(validPacketID)),
+			ibcerrors.ErrLogic,
+		},
+		{
+			"incorrect key - key prefix is incorrect",
+			fmt.Sprintf("%s/%s/%s/%d/%s", "ownerKey", validPacketID.PortId, validPacketID.ChannelId, validPacketID.Sequence, defaultAccAddress),
+			ibcerrors.ErrLogic,
+		},
+		{
+			"incorrect key - invalid sequence",
+			fmt.Sprintf("%s/%s/%s/%s/%s", types.FeeEscrowTimePrefix, validPacketID.PortId, validPacketID.ChannelId, "sequence", defaultAccAddress),
+			errors.New("invalid syntax"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		packetID, refundAddr, err := types.ParseKeyFeeEscrowTime(tc.key)
+
+		if tc.expErr == nil {
+			require.NoError(t, err)
+			require.Equal(t, validPacketID, packetID)
+			require.Equal(t, defaultAccAddress, refundAddr)
+		} else {
+			ibctesting.RequireErrorIsOrContains(t, err, tc.expErr, err.Error())
+		}
+	}
+}
+
+func TestParseKeyPayerFeesInEscrow(t *testing.T) {
+	testCases := []struct {
+		name   string
+		key    string
+		expErr error
+	}{
+		{
+			"success",
+			string(types.KeyPayerFeesInEscrow(defaultAccAddress, validPacketID)),
+			nil,
+		},
+		{
+			"incorrect key - key split has incorrect length",
+			string(types.KeyFeesInEscrow(validPacketID)),
+			ibcerrors.ErrLogic,
+		},
+		{
+			"incorrect key - key prefix is incorrect",
+			fmt.Sprintf("%s/%s/%s/%s/%d", "ownerKey", defaultAccAddress, validPacketID.PortId, validPacketID.ChannelId, validPacketID.Sequence),
+			ibcerrors.ErrLogic,
+		},
+		{
+			"incorrect key - invalid sequence",
+			fmt.Sprintf("%s/%s/%s/%s/%s", types.PayerFeesInEscrowPrefix, defaultAccAddress, validPacketID.PortId, validPacketID.ChannelId, "sequence"),
+			errors.New("invalid syntax"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		payerAddr, packetID, err := types.ParseKeyPayerFeesInEscrow(tc.key)
+
+		if tc.expErr == nil {
+			require.NoError(t, err)
+			require.Equal(t, defaultAccAddress, payerAddr)
+			require.Equal(t, validPacketID, packetID)
+		} else {
+			ibctesting.RequireErrorIsOrContains(t, err, tc.expErr, err.Error())
+		}
+	}
+}
+
 func TestKeyFeesInEscrow(t *testing.T) {
 	key := types.KeyFeesInEscrow(validPacketID)
 	require.Equal(t, string(key), fmt.Sprintf("%s/%s/%s/%d", types.FeesInEscrowPrefix, ibctesting.MockFeePort, ibctesting.FirstChannelID, 1))
@@ -218,3 +364,85 @@ func TestParseKeyCounterpartyPayee(t *testing.T) {
 		}
 	}
 }
+
+func TestParseKeyRelayerRewards(t *testing.T) {
+	testCases := []struct {
+		name   string
+		key    string
+		expErr error
+	}{
+		{
+			"success",
+			string(types.KeyRelayerRewards(defaultAccAddress, ibctesting.FirstChannelID)),
+			nil,
+		},
+		{
+			"incorrect key - key split has incorrect length",
+			string(types.KeyRelayerRewardsPayeePrefix(defaultAccAddress)) + "transfer/" + ibctesting.FirstChannelID,
+			ibcerrors.ErrLogic,
+		},
+		{
+			"incorrect key - key prefix is incorrect",
+			fmt.Sprintf("%s/%s/%s", types.PayeeKeyPrefix, defaultAccAddress, ibctesting.FirstChannelID),
+			ibcerrors.ErrLogic,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		payeeAddr, channelID, err := types.ParseKeyRelayerRewards(tc.key)
+
+		if tc.expErr == nil {
+			require.NoError(t, err)
+			require.Equal(t, defaultAccAddress, payeeAddr)
+			require.Equal(t, ibctesting.FirstChannelID, channelID)
+		} else {
+			require.ErrorIs(t, err, tc.expErr)
+		}
+	}
+}
+
+func TestParseKeyEpochRelayerRewards(t *testing.T) {
+	testCases := []struct {
+		name   string
+		key    string
+		expErr error
+	}{
+		{
+			"success",
+			string(types.KeyEpochRelayerRewards(5, defaultAccAddress, ibctesting.FirstChannelID)),
+			nil,
+		},
+		{
+			"incorrect key - key split has incorrect length",
+			string(types.KeyRelayerRewards(defaultAccAddress, ibctesting.FirstChannelID)),
+			ibcerrors.ErrLogic,
+		},
+		{
+			"incorrect key - key prefix is incorrect",
+			fmt.Sprintf("%s/%d/%s/%s", types.RelayerRewardsKeyPrefix, 5, defaultAccAddress, ibctesting.FirstChannelID),
+			ibcerrors.ErrLogic,
+		},
+		{
+			"incorrect key - invalid epoch",
+			fmt.Sprintf("%s/%s/%s/%s", types.EpochRelayerRewardsKeyPrefix, "epoch", defaultAccAddress, ibctesting.FirstChannelID),
+			errors.New("invalid syntax"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		epoch, payeeAddr, channelID, err := types.ParseKeyEpochRelayerRewards(tc.key)
+
+		if tc.expErr == nil {
+			require.NoError(t, err)
+			require.Equal(t, uint64(5), epoch)
+			require.Equal(t, defaultAccAddress, payeeAddr)
+			require.Equal(t, ibctesting.FirstChannelID, channelID)
+		} else {
+			ibctesting.RequireErrorIsOrContains(t, err, tc.expErr, err.Error())
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
+// NewChannelMinimumFee creates and returns a new ChannelMinimumFee instance
+func NewChannelMinimumFee(portID, channelID string, minimumFee Fee) ChannelMinimumFee {
+	return ChannelMinimumFee{
+		PortId:     portID,
+		ChannelId:  channelID,
+		MinimumFee: minimumFee,
+	}
+}
+
+// Validate performs basic validation of the channel minimum fee. The minimum fee of a channel must be a valid fee, so at
+// least one of the receive, acknowledgement and timeout fees must be non-zero.
+func (c ChannelMinimumFee) Validate() error {
+	if err := host.PortIdentifierValidator(c.PortId); err != nil {
+		return errorsmod.Wrapf(err, "invalid port identifier: %s", c.PortId)
+	}
+
+	if err := host.ChannelIdentifierValidator(c.ChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid channel identifier: %s", c.ChannelId)
+	}
+
+	return c.MinimumFee.Validate()
+}
+
+// IsEmpty returns true if the receive, acknowledgement and timeout fees are all empty
+func (f Fee) IsEmpty() bool {
+	return f.RecvFee.Empty() && f.AckFee.Empty() && f.TimeoutFee.Empty()
+}
+
+// ValidateMinimum checks the fee against the given minimum fee. Each of the receive, acknowledgement and timeout fees
+// must be greater than or equal to its minimum in at least one denomination of the minimum, as for the minimum gas
+// prices of a node. Empty minimums are always met.
+func (f Fee) ValidateMinimum(minimumFee Fee) error {
+	if !meetsMinimum(f.RecvFee, minimumFee.RecvFee) {
+		return errorsmod.Wrapf(ErrFeeBelowMinimum, "recv fee %s is below the minimum recv fee %s", f.RecvFee, minimumFee.RecvFee)
+	}
+
+	if !meetsMinimum(f.AckFee, minimumFee.AckFee) {
+		return errorsmod.Wrapf(ErrFeeBelowMinimum, "ack fee %s is below the minimum ack fee %s", f.AckFee, minimumFee.AckFee)
+	}
+
+	if !meetsMinimum(f.TimeoutFee, minimumFee.TimeoutFee) {
+		return errorsmod.Wrapf(ErrFeeBelowMinimum, "timeout fee %s is below the minimum timeout fee %s", f.TimeoutFee, minimumFee.TimeoutFee)
+	}
+
+	return nil
+}
+
+// meetsMinimum returns true if the minimum is empty or if the fee is greater than or equal to the minimum in any of its
+// denominations
+func meetsMinimum(fee, minimum sdk.Coins) bool {
+	return minimum.IsZero() || fee.IsAnyGTE(minimum)
+}
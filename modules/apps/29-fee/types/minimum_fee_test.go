@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/29-fee/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func TestFeeValidateMinimum(t *testing.T) {
+	var (
+		fee        types.Fee
+		minimumFee types.Fee
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: fee equal to the minimum fee",
+			func() {},
+			nil,
+		},
+		{
+			"success: empty minimum fee",
+			func() {
+				minimumFee = types.Fee{}
+			},
+			nil,
+		},
+		{
+			"success: empty minimum timeout fee",
+			func() {
+				fee.TimeoutFee = nil
+				minimumFee.TimeoutFee = nil
+			},
+			nil,
+		},
+		{
+			"success: fee meets the minimum fee in one of its denominations",
+			func() {
+				minimumFee.RecvFee = minimumFee.RecvFee.Add(sdk.NewCoin(ibctesting.SecondaryDenom, sdkmath.NewInt(100)))
+			},
+			nil,
+		},
+		{
+			"recv fee below the minimum",
+			func() {
+				minimumFee.RecvFee = defaultRecvFee.Add(defaultRecvFee...)
+			},
+			types.ErrFeeBelowMinimum,
+		},
+		{
+			"ack fee below the minimum",
+			func() {
+				fee.AckFee = nil
+			},
+			types.ErrFeeBelowMinimum,
+		},
+		{
+			"timeout fee in another denomination than the minimum",
+			func() {
+				fee.TimeoutFee = sdk.NewCoins(sdk.NewCoin(ibctesting.SecondaryDenom, sdkmath.NewInt(300)))
+			},
+			types.ErrFeeBelowMinimum,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			fee = types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			minimumFee = types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+			tc.malleate()
+
+			err := fee.ValidateMinimum(minimumFee)
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
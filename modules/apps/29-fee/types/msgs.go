@@ -19,11 +19,29 @@ var (
 	_ sdk.Msg = (*MsgRegisterCounterpartyPayee)(nil)
 	_ sdk.Msg = (*MsgPayPacketFee)(nil)
 	_ sdk.Msg = (*MsgPayPacketFeeAsync)(nil)
+	_ sdk.Msg = (*MsgRegisterFeePreferences)(nil)
+	_ sdk.Msg = (*MsgUpdateChannelMinimumFee)(nil)
+	_ sdk.Msg = (*MsgRefundPacketFee)(nil)
+	_ sdk.Msg = (*MsgRefundSponsoredPacketFee)(nil)
+	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgRegisterFeeSponsorPool)(nil)
+	_ sdk.Msg = (*MsgFundFeeSponsorPool)(nil)
+	_ sdk.Msg = (*MsgWithdrawFeeSponsorPool)(nil)
+	_ sdk.Msg = (*MsgDeleteFeeSponsorPool)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgRegisterPayee)(nil)
 	_ sdk.HasValidateBasic = (*MsgRegisterCounterpartyPayee)(nil)
 	_ sdk.HasValidateBasic = (*MsgPayPacketFee)(nil)
 	_ sdk.HasValidateBasic = (*MsgPayPacketFeeAsync)(nil)
+	_ sdk.HasValidateBasic = (*MsgRegisterFeePreferences)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateChannelMinimumFee)(nil)
+	_ sdk.HasValidateBasic = (*MsgRefundPacketFee)(nil)
+	_ sdk.HasValidateBasic = (*MsgRefundSponsoredPacketFee)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgRegisterFeeSponsorPool)(nil)
+	_ sdk.HasValidateBasic = (*MsgFundFeeSponsorPool)(nil)
+	_ sdk.HasValidateBasic = (*MsgWithdrawFeeSponsorPool)(nil)
+	_ sdk.HasValidateBasic = (*MsgDeleteFeeSponsorPool)(nil)
 )
 
 // NewMsgRegisterPayee creates a new instance of MsgRegisterPayee
@@ -128,6 +146,12 @@ func (msg MsgPayPacketFee) ValidateBasic() error {
 		return ErrRelayersNotEmpty
 	}
 
+	if msg.FeeSponsor != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.FeeSponsor); err != nil {
+			return errorsmod.Wrap(err, "failed to convert msg.FeeSponsor into sdk.AccAddress")
+		}
+	}
+
 	return msg.Fee.Validate()
 }
 
@@ -145,5 +169,267 @@ func (msg MsgPayPacketFeeAsync) ValidateBasic() error {
 		return err
 	}
 
+	if msg.FeeSponsor != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.FeeSponsor); err != nil {
+			return errorsmod.Wrap(err, "failed to convert msg.FeeSponsor into sdk.AccAddress")
+		}
+	}
+
 	return msg.PacketFee.Validate()
 }
+
+// NewMsgRegisterFeePreferences creates a new instance of MsgRegisterFeePreferences
+func NewMsgRegisterFeePreferences(portID, channelID, payeeAddr string, acceptedDenoms []string, fallbackAddr string) *MsgRegisterFeePreferences {
+	return &MsgRegisterFeePreferences{
+		PortId:          portID,
+		ChannelId:       channelID,
+		Payee:           payeeAddr,
+		AcceptedDenoms:  acceptedDenoms,
+		FallbackAddress: fallbackAddr,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgRegisterFeePreferences fields
+func (msg MsgRegisterFeePreferences) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Payee)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to create sdk.AccAddress from payee address")
+	}
+
+	// empty accepted denominations remove the fee preferences
+	if len(msg.AcceptedDenoms) == 0 {
+		if msg.FallbackAddress != "" {
+			return errorsmod.Wrap(ErrInvalidFeePreferences, "fallback address must be empty when no fee denominations are accepted")
+		}
+
+		return nil
+	}
+
+	return ValidateFeePreferences(msg.AcceptedDenoms, msg.FallbackAddress)
+}
+
+// NewMsgUpdateChannelMinimumFee creates a new instance of MsgUpdateChannelMinimumFee
+func NewMsgUpdateChannelMinimumFee(signer, portID, channelID string, minimumFee Fee) *MsgUpdateChannelMinimumFee {
+	return &MsgUpdateChannelMinimumFee{
+		Signer:     signer,
+		PortId:     portID,
+		ChannelId:  channelID,
+		MinimumFee: minimumFee,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgUpdateChannelMinimumFee fields
+func (msg MsgUpdateChannelMinimumFee) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrap(err, "failed to convert msg.Signer into sdk.AccAddress")
+	}
+
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	// an empty minimum fee removes the minimum fee of the channel
+	if msg.MinimumFee.IsEmpty() {
+		return nil
+	}
+
+	return msg.MinimumFee.Validate()
+}
+
+// NewMsgRefundPacketFee creates a new instance of MsgRefundPacketFee
+func NewMsgRefundPacketFee(packetID channeltypes.PacketId, refundAddr string) *MsgRefundPacketFee {
+	return &MsgRefundPacketFee{
+		PacketId:      packetID,
+		RefundAddress: refundAddr,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgRefundPacketFee fields
+func (msg MsgRefundPacketFee) ValidateBasic() error {
+	if err := msg.PacketId.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.RefundAddress); err != nil {
+		return errorsmod.Wrap(err, "failed to convert msg.RefundAddress into sdk.AccAddress")
+	}
+
+	return nil
+}
+
+// NewMsgRefundSponsoredPacketFee creates a new instance of MsgRefundSponsoredPacketFee
+func NewMsgRefundSponsoredPacketFee(packetID channeltypes.PacketId, sponsorAddr string) *MsgRefundSponsoredPacketFee {
+	return &MsgRefundSponsoredPacketFee{
+		PacketId: packetID,
+		Sponsor:  sponsorAddr,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgRefundSponsoredPacketFee fields
+func (msg MsgRefundSponsoredPacketFee) ValidateBasic() error {
+	if err := msg.PacketId.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sponsor); err != nil {
+		return errorsmod.Wrap(err, "failed to convert msg.Sponsor into sdk.AccAddress")
+	}
+
+	return nil
+}
+
+// NewMsgUpdateParams creates a new instance of MsgUpdateParams
+func NewMsgUpdateParams(signer string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Signer: signer,
+		Params: params,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgUpdateParams fields
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrap(err, "failed to convert msg.Signer into sdk.AccAddress")
+	}
+
+	return msg.Params.Validate()
+}
+
+// NewMsgRegisterFeeSponsorPool creates a new instance of MsgRegisterFeeSponsorPool
+func NewMsgRegisterFeeSponsorPool(portID, channelID, sponsorAddr string, allowances []FeeSponsorAllowance) *MsgRegisterFeeSponsorPool {
+	return &MsgRegisterFeeSponsorPool{
+		PortId:     portID,
+		ChannelId:  channelID,
+		Sponsor:    sponsorAddr,
+		Allowances: allowances,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgRegisterFeeSponsorPool fields
+func (msg MsgRegisterFeeSponsorPool) ValidateBasic() error {
+	if len(msg.Allowances) == 0 {
+		return errorsmod.Wrap(ErrInvalidFeeSponsorPool, "allowances cannot be empty")
+	}
+
+	return NewFeeSponsorPool(msg.PortId, msg.ChannelId, msg.Sponsor, msg.Allowances).Validate()
+}
+
+// NewMsgFundFeeSponsorPool creates a new instance of MsgFundFeeSponsorPool
+func NewMsgFundFeeSponsorPool(portID, channelID, sponsorAddr, depositorAddr string, amount sdk.Coins) *MsgFundFeeSponsorPool {
+	return &MsgFundFeeSponsorPool{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sponsor:   sponsorAddr,
+		Depositor: depositorAddr,
+		Amount:    amount,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgFundFeeSponsorPool fields
+func (msg MsgFundFeeSponsorPool) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sponsor); err != nil {
+		return errorsmod.Wrap(err, "failed to convert msg.Sponsor into sdk.AccAddress")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return errorsmod.Wrap(err, "failed to convert msg.Depositor into sdk.AccAddress")
+	}
+
+	return validateFeeSponsorPoolAmount(msg.Amount)
+}
+
+// NewMsgWithdrawFeeSponsorPool creates a new instance of MsgWithdrawFeeSponsorPool
+func NewMsgWithdrawFeeSponsorPool(portID, channelID, sponsorAddr, recipientAddr string, amount sdk.Coins) *MsgWithdrawFeeSponsorPool {
+	return &MsgWithdrawFeeSponsorPool{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sponsor:   sponsorAddr,
+		Recipient: recipientAddr,
+		Amount:    amount,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgWithdrawFeeSponsorPool fields
+func (msg MsgWithdrawFeeSponsorPool) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sponsor); err != nil {
+		return errorsmod.Wrap(err, "failed to convert msg.Sponsor into sdk.AccAddress")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return errorsmod.Wrap(err, "failed to convert msg.Recipient into sdk.AccAddress")
+	}
+
+	return validateFeeSponsorPoolAmount(msg.Amount)
+}
+
+// NewMsgDeleteFeeSponsorPool creates a new instance of MsgDeleteFeeSponsorPool
+func NewMsgDeleteFeeSponsorPool(portID, channelID, sponsorAddr, recipientAddr string) *MsgDeleteFeeSponsorPool {
+	return &MsgDeleteFeeSponsorPool{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sponsor:   sponsorAddr,
+		Recipient: recipientAddr,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgDeleteFeeSponsorPool fields
+func (msg MsgDeleteFeeSponsorPool) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sponsor); err != nil {
+		return errorsmod.Wrap(err, "failed to convert msg.Sponsor into sdk.AccAddress")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return errorsmod.Wrap(err, "failed to convert msg.Recipient into sdk.AccAddress")
+	}
+
+	return nil
+}
+
+// validateFeeSponsorPoolAmount validates the amount deposited into or withdrawn from a fee sponsor pool
+func validateFeeSponsorPoolAmount(amount sdk.Coins) error {
+	if !amount.IsValid() {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid amount: %s", amount)
+	}
+
+	if amount.IsZero() {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "amount cannot be zero")
+	}
+
+	return nil
+}
@@ -3,9 +3,12 @@ package types_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 
@@ -190,8 +193,8 @@ func TestRegisterCountepartyAddressGetSigners(t *testing.T) {
 	require.Equal(t, accAddress.Bytes(), signers[0])
 }
 
-func TestMsgPayPacketFeeValidation(t *testing.T) {
-	var msg *types.MsgPayPacketFee
+func TestMsgRegisterFeePreferencesValidation(t *testing.T) {
+	var msg *types.MsgRegisterFeePreferences
 
 	testCases := []struct {
 		name     string
@@ -204,73 +207,104 @@ func TestMsgPayPacketFeeValidation(t *testing.T) {
 			nil,
 		},
 		{
-			"success with empty relayers",
+			"success: without fallback address",
 			func() {
-				msg.Relayers = []string{}
+				msg.FallbackAddress = ""
 			},
 			nil,
 		},
 		{
-			"invalid channelID",
+			"success: remove fee preferences",
 			func() {
-				msg.SourceChannelId = ""
+				msg.AcceptedDenoms = nil
+				msg.FallbackAddress = ""
 			},
-			host.ErrInvalidID,
+			nil,
 		},
 		{
 			"invalid portID",
 			func() {
-				msg.SourcePortId = ""
+				msg.PortId = ""
 			},
 			host.ErrInvalidID,
 		},
 		{
-			"relayers is not nil",
+			"invalid channelID",
 			func() {
-				msg.Relayers = []string{defaultAccAddress}
+				msg.ChannelId = ""
 			},
-			types.ErrRelayersNotEmpty,
+			host.ErrInvalidID,
 		},
 		{
-			"invalid signer address",
+			"invalid payee address",
 			func() {
-				msg.Signer = invalidAddress
+				msg.Payee = invalidAddress
 			},
-			errors.New("failed to convert msg.Signer into sdk.AccAddress"),
+			errors.New("failed to create sdk.AccAddress from payee address"),
+		},
+		{
+			"invalid accepted denomination",
+			func() {
+				msg.AcceptedDenoms = []string{"1invalid"}
+			},
+			types.ErrInvalidFeePreferences,
+		},
+		{
+			"duplicate accepted denomination",
+			func() {
+				msg.AcceptedDenoms = []string{sdk.DefaultBondDenom, sdk.DefaultBondDenom}
+			},
+			types.ErrInvalidFeePreferences,
+		},
+		{
+			"fallback address without accepted denominations",
+			func() {
+				msg.AcceptedDenoms = nil
+			},
+			types.ErrInvalidFeePreferences,
+		},
+		{
+			"invalid fallback address",
+			func() {
+				msg.FallbackAddress = invalidAddress
+			},
+			errors.New("failed to convert fallback address into sdk.AccAddress"),
 		},
 	}
 
-	for _, tc := range testCases {
+	for i, tc := range testCases {
 		tc := tc
 
-		fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
-		msg = types.NewMsgPayPacketFee(fee, ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAccAddress, nil)
+		t.Run(tc.name, func(t *testing.T) {
+			payeeAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 
-		tc.malleate() // malleate mutates test data
+			msg = types.NewMsgRegisterFeePreferences(ibctesting.MockPort, ibctesting.FirstChannelID, payeeAddr.String(), []string{sdk.DefaultBondDenom, ibctesting.SecondaryDenom}, defaultAccAddress)
 
-		err := msg.ValidateBasic()
+			tc.malleate()
 
-		if tc.expErr == nil {
-			require.NoError(t, err, tc.name)
-		} else {
-			ibctesting.RequireErrorIsOrContains(t, err, tc.expErr, err.Error())
-		}
+			err := msg.ValidateBasic()
+
+			if tc.expErr == nil {
+				require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+			} else {
+				ibctesting.RequireErrorIsOrContains(t, err, tc.expErr, err.Error())
+			}
+		})
 	}
 }
 
-func TestPayPacketFeeGetSigners(t *testing.T) {
-	refundAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
-	msg := types.NewMsgPayPacketFee(fee, ibctesting.MockFeePort, ibctesting.FirstChannelID, refundAddr.String(), nil)
+func TestRegisterFeePreferencesGetSigners(t *testing.T) {
+	accAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgRegisterFeePreferences(ibctesting.MockPort, ibctesting.FirstChannelID, accAddress.String(), []string{sdk.DefaultBondDenom}, "")
 
 	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
 	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
 	require.NoError(t, err)
-	require.Equal(t, refundAddr.Bytes(), signers[0])
+	require.Equal(t, accAddress.Bytes(), signers[0])
 }
 
-func TestMsgPayPacketFeeAsyncValidation(t *testing.T) {
-	var msg *types.MsgPayPacketFeeAsync
+func TestMsgUpdateChannelMinimumFeeValidation(t *testing.T) {
+	var msg *types.MsgUpdateChannelMinimumFee
 
 	testCases := []struct {
 		name     string
@@ -283,34 +317,91 @@ func TestMsgPayPacketFeeAsyncValidation(t *testing.T) {
 			nil,
 		},
 		{
-			"success with empty relayers",
+			"success: remove minimum fee",
 			func() {
-				msg.PacketFee.Relayers = []string{}
+				msg.MinimumFee = types.Fee{}
 			},
 			nil,
 		},
 		{
-			"should pass with two empty fees",
+			"invalid signer address",
 			func() {
-				msg.PacketFee.Fee.AckFee = sdk.Coins{}
-				msg.PacketFee.Fee.TimeoutFee = sdk.Coins{}
+				msg.Signer = invalidAddress
 			},
-			nil,
+			errors.New("failed to convert msg.Signer into sdk.AccAddress"),
 		},
 		{
-			"should pass with one empty fee",
+			"invalid portID",
 			func() {
-				msg.PacketFee.Fee.TimeoutFee = sdk.Coins{}
+				msg.PortId = ""
 			},
-			nil,
+			host.ErrInvalidID,
 		},
 		{
 			"invalid channelID",
 			func() {
-				msg.PacketId.ChannelId = ""
+				msg.ChannelId = ""
 			},
 			host.ErrInvalidID,
 		},
+		{
+			"invalid minimum fee",
+			func() {
+				msg.MinimumFee.AckFee = invalidFee
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"zero minimum fee",
+			func() {
+				msg.MinimumFee = types.NewFee(sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.ZeroInt())}, nil, nil)
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			msg = types.NewMsgUpdateChannelMinimumFee(defaultAccAddress, ibctesting.MockFeePort, ibctesting.FirstChannelID, types.NewFee(defaultRecvFee, defaultAckFee, nil))
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+
+			if tc.expErr == nil {
+				require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+			} else {
+				ibctesting.RequireErrorIsOrContains(t, err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func TestUpdateChannelMinimumFeeGetSigners(t *testing.T) {
+	accAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgUpdateChannelMinimumFee(accAddress.String(), ibctesting.MockFeePort, ibctesting.FirstChannelID, types.NewFee(defaultRecvFee, nil, nil))
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, accAddress.Bytes(), signers[0])
+}
+
+func TestMsgRefundPacketFeeValidation(t *testing.T) {
+	var msg *types.MsgRefundPacketFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
 		{
 			"invalid portID",
 			func() {
@@ -318,6 +409,13 @@ func TestMsgPayPacketFeeAsyncValidation(t *testing.T) {
 			},
 			host.ErrInvalidID,
 		},
+		{
+			"invalid channelID",
+			func() {
+				msg.PacketId.ChannelId = ""
+			},
+			host.ErrInvalidID,
+		},
 		{
 			"invalid sequence",
 			func() {
@@ -326,84 +424,747 @@ func TestMsgPayPacketFeeAsyncValidation(t *testing.T) {
 			channeltypes.ErrInvalidPacket,
 		},
 		{
-			"relayers is not nil",
+			"invalid refund address",
 			func() {
-				msg.PacketFee.Relayers = []string{defaultAccAddress}
+				msg.RefundAddress = invalidAddress
 			},
-			types.ErrRelayersNotEmpty,
+			errors.New("failed to convert msg.RefundAddress into sdk.AccAddress"),
 		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			msg = types.NewMsgRefundPacketFee(channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1), defaultAccAddress)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+
+			if tc.expErr == nil {
+				require.NoError(t, err, tc.name)
+			} else {
+				ibctesting.RequireErrorIsOrContains(t, err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func TestRefundPacketFeeGetSigners(t *testing.T) {
+	refundAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgRefundPacketFee(channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1), refundAddr.String())
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, refundAddr.Bytes(), signers[0])
+}
+
+func TestMsgRefundSponsoredPacketFeeValidation(t *testing.T) {
+	var msg *types.MsgRefundSponsoredPacketFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
 		{
-			"invalid signer address",
-			func() {
-				msg.PacketFee.RefundAddress = "invalid-addr"
-			},
-			errors.New("failed to convert RefundAddress into sdk.AccAddress"),
+			"success",
+			func() {},
+			nil,
 		},
 		{
-			"should fail when all fees are invalid",
+			"invalid portID",
 			func() {
-				msg.PacketFee.Fee.AckFee = invalidFee
-				msg.PacketFee.Fee.RecvFee = invalidFee
-				msg.PacketFee.Fee.TimeoutFee = invalidFee
+				msg.PacketId.PortId = ""
 			},
-			ibcerrors.ErrInvalidCoins,
+			host.ErrInvalidID,
 		},
 		{
-			"should fail with single invalid fee",
+			"invalid channelID",
 			func() {
-				msg.PacketFee.Fee.AckFee = invalidFee
+				msg.PacketId.ChannelId = ""
 			},
-			ibcerrors.ErrInvalidCoins,
+			host.ErrInvalidID,
 		},
 		{
-			"should fail with two invalid fees",
+			"invalid sequence",
 			func() {
-				msg.PacketFee.Fee.AckFee = invalidFee
-				msg.PacketFee.Fee.TimeoutFee = invalidFee
+				msg.PacketId.Sequence = 0
 			},
-			ibcerrors.ErrInvalidCoins,
+			channeltypes.ErrInvalidPacket,
 		},
 		{
-			"should fail if all fees are empty",
+			"invalid sponsor address",
 			func() {
-				msg.PacketFee.Fee.AckFee = sdk.Coins{}
-				msg.PacketFee.Fee.RecvFee = sdk.Coins{}
-				msg.PacketFee.Fee.TimeoutFee = sdk.Coins{}
+				msg.Sponsor = invalidAddress
 			},
-			ibcerrors.ErrInvalidCoins,
+			errors.New("failed to convert msg.Sponsor into sdk.AccAddress"),
 		},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 
-		packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
-		fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
-		packetFee := types.NewPacketFee(fee, defaultAccAddress, nil)
-
-		msg = types.NewMsgPayPacketFeeAsync(packetID, packetFee)
+		t.Run(tc.name, func(t *testing.T) {
+			msg = types.NewMsgRefundSponsoredPacketFee(channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1), defaultAccAddress)
 
-		tc.malleate() // malleate mutates test data
+			tc.malleate()
 
-		err := msg.ValidateBasic()
+			err := msg.ValidateBasic()
 
-		if tc.expErr == nil {
-			require.NoError(t, err, tc.name)
-		} else {
-			ibctesting.RequireErrorIsOrContains(t, err, tc.expErr, err.Error())
-		}
+			if tc.expErr == nil {
+				require.NoError(t, err, tc.name)
+			} else {
+				ibctesting.RequireErrorIsOrContains(t, err, tc.expErr, err.Error())
+			}
+		})
 	}
 }
 
-func TestPayPacketFeeAsyncGetSigners(t *testing.T) {
-	refundAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-	packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
-	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
-	packetFee := types.NewPacketFee(fee, refundAddr.String(), nil)
-	msg := types.NewMsgPayPacketFeeAsync(packetID, packetFee)
+func TestRefundSponsoredPacketFeeGetSigners(t *testing.T) {
+	sponsorAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgRefundSponsoredPacketFee(channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1), sponsorAddr.String())
 
 	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
 	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
 	require.NoError(t, err)
-	require.Equal(t, refundAddr.Bytes(), signers[0])
+	require.Equal(t, sponsorAddr.Bytes(), signers[0])
+}
+
+func TestMsgUpdateParamsValidation(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateParams
+		expErr error
+	}{
+		{
+			"success",
+			types.NewMsgUpdateParams(defaultAccAddress, types.NewParams(time.Hour, 0, types.DefaultRewardEpochsRetained)),
+			nil,
+		},
+		{
+			"success: refunds disabled",
+			types.NewMsgUpdateParams(defaultAccAddress, types.DefaultParams()),
+			nil,
+		},
+		{
+			"invalid signer address",
+			types.NewMsgUpdateParams(invalidAddress, types.DefaultParams()),
+			errors.New("failed to convert msg.Signer into sdk.AccAddress"),
+		},
+		{
+			"negative refund grace period",
+			types.NewMsgUpdateParams(defaultAccAddress, types.NewParams(-time.Hour, 0, types.DefaultRewardEpochsRetained)),
+			ibcerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expErr == nil {
+				require.NoError(t, err, tc.name)
+			} else {
+				ibctesting.RequireErrorIsOrContains(t, err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func TestUpdateParamsGetSigners(t *testing.T) {
+	accAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgUpdateParams(accAddress.String(), types.DefaultParams())
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, accAddress.Bytes(), signers[0])
+}
+
+func TestMsgPayPacketFeeValidation(t *testing.T) {
+	var msg *types.MsgPayPacketFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success with empty relayers",
+			func() {
+				msg.Relayers = []string{}
+			},
+			nil,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.SourceChannelId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.SourcePortId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"relayers is not nil",
+			func() {
+				msg.Relayers = []string{defaultAccAddress}
+			},
+			types.ErrRelayersNotEmpty,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = invalidAddress
+			},
+			errors.New("failed to convert msg.Signer into sdk.AccAddress"),
+		},
+		{
+			"success with fee sponsor",
+			func() {
+				msg.FeeSponsor = defaultAccAddress
+			},
+			nil,
+		},
+		{
+			"invalid fee sponsor address",
+			func() {
+				msg.FeeSponsor = invalidAddress
+			},
+			errors.New("failed to convert msg.FeeSponsor into sdk.AccAddress"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+		msg = types.NewMsgPayPacketFee(fee, ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAccAddress, nil)
+
+		tc.malleate() // malleate mutates test data
+
+		err := msg.ValidateBasic()
+
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			ibctesting.RequireErrorIsOrContains(t, err, tc.expErr, err.Error())
+		}
+	}
+}
+
+func TestPayPacketFeeGetSigners(t *testing.T) {
+	refundAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	msg := types.NewMsgPayPacketFee(fee, ibctesting.MockFeePort, ibctesting.FirstChannelID, refundAddr.String(), nil)
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, refundAddr.Bytes(), signers[0])
+}
+
+func TestMsgPayPacketFeeAsyncValidation(t *testing.T) {
+	var msg *types.MsgPayPacketFeeAsync
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success with empty relayers",
+			func() {
+				msg.PacketFee.Relayers = []string{}
+			},
+			nil,
+		},
+		{
+			"should pass with two empty fees",
+			func() {
+				msg.PacketFee.Fee.AckFee = sdk.Coins{}
+				msg.PacketFee.Fee.TimeoutFee = sdk.Coins{}
+			},
+			nil,
+		},
+		{
+			"should pass with one empty fee",
+			func() {
+				msg.PacketFee.Fee.TimeoutFee = sdk.Coins{}
+			},
+			nil,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.PacketId.ChannelId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.PacketId.PortId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid sequence",
+			func() {
+				msg.PacketId.Sequence = 0
+			},
+			channeltypes.ErrInvalidPacket,
+		},
+		{
+			"relayers is not nil",
+			func() {
+				msg.PacketFee.Relayers = []string{defaultAccAddress}
+			},
+			types.ErrRelayersNotEmpty,
+		},
+		{
+			"success with fee sponsor",
+			func() {
+				msg.FeeSponsor = defaultAccAddress
+			},
+			nil,
+		},
+		{
+			"invalid fee sponsor address",
+			func() {
+				msg.FeeSponsor = invalidAddress
+			},
+			errors.New("failed to convert msg.FeeSponsor into sdk.AccAddress"),
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.PacketFee.RefundAddress = "invalid-addr"
+			},
+			errors.New("failed to convert RefundAddress into sdk.AccAddress"),
+		},
+		{
+			"should fail when all fees are invalid",
+			func() {
+				msg.PacketFee.Fee.AckFee = invalidFee
+				msg.PacketFee.Fee.RecvFee = invalidFee
+				msg.PacketFee.Fee.TimeoutFee = invalidFee
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"should fail with single invalid fee",
+			func() {
+				msg.PacketFee.Fee.AckFee = invalidFee
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"should fail with two invalid fees",
+			func() {
+				msg.PacketFee.Fee.AckFee = invalidFee
+				msg.PacketFee.Fee.TimeoutFee = invalidFee
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"should fail if all fees are empty",
+			func() {
+				msg.PacketFee.Fee.AckFee = sdk.Coins{}
+				msg.PacketFee.Fee.RecvFee = sdk.Coins{}
+				msg.PacketFee.Fee.TimeoutFee = sdk.Coins{}
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+		fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+		packetFee := types.NewPacketFee(fee, defaultAccAddress, nil)
+
+		msg = types.NewMsgPayPacketFeeAsync(packetID, packetFee)
+
+		tc.malleate() // malleate mutates test data
+
+		err := msg.ValidateBasic()
+
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			ibctesting.RequireErrorIsOrContains(t, err, tc.expErr, err.Error())
+		}
+	}
+}
+
+func TestPayPacketFeeAsyncGetSigners(t *testing.T) {
+	refundAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	packetFee := types.NewPacketFee(fee, refundAddr.String(), nil)
+	msg := types.NewMsgPayPacketFeeAsync(packetID, packetFee)
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, refundAddr.Bytes(), signers[0])
+}
+
+func TestMsgRegisterFeeSponsorPoolValidation(t *testing.T) {
+	var msg *types.MsgRegisterFeeSponsorPool
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.PortId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.ChannelId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid sponsor address",
+			func() {
+				msg.Sponsor = invalidAddress
+			},
+			errors.New("failed to convert sponsor address into sdk.AccAddress"),
+		},
+		{
+			"empty allowances",
+			func() {
+				msg.Allowances = []types.FeeSponsorAllowance{}
+			},
+			types.ErrInvalidFeeSponsorPool,
+		},
+		{
+			"invalid allowed sender address",
+			func() {
+				msg.Allowances[0].Sender = invalidAddress
+			},
+			types.ErrInvalidFeeSponsorPool,
+		},
+		{
+			"invalid spend limit",
+			func() {
+				msg.Allowances[0].SpendLimit = invalidFee
+			},
+			types.ErrInvalidFeeSponsorPool,
+		},
+		{
+			"duplicate allowed sender address",
+			func() {
+				msg.Allowances = append(msg.Allowances, types.NewFeeSponsorAllowance(defaultAccAddress, nil, nil))
+			},
+			types.ErrInvalidFeeSponsorPool,
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			msg = types.NewMsgRegisterFeeSponsorPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAccAddress, []types.FeeSponsorAllowance{types.NewFeeSponsorAllowance(defaultAccAddress, defaultRecvFee, nil)})
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+
+			if tc.expErr == nil {
+				require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+			} else {
+				ibctesting.RequireErrorIsOrContains(t, err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func TestRegisterFeeSponsorPoolGetSigners(t *testing.T) {
+	accAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgRegisterFeeSponsorPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, accAddress.String(), []types.FeeSponsorAllowance{types.NewFeeSponsorAllowance(defaultAccAddress, nil, nil)})
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, accAddress.Bytes(), signers[0])
+}
+
+func TestMsgFundFeeSponsorPoolValidation(t *testing.T) {
+	var msg *types.MsgFundFeeSponsorPool
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.PortId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.ChannelId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid sponsor address",
+			func() {
+				msg.Sponsor = invalidAddress
+			},
+			errors.New("failed to convert msg.Sponsor into sdk.AccAddress"),
+		},
+		{
+			"invalid depositor address",
+			func() {
+				msg.Depositor = invalidAddress
+			},
+			errors.New("failed to convert msg.Depositor into sdk.AccAddress"),
+		},
+		{
+			"invalid amount",
+			func() {
+				msg.Amount = invalidFee
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"zero amount",
+			func() {
+				msg.Amount = sdk.Coins{}
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			msg = types.NewMsgFundFeeSponsorPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAccAddress, defaultAccAddress, defaultRecvFee)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+
+			if tc.expErr == nil {
+				require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+			} else {
+				ibctesting.RequireErrorIsOrContains(t, err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func TestFundFeeSponsorPoolGetSigners(t *testing.T) {
+	accAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgFundFeeSponsorPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAccAddress, accAddress.String(), defaultRecvFee)
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, accAddress.Bytes(), signers[0])
+}
+
+func TestMsgWithdrawFeeSponsorPoolValidation(t *testing.T) {
+	var msg *types.MsgWithdrawFeeSponsorPool
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.PortId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.ChannelId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid sponsor address",
+			func() {
+				msg.Sponsor = invalidAddress
+			},
+			errors.New("failed to convert msg.Sponsor into sdk.AccAddress"),
+		},
+		{
+			"invalid recipient address",
+			func() {
+				msg.Recipient = invalidAddress
+			},
+			errors.New("failed to convert msg.Recipient into sdk.AccAddress"),
+		},
+		{
+			"invalid amount",
+			func() {
+				msg.Amount = invalidFee
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"zero amount",
+			func() {
+				msg.Amount = sdk.Coins{}
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			msg = types.NewMsgWithdrawFeeSponsorPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAccAddress, defaultAccAddress, defaultRecvFee)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+
+			if tc.expErr == nil {
+				require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+			} else {
+				ibctesting.RequireErrorIsOrContains(t, err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func TestWithdrawFeeSponsorPoolGetSigners(t *testing.T) {
+	accAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgWithdrawFeeSponsorPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, accAddress.String(), defaultAccAddress, defaultRecvFee)
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, accAddress.Bytes(), signers[0])
+}
+
+func TestMsgDeleteFeeSponsorPoolValidation(t *testing.T) {
+	var msg *types.MsgDeleteFeeSponsorPool
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.PortId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.ChannelId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid sponsor address",
+			func() {
+				msg.Sponsor = invalidAddress
+			},
+			errors.New("failed to convert msg.Sponsor into sdk.AccAddress"),
+		},
+		{
+			"invalid recipient address",
+			func() {
+				msg.Recipient = invalidAddress
+			},
+			errors.New("failed to convert msg.Recipient into sdk.AccAddress"),
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			msg = types.NewMsgDeleteFeeSponsorPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAccAddress, defaultAccAddress)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+
+			if tc.expErr == nil {
+				require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+			} else {
+				ibctesting.RequireErrorIsOrContains(t, err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func TestDeleteFeeSponsorPoolGetSigners(t *testing.T) {
+	accAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgDeleteFeeSponsorPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, accAddress.String(), defaultAccAddress)
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, accAddress.Bytes(), signers[0])
 }
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+const (
+	// DefaultRefundGracePeriod is the default refund grace period, refunds with MsgRefundPacketFee are disabled by default
+	DefaultRefundGracePeriod time.Duration = 0
+	// DefaultRewardEpochBlocks is the default number of blocks of a relayer reward epoch, per-epoch relayer reward
+	// accounting is disabled by default
+	DefaultRewardEpochBlocks uint64 = 0
+	// DefaultRewardEpochsRetained is the default number of completed relayer reward epochs for which the per-epoch
+	// relayer rewards are retained
+	DefaultRewardEpochsRetained uint64 = 30
+)
+
+// NewParams creates a new parameter configuration for the fee middleware
+func NewParams(refundGracePeriod time.Duration, rewardEpochBlocks, rewardEpochsRetained uint64) Params {
+	return Params{
+		RefundGracePeriod:    refundGracePeriod,
+		RewardEpochBlocks:    rewardEpochBlocks,
+		RewardEpochsRetained: rewardEpochsRetained,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the fee middleware
+func DefaultParams() Params {
+	return NewParams(DefaultRefundGracePeriod, DefaultRewardEpochBlocks, DefaultRewardEpochsRetained)
+}
+
+// Validate performs basic validation of the fee middleware parameters.
+func (p Params) Validate() error {
+	if p.RefundGracePeriod < 0 {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "refund grace period cannot be negative: %s", p.RefundGracePeriod)
+	}
+
+	return nil
+}
+
+// IsRefundEnabled returns true if packet fees may be refunded with MsgRefundPacketFee.
+func (p Params) IsRefundEnabled() bool {
+	return p.RefundGracePeriod > 0
+}
+
+// IsRewardEpochEnabled returns true if relayer rewards are accounted per epoch.
+func (p Params) IsRewardEpochEnabled() bool {
+	return p.RewardEpochBlocks > 0
+}
@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/29-fee/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		params                types.Params
+		expRefundEnabled      bool
+		expRewardEpochEnabled bool
+		expErr                error
+	}{
+		{"success: default params", types.DefaultParams(), false, false, nil},
+		{"success: refund grace period", types.NewParams(time.Hour, 0, types.DefaultRewardEpochsRetained), true, false, nil},
+		{"success: reward epoch blocks", types.NewParams(0, 100, types.DefaultRewardEpochsRetained), false, true, nil},
+		{"failure: negative refund grace period", types.NewParams(-time.Hour, 0, types.DefaultRewardEpochsRetained), false, false, ibcerrors.ErrInvalidRequest},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+
+			require.Equal(t, tc.expRefundEnabled, tc.params.IsRefundEnabled())
+			require.Equal(t, tc.expRewardEpochEnabled, tc.params.IsRewardEpochEnabled())
+		})
+	}
+}
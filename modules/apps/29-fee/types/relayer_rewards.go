@@ -0,0 +1,85 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// NewRelayerRewards creates and returns a new RelayerRewards instance
+func NewRelayerRewards(payeeAddr, channelID string, rewards Fee) RelayerRewards {
+	return RelayerRewards{
+		Payee:     payeeAddr,
+		ChannelId: channelID,
+		Rewards:   rewards,
+	}
+}
+
+// Validate performs basic validation of the relayer rewards
+func (r RelayerRewards) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Payee); err != nil {
+		return errorsmod.Wrap(err, "failed to convert payee address into sdk.AccAddress")
+	}
+
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid channel identifier: %s", r.ChannelId)
+	}
+
+	return r.Rewards.Validate()
+}
+
+// NewEpochRelayerRewards creates and returns a new EpochRelayerRewards instance
+func NewEpochRelayerRewards(epoch uint64, relayerRewards RelayerRewards) EpochRelayerRewards {
+	return EpochRelayerRewards{
+		Epoch:          epoch,
+		RelayerRewards: relayerRewards,
+	}
+}
+
+// Validate performs basic validation of the epoch relayer rewards
+func (r EpochRelayerRewards) Validate() error {
+	if r.Epoch == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "relayer reward epoch cannot be zero")
+	}
+
+	return r.RelayerRewards.Validate()
+}
+
+// NewRewardEpoch creates and returns a new RewardEpoch instance
+func NewRewardEpoch(number uint64, startHeight int64) RewardEpoch {
+	return RewardEpoch{
+		Number:      number,
+		StartHeight: startHeight,
+	}
+}
+
+// Validate performs basic validation of the reward epoch. A zero epoch number indicates that no epoch has started.
+func (e RewardEpoch) Validate() error {
+	if e.StartHeight < 0 {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "reward epoch start height cannot be negative: %d", e.StartHeight)
+	}
+
+	if e.Number == 0 && e.StartHeight != 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "reward epoch start height must be zero if no epoch has started")
+	}
+
+	return nil
+}
+
+// AdvanceTo returns the reward epoch containing the given block height, for epochs of the given number of blocks.
+// The first epoch starts at the given height if no epoch has started. The epoch blocks must be greater than zero.
+func (e RewardEpoch) AdvanceTo(height int64, epochBlocks uint64) RewardEpoch {
+	if e.Number == 0 {
+		return NewRewardEpoch(1, height)
+	}
+
+	if height <= e.StartHeight {
+		return e
+	}
+
+	elapsed := uint64(height-e.StartHeight) / epochBlocks
+	return NewRewardEpoch(e.Number+elapsed, e.StartHeight+int64(elapsed*epochBlocks))
+}
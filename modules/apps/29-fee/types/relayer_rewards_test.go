@@ -0,0 +1,126 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/29-fee/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func TestEpochRelayerRewardsValidate(t *testing.T) {
+	var epochRelayerRewards types.EpochRelayerRewards
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"zero epoch",
+			func() {
+				epochRelayerRewards.Epoch = 0
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"invalid payee address",
+			func() {
+				epochRelayerRewards.RelayerRewards.Payee = ibctesting.InvalidID
+			},
+			errors.New("decoding bech32 failed"),
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				epochRelayerRewards.RelayerRewards.ChannelId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid rewards",
+			func() {
+				epochRelayerRewards.RelayerRewards.Rewards = types.NewFee(invalidFee, nil, nil)
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			relayerRewards := types.NewRelayerRewards(defaultAccAddress, ibctesting.FirstChannelID, types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee))
+			epochRelayerRewards = types.NewEpochRelayerRewards(1, relayerRewards)
+
+			tc.malleate()
+
+			err := epochRelayerRewards.Validate()
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				ibctesting.RequireErrorIsOrContains(t, err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func TestRewardEpochValidate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		rewardEpoch types.RewardEpoch
+		expErr      error
+	}{
+		{"success: no epoch started", types.RewardEpoch{}, nil},
+		{"success: epoch started", types.NewRewardEpoch(3, 100), nil},
+		{"failure: negative start height", types.NewRewardEpoch(3, -1), ibcerrors.ErrInvalidRequest},
+		{"failure: start height set without an epoch", types.NewRewardEpoch(0, 100), ibcerrors.ErrInvalidRequest},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rewardEpoch.Validate()
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestRewardEpochAdvanceTo(t *testing.T) {
+	testCases := []struct {
+		name        string
+		rewardEpoch types.RewardEpoch
+		height      int64
+		expEpoch    types.RewardEpoch
+	}{
+		{"first epoch starts at the given height", types.RewardEpoch{}, 25, types.NewRewardEpoch(1, 25)},
+		{"height within the epoch", types.NewRewardEpoch(2, 20), 29, types.NewRewardEpoch(2, 20)},
+		{"height before the epoch start", types.NewRewardEpoch(2, 20), 15, types.NewRewardEpoch(2, 20)},
+		{"height at the next epoch start", types.NewRewardEpoch(2, 20), 30, types.NewRewardEpoch(3, 30)},
+		{"several epochs elapsed", types.NewRewardEpoch(2, 20), 57, types.NewRewardEpoch(5, 50)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expEpoch, tc.rewardEpoch.AdvanceTo(tc.height, 10))
+		})
+	}
+}
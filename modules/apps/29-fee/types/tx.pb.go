@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -209,6 +211,8 @@ type MsgPayPacketFee struct {
 	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
 	// optional list of relayers permitted to the receive packet fees
 	Relayers []string `protobuf:"bytes,5,rep,name=relayers,proto3" json:"relayers,omitempty"`
+	// optional address of the fee sponsor whose pool on the source channel pays the fee on behalf of the signer
+	FeeSponsor string `protobuf:"bytes,6,opt,name=fee_sponsor,json=feeSponsor,proto3" json:"fee_sponsor,omitempty"`
 }
 
 func (m *MsgPayPacketFee) Reset()         { *m = MsgPayPacketFee{} }
@@ -288,6 +292,9 @@ type MsgPayPacketFeeAsync struct {
 	PacketId types.PacketId `protobuf:"bytes,1,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
 	// the packet fee associated with a particular IBC packet
 	PacketFee PacketFee `protobuf:"bytes,2,opt,name=packet_fee,json=packetFee,proto3" json:"packet_fee"`
+	// optional address of the fee sponsor whose pool on the packet channel pays the fee on behalf of the refund address
+	// of the packet fee
+	FeeSponsor string `protobuf:"bytes,3,opt,name=fee_sponsor,json=feeSponsor,proto3" json:"fee_sponsor,omitempty"`
 }
 
 func (m *MsgPayPacketFeeAsync) Reset()         { *m = MsgPayPacketFeeAsync{} }
@@ -360,737 +367,4839 @@ func (m *MsgPayPacketFeeAsyncResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPayPacketFeeAsyncResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgRegisterPayee)(nil), "ibc.applications.fee.v1.MsgRegisterPayee")
-	proto.RegisterType((*MsgRegisterPayeeResponse)(nil), "ibc.applications.fee.v1.MsgRegisterPayeeResponse")
-	proto.RegisterType((*MsgRegisterCounterpartyPayee)(nil), "ibc.applications.fee.v1.MsgRegisterCounterpartyPayee")
-	proto.RegisterType((*MsgRegisterCounterpartyPayeeResponse)(nil), "ibc.applications.fee.v1.MsgRegisterCounterpartyPayeeResponse")
-	proto.RegisterType((*MsgPayPacketFee)(nil), "ibc.applications.fee.v1.MsgPayPacketFee")
-	proto.RegisterType((*MsgPayPacketFeeResponse)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeResponse")
-	proto.RegisterType((*MsgPayPacketFeeAsync)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeAsync")
-	proto.RegisterType((*MsgPayPacketFeeAsyncResponse)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeAsyncResponse")
+// MsgRegisterFeePreferences defines the request type for the RegisterFeePreferences rpc
+type MsgRegisterFeePreferences struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the address to which packet fees are paid out, either a relayer or a registered payee
+	Payee string `protobuf:"bytes,3,opt,name=payee,proto3" json:"payee,omitempty"`
+	// list of fee denominations accepted by the payee, the fee preferences are removed if empty
+	AcceptedDenoms []string `protobuf:"bytes,4,rep,name=accepted_denoms,json=acceptedDenoms,proto3" json:"accepted_denoms,omitempty"`
+	// the address to which the packet fees in other denominations are sent, if empty these fees are refunded
+	FallbackAddress string `protobuf:"bytes,5,opt,name=fallback_address,json=fallbackAddress,proto3" json:"fallback_address,omitempty"`
 }
 
-func init() { proto.RegisterFile("ibc/applications/fee/v1/tx.proto", fileDescriptor_05c93128649f1b96) }
-
-var fileDescriptor_05c93128649f1b96 = []byte{
-	// 702 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4d, 0x4f, 0xdb, 0x4a,
-	0x14, 0x8d, 0xc9, 0xe3, 0x23, 0x17, 0xde, 0xe3, 0xc5, 0x42, 0x2f, 0xc1, 0x0f, 0x0c, 0xb5, 0x50,
-	0x4b, 0x23, 0xc5, 0x26, 0xa9, 0x50, 0x45, 0xd4, 0x2e, 0x0a, 0x2a, 0x12, 0x52, 0x51, 0xa3, 0x2c,
-	0xbb, 0x41, 0x8e, 0x73, 0x31, 0x2e, 0xb1, 0xc7, 0xf2, 0x38, 0x51, 0xbd, 0xab, 0x58, 0x55, 0x5d,
-	0xb5, 0xff, 0xa0, 0xcb, 0x2e, 0xba, 0xe0, 0x67, 0xb0, 0x64, 0xd9, 0x4d, 0xab, 0x0a, 0x2a, 0xf1,
-	0x0f, 0xba, 0xae, 0x66, 0x3c, 0xb6, 0x4c, 0x42, 0x50, 0x5a, 0xa9, 0x1b, 0xcb, 0x73, 0xcf, 0x99,
-	0x33, 0xf7, 0x1c, 0xcd, 0xd5, 0xc0, 0xaa, 0xd3, 0xb6, 0x0c, 0xd3, 0xf7, 0xbb, 0x8e, 0x65, 0x86,
-	0x0e, 0xf1, 0xa8, 0x71, 0x88, 0x68, 0xf4, 0x6b, 0x46, 0xf8, 0x4a, 0xf7, 0x03, 0x12, 0x12, 0xb9,
-	0xe4, 0xb4, 0x2d, 0x3d, 0xcb, 0xd0, 0x0f, 0x11, 0xf5, 0x7e, 0x4d, 0x29, 0x9a, 0xae, 0xe3, 0x11,
-	0x83, 0x7f, 0x63, 0xae, 0xb2, 0x60, 0x13, 0x9b, 0xf0, 0x5f, 0x83, 0xfd, 0x89, 0xea, 0x9d, 0x51,
-	0x67, 0x30, 0xa1, 0x0c, 0xc5, 0x22, 0x01, 0x1a, 0xd6, 0x91, 0xe9, 0x79, 0xd8, 0x65, 0xb0, 0xf8,
-	0x15, 0x94, 0x92, 0x45, 0xa8, 0x4b, 0xa8, 0xe1, 0x52, 0x9b, 0x81, 0x2e, 0xb5, 0x63, 0x40, 0xfb,
-	0x24, 0xc1, 0xbf, 0xfb, 0xd4, 0x6e, 0xa1, 0xed, 0xd0, 0x10, 0x83, 0xa6, 0x19, 0x21, 0xca, 0x25,
-	0x98, 0xf6, 0x49, 0x10, 0x1e, 0x38, 0x9d, 0xb2, 0xb4, 0x2a, 0xad, 0x17, 0x5a, 0x53, 0x6c, 0xb9,
-	0xd7, 0x91, 0x97, 0x01, 0x84, 0x2e, 0xc3, 0x26, 0x38, 0x56, 0x10, 0x95, 0xbd, 0x8e, 0x5c, 0x86,
-	0xe9, 0x00, 0xbb, 0x66, 0x84, 0x41, 0x39, 0xcf, 0xb1, 0x64, 0x29, 0x2f, 0xc0, 0xa4, 0xcf, 0xa4,
-	0xcb, 0x7f, 0xf1, 0x7a, 0xbc, 0x68, 0x6c, 0xbc, 0xf9, 0xb0, 0x92, 0x3b, 0xb9, 0x3a, 0xad, 0x24,
-	0xbc, 0xb7, 0x57, 0xa7, 0x95, 0xff, 0xe3, 0x56, 0xab, 0xb4, 0x73, 0x6c, 0x0c, 0x76, 0xa6, 0x29,
-	0x50, 0x1e, 0xac, 0xb5, 0x90, 0xfa, 0xc4, 0xa3, 0xa8, 0x7d, 0x91, 0x60, 0x29, 0x03, 0xee, 0x90,
-	0x9e, 0x17, 0x62, 0xe0, 0x9b, 0x41, 0x18, 0xfd, 0x29, 0x5b, 0x55, 0x90, 0xad, 0xcc, 0x31, 0x07,
-	0x59, 0x8f, 0x45, 0x6b, 0xb0, 0x81, 0xc6, 0xa3, 0x9b, 0xfc, 0xde, 0xbb, 0xd9, 0xef, 0x50, 0xfb,
-	0xda, 0x5d, 0x58, 0xbb, 0x0d, 0x4f, 0x73, 0x38, 0x99, 0x80, 0xf9, 0x7d, 0x6a, 0x37, 0xcd, 0xa8,
-	0x69, 0x5a, 0xc7, 0x18, 0xee, 0x22, 0xca, 0x5b, 0x90, 0x3f, 0x44, 0xe4, 0xb6, 0x67, 0xeb, 0x4b,
-	0xfa, 0x88, 0x5b, 0xa9, 0xef, 0x22, 0x6e, 0x17, 0xce, 0xbe, 0xae, 0xe4, 0x3e, 0x5e, 0x9d, 0x56,
-	0xa4, 0x16, 0xdb, 0x23, 0xaf, 0xc1, 0x3f, 0x94, 0xf4, 0x02, 0x0b, 0x0f, 0x92, 0xf0, 0xe2, 0x80,
-	0xe6, 0xe2, 0x6a, 0x33, 0x8e, 0xb0, 0x02, 0x45, 0xc1, 0xca, 0x24, 0x19, 0xa7, 0x35, 0x1f, 0x03,
-	0x3b, 0x69, 0x9e, 0xff, 0xc1, 0x14, 0x75, 0x6c, 0x0f, 0x03, 0x91, 0x94, 0x58, 0xc9, 0x0a, 0xcc,
-	0x88, 0x5c, 0x68, 0x79, 0x72, 0x35, 0xbf, 0x5e, 0x68, 0xa5, 0xeb, 0x86, 0x9e, 0x44, 0x27, 0xc8,
-	0x2c, 0x39, 0xe5, 0x7a, 0x72, 0x59, 0xc3, 0xda, 0x22, 0x94, 0x06, 0x4a, 0x69, 0x3e, 0xdf, 0x25,
-	0x58, 0x18, 0xc0, 0x9e, 0xd0, 0xc8, 0xb3, 0xe4, 0xa7, 0x50, 0xf0, 0x79, 0x25, 0xb9, 0x21, 0xb3,
-	0xf5, 0x65, 0x1e, 0x15, 0x9b, 0x2d, 0x3d, 0x19, 0xa8, 0x7e, 0x4d, 0x8f, 0xf7, 0xed, 0x75, 0xb2,
-	0x59, 0xcd, 0xf8, 0xa2, 0x28, 0x3f, 0x03, 0x10, 0x32, 0x2c, 0xf2, 0x09, 0xae, 0xa3, 0x8d, 0x8c,
-	0x3c, 0xed, 0x21, 0x2b, 0x26, 0xfa, 0xd8, 0x45, 0x6c, 0x3c, 0x4c, 0x8c, 0x67, 0x44, 0x99, 0xf9,
-	0x95, 0xd1, 0xe6, 0xb9, 0x1b, 0x4d, 0xe5, 0xd3, 0x30, 0x54, 0x4f, 0x62, 0xa8, 0xff, 0xc8, 0x43,
-	0x7e, 0x9f, 0xda, 0xb2, 0x0b, 0x7f, 0x5f, 0x9f, 0xfe, 0xfb, 0x23, 0x7b, 0x1d, 0x1c, 0x3d, 0xa5,
-	0x36, 0x36, 0x35, 0x39, 0x56, 0x7e, 0x2f, 0xc1, 0xe2, 0xe8, 0x11, 0xdd, 0x1c, 0x47, 0x70, 0x68,
-	0x9b, 0xf2, 0xf8, 0xb7, 0xb6, 0xa5, 0x3d, 0xbd, 0x84, 0xb9, 0x6b, 0xd3, 0xb2, 0x7e, 0x9b, 0x5c,
-	0x96, 0xa9, 0x6c, 0x8c, 0xcb, 0x4c, 0xcf, 0x8a, 0xa0, 0x38, 0x7c, 0xf3, 0xaa, 0xe3, 0xca, 0x70,
-	0xba, 0xb2, 0xf9, 0x4b, 0xf4, 0xe4, 0x68, 0x65, 0xf2, 0x35, 0xbb, 0x5c, 0xdb, 0xcf, 0xcf, 0x2e,
-	0x54, 0xe9, 0xfc, 0x42, 0x95, 0xbe, 0x5d, 0xa8, 0xd2, 0xbb, 0x4b, 0x35, 0x77, 0x7e, 0xa9, 0xe6,
-	0x3e, 0x5f, 0xaa, 0xb9, 0x17, 0x9b, 0xb6, 0x13, 0x1e, 0xf5, 0xda, 0xba, 0x45, 0x5c, 0x43, 0x3c,
-	0x18, 0x4e, 0xdb, 0xaa, 0xda, 0xc4, 0xe8, 0x6f, 0x19, 0x2e, 0xe9, 0xf4, 0xba, 0x48, 0xd9, 0x5b,
-	0x44, 0x8d, 0xfa, 0x56, 0x95, 0x3d, 0x43, 0x61, 0xe4, 0x23, 0x6d, 0x4f, 0xf1, 0xa7, 0xe4, 0xc1,
-	0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7f, 0x85, 0x8d, 0x1d, 0x0f, 0x07, 0x00, 0x00,
+func (m *MsgRegisterFeePreferences) Reset()         { *m = MsgRegisterFeePreferences{} }
+func (m *MsgRegisterFeePreferences) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterFeePreferences) ProtoMessage()    {}
+func (*MsgRegisterFeePreferences) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{8}
+}
+func (m *MsgRegisterFeePreferences) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterFeePreferences) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterFeePreferences.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterFeePreferences) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterFeePreferences.Merge(m, src)
+}
+func (m *MsgRegisterFeePreferences) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterFeePreferences) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterFeePreferences.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgRegisterFeePreferences proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// RegisterPayee defines a rpc handler method for MsgRegisterPayee
-	// RegisterPayee is called by the relayer on each channelEnd and allows them to set an optional
-	// payee to which reverse and timeout relayer packet fees will be paid out. The payee should be registered on
-	// the source chain from which packets originate as this is where fee distribution takes place. This function may be
-	// called more than once by a relayer, in which case, the latest payee is always used.
-	RegisterPayee(ctx context.Context, in *MsgRegisterPayee, opts ...grpc.CallOption) (*MsgRegisterPayeeResponse, error)
-	// RegisterCounterpartyPayee defines a rpc handler method for MsgRegisterCounterpartyPayee
-	// RegisterCounterpartyPayee is called by the relayer on each channelEnd and allows them to specify the counterparty
-	// payee address before relaying. This ensures they will be properly compensated for forward relaying since
-	// the destination chain must include the registered counterparty payee address in the acknowledgement. This function
-	// may be called more than once by a relayer, in which case, the latest counterparty payee address is always used.
-	RegisterCounterpartyPayee(ctx context.Context, in *MsgRegisterCounterpartyPayee, opts ...grpc.CallOption) (*MsgRegisterCounterpartyPayeeResponse, error)
-	// PayPacketFee defines a rpc handler method for MsgPayPacketFee
-	// PayPacketFee is an open callback that may be called by any module/user that wishes to escrow funds in order to
-	// incentivize the relaying of the packet at the next sequence
-	// NOTE: This method is intended to be used within a multi msg transaction, where the subsequent msg that follows
-	// initiates the lifecycle of the incentivized packet
-	PayPacketFee(ctx context.Context, in *MsgPayPacketFee, opts ...grpc.CallOption) (*MsgPayPacketFeeResponse, error)
-	// PayPacketFeeAsync defines a rpc handler method for MsgPayPacketFeeAsync
-	// PayPacketFeeAsync is an open callback that may be called by any module/user that wishes to escrow funds in order to
-	// incentivize the relaying of a known packet (i.e. at a particular sequence)
-	PayPacketFeeAsync(ctx context.Context, in *MsgPayPacketFeeAsync, opts ...grpc.CallOption) (*MsgPayPacketFeeAsyncResponse, error)
+// MsgRegisterFeePreferencesResponse defines the response type for the RegisterFeePreferences rpc
+type MsgRegisterFeePreferencesResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRegisterFeePreferencesResponse) Reset()         { *m = MsgRegisterFeePreferencesResponse{} }
+func (m *MsgRegisterFeePreferencesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterFeePreferencesResponse) ProtoMessage()    {}
+func (*MsgRegisterFeePreferencesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{9}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRegisterFeePreferencesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) RegisterPayee(ctx context.Context, in *MsgRegisterPayee, opts ...grpc.CallOption) (*MsgRegisterPayeeResponse, error) {
-	out := new(MsgRegisterPayeeResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/RegisterPayee", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterFeePreferencesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterFeePreferencesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) RegisterCounterpartyPayee(ctx context.Context, in *MsgRegisterCounterpartyPayee, opts ...grpc.CallOption) (*MsgRegisterCounterpartyPayeeResponse, error) {
-	out := new(MsgRegisterCounterpartyPayeeResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/RegisterCounterpartyPayee", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRegisterFeePreferencesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterFeePreferencesResponse.Merge(m, src)
+}
+func (m *MsgRegisterFeePreferencesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterFeePreferencesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterFeePreferencesResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) PayPacketFee(ctx context.Context, in *MsgPayPacketFee, opts ...grpc.CallOption) (*MsgPayPacketFeeResponse, error) {
-	out := new(MsgPayPacketFeeResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/PayPacketFee", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRegisterFeePreferencesResponse proto.InternalMessageInfo
+
+// MsgUpdateChannelMinimumFee defines the request type for the UpdateChannelMinimumFee rpc
+type MsgUpdateChannelMinimumFee struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// unique port identifier
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the minimum receive, acknowledgement and timeout fees, the minimum fee is removed if empty
+	MinimumFee Fee `protobuf:"bytes,4,opt,name=minimum_fee,json=minimumFee,proto3" json:"minimum_fee"`
 }
 
-func (c *msgClient) PayPacketFeeAsync(ctx context.Context, in *MsgPayPacketFeeAsync, opts ...grpc.CallOption) (*MsgPayPacketFeeAsyncResponse, error) {
-	out := new(MsgPayPacketFeeAsyncResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/PayPacketFeeAsync", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateChannelMinimumFee) Reset()         { *m = MsgUpdateChannelMinimumFee{} }
+func (m *MsgUpdateChannelMinimumFee) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateChannelMinimumFee) ProtoMessage()    {}
+func (*MsgUpdateChannelMinimumFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{10}
+}
+func (m *MsgUpdateChannelMinimumFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateChannelMinimumFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateChannelMinimumFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// RegisterPayee defines a rpc handler method for MsgRegisterPayee
-	// RegisterPayee is called by the relayer on each channelEnd and allows them to set an optional
-	// payee to which reverse and timeout relayer packet fees will be paid out. The payee should be registered on
-	// the source chain from which packets originate as this is where fee distribution takes place. This function may be
-	// called more than once by a relayer, in which case, the latest payee is always used.
-	RegisterPayee(context.Context, *MsgRegisterPayee) (*MsgRegisterPayeeResponse, error)
-	// RegisterCounterpartyPayee defines a rpc handler method for MsgRegisterCounterpartyPayee
-	// RegisterCounterpartyPayee is called by the relayer on each channelEnd and allows them to specify the counterparty
-	// payee address before relaying. This ensures they will be properly compensated for forward relaying since
-	// the destination chain must include the registered counterparty payee address in the acknowledgement. This function
-	// may be called more than once by a relayer, in which case, the latest counterparty payee address is always used.
-	RegisterCounterpartyPayee(context.Context, *MsgRegisterCounterpartyPayee) (*MsgRegisterCounterpartyPayeeResponse, error)
-	// PayPacketFee defines a rpc handler method for MsgPayPacketFee
-	// PayPacketFee is an open callback that may be called by any module/user that wishes to escrow funds in order to
-	// incentivize the relaying of the packet at the next sequence
-	// NOTE: This method is intended to be used within a multi msg transaction, where the subsequent msg that follows
-	// initiates the lifecycle of the incentivized packet
-	PayPacketFee(context.Context, *MsgPayPacketFee) (*MsgPayPacketFeeResponse, error)
-	// PayPacketFeeAsync defines a rpc handler method for MsgPayPacketFeeAsync
-	// PayPacketFeeAsync is an open callback that may be called by any module/user that wishes to escrow funds in order to
-	// incentivize the relaying of a known packet (i.e. at a particular sequence)
-	PayPacketFeeAsync(context.Context, *MsgPayPacketFeeAsync) (*MsgPayPacketFeeAsyncResponse, error)
+func (m *MsgUpdateChannelMinimumFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateChannelMinimumFee.Merge(m, src)
+}
+func (m *MsgUpdateChannelMinimumFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateChannelMinimumFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateChannelMinimumFee.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgUpdateChannelMinimumFee proto.InternalMessageInfo
+
+// MsgUpdateChannelMinimumFeeResponse defines the response type for the UpdateChannelMinimumFee rpc
+type MsgUpdateChannelMinimumFeeResponse struct {
 }
 
-func (*UnimplementedMsgServer) RegisterPayee(ctx context.Context, req *MsgRegisterPayee) (*MsgRegisterPayeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterPayee not implemented")
+func (m *MsgUpdateChannelMinimumFeeResponse) Reset()         { *m = MsgUpdateChannelMinimumFeeResponse{} }
+func (m *MsgUpdateChannelMinimumFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateChannelMinimumFeeResponse) ProtoMessage()    {}
+func (*MsgUpdateChannelMinimumFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{11}
 }
-func (*UnimplementedMsgServer) RegisterCounterpartyPayee(ctx context.Context, req *MsgRegisterCounterpartyPayee) (*MsgRegisterCounterpartyPayeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterCounterpartyPayee not implemented")
+func (m *MsgUpdateChannelMinimumFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) PayPacketFee(ctx context.Context, req *MsgPayPacketFee) (*MsgPayPacketFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PayPacketFee not implemented")
+func (m *MsgUpdateChannelMinimumFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateChannelMinimumFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) PayPacketFeeAsync(ctx context.Context, req *MsgPayPacketFeeAsync) (*MsgPayPacketFeeAsyncResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PayPacketFeeAsync not implemented")
+func (m *MsgUpdateChannelMinimumFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateChannelMinimumFeeResponse.Merge(m, src)
+}
+func (m *MsgUpdateChannelMinimumFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateChannelMinimumFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateChannelMinimumFeeResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgUpdateChannelMinimumFeeResponse proto.InternalMessageInfo
+
+// MsgRefundPacketFee defines the request type for the RefundPacketFee rpc
+type MsgRefundPacketFee struct {
+	// unique packet identifier comprised of the channel ID, port ID and sequence
+	PacketId types.PacketId `protobuf:"bytes,1,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
+	// the refund address of the packet fees to be refunded
+	RefundAddress string `protobuf:"bytes,2,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
 }
 
-func _Msg_RegisterPayee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterPayee)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterPayee(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.fee.v1.Msg/RegisterPayee",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterPayee(ctx, req.(*MsgRegisterPayee))
+func (m *MsgRefundPacketFee) Reset()         { *m = MsgRefundPacketFee{} }
+func (m *MsgRefundPacketFee) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundPacketFee) ProtoMessage()    {}
+func (*MsgRefundPacketFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{12}
+}
+func (m *MsgRefundPacketFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundPacketFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundPacketFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRefundPacketFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundPacketFee.Merge(m, src)
+}
+func (m *MsgRefundPacketFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundPacketFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundPacketFee.DiscardUnknown(m)
 }
 
-func _Msg_RegisterCounterpartyPayee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterCounterpartyPayee)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterCounterpartyPayee(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.fee.v1.Msg/RegisterCounterpartyPayee",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterCounterpartyPayee(ctx, req.(*MsgRegisterCounterpartyPayee))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgRefundPacketFee proto.InternalMessageInfo
+
+// MsgRefundPacketFeeResponse defines the response type for the RefundPacketFee rpc
+type MsgRefundPacketFeeResponse struct {
 }
 
-func _Msg_PayPacketFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPayPacketFee)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PayPacketFee(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.fee.v1.Msg/PayPacketFee",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PayPacketFee(ctx, req.(*MsgPayPacketFee))
+func (m *MsgRefundPacketFeeResponse) Reset()         { *m = MsgRefundPacketFeeResponse{} }
+func (m *MsgRefundPacketFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundPacketFeeResponse) ProtoMessage()    {}
+func (*MsgRefundPacketFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{13}
+}
+func (m *MsgRefundPacketFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundPacketFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundPacketFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Msg_PayPacketFeeAsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPayPacketFeeAsync)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PayPacketFeeAsync(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.fee.v1.Msg/PayPacketFeeAsync",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PayPacketFeeAsync(ctx, req.(*MsgPayPacketFeeAsync))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgRefundPacketFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundPacketFeeResponse.Merge(m, src)
+}
+func (m *MsgRefundPacketFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundPacketFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundPacketFeeResponse.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ibc.applications.fee.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterPayee",
-			Handler:    _Msg_RegisterPayee_Handler,
-		},
-		{
-			MethodName: "RegisterCounterpartyPayee",
-			Handler:    _Msg_RegisterCounterpartyPayee_Handler,
-		},
-		{
-			MethodName: "PayPacketFee",
-			Handler:    _Msg_PayPacketFee_Handler,
-		},
-		{
-			MethodName: "PayPacketFeeAsync",
-			Handler:    _Msg_PayPacketFeeAsync_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ibc/applications/fee/v1/tx.proto",
+var xxx_messageInfo_MsgRefundPacketFeeResponse proto.InternalMessageInfo
+
+// MsgRefundSponsoredPacketFee defines the request type for the RefundSponsoredPacketFee rpc
+type MsgRefundSponsoredPacketFee struct {
+	// unique packet identifier comprised of the channel ID, port ID and sequence
+	PacketId types.PacketId `protobuf:"bytes,1,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
+	// the address managing the pool from which the packet fees to be refunded were drawn
+	Sponsor string `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
 }
 
-func (m *MsgRegisterPayee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRefundSponsoredPacketFee) Reset()         { *m = MsgRefundSponsoredPacketFee{} }
+func (m *MsgRefundSponsoredPacketFee) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundSponsoredPacketFee) ProtoMessage()    {}
+func (*MsgRefundSponsoredPacketFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{14}
+}
+func (m *MsgRefundSponsoredPacketFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundSponsoredPacketFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundSponsoredPacketFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRegisterPayee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRefundSponsoredPacketFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundSponsoredPacketFee.Merge(m, src)
+}
+func (m *MsgRefundSponsoredPacketFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundSponsoredPacketFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundSponsoredPacketFee.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterPayee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Payee) > 0 {
-		i -= len(m.Payee)
-		copy(dAtA[i:], m.Payee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Payee)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Relayer) > 0 {
-		i -= len(m.Relayer)
-		copy(dAtA[i:], m.Relayer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Relayer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgRefundSponsoredPacketFee proto.InternalMessageInfo
+
+// MsgRefundSponsoredPacketFeeResponse defines the response type for the RefundSponsoredPacketFee rpc
+type MsgRefundSponsoredPacketFeeResponse struct {
 }
 
-func (m *MsgRegisterPayeeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRefundSponsoredPacketFeeResponse) Reset()         { *m = MsgRefundSponsoredPacketFeeResponse{} }
+func (m *MsgRefundSponsoredPacketFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundSponsoredPacketFeeResponse) ProtoMessage()    {}
+func (*MsgRefundSponsoredPacketFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{15}
+}
+func (m *MsgRefundSponsoredPacketFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundSponsoredPacketFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundSponsoredPacketFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRegisterPayeeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRefundSponsoredPacketFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundSponsoredPacketFeeResponse.Merge(m, src)
+}
+func (m *MsgRefundSponsoredPacketFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundSponsoredPacketFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundSponsoredPacketFeeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterPayeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgRefundSponsoredPacketFeeResponse proto.InternalMessageInfo
+
+// MsgUpdateParams defines the request type for the UpdateParams rpc
+type MsgUpdateParams struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// params defines the fee middleware parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgRegisterCounterpartyPayee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{16}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRegisterCounterpartyPayee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterCounterpartyPayee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CounterpartyPayee) > 0 {
-		i -= len(m.CounterpartyPayee)
-		copy(dAtA[i:], m.CounterpartyPayee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CounterpartyPayee)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Relayer) > 0 {
-		i -= len(m.Relayer)
-		copy(dAtA[i:], m.Relayer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Relayer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+// MsgUpdateParamsResponse defines the response type for the UpdateParams rpc
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgRegisterCounterpartyPayeeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{17}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRegisterCounterpartyPayeeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterCounterpartyPayeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgRegisterFeeSponsorPool defines the request type for the RegisterFeeSponsorPool rpc
+type MsgRegisterFeeSponsorPool struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the address managing the pool
+	Sponsor string `protobuf:"bytes,3,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// list of the allowances of the senders allowed to draw packet fees from the pool
+	Allowances []FeeSponsorAllowance `protobuf:"bytes,4,rep,name=allowances,proto3" json:"allowances"`
 }
 
-func (m *MsgPayPacketFee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterFeeSponsorPool) Reset()         { *m = MsgRegisterFeeSponsorPool{} }
+func (m *MsgRegisterFeeSponsorPool) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterFeeSponsorPool) ProtoMessage()    {}
+func (*MsgRegisterFeeSponsorPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{18}
+}
+func (m *MsgRegisterFeeSponsorPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterFeeSponsorPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterFeeSponsorPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgPayPacketFee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRegisterFeeSponsorPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterFeeSponsorPool.Merge(m, src)
 }
-
-func (m *MsgPayPacketFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Relayers) > 0 {
-		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Relayers[iNdEx])
-			copy(dAtA[i:], m.Relayers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Relayers[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.SourceChannelId) > 0 {
-		i -= len(m.SourceChannelId)
-		copy(dAtA[i:], m.SourceChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceChannelId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SourcePortId) > 0 {
-		i -= len(m.SourcePortId)
-		copy(dAtA[i:], m.SourcePortId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SourcePortId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRegisterFeeSponsorPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterFeeSponsorPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterFeeSponsorPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterFeeSponsorPool proto.InternalMessageInfo
+
+// MsgRegisterFeeSponsorPoolResponse defines the response type for the RegisterFeeSponsorPool rpc
+type MsgRegisterFeeSponsorPoolResponse struct {
+}
+
+func (m *MsgRegisterFeeSponsorPoolResponse) Reset()         { *m = MsgRegisterFeeSponsorPoolResponse{} }
+func (m *MsgRegisterFeeSponsorPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterFeeSponsorPoolResponse) ProtoMessage()    {}
+func (*MsgRegisterFeeSponsorPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{19}
+}
+func (m *MsgRegisterFeeSponsorPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterFeeSponsorPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterFeeSponsorPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgPayPacketFeeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRegisterFeeSponsorPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterFeeSponsorPoolResponse.Merge(m, src)
 }
-
-func (m *MsgPayPacketFeeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRegisterFeeSponsorPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterFeeSponsorPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterFeeSponsorPoolResponse.DiscardUnknown(m)
 }
 
-func (m *MsgPayPacketFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgRegisterFeeSponsorPoolResponse proto.InternalMessageInfo
+
+// MsgFundFeeSponsorPool defines the request type for the FundFeeSponsorPool rpc
+type MsgFundFeeSponsorPool struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the address managing the pool
+	Sponsor string `protobuf:"bytes,3,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// the address funding the pool
+	Depositor string `protobuf:"bytes,4,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// the amount deposited into the pool
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (m *MsgPayPacketFeeAsync) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgFundFeeSponsorPool) Reset()         { *m = MsgFundFeeSponsorPool{} }
+func (m *MsgFundFeeSponsorPool) String() string { return proto.CompactTextString(m) }
+func (*MsgFundFeeSponsorPool) ProtoMessage()    {}
+func (*MsgFundFeeSponsorPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{20}
+}
+func (m *MsgFundFeeSponsorPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundFeeSponsorPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundFeeSponsorPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgFundFeeSponsorPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundFeeSponsorPool.Merge(m, src)
+}
+func (m *MsgFundFeeSponsorPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundFeeSponsorPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundFeeSponsorPool.DiscardUnknown(m)
 }
 
-func (m *MsgPayPacketFeeAsync) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgFundFeeSponsorPool proto.InternalMessageInfo
+
+// MsgFundFeeSponsorPoolResponse defines the response type for the FundFeeSponsorPool rpc
+type MsgFundFeeSponsorPoolResponse struct {
 }
 
-func (m *MsgPayPacketFeeAsync) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PacketFee.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgFundFeeSponsorPoolResponse) Reset()         { *m = MsgFundFeeSponsorPoolResponse{} }
+func (m *MsgFundFeeSponsorPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundFeeSponsorPoolResponse) ProtoMessage()    {}
+func (*MsgFundFeeSponsorPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{21}
+}
+func (m *MsgFundFeeSponsorPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundFeeSponsorPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundFeeSponsorPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgFundFeeSponsorPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundFeeSponsorPoolResponse.Merge(m, src)
+}
+func (m *MsgFundFeeSponsorPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundFeeSponsorPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundFeeSponsorPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundFeeSponsorPoolResponse proto.InternalMessageInfo
+
+// MsgWithdrawFeeSponsorPool defines the request type for the WithdrawFeeSponsorPool rpc
+type MsgWithdrawFeeSponsorPool struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the address managing the pool
+	Sponsor string `protobuf:"bytes,3,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// the address receiving the withdrawn funds
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// the amount withdrawn from the pool
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawFeeSponsorPool) Reset()         { *m = MsgWithdrawFeeSponsorPool{} }
+func (m *MsgWithdrawFeeSponsorPool) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFeeSponsorPool) ProtoMessage()    {}
+func (*MsgWithdrawFeeSponsorPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{22}
+}
+func (m *MsgWithdrawFeeSponsorPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFeeSponsorPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFeeSponsorPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgPayPacketFeeAsyncResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgWithdrawFeeSponsorPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFeeSponsorPool.Merge(m, src)
 }
-
-func (m *MsgPayPacketFeeAsyncResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgWithdrawFeeSponsorPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFeeSponsorPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFeeSponsorPool.DiscardUnknown(m)
 }
 
-func (m *MsgPayPacketFeeAsyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgWithdrawFeeSponsorPool proto.InternalMessageInfo
+
+// MsgWithdrawFeeSponsorPoolResponse defines the response type for the WithdrawFeeSponsorPool rpc
+type MsgWithdrawFeeSponsorPoolResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgWithdrawFeeSponsorPoolResponse) Reset()         { *m = MsgWithdrawFeeSponsorPoolResponse{} }
+func (m *MsgWithdrawFeeSponsorPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFeeSponsorPoolResponse) ProtoMessage()    {}
+func (*MsgWithdrawFeeSponsorPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{23}
 }
-func (m *MsgRegisterPayee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Relayer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Payee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgWithdrawFeeSponsorPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFeeSponsorPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFeeSponsorPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgWithdrawFeeSponsorPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFeeSponsorPoolResponse.Merge(m, src)
+}
+func (m *MsgWithdrawFeeSponsorPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFeeSponsorPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFeeSponsorPoolResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterPayeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgWithdrawFeeSponsorPoolResponse proto.InternalMessageInfo
+
+// MsgDeleteFeeSponsorPool defines the request type for the DeleteFeeSponsorPool rpc
+type MsgDeleteFeeSponsorPool struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the address managing the pool
+	Sponsor string `protobuf:"bytes,3,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// the address receiving the remaining pool funds
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-func (m *MsgRegisterCounterpartyPayee) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteFeeSponsorPool) Reset()         { *m = MsgDeleteFeeSponsorPool{} }
+func (m *MsgDeleteFeeSponsorPool) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteFeeSponsorPool) ProtoMessage()    {}
+func (*MsgDeleteFeeSponsorPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{24}
+}
+func (m *MsgDeleteFeeSponsorPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteFeeSponsorPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteFeeSponsorPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgDeleteFeeSponsorPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteFeeSponsorPool.Merge(m, src)
+}
+func (m *MsgDeleteFeeSponsorPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteFeeSponsorPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteFeeSponsorPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteFeeSponsorPool proto.InternalMessageInfo
+
+// MsgDeleteFeeSponsorPoolResponse defines the response type for the DeleteFeeSponsorPool rpc
+type MsgDeleteFeeSponsorPoolResponse struct {
+}
+
+func (m *MsgDeleteFeeSponsorPoolResponse) Reset()         { *m = MsgDeleteFeeSponsorPoolResponse{} }
+func (m *MsgDeleteFeeSponsorPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteFeeSponsorPoolResponse) ProtoMessage()    {}
+func (*MsgDeleteFeeSponsorPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{25}
+}
+func (m *MsgDeleteFeeSponsorPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteFeeSponsorPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteFeeSponsorPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgDeleteFeeSponsorPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteFeeSponsorPoolResponse.Merge(m, src)
+}
+func (m *MsgDeleteFeeSponsorPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteFeeSponsorPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteFeeSponsorPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteFeeSponsorPoolResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRegisterPayee)(nil), "ibc.applications.fee.v1.MsgRegisterPayee")
+	proto.RegisterType((*MsgRegisterPayeeResponse)(nil), "ibc.applications.fee.v1.MsgRegisterPayeeResponse")
+	proto.RegisterType((*MsgRegisterCounterpartyPayee)(nil), "ibc.applications.fee.v1.MsgRegisterCounterpartyPayee")
+	proto.RegisterType((*MsgRegisterCounterpartyPayeeResponse)(nil), "ibc.applications.fee.v1.MsgRegisterCounterpartyPayeeResponse")
+	proto.RegisterType((*MsgPayPacketFee)(nil), "ibc.applications.fee.v1.MsgPayPacketFee")
+	proto.RegisterType((*MsgPayPacketFeeResponse)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeResponse")
+	proto.RegisterType((*MsgPayPacketFeeAsync)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeAsync")
+	proto.RegisterType((*MsgPayPacketFeeAsyncResponse)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeAsyncResponse")
+	proto.RegisterType((*MsgRegisterFeePreferences)(nil), "ibc.applications.fee.v1.MsgRegisterFeePreferences")
+	proto.RegisterType((*MsgRegisterFeePreferencesResponse)(nil), "ibc.applications.fee.v1.MsgRegisterFeePreferencesResponse")
+	proto.RegisterType((*MsgUpdateChannelMinimumFee)(nil), "ibc.applications.fee.v1.MsgUpdateChannelMinimumFee")
+	proto.RegisterType((*MsgUpdateChannelMinimumFeeResponse)(nil), "ibc.applications.fee.v1.MsgUpdateChannelMinimumFeeResponse")
+	proto.RegisterType((*MsgRefundPacketFee)(nil), "ibc.applications.fee.v1.MsgRefundPacketFee")
+	proto.RegisterType((*MsgRefundPacketFeeResponse)(nil), "ibc.applications.fee.v1.MsgRefundPacketFeeResponse")
+	proto.RegisterType((*MsgRefundSponsoredPacketFee)(nil), "ibc.applications.fee.v1.MsgRefundSponsoredPacketFee")
+	proto.RegisterType((*MsgRefundSponsoredPacketFeeResponse)(nil), "ibc.applications.fee.v1.MsgRefundSponsoredPacketFeeResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.fee.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.fee.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterFeeSponsorPool)(nil), "ibc.applications.fee.v1.MsgRegisterFeeSponsorPool")
+	proto.RegisterType((*MsgRegisterFeeSponsorPoolResponse)(nil), "ibc.applications.fee.v1.MsgRegisterFeeSponsorPoolResponse")
+	proto.RegisterType((*MsgFundFeeSponsorPool)(nil), "ibc.applications.fee.v1.MsgFundFeeSponsorPool")
+	proto.RegisterType((*MsgFundFeeSponsorPoolResponse)(nil), "ibc.applications.fee.v1.MsgFundFeeSponsorPoolResponse")
+	proto.RegisterType((*MsgWithdrawFeeSponsorPool)(nil), "ibc.applications.fee.v1.MsgWithdrawFeeSponsorPool")
+	proto.RegisterType((*MsgWithdrawFeeSponsorPoolResponse)(nil), "ibc.applications.fee.v1.MsgWithdrawFeeSponsorPoolResponse")
+	proto.RegisterType((*MsgDeleteFeeSponsorPool)(nil), "ibc.applications.fee.v1.MsgDeleteFeeSponsorPool")
+	proto.RegisterType((*MsgDeleteFeeSponsorPoolResponse)(nil), "ibc.applications.fee.v1.MsgDeleteFeeSponsorPoolResponse")
+}
+
+func init() { proto.RegisterFile("ibc/applications/fee/v1/tx.proto", fileDescriptor_05c93128649f1b96) }
+
+var fileDescriptor_05c93128649f1b96 = []byte{
+	// 1449 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4d, 0x6c, 0xdb, 0xe4,
+	0x1b, 0xaf, 0x9b, 0xae, 0x5b, 0x9e, 0x6e, 0xeb, 0x6a, 0xf5, 0xbf, 0xa6, 0x5e, 0x9b, 0x74, 0x5e,
+	0xbb, 0x75, 0xfd, 0x53, 0x7b, 0xcd, 0x18, 0xd0, 0x6c, 0x93, 0xd8, 0x07, 0x15, 0x93, 0xa8, 0x88,
+	0x8a, 0x10, 0x12, 0x97, 0xc8, 0xb1, 0x9f, 0x78, 0x66, 0xf1, 0x87, 0xfc, 0x3a, 0x1d, 0x91, 0x38,
+	0xa0, 0x49, 0x48, 0x13, 0x48, 0x08, 0xae, 0xe3, 0x82, 0xc4, 0x05, 0x21, 0x0e, 0x3d, 0x21, 0x24,
+	0x4e, 0x70, 0xda, 0x71, 0x47, 0x2e, 0x7c, 0x68, 0x3b, 0xf4, 0xcc, 0x85, 0x13, 0x07, 0xf4, 0xda,
+	0xaf, 0xdf, 0x38, 0x89, 0x9d, 0x25, 0x85, 0xb1, 0x4b, 0x55, 0x3f, 0x5f, 0xef, 0xf3, 0xfb, 0xbd,
+	0xcf, 0xf3, 0xf8, 0x71, 0x60, 0xc9, 0xaa, 0xeb, 0xaa, 0xe6, 0x79, 0x4d, 0x4b, 0xd7, 0x02, 0xcb,
+	0x75, 0x88, 0xda, 0x40, 0x54, 0x77, 0x37, 0xd4, 0xe0, 0x7d, 0xc5, 0xf3, 0xdd, 0xc0, 0x15, 0xe7,
+	0xac, 0xba, 0xae, 0x24, 0x2d, 0x94, 0x06, 0xa2, 0xb2, 0xbb, 0x21, 0xcd, 0x68, 0xb6, 0xe5, 0xb8,
+	0x6a, 0xf8, 0x37, 0xb2, 0x95, 0x66, 0x4d, 0xd7, 0x74, 0xc3, 0x7f, 0x55, 0xfa, 0x1f, 0x93, 0x9e,
+	0xce, 0x3a, 0x83, 0x06, 0x8a, 0x4c, 0x56, 0xb2, 0x4c, 0x4c, 0x74, 0x90, 0x58, 0x24, 0x19, 0x49,
+	0x77, 0x7d, 0x54, 0xf5, 0xdb, 0x9a, 0xe3, 0x60, 0x93, 0x9a, 0xb0, 0x7f, 0x99, 0x49, 0x51, 0x77,
+	0x89, 0xed, 0x12, 0xb5, 0xae, 0x11, 0x1a, 0xa0, 0x8e, 0x81, 0xb6, 0xa1, 0xea, 0xae, 0xe5, 0x30,
+	0xfd, 0x1c, 0xd3, 0xdb, 0xc4, 0xa4, 0xce, 0x36, 0x31, 0x23, 0x85, 0xfc, 0xad, 0x00, 0x27, 0xb6,
+	0x89, 0xb9, 0x83, 0xa6, 0x45, 0x02, 0xf4, 0xab, 0x5a, 0x1b, 0x51, 0x9c, 0x83, 0xc3, 0x9e, 0xeb,
+	0x07, 0x35, 0xcb, 0x28, 0x08, 0x4b, 0xc2, 0x6a, 0x7e, 0x67, 0x92, 0x3e, 0xde, 0x32, 0xc4, 0x45,
+	0x00, 0x76, 0x2e, 0xd5, 0x8d, 0x87, 0xba, 0x3c, 0x93, 0xdc, 0x32, 0xc4, 0x02, 0x1c, 0xf6, 0xb1,
+	0xa9, 0xb5, 0xd1, 0x2f, 0xe4, 0x42, 0x5d, 0xfc, 0x28, 0xce, 0xc2, 0x21, 0x8f, 0x86, 0x2e, 0x4c,
+	0x84, 0xf2, 0xe8, 0xa1, 0x72, 0xe1, 0xfe, 0x97, 0xa5, 0xb1, 0x7b, 0xfb, 0x7b, 0x6b, 0xb1, 0xdd,
+	0xc7, 0xfb, 0x7b, 0x6b, 0xa7, 0xa2, 0x54, 0xd7, 0x89, 0x71, 0x47, 0xed, 0xcd, 0x4c, 0x96, 0xa0,
+	0xd0, 0x2b, 0xdb, 0x41, 0xe2, 0xb9, 0x0e, 0x41, 0xf9, 0x17, 0x01, 0x16, 0x12, 0xca, 0x1b, 0x6e,
+	0xcb, 0x09, 0xd0, 0xf7, 0x34, 0x3f, 0x68, 0x3f, 0x2b, 0x58, 0xeb, 0x20, 0xea, 0x89, 0x63, 0x6a,
+	0x49, 0x8c, 0x33, 0x7a, 0x6f, 0x02, 0x95, 0x2b, 0x69, 0x78, 0xcf, 0xa5, 0xe3, 0xed, 0x4b, 0x5f,
+	0x3e, 0x0b, 0xcb, 0x83, 0xf4, 0x9c, 0x87, 0xaf, 0xc6, 0x61, 0x7a, 0x9b, 0x98, 0x55, 0xad, 0x5d,
+	0xd5, 0xf4, 0x3b, 0x18, 0x6c, 0x21, 0x8a, 0x9b, 0x90, 0x6b, 0x20, 0x86, 0xb0, 0xa7, 0xca, 0x0b,
+	0x4a, 0x46, 0x71, 0x2b, 0x5b, 0x88, 0xd7, 0xf3, 0x0f, 0x7f, 0x2d, 0x8d, 0x7d, 0xbd, 0xbf, 0xb7,
+	0x26, 0xec, 0x50, 0x1f, 0x71, 0x19, 0x8e, 0x13, 0xb7, 0xe5, 0xeb, 0x58, 0x8b, 0xc9, 0x8b, 0x08,
+	0x3a, 0x1a, 0x49, 0xab, 0x11, 0x85, 0x6b, 0x30, 0xc3, 0xac, 0x12, 0x4c, 0x46, 0x6c, 0x4d, 0x47,
+	0x8a, 0x1b, 0x9c, 0xcf, 0x93, 0x30, 0x49, 0x2c, 0xd3, 0x41, 0x9f, 0x31, 0xc5, 0x9e, 0x44, 0x09,
+	0x8e, 0x30, 0x5e, 0x48, 0xe1, 0xd0, 0x52, 0x6e, 0x35, 0xbf, 0xc3, 0x9f, 0xc5, 0x12, 0x4c, 0x35,
+	0x10, 0x6b, 0x21, 0x44, 0xd7, 0x2f, 0x4c, 0x86, 0x8e, 0xd0, 0x40, 0x7c, 0x2b, 0x92, 0x54, 0x94,
+	0x98, 0x5b, 0x16, 0x8d, 0x52, 0x2b, 0x75, 0x53, 0x9b, 0x64, 0x44, 0x9e, 0x87, 0xb9, 0x1e, 0x11,
+	0x27, 0xf0, 0xa3, 0x71, 0x98, 0xed, 0xd1, 0x5d, 0x23, 0x6d, 0x47, 0x17, 0x5f, 0x83, 0xbc, 0x17,
+	0x4a, 0xe2, 0x12, 0x9a, 0x2a, 0x2f, 0x86, 0x5c, 0xd2, 0xe6, 0x54, 0xe2, 0x8e, 0xdc, 0xdd, 0x50,
+	0x22, 0xbf, 0x5b, 0x46, 0x92, 0xcc, 0x23, 0x1e, 0x13, 0x8a, 0x6f, 0x00, 0xb0, 0x30, 0xf4, 0x4e,
+	0xc6, 0xc3, 0x38, 0x72, 0xe6, 0x9d, 0xf0, 0x1c, 0x92, 0xc1, 0xf2, 0x1e, 0xbf, 0xda, 0x1e, 0x66,
+	0x72, 0x7d, 0xcc, 0xbc, 0x1c, 0x33, 0x93, 0x38, 0x95, 0xb2, 0x53, 0xca, 0x66, 0x27, 0x84, 0x2b,
+	0x17, 0x61, 0x21, 0x4d, 0xce, 0x79, 0xfa, 0x53, 0x80, 0xf9, 0x44, 0x45, 0x6e, 0x21, 0x56, 0x7d,
+	0x6c, 0xa0, 0x8f, 0x8e, 0x8e, 0xe4, 0xc0, 0xdd, 0xc6, 0x47, 0x45, 0x2e, 0x31, 0x2a, 0xc4, 0x73,
+	0x30, 0xad, 0xe9, 0x3a, 0x7a, 0x01, 0x1a, 0x35, 0x03, 0x1d, 0xd7, 0x26, 0x85, 0x89, 0xb0, 0x44,
+	0x8e, 0xc7, 0xe2, 0x9b, 0xa1, 0x54, 0x3c, 0x0f, 0x27, 0x1a, 0x5a, 0xb3, 0x59, 0xd7, 0xf4, 0x3b,
+	0x35, 0xcd, 0x30, 0x7c, 0x24, 0xb4, 0x98, 0xc2, 0x3a, 0x8c, 0xe5, 0xd7, 0x22, 0x71, 0x87, 0x98,
+	0xe8, 0x0c, 0xca, 0xc9, 0x72, 0x7a, 0x33, 0x76, 0x43, 0x93, 0xcf, 0xc0, 0xe9, 0x4c, 0x25, 0x67,
+	0xe7, 0x0f, 0x01, 0xa4, 0x6d, 0x62, 0xbe, 0xed, 0x19, 0x5a, 0x10, 0x17, 0xff, 0xb6, 0xe5, 0x58,
+	0x76, 0xcb, 0xa6, 0xd7, 0xd6, 0x69, 0x02, 0xa1, 0xab, 0x09, 0x12, 0xb4, 0x8d, 0x0f, 0xa0, 0x2d,
+	0xd7, 0x4b, 0xdb, 0xeb, 0x30, 0x65, 0x47, 0xd1, 0xc3, 0xaa, 0x9a, 0x18, 0xad, 0xd3, 0xc1, 0xe6,
+	0x99, 0x55, 0x36, 0x53, 0x3a, 0x69, 0xa5, 0x9b, 0x97, 0x0c, 0x50, 0xf2, 0x32, 0xc8, 0xd9, 0x5a,
+	0xce, 0xcc, 0xf7, 0x02, 0x88, 0x21, 0x7f, 0x8d, 0x96, 0x63, 0x74, 0x66, 0xd4, 0xab, 0x23, 0x77,
+	0xd7, 0x04, 0x05, 0x90, 0x68, 0xac, 0x15, 0x38, 0xee, 0x87, 0x41, 0xf9, 0xcd, 0x47, 0x14, 0x1e,
+	0x8b, 0xa4, 0xf1, 0xbd, 0x73, 0x80, 0x3d, 0xd6, 0x14, 0xe8, 0x62, 0x6f, 0x01, 0x74, 0xe5, 0x28,
+	0x2f, 0x80, 0xd4, 0x2f, 0xe5, 0xc0, 0xbe, 0x13, 0xe0, 0x14, 0x57, 0xb3, 0xf6, 0xc3, 0x7f, 0x15,
+	0x61, 0x01, 0x0e, 0xc7, 0x8d, 0x1e, 0x41, 0x8b, 0x1f, 0x2b, 0x97, 0xf9, 0xbb, 0x85, 0x49, 0x28,
+	0x9a, 0xb3, 0x69, 0x68, 0xfa, 0x13, 0x93, 0x57, 0xe0, 0xcc, 0x00, 0x35, 0xc7, 0xf7, 0x85, 0x00,
+	0xd3, 0xfc, 0x7e, 0xab, 0x9a, 0xaf, 0xd9, 0x24, 0xb3, 0x8e, 0xaf, 0xc2, 0xa4, 0x17, 0x5a, 0xb0,
+	0x01, 0x57, 0x1a, 0x30, 0xe0, 0xa8, 0x19, 0x83, 0xca, 0x9c, 0x2a, 0x1b, 0x29, 0x45, 0xd8, 0x73,
+	0x37, 0x5b, 0x88, 0xc9, 0x4c, 0xd8, 0x44, 0x4f, 0x8a, 0x78, 0xe2, 0x7f, 0xf5, 0x4d, 0x2a, 0x86,
+	0xb2, 0xea, 0xba, 0xcd, 0x7f, 0xb2, 0x17, 0x74, 0x4f, 0xdd, 0xf8, 0x51, 0xdc, 0x01, 0xd0, 0x9a,
+	0x4d, 0xf7, 0xae, 0xe6, 0xe8, 0x18, 0x0d, 0xaa, 0xa9, 0xf2, 0x0b, 0x83, 0x7a, 0x91, 0xa5, 0x73,
+	0x2d, 0x76, 0x62, 0x6c, 0x24, 0xa2, 0x74, 0xaa, 0x36, 0x79, 0xc1, 0xd9, 0xf3, 0x2a, 0x01, 0xb0,
+	0x7f, 0x5e, 0x25, 0x94, 0x9c, 0xa3, 0x1f, 0xc6, 0xe1, 0x7f, 0x94, 0xd5, 0x96, 0x63, 0x3c, 0x73,
+	0x7e, 0x16, 0x20, 0x6f, 0xa0, 0xe7, 0x12, 0x2b, 0x70, 0xe3, 0x25, 0xa0, 0x23, 0x10, 0xdb, 0x30,
+	0xa9, 0xd9, 0x74, 0xbd, 0x09, 0xb7, 0x80, 0xa9, 0xf2, 0xbc, 0x12, 0xa1, 0x54, 0xe8, 0x76, 0xab,
+	0xb0, 0xed, 0x56, 0xb9, 0xe1, 0x5a, 0xce, 0xf5, 0x2d, 0x4a, 0xd3, 0x37, 0xbf, 0x95, 0x56, 0x4d,
+	0x2b, 0xb8, 0xdd, 0xaa, 0x2b, 0xba, 0x6b, 0xab, 0x6c, 0xd5, 0x4d, 0x30, 0x13, 0xb4, 0x3d, 0x24,
+	0xa1, 0x03, 0x79, 0xb0, 0xbf, 0xb7, 0x76, 0xb4, 0x89, 0xa6, 0xa6, 0xb7, 0x6b, 0x74, 0x3f, 0x26,
+	0xd1, 0xfc, 0x63, 0x07, 0x76, 0x5e, 0x09, 0x9d, 0x74, 0x28, 0xcd, 0x4b, 0x3d, 0x95, 0xd7, 0xc7,
+	0x91, 0x5c, 0x82, 0xc5, 0x54, 0x05, 0xa7, 0xf7, 0xc7, 0xf1, 0xb0, 0x04, 0xdf, 0xb1, 0x82, 0xdb,
+	0x86, 0xaf, 0xdd, 0xfd, 0x2f, 0x28, 0xf6, 0x51, 0xb7, 0x3c, 0x0b, 0x9d, 0x20, 0xa6, 0x98, 0x0b,
+	0x9e, 0x27, 0xc5, 0xc3, 0xd4, 0x71, 0x3a, 0x4b, 0xac, 0x8e, 0xd3, 0x95, 0x9c, 0xe8, 0x9f, 0x84,
+	0x70, 0x0e, 0xdc, 0xc4, 0x26, 0x06, 0xf8, 0x7c, 0x69, 0xee, 0x94, 0x53, 0x12, 0xab, 0xdc, 0x8d,
+	0x35, 0x2d, 0x51, 0xf9, 0x34, 0x94, 0x32, 0x54, 0x31, 0xce, 0xf2, 0x83, 0x63, 0x90, 0xdb, 0x26,
+	0xa6, 0x68, 0xc3, 0xb1, 0xee, 0xaf, 0xb7, 0xf3, 0x99, 0x83, 0xa6, 0xf7, 0xd3, 0x49, 0xda, 0x18,
+	0xda, 0x34, 0x3e, 0x56, 0xfc, 0x5c, 0x80, 0xf9, 0xec, 0x4f, 0xac, 0x4b, 0xc3, 0x04, 0xec, 0x73,
+	0x93, 0xae, 0x1e, 0xc8, 0x8d, 0xe7, 0xf4, 0x1e, 0x1c, 0xed, 0xfa, 0xda, 0x59, 0x1d, 0x14, 0x2e,
+	0x69, 0x29, 0x5d, 0x18, 0xd6, 0x92, 0x9f, 0xd5, 0x86, 0x99, 0xfe, 0x0f, 0x83, 0xf5, 0x61, 0xc3,
+	0x84, 0xe6, 0xd2, 0xa5, 0x91, 0xcc, 0xf9, 0xd1, 0xf7, 0x05, 0x38, 0x99, 0xb1, 0x6c, 0x97, 0x87,
+	0x21, 0xb0, 0xdb, 0x47, 0xaa, 0x8c, 0xee, 0xc3, 0x53, 0xf9, 0x44, 0x80, 0xb9, 0xac, 0xcd, 0xf6,
+	0xe2, 0xa0, 0xb8, 0x19, 0x4e, 0xd2, 0xe5, 0x03, 0x38, 0xf1, 0x6c, 0x08, 0x4c, 0xf7, 0x2e, 0x93,
+	0xff, 0x1f, 0x0c, 0xae, 0xcb, 0x58, 0xba, 0x38, 0x82, 0x31, 0x3f, 0xf4, 0x53, 0x01, 0x0a, 0x99,
+	0x9b, 0xde, 0x8b, 0x4f, 0x8f, 0xd8, 0xef, 0x25, 0x5d, 0x39, 0x88, 0x57, 0xb2, 0x0b, 0xba, 0x36,
+	0xb3, 0xd5, 0xa7, 0x53, 0x1a, 0x59, 0x4a, 0x17, 0x86, 0xb5, 0xcc, 0x2a, 0xc5, 0xe4, 0x8c, 0x1d,
+	0xb6, 0x14, 0x13, 0x3e, 0x52, 0x65, 0x74, 0x1f, 0x9e, 0xca, 0x07, 0x20, 0xa6, 0xec, 0x2c, 0xca,
+	0xa0, 0x88, 0xfd, 0xf6, 0xd2, 0x4b, 0xa3, 0xd9, 0x77, 0x11, 0x91, 0xf1, 0x4e, 0x1f, 0x48, 0x44,
+	0xba, 0x8f, 0x54, 0x19, 0xdd, 0x87, 0xa7, 0x72, 0x4f, 0x80, 0xd9, 0xd4, 0xb7, 0xde, 0xc0, 0xeb,
+	0x4d, 0xf3, 0x90, 0x5e, 0x19, 0xd5, 0x23, 0x4e, 0x42, 0x3a, 0xf4, 0x21, 0x7d, 0xd9, 0x5f, 0x7f,
+	0xf3, 0xe1, 0xe3, 0xa2, 0xf0, 0xe8, 0x71, 0x51, 0xf8, 0xfd, 0x71, 0x51, 0xf8, 0xec, 0x49, 0x71,
+	0xec, 0xd1, 0x93, 0xe2, 0xd8, 0xcf, 0x4f, 0x8a, 0x63, 0xef, 0x5e, 0xea, 0x5f, 0x23, 0xac, 0xba,
+	0xbe, 0x6e, 0xba, 0xea, 0xee, 0xa6, 0x6a, 0xbb, 0x46, 0xab, 0x89, 0x84, 0xfe, 0x26, 0x4a, 0xd4,
+	0xf2, 0xe6, 0x3a, 0xfd, 0x39, 0x34, 0xdc, 0x2c, 0xea, 0x93, 0xe1, 0xcf, 0x95, 0x17, 0xff, 0x1e,
+	0x00, 0x82, 0x38, 0x7c, 0x70, 0xba, 0x15, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// RegisterPayee defines a rpc handler method for MsgRegisterPayee
+	// RegisterPayee is called by the relayer on each channelEnd and allows them to set an optional
+	// payee to which reverse and timeout relayer packet fees will be paid out. The payee should be registered on
+	// the source chain from which packets originate as this is where fee distribution takes place. This function may be
+	// called more than once by a relayer, in which case, the latest payee is always used.
+	RegisterPayee(ctx context.Context, in *MsgRegisterPayee, opts ...grpc.CallOption) (*MsgRegisterPayeeResponse, error)
+	// RegisterCounterpartyPayee defines a rpc handler method for MsgRegisterCounterpartyPayee
+	// RegisterCounterpartyPayee is called by the relayer on each channelEnd and allows them to specify the counterparty
+	// payee address before relaying. This ensures they will be properly compensated for forward relaying since
+	// the destination chain must include the registered counterparty payee address in the acknowledgement. This function
+	// may be called more than once by a relayer, in which case, the latest counterparty payee address is always used.
+	RegisterCounterpartyPayee(ctx context.Context, in *MsgRegisterCounterpartyPayee, opts ...grpc.CallOption) (*MsgRegisterCounterpartyPayeeResponse, error)
+	// PayPacketFee defines a rpc handler method for MsgPayPacketFee
+	// PayPacketFee is an open callback that may be called by any module/user that wishes to escrow funds in order to
+	// incentivize the relaying of the packet at the next sequence
+	// NOTE: This method is intended to be used within a multi msg transaction, where the subsequent msg that follows
+	// initiates the lifecycle of the incentivized packet
+	PayPacketFee(ctx context.Context, in *MsgPayPacketFee, opts ...grpc.CallOption) (*MsgPayPacketFeeResponse, error)
+	// PayPacketFeeAsync defines a rpc handler method for MsgPayPacketFeeAsync
+	// PayPacketFeeAsync is an open callback that may be called by any module/user that wishes to escrow funds in order to
+	// incentivize the relaying of a known packet (i.e. at a particular sequence)
+	PayPacketFeeAsync(ctx context.Context, in *MsgPayPacketFeeAsync, opts ...grpc.CallOption) (*MsgPayPacketFeeAsyncResponse, error)
+	// RegisterFeePreferences defines a rpc handler method for MsgRegisterFeePreferences
+	// RegisterFeePreferences is called by the address to which packet fees are paid out on a channelEnd, either a relayer
+	// or a registered payee, and allows them to set the fee denominations they accept. Packet fees in other denominations
+	// are sent to the fallback address, if any, or refunded. This function may be called more than once, in which case,
+	// the latest fee preferences are always used.
+	RegisterFeePreferences(ctx context.Context, in *MsgRegisterFeePreferences, opts ...grpc.CallOption) (*MsgRegisterFeePreferencesResponse, error)
+	// UpdateChannelMinimumFee defines a rpc handler method for MsgUpdateChannelMinimumFee
+	// UpdateChannelMinimumFee is called by the module authority (i.e. governance) and sets the minimum receive,
+	// acknowledgement and timeout fees which must be escrowed for a packet on a fee enabled channel. An empty minimum fee
+	// removes the minimum fee of the channel.
+	UpdateChannelMinimumFee(ctx context.Context, in *MsgUpdateChannelMinimumFee, opts ...grpc.CallOption) (*MsgUpdateChannelMinimumFeeResponse, error)
+	// RefundPacketFee defines a rpc handler method for MsgRefundPacketFee
+	// RefundPacketFee is called by a payer to withdraw their unspent fees escrowed for a packet
+	// once the refund grace period has elapsed without the packet being relayed
+	RefundPacketFee(ctx context.Context, in *MsgRefundPacketFee, opts ...grpc.CallOption) (*MsgRefundPacketFeeResponse, error)
+	// RefundSponsoredPacketFee defines a rpc handler method for MsgRefundSponsoredPacketFee
+	// RefundSponsoredPacketFee is called by a fee sponsor to return the unspent fees escrowed for a packet from its
+	// fee sponsor pool to the pool once the refund grace period has elapsed without the packet being relayed
+	RefundSponsoredPacketFee(ctx context.Context, in *MsgRefundSponsoredPacketFee, opts ...grpc.CallOption) (*MsgRefundSponsoredPacketFeeResponse, error)
+	// UpdateParams defines a rpc handler method for MsgUpdateParams
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterFeeSponsorPool defines a rpc handler method for MsgRegisterFeeSponsorPool
+	// RegisterFeeSponsorPool is called by a fee sponsor to create or update its fee sponsor pool on a channel,
+	// allowing the listed senders to pay packet fees from the pool
+	RegisterFeeSponsorPool(ctx context.Context, in *MsgRegisterFeeSponsorPool, opts ...grpc.CallOption) (*MsgRegisterFeeSponsorPoolResponse, error)
+	// FundFeeSponsorPool defines a rpc handler method for MsgFundFeeSponsorPool
+	// FundFeeSponsorPool is called by any account, such as a protocol treasury, to top up a fee sponsor pool
+	FundFeeSponsorPool(ctx context.Context, in *MsgFundFeeSponsorPool, opts ...grpc.CallOption) (*MsgFundFeeSponsorPoolResponse, error)
+	// WithdrawFeeSponsorPool defines a rpc handler method for MsgWithdrawFeeSponsorPool
+	// WithdrawFeeSponsorPool is called by a fee sponsor to withdraw funds from its fee sponsor pool
+	WithdrawFeeSponsorPool(ctx context.Context, in *MsgWithdrawFeeSponsorPool, opts ...grpc.CallOption) (*MsgWithdrawFeeSponsorPoolResponse, error)
+	// DeleteFeeSponsorPool defines a rpc handler method for MsgDeleteFeeSponsorPool
+	// DeleteFeeSponsorPool is called by a fee sponsor to delete its fee sponsor pool on a channel and withdraw the
+	// remaining pool funds
+	DeleteFeeSponsorPool(ctx context.Context, in *MsgDeleteFeeSponsorPool, opts ...grpc.CallOption) (*MsgDeleteFeeSponsorPoolResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterPayee(ctx context.Context, in *MsgRegisterPayee, opts ...grpc.CallOption) (*MsgRegisterPayeeResponse, error) {
+	out := new(MsgRegisterPayeeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/RegisterPayee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Relayer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RegisterCounterpartyPayee(ctx context.Context, in *MsgRegisterCounterpartyPayee, opts ...grpc.CallOption) (*MsgRegisterCounterpartyPayeeResponse, error) {
+	out := new(MsgRegisterCounterpartyPayeeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/RegisterCounterpartyPayee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.CounterpartyPayee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) PayPacketFee(ctx context.Context, in *MsgPayPacketFee, opts ...grpc.CallOption) (*MsgPayPacketFeeResponse, error) {
+	out := new(MsgPayPacketFeeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/PayPacketFee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgRegisterCounterpartyPayeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) PayPacketFeeAsync(ctx context.Context, in *MsgPayPacketFeeAsync, opts ...grpc.CallOption) (*MsgPayPacketFeeAsyncResponse, error) {
+	out := new(MsgPayPacketFeeAsyncResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/PayPacketFeeAsync", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgPayPacketFee) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RegisterFeePreferences(ctx context.Context, in *MsgRegisterFeePreferences, opts ...grpc.CallOption) (*MsgRegisterFeePreferencesResponse, error) {
+	out := new(MsgRegisterFeePreferencesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/RegisterFeePreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Fee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.SourcePortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateChannelMinimumFee(ctx context.Context, in *MsgUpdateChannelMinimumFee, opts ...grpc.CallOption) (*MsgUpdateChannelMinimumFeeResponse, error) {
+	out := new(MsgUpdateChannelMinimumFeeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/UpdateChannelMinimumFee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.SourceChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RefundPacketFee(ctx context.Context, in *MsgRefundPacketFee, opts ...grpc.CallOption) (*MsgRefundPacketFeeResponse, error) {
+	out := new(MsgRefundPacketFeeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/RefundPacketFee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RefundSponsoredPacketFee(ctx context.Context, in *MsgRefundSponsoredPacketFee, opts ...grpc.CallOption) (*MsgRefundSponsoredPacketFeeResponse, error) {
+	out := new(MsgRefundSponsoredPacketFeeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/RefundSponsoredPacketFee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Relayers) > 0 {
-		for _, s := range m.Relayers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterFeeSponsorPool(ctx context.Context, in *MsgRegisterFeeSponsorPool, opts ...grpc.CallOption) (*MsgRegisterFeeSponsorPoolResponse, error) {
+	out := new(MsgRegisterFeeSponsorPoolResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/RegisterFeeSponsorPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundFeeSponsorPool(ctx context.Context, in *MsgFundFeeSponsorPool, opts ...grpc.CallOption) (*MsgFundFeeSponsorPoolResponse, error) {
+	out := new(MsgFundFeeSponsorPoolResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/FundFeeSponsorPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawFeeSponsorPool(ctx context.Context, in *MsgWithdrawFeeSponsorPool, opts ...grpc.CallOption) (*MsgWithdrawFeeSponsorPoolResponse, error) {
+	out := new(MsgWithdrawFeeSponsorPoolResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/WithdrawFeeSponsorPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteFeeSponsorPool(ctx context.Context, in *MsgDeleteFeeSponsorPool, opts ...grpc.CallOption) (*MsgDeleteFeeSponsorPoolResponse, error) {
+	out := new(MsgDeleteFeeSponsorPoolResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/DeleteFeeSponsorPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// RegisterPayee defines a rpc handler method for MsgRegisterPayee
+	// RegisterPayee is called by the relayer on each channelEnd and allows them to set an optional
+	// payee to which reverse and timeout relayer packet fees will be paid out. The payee should be registered on
+	// the source chain from which packets originate as this is where fee distribution takes place. This function may be
+	// called more than once by a relayer, in which case, the latest payee is always used.
+	RegisterPayee(context.Context, *MsgRegisterPayee) (*MsgRegisterPayeeResponse, error)
+	// RegisterCounterpartyPayee defines a rpc handler method for MsgRegisterCounterpartyPayee
+	// RegisterCounterpartyPayee is called by the relayer on each channelEnd and allows them to specify the counterparty
+	// payee address before relaying. This ensures they will be properly compensated for forward relaying since
+	// the destination chain must include the registered counterparty payee address in the acknowledgement. This function
+	// may be called more than once by a relayer, in which case, the latest counterparty payee address is always used.
+	RegisterCounterpartyPayee(context.Context, *MsgRegisterCounterpartyPayee) (*MsgRegisterCounterpartyPayeeResponse, error)
+	// PayPacketFee defines a rpc handler method for MsgPayPacketFee
+	// PayPacketFee is an open callback that may be called by any module/user that wishes to escrow funds in order to
+	// incentivize the relaying of the packet at the next sequence
+	// NOTE: This method is intended to be used within a multi msg transaction, where the subsequent msg that follows
+	// initiates the lifecycle of the incentivized packet
+	PayPacketFee(context.Context, *MsgPayPacketFee) (*MsgPayPacketFeeResponse, error)
+	// PayPacketFeeAsync defines a rpc handler method for MsgPayPacketFeeAsync
+	// PayPacketFeeAsync is an open callback that may be called by any module/user that wishes to escrow funds in order to
+	// incentivize the relaying of a known packet (i.e. at a particular sequence)
+	PayPacketFeeAsync(context.Context, *MsgPayPacketFeeAsync) (*MsgPayPacketFeeAsyncResponse, error)
+	// RegisterFeePreferences defines a rpc handler method for MsgRegisterFeePreferences
+	// RegisterFeePreferences is called by the address to which packet fees are paid out on a channelEnd, either a relayer
+	// or a registered payee, and allows them to set the fee denominations they accept. Packet fees in other denominations
+	// are sent to the fallback address, if any, or refunded. This function may be called more than once, in which case,
+	// the latest fee preferences are always used.
+	RegisterFeePreferences(context.Context, *MsgRegisterFeePreferences) (*MsgRegisterFeePreferencesResponse, error)
+	// UpdateChannelMinimumFee defines a rpc handler method for MsgUpdateChannelMinimumFee
+	// UpdateChannelMinimumFee is called by the module authority (i.e. governance) and sets the minimum receive,
+	// acknowledgement and timeout fees which must be escrowed for a packet on a fee enabled channel. An empty minimum fee
+	// removes the minimum fee of the channel.
+	UpdateChannelMinimumFee(context.Context, *MsgUpdateChannelMinimumFee) (*MsgUpdateChannelMinimumFeeResponse, error)
+	// RefundPacketFee defines a rpc handler method for MsgRefundPacketFee
+	// RefundPacketFee is called by a payer to withdraw their unspent fees escrowed for a packet
+	// once the refund grace period has elapsed without the packet being relayed
+	RefundPacketFee(context.Context, *MsgRefundPacketFee) (*MsgRefundPacketFeeResponse, error)
+	// RefundSponsoredPacketFee defines a rpc handler method for MsgRefundSponsoredPacketFee
+	// RefundSponsoredPacketFee is called by a fee sponsor to return the unspent fees escrowed for a packet from its
+	// fee sponsor pool to the pool once the refund grace period has elapsed without the packet being relayed
+	RefundSponsoredPacketFee(context.Context, *MsgRefundSponsoredPacketFee) (*MsgRefundSponsoredPacketFeeResponse, error)
+	// UpdateParams defines a rpc handler method for MsgUpdateParams
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterFeeSponsorPool defines a rpc handler method for MsgRegisterFeeSponsorPool
+	// RegisterFeeSponsorPool is called by a fee sponsor to create or update its fee sponsor pool on a channel,
+	// allowing the listed senders to pay packet fees from the pool
+	RegisterFeeSponsorPool(context.Context, *MsgRegisterFeeSponsorPool) (*MsgRegisterFeeSponsorPoolResponse, error)
+	// FundFeeSponsorPool defines a rpc handler method for MsgFundFeeSponsorPool
+	// FundFeeSponsorPool is called by any account, such as a protocol treasury, to top up a fee sponsor pool
+	FundFeeSponsorPool(context.Context, *MsgFundFeeSponsorPool) (*MsgFundFeeSponsorPoolResponse, error)
+	// WithdrawFeeSponsorPool defines a rpc handler method for MsgWithdrawFeeSponsorPool
+	// WithdrawFeeSponsorPool is called by a fee sponsor to withdraw funds from its fee sponsor pool
+	WithdrawFeeSponsorPool(context.Context, *MsgWithdrawFeeSponsorPool) (*MsgWithdrawFeeSponsorPoolResponse, error)
+	// DeleteFeeSponsorPool defines a rpc handler method for MsgDeleteFeeSponsorPool
+	// DeleteFeeSponsorPool is called by a fee sponsor to delete its fee sponsor pool on a channel and withdraw the
+	// remaining pool funds
+	DeleteFeeSponsorPool(context.Context, *MsgDeleteFeeSponsorPool) (*MsgDeleteFeeSponsorPoolResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RegisterPayee(ctx context.Context, req *MsgRegisterPayee) (*MsgRegisterPayeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPayee not implemented")
+}
+func (*UnimplementedMsgServer) RegisterCounterpartyPayee(ctx context.Context, req *MsgRegisterCounterpartyPayee) (*MsgRegisterCounterpartyPayeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCounterpartyPayee not implemented")
+}
+func (*UnimplementedMsgServer) PayPacketFee(ctx context.Context, req *MsgPayPacketFee) (*MsgPayPacketFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayPacketFee not implemented")
+}
+func (*UnimplementedMsgServer) PayPacketFeeAsync(ctx context.Context, req *MsgPayPacketFeeAsync) (*MsgPayPacketFeeAsyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayPacketFeeAsync not implemented")
+}
+func (*UnimplementedMsgServer) RegisterFeePreferences(ctx context.Context, req *MsgRegisterFeePreferences) (*MsgRegisterFeePreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterFeePreferences not implemented")
+}
+func (*UnimplementedMsgServer) UpdateChannelMinimumFee(ctx context.Context, req *MsgUpdateChannelMinimumFee) (*MsgUpdateChannelMinimumFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannelMinimumFee not implemented")
+}
+func (*UnimplementedMsgServer) RefundPacketFee(ctx context.Context, req *MsgRefundPacketFee) (*MsgRefundPacketFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundPacketFee not implemented")
+}
+func (*UnimplementedMsgServer) RefundSponsoredPacketFee(ctx context.Context, req *MsgRefundSponsoredPacketFee) (*MsgRefundSponsoredPacketFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundSponsoredPacketFee not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RegisterFeeSponsorPool(ctx context.Context, req *MsgRegisterFeeSponsorPool) (*MsgRegisterFeeSponsorPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterFeeSponsorPool not implemented")
+}
+func (*UnimplementedMsgServer) FundFeeSponsorPool(ctx context.Context, req *MsgFundFeeSponsorPool) (*MsgFundFeeSponsorPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundFeeSponsorPool not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawFeeSponsorPool(ctx context.Context, req *MsgWithdrawFeeSponsorPool) (*MsgWithdrawFeeSponsorPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFeeSponsorPool not implemented")
+}
+func (*UnimplementedMsgServer) DeleteFeeSponsorPool(ctx context.Context, req *MsgDeleteFeeSponsorPool) (*MsgDeleteFeeSponsorPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeeSponsorPool not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RegisterPayee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterPayee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterPayee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/RegisterPayee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterPayee(ctx, req.(*MsgRegisterPayee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterCounterpartyPayee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterCounterpartyPayee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterCounterpartyPayee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/RegisterCounterpartyPayee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterCounterpartyPayee(ctx, req.(*MsgRegisterCounterpartyPayee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PayPacketFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPayPacketFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PayPacketFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/PayPacketFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PayPacketFee(ctx, req.(*MsgPayPacketFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PayPacketFeeAsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPayPacketFeeAsync)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PayPacketFeeAsync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/PayPacketFeeAsync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PayPacketFeeAsync(ctx, req.(*MsgPayPacketFeeAsync))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterFeePreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterFeePreferences)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterFeePreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/RegisterFeePreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterFeePreferences(ctx, req.(*MsgRegisterFeePreferences))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateChannelMinimumFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateChannelMinimumFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateChannelMinimumFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/UpdateChannelMinimumFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateChannelMinimumFee(ctx, req.(*MsgUpdateChannelMinimumFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundPacketFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundPacketFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundPacketFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/RefundPacketFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundPacketFee(ctx, req.(*MsgRefundPacketFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundSponsoredPacketFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundSponsoredPacketFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundSponsoredPacketFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/RefundSponsoredPacketFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundSponsoredPacketFee(ctx, req.(*MsgRefundSponsoredPacketFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterFeeSponsorPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterFeeSponsorPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterFeeSponsorPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/RegisterFeeSponsorPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterFeeSponsorPool(ctx, req.(*MsgRegisterFeeSponsorPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundFeeSponsorPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundFeeSponsorPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundFeeSponsorPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/FundFeeSponsorPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundFeeSponsorPool(ctx, req.(*MsgFundFeeSponsorPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawFeeSponsorPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFeeSponsorPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFeeSponsorPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/WithdrawFeeSponsorPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFeeSponsorPool(ctx, req.(*MsgWithdrawFeeSponsorPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteFeeSponsorPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteFeeSponsorPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteFeeSponsorPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/DeleteFeeSponsorPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteFeeSponsorPool(ctx, req.(*MsgDeleteFeeSponsorPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.fee.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterPayee",
+			Handler:    _Msg_RegisterPayee_Handler,
+		},
+		{
+			MethodName: "RegisterCounterpartyPayee",
+			Handler:    _Msg_RegisterCounterpartyPayee_Handler,
+		},
+		{
+			MethodName: "PayPacketFee",
+			Handler:    _Msg_PayPacketFee_Handler,
+		},
+		{
+			MethodName: "PayPacketFeeAsync",
+			Handler:    _Msg_PayPacketFeeAsync_Handler,
+		},
+		{
+			MethodName: "RegisterFeePreferences",
+			Handler:    _Msg_RegisterFeePreferences_Handler,
+		},
+		{
+			MethodName: "UpdateChannelMinimumFee",
+			Handler:    _Msg_UpdateChannelMinimumFee_Handler,
+		},
+		{
+			MethodName: "RefundPacketFee",
+			Handler:    _Msg_RefundPacketFee_Handler,
+		},
+		{
+			MethodName: "RefundSponsoredPacketFee",
+			Handler:    _Msg_RefundSponsoredPacketFee_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RegisterFeeSponsorPool",
+			Handler:    _Msg_RegisterFeeSponsorPool_Handler,
+		},
+		{
+			MethodName: "FundFeeSponsorPool",
+			Handler:    _Msg_FundFeeSponsorPool_Handler,
+		},
+		{
+			MethodName: "WithdrawFeeSponsorPool",
+			Handler:    _Msg_WithdrawFeeSponsorPool_Handler,
+		},
+		{
+			MethodName: "DeleteFeeSponsorPool",
+			Handler:    _Msg_DeleteFeeSponsorPool_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/fee/v1/tx.proto",
+}
+
+func (m *MsgRegisterPayee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterPayee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterPayee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payee) > 0 {
+		i -= len(m.Payee)
+		copy(dAtA[i:], m.Payee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterPayeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterPayeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterPayeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCounterpartyPayee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCounterpartyPayee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCounterpartyPayee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CounterpartyPayee) > 0 {
+		i -= len(m.CounterpartyPayee)
+		copy(dAtA[i:], m.CounterpartyPayee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CounterpartyPayee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCounterpartyPayeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCounterpartyPayeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCounterpartyPayeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPayPacketFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayPacketFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayPacketFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeSponsor) > 0 {
+		i -= len(m.FeeSponsor)
+		copy(dAtA[i:], m.FeeSponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeeSponsor)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Relayers[iNdEx])
+			copy(dAtA[i:], m.Relayers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Relayers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceChannelId) > 0 {
+		i -= len(m.SourceChannelId)
+		copy(dAtA[i:], m.SourceChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourcePortId) > 0 {
+		i -= len(m.SourcePortId)
+		copy(dAtA[i:], m.SourcePortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourcePortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPayPacketFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayPacketFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayPacketFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPayPacketFeeAsync) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayPacketFeeAsync) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayPacketFeeAsync) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeSponsor) > 0 {
+		i -= len(m.FeeSponsor)
+		copy(dAtA[i:], m.FeeSponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeeSponsor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.PacketFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPayPacketFeeAsyncResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayPacketFeeAsyncResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayPacketFeeAsyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterFeePreferences) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterFeePreferences) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterFeePreferences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FallbackAddress) > 0 {
+		i -= len(m.FallbackAddress)
+		copy(dAtA[i:], m.FallbackAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FallbackAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AcceptedDenoms) > 0 {
+		for iNdEx := len(m.AcceptedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AcceptedDenoms[iNdEx])
+			copy(dAtA[i:], m.AcceptedDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AcceptedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Payee) > 0 {
+		i -= len(m.Payee)
+		copy(dAtA[i:], m.Payee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterFeePreferencesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterFeePreferencesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterFeePreferencesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateChannelMinimumFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateChannelMinimumFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateChannelMinimumFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MinimumFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateChannelMinimumFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateChannelMinimumFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateChannelMinimumFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundPacketFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundPacketFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundPacketFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundPacketFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundPacketFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundPacketFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundSponsoredPacketFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundSponsoredPacketFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundSponsoredPacketFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundSponsoredPacketFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundSponsoredPacketFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundSponsoredPacketFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterFeeSponsorPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterFeeSponsorPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterFeeSponsorPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterFeeSponsorPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterFeeSponsorPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterFeeSponsorPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundFeeSponsorPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundFeeSponsorPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundFeeSponsorPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundFeeSponsorPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundFeeSponsorPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundFeeSponsorPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFeeSponsorPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFeeSponsorPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFeeSponsorPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFeeSponsorPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFeeSponsorPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFeeSponsorPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteFeeSponsorPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteFeeSponsorPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteFeeSponsorPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteFeeSponsorPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteFeeSponsorPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteFeeSponsorPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterPayee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterPayeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterCounterpartyPayee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CounterpartyPayee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterCounterpartyPayeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPayPacketFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.SourcePortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Relayers) > 0 {
+		for _, s := range m.Relayers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.FeeSponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPayPacketFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPayPacketFeeAsync) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PacketFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.FeeSponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPayPacketFeeAsyncResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterFeePreferences) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AcceptedDenoms) > 0 {
+		for _, s := range m.AcceptedDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.FallbackAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterFeePreferencesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateChannelMinimumFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinimumFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateChannelMinimumFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRefundPacketFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRefundPacketFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRefundSponsoredPacketFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRefundSponsoredPacketFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterFeeSponsorPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterFeeSponsorPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundFeeSponsorPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundFeeSponsorPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawFeeSponsorPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawFeeSponsorPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteFeeSponsorPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteFeeSponsorPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPayee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPayeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayPacketFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayPacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayPacketFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayPacketFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayPacketFeeAsync: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayPacketFeeAsync: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayPacketFeeAsyncResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterFeePreferences) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterFeePreferences: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterFeePreferences: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedDenoms = append(m.AcceptedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterFeePreferencesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterFeePreferencesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterFeePreferencesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateChannelMinimumFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateChannelMinimumFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateChannelMinimumFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimumFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateChannelMinimumFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateChannelMinimumFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateChannelMinimumFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundPacketFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundPacketFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundPacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundPacketFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundPacketFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundPacketFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundSponsoredPacketFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundSponsoredPacketFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundSponsoredPacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundSponsoredPacketFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundSponsoredPacketFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundSponsoredPacketFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *MsgPayPacketFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgPayPacketFeeAsync) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PacketId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.PacketFee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgPayPacketFeeAsyncResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterFeeSponsorPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1113,10 +5222,10 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterPayee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterFeeSponsorPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterFeeSponsorPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1185,7 +5294,7 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1213,13 +5322,13 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1229,23 +5338,25 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payee = string(dAtA[iNdEx:postIndex])
+			m.Allowances = append(m.Allowances, FeeSponsorAllowance{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1268,7 +5379,7 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterFeeSponsorPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1291,10 +5402,10 @@ func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterPayeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterFeeSponsorPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterFeeSponsorPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1318,7 +5429,7 @@ func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
+func (m *MsgFundFeeSponsorPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1341,10 +5452,10 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundFeeSponsorPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundFeeSponsorPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1413,7 +5524,7 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1441,11 +5552,11 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1473,7 +5584,41 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1496,7 +5641,7 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundFeeSponsorPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1519,10 +5664,10 @@ func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundFeeSponsorPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundFeeSponsorPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1546,7 +5691,7 @@ func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawFeeSponsorPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1569,17 +5714,17 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawFeeSponsorPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawFeeSponsorPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1589,28 +5734,27 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourcePortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1638,11 +5782,11 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourcePortId = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1670,11 +5814,11 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceChannelId = string(dAtA[iNdEx:postIndex])
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1702,13 +5846,13 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1718,23 +5862,25 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1757,7 +5903,7 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawFeeSponsorPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1780,10 +5926,10 @@ func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawFeeSponsorPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawFeeSponsorPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1807,7 +5953,7 @@ func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteFeeSponsorPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1830,17 +5976,17 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsync: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteFeeSponsorPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsync: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteFeeSponsorPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1850,30 +5996,29 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1883,24 +6028,87 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1923,7 +6131,7 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFeeAsyncResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteFeeSponsorPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1946,10 +6154,10 @@ func (m *MsgPayPacketFeeAsyncResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteFeeSponsorPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteFeeSponsorPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -159,9 +159,9 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 
 	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
 	err = im.processCallback(sdkCtx, types.CallbackTypeAcknowledgementPacket, callbackData, callbackExecutor)
-	types.EmitCallbackEvent(
+	types.EmitAcknowledgementCallbackEvent(
 		sdkCtx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
-		types.CallbackTypeAcknowledgementPacket, callbackData, err,
+		callbackData, acknowledgement, err,
 	)
 
 	return nil
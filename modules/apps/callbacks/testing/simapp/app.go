@@ -79,6 +79,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	ibccallbacks "github.com/cosmos/ibc-go/modules/apps/callbacks"
+	ibccallbackstypes "github.com/cosmos/ibc-go/modules/apps/callbacks/types"
 	ica "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts"
 	icacontroller "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller"
 	icacontrollerkeeper "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/keeper"
@@ -354,6 +355,7 @@ func NewSimApp(
 		app.IBCKeeper.ChannelKeeper, // may be replaced with IBC middleware
 		app.IBCKeeper.ChannelKeeper,
 		app.AccountKeeper, app.BankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	// ICA Controller keeper
@@ -391,6 +393,10 @@ func NewSimApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// the callbacks middleware owns the callback keys of the transfer memo
+	app.TransferKeeper.RegisterMemoKey(ibccallbackstypes.SourceCallbackKey, ibccallbackstypes.ValidateCallbackMemo)
+	app.TransferKeeper.RegisterMemoKey(ibccallbackstypes.DestinationCallbackKey, ibccallbackstypes.ValidateCallbackMemo)
+
 	// Mock Module Stack
 
 	// Mock Module setup for testing IBC and also acts as the interchain accounts authentication module
@@ -210,3 +210,15 @@ func getCallbackAddress(callbackData map[string]interface{}) string {
 func (c CallbackData) AllowRetry() bool {
 	return c.ExecutionGasLimit < c.CommitGasLimit
 }
+
+// GetStructuredErrorAcknowledgement returns the structured error of an acknowledgement if it is an error
+// acknowledgement created using channeltypes.NewStructuredErrorAcknowledgement. Contract keepers may use it
+// to identify the error for which a packet failed on the counterparty chain.
+func GetStructuredErrorAcknowledgement(acknowledgement []byte) (channeltypes.ErrorAcknowledgement, bool) {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return channeltypes.ErrorAcknowledgement{}, false
+	}
+
+	return ack.StructuredError()
+}
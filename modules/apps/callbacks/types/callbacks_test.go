@@ -1233,3 +1233,48 @@ func (s *CallbacksTypesTestSuite) TestUserDefinedGasLimit() {
 		})
 	}
 }
+
+func (s *CallbacksTypesTestSuite) TestGetStructuredErrorAcknowledgement() {
+	testCases := []struct {
+		name     string
+		ack      []byte
+		expFound bool
+	}{
+		{
+			"success: structured error acknowledgement",
+			channeltypes.NewStructuredErrorAcknowledgement(transfertypes.ErrReceiveDisabled, "receive disabled").Acknowledgement(),
+			true,
+		},
+		{
+			"failure: error acknowledgement",
+			channeltypes.NewErrorAcknowledgement(transfertypes.ErrReceiveDisabled).Acknowledgement(),
+			false,
+		},
+		{
+			"failure: result acknowledgement",
+			channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement(),
+			false,
+		},
+		{
+			"failure: acknowledgement in another format",
+			[]byte("invalid"),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			errorAck, found := types.GetStructuredErrorAcknowledgement(tc.ack)
+			s.Require().Equal(tc.expFound, found)
+
+			if tc.expFound {
+				s.Require().Equal(transfertypes.ModuleName, errorAck.Codespace)
+				s.Require().Equal(transfertypes.ErrReceiveDisabled.ABCICode(), errorAck.Code)
+				s.Require().Equal("receive disabled", errorAck.Reason)
+			} else {
+				s.Require().Equal(channeltypes.ErrorAcknowledgement{}, errorAck)
+			}
+		})
+	}
+}
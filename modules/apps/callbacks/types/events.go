@@ -44,6 +44,15 @@ const (
 	AttributeKeyCallbackSequence = "packet_sequence"
 	// AttributeKeyCallbackBaseApplicationVersion denotes the callback base application version
 	AttributeKeyCallbackBaseApplicationVersion = "callback_base_application_version"
+	// AttributeKeyCallbackAckErrorCodespace denotes the codespace of a structured error acknowledgement
+	// if the acknowledgement is not a structured error acknowledgement, then this key will not be included in the event
+	AttributeKeyCallbackAckErrorCodespace = "callback_ack_error_codespace"
+	// AttributeKeyCallbackAckErrorCode denotes the ABCI code of a structured error acknowledgement
+	// if the acknowledgement is not a structured error acknowledgement, then this key will not be included in the event
+	AttributeKeyCallbackAckErrorCode = "callback_ack_error_code"
+	// AttributeKeyCallbackAckErrorReason denotes the reason of a structured error acknowledgement
+	// if the acknowledgement is not a structured error acknowledgement, then this key will not be included in the event
+	AttributeKeyCallbackAckErrorReason = "callback_ack_error_reason"
 	// AttributeValueCallbackSuccess denotes that the callback is successfully executed
 	AttributeValueCallbackSuccess = "success"
 	// AttributeValueCallbackFailure denotes that the callback has failed to execute
@@ -59,6 +68,43 @@ func EmitCallbackEvent(
 	callbackType CallbackType,
 	callbackData CallbackData,
 	err error,
+) {
+	emitCallbackEvent(ctx, portID, channelID, sequence, callbackType, callbackData, err, nil)
+}
+
+// EmitAcknowledgementCallbackEvent emits an event for an acknowledgement callback. If the acknowledgement
+// is a structured error acknowledgement, its codespace, code and reason are included in the event.
+func EmitAcknowledgementCallbackEvent(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	sequence uint64,
+	callbackData CallbackData,
+	acknowledgement []byte,
+	err error,
+) {
+	var ackAttributes []sdk.Attribute
+	if errorAck, ok := GetStructuredErrorAcknowledgement(acknowledgement); ok {
+		ackAttributes = []sdk.Attribute{
+			sdk.NewAttribute(AttributeKeyCallbackAckErrorCodespace, errorAck.Codespace),
+			sdk.NewAttribute(AttributeKeyCallbackAckErrorCode, fmt.Sprintf("%d", errorAck.Code)),
+			sdk.NewAttribute(AttributeKeyCallbackAckErrorReason, errorAck.Reason),
+		}
+	}
+
+	emitCallbackEvent(ctx, portID, channelID, sequence, CallbackTypeAcknowledgementPacket, callbackData, err, ackAttributes)
+}
+
+// emitCallbackEvent emits an event for a callback including the provided additional attributes
+func emitCallbackEvent(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	sequence uint64,
+	callbackType CallbackType,
+	callbackData CallbackData,
+	err error,
+	additionalAttributes []sdk.Attribute,
 ) {
 	attributes := []sdk.Attribute{
 		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
@@ -79,6 +125,8 @@ func EmitCallbackEvent(
 		)
 	}
 
+	attributes = append(attributes, additionalAttributes...)
+
 	var eventType string
 	switch callbackType {
 	case CallbackTypeReceivePacket:
@@ -241,3 +241,82 @@ func (s *CallbacksTypesTestSuite) TestEvents() {
 		})
 	}
 }
+
+func (s *CallbacksTypesTestSuite) TestAcknowledgementEvents() {
+	packet := channeltypes.NewPacket(
+		ibctesting.MockPacketData, 1, ibctesting.MockPort, ibctesting.FirstChannelID,
+		ibctesting.MockFeePort, ibctesting.InvalidID, clienttypes.NewHeight(1, 100), 0,
+	)
+	callbackData := types.CallbackData{
+		CallbackAddress:    ibctesting.TestAccAddress,
+		ExecutionGasLimit:  100_000,
+		CommitGasLimit:     200_000,
+		ApplicationVersion: transfertypes.V2,
+	}
+
+	newEvent := func(additionalAttributes ...sdk.Attribute) []abci.Event {
+		attributes := []sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyCallbackType, string(types.CallbackTypeAcknowledgementPacket)),
+			sdk.NewAttribute(types.AttributeKeyCallbackAddress, ibctesting.TestAccAddress),
+			sdk.NewAttribute(types.AttributeKeyCallbackGasLimit, "100000"),
+			sdk.NewAttribute(types.AttributeKeyCallbackCommitGasLimit, "200000"),
+			sdk.NewAttribute(types.AttributeKeyCallbackSourcePortID, ibctesting.MockPort),
+			sdk.NewAttribute(types.AttributeKeyCallbackSourceChannelID, ibctesting.FirstChannelID),
+			sdk.NewAttribute(types.AttributeKeyCallbackSequence, "1"),
+			sdk.NewAttribute(types.AttributeKeyCallbackResult, types.AttributeValueCallbackSuccess),
+			sdk.NewAttribute(types.AttributeKeyCallbackBaseApplicationVersion, transfertypes.V2),
+		}
+
+		return sdk.Events{
+			sdk.NewEvent(types.EventTypeSourceCallback, append(attributes, additionalAttributes...)...),
+		}.ToABCIEvents()
+	}
+
+	testCases := []struct {
+		name           string
+		ack            []byte
+		expectedEvents []abci.Event
+	}{
+		{
+			"success: result acknowledgement",
+			channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement(),
+			newEvent(),
+		},
+		{
+			"success: error acknowledgement",
+			channeltypes.NewErrorAcknowledgement(transfertypes.ErrReceiveDisabled).Acknowledgement(),
+			newEvent(),
+		},
+		{
+			"success: structured error acknowledgement",
+			channeltypes.NewStructuredErrorAcknowledgement(transfertypes.ErrReceiveDisabled, "receive disabled").Acknowledgement(),
+			newEvent(
+				sdk.NewAttribute(types.AttributeKeyCallbackAckErrorCodespace, transfertypes.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyCallbackAckErrorCode, "8"),
+				sdk.NewAttribute(types.AttributeKeyCallbackAckErrorReason, "receive disabled"),
+			),
+		},
+		{
+			"success: acknowledgement in another format",
+			ibctesting.MockAcknowledgement,
+			newEvent(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			newCtx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+			types.EmitAcknowledgementCallbackEvent(
+				newCtx, packet.GetSourcePort(), packet.GetSourceChannel(),
+				packet.GetSequence(), callbackData, tc.ack, nil,
+			)
+
+			actualEvents := newCtx.EventManager().Events().ToABCIEvents()
+			ibctesting.AssertEvents(&s.Suite, tc.expectedEvents, actualEvents)
+			// the structured error attributes are only emitted for structured error acknowledgements
+			s.Require().Len(actualEvents[0].Attributes, len(tc.expectedEvents[0].Attributes))
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ValidateCallbackMemo validates the value of the source or destination callback key of a memo.
+// It may be registered as the validator of the callback keys in the transfer keeper.
+//
+// The value is expected to be a json object containing a non-blank callback address, and optionally
+// a user defined gas limit set as a string holding an unsigned integer:
+// { "address": {stringCallbackAddress}, "gas_limit": {stringForCallback} }
+func ValidateCallbackMemo(value json.RawMessage) error {
+	var callbackData map[string]interface{}
+	if err := json.Unmarshal(value, &callbackData); err != nil || callbackData == nil {
+		return errorsmod.Wrap(ErrInvalidCallbackData, "callback data must be a json object")
+	}
+
+	callbackAddress, ok := callbackData[CallbackAddressKey].(string)
+	if !ok || strings.TrimSpace(callbackAddress) == "" {
+		return errorsmod.Wrapf(ErrCallbackAddressNotFound, "callback data must contain a non-blank string under key %s", CallbackAddressKey)
+	}
+
+	gasLimit, found := callbackData[UserDefinedGasLimitKey]
+	if !found {
+		return nil
+	}
+
+	// the gas limit must be specified as a string and not a json number
+	gasLimitStr, ok := gasLimit.(string)
+	if !ok {
+		return errorsmod.Wrapf(ErrInvalidCallbackData, "%s must be a string", UserDefinedGasLimitKey)
+	}
+
+	if _, err := strconv.ParseUint(gasLimitStr, 10, 64); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCallbackData, "%s must be an unsigned integer: %v", UserDefinedGasLimitKey, err)
+	}
+
+	return nil
+}
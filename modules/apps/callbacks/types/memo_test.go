@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+)
+
+func (s *CallbacksTypesTestSuite) TestValidateCallbackMemo() {
+	testCases := []struct {
+		name   string
+		value  string
+		expErr error
+	}{
+		{
+			"success: address only",
+			`{"address": "cosmos1address"}`,
+			nil,
+		},
+		{
+			"success: address and gas limit",
+			`{"address": "cosmos1address", "gas_limit": "100"}`,
+			nil,
+		},
+		{
+			"success: unknown fields are ignored",
+			`{"address": "cosmos1address", "other": 1}`,
+			nil,
+		},
+		{
+			"failure: value is not an object",
+			`"cosmos1address"`,
+			types.ErrInvalidCallbackData,
+		},
+		{
+			"failure: value is null",
+			`null`,
+			types.ErrInvalidCallbackData,
+		},
+		{
+			"failure: address is missing",
+			`{"gas_limit": "100"}`,
+			types.ErrCallbackAddressNotFound,
+		},
+		{
+			"failure: address is blank",
+			`{"address": " "}`,
+			types.ErrCallbackAddressNotFound,
+		},
+		{
+			"failure: address is not a string",
+			`{"address": 10}`,
+			types.ErrCallbackAddressNotFound,
+		},
+		{
+			"failure: gas limit is a json number",
+			`{"address": "cosmos1address", "gas_limit": 100}`,
+			types.ErrInvalidCallbackData,
+		},
+		{
+			"failure: gas limit is not an unsigned integer",
+			`{"address": "cosmos1address", "gas_limit": "-1"}`,
+			types.ErrInvalidCallbackData,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			err := types.ValidateCallbackMemo(json.RawMessage(tc.value))
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
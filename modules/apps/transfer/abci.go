@@ -0,0 +1,12 @@
+package transfer
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/keeper"
+)
+
+// EndBlocker is used to return the tokens of expired claimable transfers to their sender
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.ReturnExpiredClaims(ctx)
+}
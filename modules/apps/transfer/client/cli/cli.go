@@ -22,6 +22,12 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryEscrowAddress(),
 		GetCmdQueryDenomHash(),
 		GetCmdQueryTotalEscrowForDenom(),
+		GetCmdQueryRateLimit(),
+		GetCmdQueryRateLimits(),
+		GetCmdQueryPendingClaim(),
+		GetCmdQueryPendingClaims(),
+		GetCmdQueryForwardedPacket(),
+		GetCmdQueryForwardedPackets(),
 	)
 
 	return queryCmd
@@ -39,6 +45,8 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewTransferTxCmd(),
+		NewMultiTransferTxCmd(),
+		NewClaimTransferTxCmd(),
 	)
 
 	return txCmd
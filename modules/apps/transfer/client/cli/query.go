@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -12,6 +13,8 @@ import (
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
 )
 
+const flagReceiver = "receiver"
+
 // GetCmdQueryDenom defines the command to query a denomination from a given hash or ibc denom.
 func GetCmdQueryDenom() *cobra.Command {
 	cmd := &cobra.Command{
@@ -200,3 +203,244 @@ func GetCmdQueryTotalEscrowForDenom() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryRateLimit defines the command to query the rate limit of a denom on a channel
+func GetCmdQueryRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limit [channel-id] [denom]",
+		Short:   "Query the rate limit of a denom on a channel and its remaining quota",
+		Long:    "Query the rate limit of a denom on a channel and its remaining quota",
+		Example: fmt.Sprintf("%s query ibc-transfer rate-limit channel-0 uosmo", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRateLimitRequest{
+				ChannelId: args[0],
+				Denom:     args[1],
+			}
+
+			res, err := queryClient.RateLimit(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRateLimits defines the command to query all the rate limits
+func GetCmdQueryRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limits",
+		Short:   "Query all the rate limits",
+		Long:    "Query all the rate limits",
+		Example: fmt.Sprintf("%s query ibc-transfer rate-limits", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRateLimitsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.RateLimits(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rate limits")
+
+	return cmd
+}
+
+// GetCmdQueryPendingClaim defines the command to query the pending claim of a claimable transfer
+func GetCmdQueryPendingClaim() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-claim [port-id] [channel-id] [sequence]",
+		Short:   "Query the pending claim of a claimable transfer",
+		Long:    "Query the pending claim of a claimable transfer received on a port and channel in the packet with the given sequence",
+		Example: fmt.Sprintf("%s query ibc-transfer pending-claim transfer channel-0 1", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPendingClaimRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sequence:  sequence,
+			}
+
+			res, err := queryClient.PendingClaim(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryPendingClaims defines the command to query all the pending claims, optionally filtered by receiver
+func GetCmdQueryPendingClaims() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-claims",
+		Short:   "Query all the pending claims",
+		Long:    "Query all the pending claims, optionally filtered by receiver using the {receiver} flag",
+		Example: fmt.Sprintf("%s query ibc-transfer pending-claims --%s cosmos1...", version.AppName, flagReceiver),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			receiver, err := cmd.Flags().GetString(flagReceiver)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPendingClaimsRequest{
+				Receiver:   receiver,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PendingClaims(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagReceiver, "", "Receiver address to filter the pending claims by")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending claims")
+
+	return cmd
+}
+
+// GetCmdQueryForwardedPacket defines the command to query an in-flight forwarded packet
+func GetCmdQueryForwardedPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "forwarded-packet [port-id] [channel-id] [sequence]",
+		Short:   "Query an in-flight forwarded packet",
+		Long:    "Query the in-flight forward of the tokens sent on the next hop on a port and channel in the packet with the given sequence",
+		Example: fmt.Sprintf("%s query ibc-transfer forwarded-packet transfer channel-1 1", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryForwardedPacketRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sequence:  sequence,
+			}
+
+			res, err := queryClient.ForwardedPacket(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryForwardedPackets defines the command to query all the in-flight forwarded packets
+func GetCmdQueryForwardedPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "forwarded-packets",
+		Short:   "Query all the in-flight forwarded packets",
+		Long:    "Query all the in-flight forwarded packets, whose tokens were sent on the next hop and are awaiting an acknowledgement or timeout",
+		Example: fmt.Sprintf("%s query ibc-transfer forwarded-packets", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryForwardedPacketsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ForwardedPackets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "forwarded packets")
+
+	return cmd
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -25,6 +26,10 @@ const (
 	flagMemo                   = "memo"
 	flagForwarding             = "forwarding"
 	flagUnwind                 = "unwind"
+	flagForwardingTimeouts     = "forwarding-timeouts"
+	flagForwardingMaxRetries   = "forwarding-max-retries"
+	flagClaimTimeout           = "claim-timeout"
+	flagIncludeDenomMetadata   = "include-denom-metadata"
 )
 
 // defaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
@@ -46,7 +51,14 @@ using the {packet-timeout-timestamp} flag. If no timeout value is set then a def
 can be automatically unwound to their native chain using the {unwind} flag. Please note that if the {unwind} flag is used, then all coins must
 be IBC vouchers and share exactly the same denomination trace path, and the src-port and src-channel arguments must not be specified. Tokens can also be 
 automatically forwarded through multiple chains using the {fowarding} flag and specifying a comma-separated list of source portID/channelID pairs for 
-each intermediary chain. {unwind} and {forwarding} flags can be used together to first unwind IBC tokens to their native chain and then forward them to the final destination.`),
+each intermediary chain. {unwind} and {forwarding} flags can be used together to first unwind IBC tokens to their native chain and then forward them to the final destination.
+The timeout of the packet sent on each forwarding hop can be set using the {forwarding-timeouts} flag and specifying a comma-separated list of
+timestamps in nanoseconds, one per hop, which are relative to the block time of the forwarding chain unless the {absolute-timeouts} flag is used.
+A timestamp of 0 uses the timeout of the packet received by the forwarding chain. The {forwarding-max-retries} flag sets the number of times a
+packet that timed out on a forwarding hop is sent again before the tokens are refunded, and requires relative timeouts for all hops. The {claim-timeout} flag makes the transfer
+claimable: the tokens are held on the destination chain until the receiver claims them, and are returned to the sender if they are
+not claimed within the claim timeout in nanoseconds. The {include-denom-metadata} flag sends the bank metadata of tokens native to
+this chain along with them, so that the receiving chain can record it for the vouchers.`),
 		Example: fmt.Sprintf("%s tx ibc-transfer transfer [src-port] [src-channel] [receiver] [coins]", version.AppName),
 		Args:    cobra.RangeArgs(2, 4),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -77,59 +89,140 @@ each intermediary chain. {unwind} and {forwarding} flags can be used together to
 				}
 			}
 
-			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+			timeoutHeight, timeoutTimestamp, err := parseTimeouts(cmd)
 			if err != nil {
 				return err
 			}
 
-			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+			memo, err := cmd.Flags().GetString(flagMemo)
 			if err != nil {
 				return err
 			}
 
-			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			forwarding, err := parseForwarding(cmd)
 			if err != nil {
 				return err
 			}
 
-			absoluteTimeouts, err := cmd.Flags().GetBool(flagAbsoluteTimeouts)
+			claimTimeout, err := cmd.Flags().GetUint64(flagClaimTimeout)
 			if err != nil {
 				return err
 			}
 
-			memo, err := cmd.Flags().GetString(flagMemo)
+			includeDenomMetadata, err := cmd.Flags().GetBool(flagIncludeDenomMetadata)
 			if err != nil {
 				return err
 			}
 
-			forwarding, err := parseForwarding(cmd)
+			msg := types.NewMsgTransfer(
+				srcPort, srcChannel, coins, sender, receiver, timeoutHeight, timeoutTimestamp, memo, forwarding,
+			)
+			msg.ClaimTimeout = claimTimeout
+			msg.IncludeDenomMetadata = includeDenomMetadata
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagPacketTimeoutHeight, "0-0", "Packet timeout block height in the format {revision}-{height}. The timeout is disabled when set to 0-0.")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, defaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. The timeout is disabled when set to 0.")
+	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
+	cmd.Flags().String(flagForwarding, "", "Forwarding information in the form of a comma separated list of portID/channelID pairs.")
+	cmd.Flags().Bool(flagUnwind, false, "Flag to indicate if the coin should be unwound to its native chain before forwarding.")
+	cmd.Flags().String(flagForwardingTimeouts, "", "Forwarding timeouts in the form of a comma separated list of timestamps in nanoseconds, one per forwarding hop.")
+	cmd.Flags().Uint64(flagForwardingMaxRetries, 0, "Number of times a packet that timed out on a forwarding hop is sent again before the tokens are refunded.")
+	cmd.Flags().Uint64(flagClaimTimeout, 0, "Time in nanoseconds the receiver has to claim the tokens before they are returned to the sender. The transfer is not claimable when set to 0.")
+	cmd.Flags().Bool(flagIncludeDenomMetadata, false, "Flag to indicate if the bank metadata of native tokens should be sent along with them.")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewClaimTransferTxCmd returns the command to create a MsgClaimTransfer transaction
+func NewClaimTransferTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim [port-id] [channel-id] [sequence]",
+		Short: "Claim the tokens of a claimable transfer",
+		Long: strings.TrimSpace(`Claim the tokens of a claimable transfer received on the given port and channel in the packet with the given sequence.
+The transaction must be signed by the receiver of the transfer before the claim expires.`),
+		Example: fmt.Sprintf("%s tx ibc-transfer claim transfer channel-0 1", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			// NOTE: relative timeouts using block height are not supported.
-			// if the timeouts are not absolute, CLI users rely solely on local clock time in order to calculate relative timestamps.
-			if !absoluteTimeouts {
-				if !timeoutHeight.IsZero() {
-					return errors.New("relative timeouts using block height is not supported")
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimTransfer(clientCtx.GetFromAddress().String(), args[0], args[1], sequence)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMultiTransferTxCmd returns the command to create a MsgMultiTransfer transaction
+func NewMultiTransferTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-transfer [src-port] [src-channel] [receiver=coins]...",
+		Short: "Transfer a fungible token to multiple receivers through IBC",
+		Long: strings.TrimSpace(`Transfer fungible tokens to multiple receivers through IBC in a single packet. Each receiver is specified together with
+the coins to be transferred to it in the form {receiver}={coins}, where coins is a comma-separated string (e.g. cosmos1...=100uatom,100uosmo).
+Multi transfers are only supported on channels with the ics20-2 version. Timeouts are specified as for the {transfer} command using the
+{packet-timeout-height}, {packet-timeout-timestamp} and {absolute-timeouts} flags. The coins of the receivers whose tokens cannot be
+received by the counterparty chain are refunded to the sender.`),
+		Example: fmt.Sprintf("%s tx ibc-transfer multi-transfer [src-port] [src-channel] [receiver=coins] [receiver=coins]", version.AppName),
+		Args:    cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender := clientCtx.GetFromAddress().String()
+
+			outputs := make([]types.MultiTransferOutput, 0, len(args)-2)
+			for _, arg := range args[2:] {
+				receiver, coinsStr, found := strings.Cut(arg, "=")
+				if !found {
+					return fmt.Errorf("expected receiver and coins in the form {receiver}={coins}, got %s", arg)
 				}
 
-				if timeoutTimestamp == 0 {
-					return errors.New("relative timeouts must provide a non zero value timestamp")
+				coins, err := sdk.ParseCoinsNormalized(coinsStr)
+				if err != nil {
+					return err
 				}
 
-				// use local clock time as reference time for calculating timeout timestamp.
-				now := time.Now().UnixNano()
-				if now <= 0 {
-					return errors.New("local clock time is not greater than Jan 1st, 1970 12:00 AM")
+				for i, coin := range coins {
+					if !strings.HasPrefix(coin.Denom, "ibc/") {
+						denom := types.ExtractDenomFromPath(coin.Denom)
+						coins[i].Denom = denom.IBCDenom()
+					}
 				}
 
-				timeoutTimestamp = uint64(now) + timeoutTimestamp
+				outputs = append(outputs, types.NewMultiTransferOutput(receiver, coins))
 			}
 
-			msg := types.NewMsgTransfer(
-				srcPort, srcChannel, coins, sender, receiver, timeoutHeight, timeoutTimestamp, memo, forwarding,
-			)
+			timeoutHeight, timeoutTimestamp, err := parseTimeouts(cmd)
+			if err != nil {
+				return err
+			}
+
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMultiTransfer(args[0], args[1], sender, outputs, timeoutHeight, timeoutTimestamp, memo)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -139,14 +232,58 @@ each intermediary chain. {unwind} and {forwarding} flags can be used together to
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, defaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. The timeout is disabled when set to 0.")
 	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
 	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
-	cmd.Flags().String(flagForwarding, "", "Forwarding information in the form of a comma separated list of portID/channelID pairs.")
-	cmd.Flags().Bool(flagUnwind, false, "Flag to indicate if the coin should be unwound to its native chain before forwarding.")
 
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
+// parseTimeouts parses the packet timeout flags into the timeout height and timestamp of the packet. If the timeouts
+// are not absolute, the timeout timestamp is calculated relative to the local clock time.
+func parseTimeouts(cmd *cobra.Command) (clienttypes.Height, uint64, error) {
+	timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+
+	timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+
+	timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+
+	absoluteTimeouts, err := cmd.Flags().GetBool(flagAbsoluteTimeouts)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+
+	// NOTE: relative timeouts using block height are not supported.
+	// if the timeouts are not absolute, CLI users rely solely on local clock time in order to calculate relative timestamps.
+	if !absoluteTimeouts {
+		if !timeoutHeight.IsZero() {
+			return clienttypes.Height{}, 0, errors.New("relative timeouts using block height is not supported")
+		}
+
+		if timeoutTimestamp == 0 {
+			return clienttypes.Height{}, 0, errors.New("relative timeouts must provide a non zero value timestamp")
+		}
+
+		// use local clock time as reference time for calculating timeout timestamp.
+		now := time.Now().UnixNano()
+		if now <= 0 {
+			return clienttypes.Height{}, 0, errors.New("local clock time is not greater than Jan 1st, 1970 12:00 AM")
+		}
+
+		timeoutTimestamp = uint64(now) + timeoutTimestamp
+	}
+
+	return timeoutHeight, timeoutTimestamp, nil
+}
+
 // parseForwarding parses the forwarding flag into a Forwarding object or nil if the flag is not specified. If the flag cannot
 // be parsed or the hops aren't in the portID/channelID format an error is returned.
 func parseForwarding(cmd *cobra.Command) (*types.Forwarding, error) {
@@ -180,6 +317,41 @@ func parseForwarding(cmd *cobra.Command) (*types.Forwarding, error) {
 	}
 
 	forwarding.Hops = hops
+
+	maxRetries, err := cmd.Flags().GetUint64(flagForwardingMaxRetries)
+	if err != nil {
+		return nil, err
+	}
+	forwarding.MaxRetries = maxRetries
+
+	timeoutsString, err := cmd.Flags().GetString(flagForwardingTimeouts)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(timeoutsString) == "" {
+		return forwarding, nil
+	}
+
+	absoluteTimeouts, err := cmd.Flags().GetBool(flagAbsoluteTimeouts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, timeoutString := range strings.Split(timeoutsString, ",") {
+		timeout, err := strconv.ParseUint(strings.TrimSpace(timeoutString), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid forwarding timeout %s: %w", timeoutString, err)
+		}
+
+		hopTimeout := types.NewRelativeHopTimeout(timeout)
+		if absoluteTimeouts {
+			hopTimeout = types.NewAbsoluteHopTimeout(timeout)
+		}
+
+		forwarding.HopTimeouts = append(forwarding.HopTimeouts, hopTimeout)
+	}
+
 	return forwarding, nil
 }
 
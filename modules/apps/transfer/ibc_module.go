@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"math"
-	"slices"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
@@ -39,7 +38,7 @@ func NewIBCModule(k keeper.Keeper) IBCModule {
 }
 
 // ValidateTransferChannelParams does validation of a newly created transfer channel. A transfer
-// channel must be UNORDERED, use the correct port (by default 'transfer'), and use the current
+// channel must be UNORDERED or ORDERED, use the correct port (by default 'transfer'), and use the current
 // supported version. Only 2^32 channels are allowed to be created.
 func ValidateTransferChannelParams(
 	ctx context.Context,
@@ -57,8 +56,8 @@ func ValidateTransferChannelParams(
 	if channelSequence > uint64(math.MaxUint32) {
 		return errorsmod.Wrapf(types.ErrMaxTransferChannels, "channel sequence %d is greater than max allowed transfer channels %d", channelSequence, uint64(math.MaxUint32))
 	}
-	if order != channeltypes.UNORDERED {
-		return errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s ", channeltypes.UNORDERED, order)
+	if order != channeltypes.UNORDERED && order != channeltypes.ORDERED {
+		return errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s or %s channel, got %s ", channeltypes.UNORDERED, channeltypes.ORDERED, order)
 	}
 
 	// Require portID is the portID transfer module is bound to
@@ -89,7 +88,7 @@ func (im IBCModule) OnChanOpenInit(
 		version = types.V2
 	}
 
-	if !slices.Contains(types.SupportedVersions, version) {
+	if !types.IsSupportedVersion(version) {
 		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, version)
 	}
 
@@ -110,7 +109,7 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	if !slices.Contains(types.SupportedVersions, counterpartyVersion) {
+	if !types.IsSupportedVersion(counterpartyVersion) {
 		im.keeper.Logger(ctx).Debug("invalid counterparty version, proposing latest app version", "counterpartyVersion", counterpartyVersion, "version", types.V2)
 		return types.V2, nil
 	}
@@ -126,7 +125,7 @@ func (IBCModule) OnChanOpenAck(
 	_ string,
 	counterpartyVersion string,
 ) error {
-	if !slices.Contains(types.SupportedVersions, counterpartyVersion) {
+	if !types.IsSupportedVersion(counterpartyVersion) {
 		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: expected one of %s, got %s", types.SupportedVersions, counterpartyVersion)
 	}
 
@@ -186,13 +185,27 @@ func (im IBCModule) OnRecvPacket(
 
 	data, ackErr = types.UnmarshalPacketData(packet.GetData(), channelVersion)
 	if ackErr != nil {
-		ack = channeltypes.NewErrorAcknowledgement(ackErr)
+		ack = newErrorAcknowledgement(channelVersion, ackErr)
 		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), packet.Sequence))
 		return ack
 	}
 
+	if data.IsMultiTransfer() {
+		var result types.MultiTransferAcknowledgement
+		if result, ackErr = im.keeper.OnRecvMultiTransferPacket(ctx, packet, data); ackErr != nil {
+			ack = newErrorAcknowledgement(channelVersion, ackErr)
+			im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), packet.Sequence))
+			return ack
+		}
+
+		ack = channeltypes.NewResultAcknowledgement(result.GetBytes())
+		im.keeper.Logger(ctx).Info("successfully handled ICS-20 multi transfer packet", "sequence", packet.Sequence)
+
+		return ack
+	}
+
 	if ackErr = im.keeper.OnRecvPacket(ctx, packet, data); ackErr != nil {
-		ack = channeltypes.NewErrorAcknowledgement(ackErr)
+		ack = newErrorAcknowledgement(channelVersion, ackErr)
 		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), packet.Sequence))
 		return ack
 	}
@@ -208,6 +221,16 @@ func (im IBCModule) OnRecvPacket(
 	return ack
 }
 
+// newErrorAcknowledgement returns a structured error acknowledgement for the error if their use has been
+// negotiated in the channel version. Otherwise an error acknowledgement containing only the ABCI code is returned.
+func newErrorAcknowledgement(channelVersion string, err error) channeltypes.Acknowledgement {
+	if _, structuredErrors := types.ParseVersion(channelVersion); structuredErrors {
+		return channeltypes.NewStructuredErrorAcknowledgement(err, channeltypes.ErrorReason(err))
+	}
+
+	return channeltypes.NewErrorAcknowledgement(err)
+}
+
 // OnAcknowledgementPacket implements the IBCModule interface
 func (im IBCModule) OnAcknowledgementPacket(
 	ctx context.Context,
@@ -262,7 +285,7 @@ func (im IBCModule) OnChanUpgradeInit(ctx context.Context, portID, channelID str
 		return "", err
 	}
 
-	if !slices.Contains(types.SupportedVersions, proposedVersion) {
+	if !types.IsSupportedVersion(proposedVersion) {
 		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: expected one of %s, got %s", types.SupportedVersions, proposedVersion)
 	}
 
@@ -275,7 +298,7 @@ func (im IBCModule) OnChanUpgradeTry(ctx context.Context, portID, channelID stri
 		return "", err
 	}
 
-	if !slices.Contains(types.SupportedVersions, counterpartyVersion) {
+	if !types.IsSupportedVersion(counterpartyVersion) {
 		im.keeper.Logger(ctx).Debug("invalid counterparty version, proposing latest app version", "counterpartyVersion", counterpartyVersion, "version", types.V2)
 		return types.V2, nil
 	}
@@ -285,7 +308,7 @@ func (im IBCModule) OnChanUpgradeTry(ctx context.Context, portID, channelID stri
 
 // OnChanUpgradeAck implements the IBCModule interface
 func (IBCModule) OnChanUpgradeAck(ctx context.Context, portID, channelID, counterpartyVersion string) error {
-	if !slices.Contains(types.SupportedVersions, counterpartyVersion) {
+	if !types.IsSupportedVersion(counterpartyVersion) {
 		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: expected one of %s, got %s", types.SupportedVersions, counterpartyVersion)
 	}
 
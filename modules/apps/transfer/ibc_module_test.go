@@ -54,14 +54,29 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 				channel.Version = types.V1
 			}, nil, types.V1,
 		},
+		{
+			"success: ics20-2 with structured errors", func() {
+				channel.Version = channeltypes.NewStructuredErrorsVersion(types.V2)
+			}, nil, channeltypes.NewStructuredErrorsVersion(types.V2),
+		},
+		{
+			"invalid version with structured errors", func() {
+				channel.Version = channeltypes.NewStructuredErrorsVersion("version")
+			}, types.ErrInvalidVersion, "",
+		},
 		{
 			"max channels reached", func() {
 				path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(math.MaxUint32 + 1)
 			}, types.ErrMaxTransferChannels, "",
 		},
 		{
-			"invalid order - ORDERED", func() {
+			"success: ORDERED channel", func() {
 				channel.Ordering = channeltypes.ORDERED
+			}, nil, types.V2,
+		},
+		{
+			"invalid order - NONE", func() {
+				channel.Ordering = channeltypes.NONE
 			}, channeltypes.ErrInvalidChannelOrdering, "",
 		},
 		{
@@ -135,6 +150,11 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 				counterpartyVersion = types.V1
 			}, nil, types.V1,
 		},
+		{
+			"success: counterparty version uses structured errors", func() {
+				counterpartyVersion = channeltypes.NewStructuredErrorsVersion(types.V1)
+			}, nil, channeltypes.NewStructuredErrorsVersion(types.V1),
+		},
 		{
 			"success: invalid counterparty version, we propose new version", func() {
 				// transfer module will propose the default version
@@ -147,8 +167,13 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 			}, types.ErrMaxTransferChannels, "",
 		},
 		{
-			"failure: invalid order - ORDERED", func() {
+			"success: ORDERED channel", func() {
 				channel.Ordering = channeltypes.ORDERED
+			}, nil, types.V2,
+		},
+		{
+			"failure: invalid order - NONE", func() {
+				channel.Ordering = channeltypes.NONE
 			}, channeltypes.ErrInvalidChannelOrdering, "",
 		},
 		{
@@ -209,6 +234,13 @@ func (suite *TransferTestSuite) TestOnChanOpenAck() {
 		{
 			"success", func() {}, nil,
 		},
+		{
+			"success: counterparty version uses structured errors",
+			func() {
+				counterpartyVersion = channeltypes.NewStructuredErrorsVersion(types.V2)
+			},
+			nil,
+		},
 		{
 			"invalid counterparty version",
 			func() {
@@ -319,6 +351,17 @@ func (suite *TransferTestSuite) TestOnRecvPacket() {
 			channeltypes.NewErrorAcknowledgement(types.ErrReceiveDisabled),
 			"fungible token transfers to this chain are disabled",
 		},
+		{
+			"failure: receive disabled with structured errors",
+			func() {
+				path.EndpointB.UpdateChannel(func(channel *channeltypes.Channel) {
+					channel.Version = channeltypes.NewStructuredErrorsVersion(types.V2)
+				})
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.Params{ReceiveEnabled: false})
+			},
+			channeltypes.NewStructuredErrorAcknowledgement(types.ErrReceiveDisabled, types.ErrReceiveDisabled.Error()),
+			"fungible token transfers to this chain are disabled",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -509,12 +552,12 @@ func (suite *TransferTestSuite) TestOnChanUpgradeInit() {
 			connectiontypes.ErrConnectionNotFound,
 		},
 		{
-			"invalid upgrade ordering",
+			"success: upgrade ordering to ORDERED",
 			func() {
 				path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Ordering = channeltypes.ORDERED
 				path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Ordering = channeltypes.ORDERED
 			},
-			channeltypes.ErrInvalidChannelOrdering,
+			nil,
 		},
 		{
 			"invalid upgrade version",
@@ -582,10 +625,17 @@ func (suite *TransferTestSuite) TestOnChanUpgradeTry() {
 			nil,
 		},
 		{
-			"invalid upgrade ordering",
+			"success: upgrade ordering to ORDERED",
 			func() {
 				counterpartyUpgrade.Fields.Ordering = channeltypes.ORDERED
 			},
+			nil,
+		},
+		{
+			"invalid upgrade ordering",
+			func() {
+				counterpartyUpgrade.Fields.Ordering = channeltypes.NONE
+			},
 			channeltypes.ErrInvalidChannelOrdering,
 		},
 	}
@@ -699,6 +749,55 @@ func (suite *TransferTestSuite) TestOnChanUpgradeAck() {
 	}
 }
 
+func (suite *TransferTestSuite) TestChannelUpgradeToOrdered() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Ordering = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Ordering = channeltypes.ORDERED
+
+	err := path.EndpointA.ChanUpgradeInit()
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.ChanUpgradeTry()
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.ChanUpgradeAck()
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.ChanUpgradeConfirm()
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.ChanUpgradeOpen()
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(channeltypes.ORDERED, path.EndpointA.GetChannel().Ordering)
+	suite.Require().Equal(channeltypes.ORDERED, path.EndpointB.GetChannel().Ordering)
+
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+
+	// tokens are transferred on the upgraded channel
+	msg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		sdk.NewCoins(ibctesting.TestCoin), suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "", nil,
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	voucherDenom := types.NewDenom(ibctesting.TestCoin.Denom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucherDenom.IBCDenom())
+	suite.Require().Equal(ibctesting.TestCoin.Amount, balance.Amount)
+}
+
 func (suite *TransferTestSuite) TestPacketDataUnmarshalerInterface() {
 	var (
 		sender   = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
@@ -95,10 +95,22 @@ func EmitOnAcknowledgementPacketEvent(ctx context.Context, packetData types.Fung
 			),
 		)
 	case *channeltypes.Acknowledgement_Error:
+		attributes := []sdk.Attribute{sdk.NewAttribute(types.AttributeKeyAckError, resp.Error)}
+
+		// the fields of structured error acknowledgements are emitted so that the error can be identified
+		if errorAck, ok := ack.StructuredError(); ok {
+			attributes = append(
+				attributes,
+				sdk.NewAttribute(types.AttributeKeyAckCodespace, errorAck.Codespace),
+				sdk.NewAttribute(types.AttributeKeyAckCode, strconv.FormatUint(uint64(errorAck.Code), 10)),
+				sdk.NewAttribute(types.AttributeKeyAckReason, errorAck.Reason),
+			)
+		}
+
 		sdkCtx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypePacket,
-				sdk.NewAttribute(types.AttributeKeyAckError, resp.Error),
+				attributes...,
 			),
 		)
 	}
@@ -139,6 +151,61 @@ func EmitDenomEvent(ctx context.Context, token types.Token) {
 	)
 }
 
+// EmitDenomMetadataEvent emits a denomination metadata event when the token metadata of an IBC voucher denomination is recorded.
+func EmitDenomMetadataEvent(ctx context.Context, denom string, metadata types.TokenMetadata) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	metadataStr := mustMarshalJSON(&metadata)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDenomMetadata,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyTokenMetadata, metadataStr),
+		),
+	)
+}
+
+// EmitClaimEvent emits a claim event when the receiver claims the tokens of a pending claim.
+func EmitClaimEvent(ctx context.Context, claim types.PendingClaim) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	tokensStr := mustMarshalJSON(claim.Tokens)
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaim,
+			sdk.NewAttribute(types.AttributeKeySender, claim.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, claim.Receiver),
+			sdk.NewAttribute(types.AttributeKeyTokens, tokensStr),
+			sdk.NewAttribute(types.AttributeKeyPortID, claim.PacketId.PortId),
+			sdk.NewAttribute(types.AttributeKeyChannelID, claim.PacketId.ChannelId),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(claim.PacketId.Sequence, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitReturnClaimEvent emits a return claim event when the tokens of an expired claim are sent back to the sender.
+func EmitReturnClaimEvent(ctx context.Context, claim types.PendingClaim, returnSequence uint64) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	tokensStr := mustMarshalJSON(claim.Tokens)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReturnClaim,
+			sdk.NewAttribute(types.AttributeKeySender, claim.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, claim.Receiver),
+			sdk.NewAttribute(types.AttributeKeyTokens, tokensStr),
+			sdk.NewAttribute(types.AttributeKeyPortID, claim.PacketId.PortId),
+			sdk.NewAttribute(types.AttributeKeyChannelID, claim.PacketId.ChannelId),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(claim.PacketId.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyReturnSequence, strconv.FormatUint(returnSequence, 10)),
+		),
+	)
+}
+
 // mustMarshalJSON json marshals the given type and panics on failure.
 func mustMarshalJSON(v any) string {
 	bz, err := json.Marshal(v)
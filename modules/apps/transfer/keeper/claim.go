@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/internal/events"
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// holdClaimableTransfer stores the tokens of a claimable transfer, which have been received
+// by the module account, as a pending claim of the receiver that expires after the claim timeout.
+func (k Keeper) holdClaimableTransfer(ctx context.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2, receivedCoins sdk.Coins) {
+	blockTime := uint64(sdk.UnwrapSDKContext(ctx).BlockTime().UnixNano())
+
+	claim := types.NewPendingClaim(
+		channeltypes.NewPacketID(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()),
+		data.Sender, data.Receiver, receivedCoins, data.ClaimTimeout, blockTime+data.ClaimTimeout,
+	)
+
+	k.setPendingClaim(ctx, claim)
+}
+
+// claimTransfer sends the tokens of the pending claim to the receiver, provided the claim has not expired.
+func (k Keeper) claimTransfer(ctx context.Context, receiver sdk.AccAddress, portID, channelID string, sequence uint64) (types.PendingClaim, error) {
+	claim, found := k.GetPendingClaim(ctx, portID, channelID, sequence)
+	if !found {
+		return types.PendingClaim{}, errorsmod.Wrapf(types.ErrClaimNotFound, "port ID (%s) channel ID (%s) sequence (%d)", portID, channelID, sequence)
+	}
+
+	claimReceiver, err := sdk.AccAddressFromBech32(claim.Receiver)
+	if err != nil {
+		return types.PendingClaim{}, errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "failed to decode claim receiver address %s: %v", claim.Receiver, err)
+	}
+
+	if !claimReceiver.Equals(receiver) {
+		return types.PendingClaim{}, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", claim.Receiver, receiver)
+	}
+
+	blockTime := uint64(sdk.UnwrapSDKContext(ctx).BlockTime().UnixNano())
+	if claim.IsExpired(blockTime) {
+		return types.PendingClaim{}, errorsmod.Wrapf(types.ErrClaimExpired, "claim deadline (%d) has passed at block time (%d)", claim.Deadline, blockTime)
+	}
+
+	moduleAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
+	if err := k.bankKeeper.SendCoins(ctx, moduleAddr, receiver, claim.Tokens); err != nil {
+		return types.PendingClaim{}, errorsmod.Wrapf(err, "failed to send coins to receiver %s", receiver)
+	}
+
+	k.deletePendingClaim(ctx, claim)
+
+	events.EmitClaimEvent(ctx, claim)
+
+	return claim, nil
+}
+
+// ReturnExpiredClaims returns the tokens of the pending claims whose deadline has passed to their
+// sender, on the channel they were received on. At most MaxExpiredClaimsPerBlock claims are returned
+// per call. If the tokens cannot be returned, the claim is marked as failed: it is no longer returned
+// and the tokens are held until the receiver claims them.
+func (k Keeper) ReturnExpiredClaims(ctx context.Context) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := uint64(sdkCtx.BlockTime().UnixNano())
+
+	var expiredClaims []types.PendingClaim
+	k.iterateExpiredClaims(ctx, blockTime, func(claim types.PendingClaim) bool {
+		expiredClaims = append(expiredClaims, claim)
+		return len(expiredClaims) >= types.MaxExpiredClaimsPerBlock
+	})
+
+	for _, claim := range expiredClaims {
+		k.deletePendingClaim(ctx, claim)
+
+		// the return packet is sent in a cached context, so that no state changes
+		// are persisted if it fails and the claim must be marked as failed instead
+		cacheCtx, writeFn := sdkCtx.CacheContext()
+		sequence, err := k.returnClaim(cacheCtx, claim)
+		if err != nil {
+			k.Logger(ctx).Error("failed to return expired claim", "port-id", claim.PacketId.PortId, "channel-id", claim.PacketId.ChannelId, "sequence", claim.PacketId.Sequence, "error", err.Error())
+
+			claim.ReturnFailed = true
+			k.setPendingClaim(ctx, claim)
+			continue
+		}
+
+		writeFn()
+
+		returnPacketID := channeltypes.NewPacketID(claim.PacketId.PortId, claim.PacketId.ChannelId, sequence)
+		k.setReturnedClaim(ctx, types.NewReturnedClaim(returnPacketID, claim))
+
+		events.EmitReturnClaimEvent(ctx, claim, sequence)
+	}
+}
+
+// returnClaim sends the tokens of the claim held by the module account back to the sender
+// on the channel they were received on, and returns the sequence of the packet sent.
+func (k Keeper) returnClaim(ctx sdk.Context, claim types.PendingClaim) (uint64, error) {
+	moduleAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(types.ClaimReturnTimeout).UnixNano())
+
+	return k.sendTransfer(
+		ctx, claim.PacketId.PortId, claim.PacketId.ChannelId, claim.Tokens, moduleAddr, claim.Sender,
+		clienttypes.ZeroHeight(), timeoutTimestamp, "", nil, 0, false,
+	)
+}
+
+// restoreReturnedClaim makes the claim whose tokens were returned in the given packet pending
+// again, after the tokens have been refunded to the module account because the packet failed.
+// The receiver may then claim the tokens until the claim expires again.
+func (k Keeper) restoreReturnedClaim(ctx context.Context, packet channeltypes.Packet) {
+	returnedClaim, found := k.getReturnedClaim(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return
+	}
+
+	k.deleteReturnedClaim(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	claim := returnedClaim.Claim
+	claim.Deadline = uint64(sdk.UnwrapSDKContext(ctx).BlockTime().UnixNano()) + claim.ClaimTimeout
+	k.setPendingClaim(ctx, claim)
+}
@@ -0,0 +1,292 @@
+package keeper_test
+
+import (
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+var claimTimeout = time.Hour
+
+// sendClaimableTransfer sends a claimable transfer of the default amount of the bond denom
+// from chainA to chainB and relays it, returning the packet received by chainB.
+func (suite *KeeperTestSuite) sendClaimableTransfer(path *ibctesting.Path) channeltypes.Packet {
+	msg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, defaultAmount)),
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainB.GetTimeoutHeight(), 0,
+		"",
+		nil,
+	)
+	msg.ClaimTimeout = uint64(claimTimeout.Nanoseconds())
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketClaimable() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	packet := suite.sendClaimableTransfer(path)
+
+	voucherDenom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)).IBCDenom()
+
+	// the tokens are held by the module account instead of the receiver
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucherDenom)
+	suite.Require().True(balance.IsZero())
+
+	moduleAddr := suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName)
+	balance = suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), moduleAddr, voucherDenom)
+	suite.Require().Equal(defaultAmount, balance.Amount)
+
+	claim, found := suite.chainB.GetSimApp().TransferKeeper.GetPendingClaim(suite.chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.chainA.SenderAccount.GetAddress().String(), claim.Sender)
+	suite.Require().Equal(suite.chainB.SenderAccount.GetAddress().String(), claim.Receiver)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(voucherDenom, defaultAmount)), claim.Tokens)
+	suite.Require().Equal(uint64(claimTimeout.Nanoseconds()), claim.ClaimTimeout)
+}
+
+func (suite *KeeperTestSuite) TestClaimTransfer() {
+	var (
+		path   *ibctesting.Path
+		packet channeltypes.Packet
+		msg    *types.MsgClaimTransfer
+		ctx    sdk.Context
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: claim whose return failed does not expire",
+			func() {
+				err := path.EndpointB.SetChannelState(channeltypes.CLOSED)
+				suite.Require().NoError(err)
+
+				ctx = ctx.WithBlockTime(ctx.BlockTime().Add(claimTimeout))
+				suite.chainB.GetSimApp().TransferKeeper.ReturnExpiredClaims(ctx)
+
+				ctx = ctx.WithBlockTime(ctx.BlockTime().Add(claimTimeout))
+			},
+			nil,
+		},
+		{
+			"failure: claim not found",
+			func() {
+				msg.Sequence++
+			},
+			types.ErrClaimNotFound,
+		},
+		{
+			"failure: signer is not the receiver",
+			func() {
+				msg.Receiver = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: claim has expired",
+			func() {
+				ctx = ctx.WithBlockTime(ctx.BlockTime().Add(claimTimeout))
+			},
+			types.ErrClaimExpired,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			packet = suite.sendClaimableTransfer(path)
+
+			receiver := suite.chainB.SenderAccount.GetAddress()
+			msg = types.NewMsgClaimTransfer(receiver.String(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+			ctx = suite.chainB.GetContext()
+
+			tc.malleate()
+
+			_, err := suite.chainB.GetSimApp().TransferKeeper.ClaimTransfer(ctx, msg)
+
+			voucherDenom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)).IBCDenom()
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, voucherDenom)
+			_, found := suite.chainB.GetSimApp().TransferKeeper.GetPendingClaim(suite.chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(defaultAmount, balance.Amount)
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().True(balance.IsZero())
+				suite.Require().True(found)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestReturnExpiredClaims() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expSuccess bool
+	}{
+		{
+			"success: tokens are returned to the sender",
+			func() {},
+			true,
+		},
+		{
+			"failure: claim is restored when the return packet fails",
+			func() {
+				params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+				params.ReceiveEnabled = false
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+			packet := suite.sendClaimableTransfer(path)
+
+			// the claim is not returned before it expires
+			suite.chainB.GetSimApp().TransferKeeper.ReturnExpiredClaims(suite.chainB.GetContext())
+			_, found := suite.chainB.GetSimApp().TransferKeeper.GetPendingClaim(suite.chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+			suite.Require().True(found)
+
+			suite.coordinator.IncrementTimeBy(claimTimeout)
+
+			ctx := suite.chainB.GetContext()
+			suite.chainB.GetSimApp().TransferKeeper.ReturnExpiredClaims(ctx)
+
+			_, found = suite.chainB.GetSimApp().TransferKeeper.GetPendingClaim(suite.chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+			suite.Require().False(found)
+
+			returnPacket, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().ABCIEvents())
+			suite.Require().NoError(err)
+
+			returnedClaim, found := suite.chainB.GetSimApp().TransferKeeper.GetReturnedClaim(suite.chainB.GetContext(), returnPacket.SourcePort, returnPacket.SourceChannel, returnPacket.Sequence)
+			suite.Require().True(found)
+			suite.Require().Equal(packet.Sequence, returnedClaim.Claim.PacketId.Sequence)
+
+			tc.malleate()
+
+			suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
+
+			err = path.RelayPacket(returnPacket)
+			suite.Require().NoError(err)
+
+			_, found = suite.chainB.GetSimApp().TransferKeeper.GetReturnedClaim(suite.chainB.GetContext(), returnPacket.SourcePort, returnPacket.SourceChannel, returnPacket.Sequence)
+			suite.Require().False(found)
+
+			claim, found := suite.chainB.GetSimApp().TransferKeeper.GetPendingClaim(suite.chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+			balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+			if tc.expSuccess {
+				suite.Require().False(found)
+				suite.Require().Equal(senderBalance, balance)
+			} else {
+				suite.Require().True(found)
+				suite.Require().False(claim.IsExpired(uint64(suite.chainB.GetContext().BlockTime().UnixNano())))
+				suite.Require().Equal(senderBalance.SubAmount(defaultAmount), balance)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestReturnExpiredClaimsFailure() {
+	suite.SetupTest() // reset
+
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	// the return packets cannot be sent on a closed channel
+	err := path.EndpointB.SetChannelState(channeltypes.CLOSED)
+	suite.Require().NoError(err)
+
+	ctx := suite.chainB.GetContext()
+	blockTime := uint64(ctx.BlockTime().UnixNano())
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, defaultAmount))
+
+	numClaims := types.MaxExpiredClaimsPerBlock + 1
+	for i := 1; i <= numClaims; i++ {
+		packetID := channeltypes.NewPacketID(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, uint64(i))
+		claim := types.NewPendingClaim(
+			packetID, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+			coins, uint64(claimTimeout.Nanoseconds()), blockTime+uint64(i),
+		)
+		suite.chainB.GetSimApp().TransferKeeper.SetPendingClaim(ctx, claim)
+	}
+
+	countFailed := func() int {
+		var failed int
+		for i := 1; i <= numClaims; i++ {
+			claim, found := suite.chainB.GetSimApp().TransferKeeper.GetPendingClaim(ctx, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, uint64(i))
+			suite.Require().True(found)
+
+			if claim.ReturnFailed {
+				failed++
+				suite.Require().False(claim.IsExpired(math.MaxUint64))
+			}
+		}
+		return failed
+	}
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+
+	// only the maximum number of claims is processed in a block
+	suite.chainB.GetSimApp().TransferKeeper.ReturnExpiredClaims(ctx)
+	suite.Require().Equal(types.MaxExpiredClaimsPerBlock, countFailed())
+
+	// the remaining claim is processed in the next block
+	suite.chainB.GetSimApp().TransferKeeper.ReturnExpiredClaims(ctx)
+	suite.Require().Equal(numClaims, countFailed())
+
+	// claims whose return failed are not attempted again
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(claimTimeout * 2))
+	suite.chainB.GetSimApp().TransferKeeper.ReturnExpiredClaims(ctx)
+	suite.Require().Equal(numClaims, countFailed())
+}
@@ -54,6 +54,31 @@ func (k Keeper) GetAllForwardedPackets(ctx sdk.Context) []types.ForwardedPacket
 	return k.getAllForwardedPackets(ctx)
 }
 
+// SetForwardedPacketRetries is a wrapper around setForwardedPacketRetries for testing purposes.
+func (k Keeper) SetForwardedPacketRetries(ctx sdk.Context, portID, channelID string, sequence, retries uint64) {
+	k.setForwardedPacketRetries(ctx, portID, channelID, sequence, retries)
+}
+
+// SetForwardedPacketTime is a wrapper around setForwardedPacketTime for testing purposes.
+func (k Keeper) SetForwardedPacketTime(ctx sdk.Context, portID, channelID string, sequence, forwardedAt uint64) {
+	k.setForwardedPacketTime(ctx, portID, channelID, sequence, forwardedAt)
+}
+
+// GetForwardedPacketRetries is a wrapper around getForwardedPacketRetries for testing purposes.
+func (k Keeper) GetForwardedPacketRetries(ctx sdk.Context, portID, channelID string, sequence uint64) uint64 {
+	return k.getForwardedPacketRetries(ctx, portID, channelID, sequence)
+}
+
+// StoreRateLimit is a wrapper around setRateLimit for testing purposes.
+func (k Keeper) StoreRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	k.setRateLimit(ctx, rateLimit)
+}
+
+// GetPendingRateLimitedSend is a wrapper around getPendingRateLimitedSend for testing purposes.
+func (k Keeper) GetPendingRateLimitedSend(ctx sdk.Context, channelID string, sequence uint64, denom string) (types.PendingRateLimitedSend, bool) {
+	return k.getPendingRateLimitedSend(ctx, channelID, sequence, denom)
+}
+
 // IsBlockedAddr is a wrapper around isBlockedAddr for testing purposes
 func (k Keeper) IsBlockedAddr(addr sdk.AccAddress) bool {
 	return k.isBlockedAddr(addr)
@@ -61,5 +86,15 @@ func (k Keeper) IsBlockedAddr(addr sdk.AccAddress) bool {
 
 // CreatePacketDataBytesFromVersion is a wrapper around createPacketDataBytesFromVersion for testing purposes
 func CreatePacketDataBytesFromVersion(appVersion, sender, receiver, memo string, tokens types.Tokens, hops []types.Hop) ([]byte, error) {
-	return createPacketDataBytesFromVersion(appVersion, sender, receiver, memo, tokens, hops)
+	return createPacketDataBytesFromVersion(appVersion, sender, receiver, memo, tokens, types.NewForwarding(false, hops...), 0)
+}
+
+// GetReturnedClaim is a wrapper around getReturnedClaim for testing purposes.
+func (k Keeper) GetReturnedClaim(ctx sdk.Context, portID, channelID string, sequence uint64) (types.ReturnedClaim, bool) {
+	return k.getReturnedClaim(ctx, portID, channelID, sequence)
+}
+
+// SetPendingClaim is a wrapper around setPendingClaim for testing purposes.
+func (k Keeper) SetPendingClaim(ctx sdk.Context, claim types.PendingClaim) {
+	k.setPendingClaim(ctx, claim)
 }
@@ -14,11 +14,22 @@ import (
 )
 
 // forwardPacket forwards a fungible FungibleTokenPacketDataV2 to the next hop in the forwarding path.
-func (k Keeper) forwardPacket(ctx context.Context, data types.FungibleTokenPacketDataV2, packet channeltypes.Packet, receivedCoins sdk.Coins) error {
+// The timeout of the packet sent on the next hop is taken from the hop timeouts of the forwarding
+// packet data, or from the received packet if none is set. It returns the sequence of the packet sent.
+func (k Keeper) forwardPacket(ctx context.Context, data types.FungibleTokenPacketDataV2, packet channeltypes.Packet, receivedCoins sdk.Coins) (uint64, error) {
 	var nextForwardingPath *types.Forwarding
 	if len(data.Forwarding.Hops) > 1 {
 		// remove the first hop since we are going to send to the first hop now and we want to propagate the rest of the hops to the receiver
-		nextForwardingPath = types.NewForwarding(false, data.Forwarding.Hops[1:]...)
+		nextForwardingPath = types.NewForwarding(false, data.Forwarding.Hops[1:]...).WithMaxRetries(data.Forwarding.MaxRetries)
+		if len(data.Forwarding.HopTimeouts) > 1 {
+			nextForwardingPath.HopTimeouts = data.Forwarding.HopTimeouts[1:]
+		}
+	}
+
+	timeoutTimestamp := packet.TimeoutTimestamp
+	if len(data.Forwarding.HopTimeouts) > 0 {
+		blockTime := uint64(sdk.UnwrapSDKContext(ctx).BlockTime().UnixNano())
+		timeoutTimestamp = data.Forwarding.HopTimeouts[0].TimeoutTimestampAt(blockTime, packet.TimeoutTimestamp)
 	}
 
 	// sending from module account (used as a temporary forward escrow) to the original receiver address.
@@ -31,18 +42,74 @@ func (k Keeper) forwardPacket(ctx context.Context, data types.FungibleTokenPacke
 		sender.String(),
 		data.Receiver,
 		clienttypes.ZeroHeight(),
-		packet.TimeoutTimestamp,
+		timeoutTimestamp,
 		data.Forwarding.DestinationMemo,
 		nextForwardingPath,
 	)
+	// the tokens are held for the receiver on the final destination of a claimable transfer
+	msg.ClaimTimeout = data.ClaimTimeout
 
 	resp, err := k.Transfer(ctx, msg)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	k.setForwardedPacket(ctx, data.Forwarding.Hops[0].PortId, data.Forwarding.Hops[0].ChannelId, resp.Sequence, packet)
-	return nil
+	k.setForwardedPacketTime(ctx, data.Forwarding.Hops[0].PortId, data.Forwarding.Hops[0].ChannelId, resp.Sequence, uint64(sdk.UnwrapSDKContext(ctx).BlockTime().UnixNano()))
+	return resp.Sequence, nil
+}
+
+// retryForwardedPacket sends the tokens of a packet that timed out on the next hop of a forwarding path
+// again, provided the maximum number of retries set in the forwarding packet data of forwardedPacket has
+// not been reached. The tokens must have already been refunded to the module account. It returns true if
+// the packet was sent again, in which case the forwarded packet must not be reverted.
+func (k Keeper) retryForwardedPacket(ctx context.Context, forwardedPacket, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) bool {
+	// a timeout closes an ORDERED channel, in which case the tokens cannot be sent again on the same hop
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.SourcePort, packet.SourceChannel)
+	if !found || channel.State != channeltypes.OPEN {
+		return false
+	}
+
+	appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, forwardedPacket.DestinationPort, forwardedPacket.DestinationChannel)
+	if !found {
+		return false
+	}
+
+	forwardedData, err := types.UnmarshalPacketData(forwardedPacket.GetData(), appVersion)
+	if err != nil {
+		return false
+	}
+
+	retries := k.getForwardedPacketRetries(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if retries >= forwardedData.Forwarding.MaxRetries {
+		return false
+	}
+
+	coins := make(sdk.Coins, 0, len(data.Tokens))
+	for _, token := range data.Tokens {
+		coin, err := token.ToCoin()
+		if err != nil {
+			return false
+		}
+
+		coins = append(coins, coin)
+	}
+
+	// the packet is sent again in a cached context, so that no state changes are
+	// persisted if it fails and the forwarded packet must be reverted instead
+	cacheCtx, writeFn := sdk.UnwrapSDKContext(ctx).CacheContext()
+	sequence, err := k.forwardPacket(cacheCtx, forwardedData, forwardedPacket, coins)
+	if err != nil {
+		k.Logger(ctx).Info("failed to retry forwarded packet", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence, "error", err.Error())
+		return false
+	}
+
+	writeFn()
+
+	k.deleteForwardedPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	k.setForwardedPacketRetries(ctx, packet.SourcePort, packet.SourceChannel, sequence, retries+1)
+
+	return true
 }
 
 // acknowledgeForwardedPacket writes the async acknowledgement for forwardedPacket
@@ -96,6 +163,55 @@ func (k Keeper) revertForwardedPacket(ctx context.Context, forwardedPacket chann
 	return nil
 }
 
+// forwardedPacketInfo returns the in-flight forward of the tokens received in the given forwarded packet, including
+// the tokens sent on the next hop and the time elapsed since they were sent.
+func (k Keeper) forwardedPacketInfo(ctx context.Context, forwardedPacket types.ForwardedPacket) (types.ForwardedPacketInfo, error) {
+	packet := forwardedPacket.Packet
+
+	appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if !found {
+		return types.ForwardedPacketInfo{}, errorsmod.Wrapf(ibcerrors.ErrNotFound, "app version not found for port ID (%s) channel ID (%s)", packet.DestinationPort, packet.DestinationChannel)
+	}
+
+	data, err := types.UnmarshalPacketData(packet.GetData(), appVersion)
+	if err != nil {
+		return types.ForwardedPacketInfo{}, err
+	}
+
+	// the tokens sent on the next hop carry the denominations received by this chain
+	tokens := make(sdk.Coins, 0, len(data.Tokens))
+	for _, token := range data.Tokens {
+		if token.Denom.HasPrefix(packet.SourcePort, packet.SourceChannel) {
+			token.Denom.Trace = token.Denom.Trace[1:]
+		} else {
+			trace := []types.Hop{types.NewHop(packet.DestinationPort, packet.DestinationChannel)}
+			token.Denom.Trace = append(trace, token.Denom.Trace...)
+		}
+
+		coin, err := token.ToCoin()
+		if err != nil {
+			return types.ForwardedPacketInfo{}, err
+		}
+
+		tokens = append(tokens, coin)
+	}
+
+	var age uint64
+	blockTime := uint64(sdk.UnwrapSDKContext(ctx).BlockTime().UnixNano())
+	if forwardedPacket.ForwardedAt != 0 && blockTime > forwardedPacket.ForwardedAt {
+		age = blockTime - forwardedPacket.ForwardedAt
+	}
+
+	return types.ForwardedPacketInfo{
+		ForwardKey:  forwardedPacket.ForwardKey,
+		Packet:      packet,
+		Tokens:      tokens.Sort(),
+		Retries:     forwardedPacket.Retries,
+		ForwardedAt: forwardedPacket.ForwardedAt,
+		Age:         age,
+	}, nil
+}
+
 // getReceiverFromPacketData returns either the sender specified in the packet data or the forwarding address
 // if there are still hops left to perform.
 func (k Keeper) getReceiverFromPacketData(data types.FungibleTokenPacketDataV2) (sdk.AccAddress, error) {
@@ -10,6 +10,11 @@ import (
 func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	k.SetPort(ctx, state.PortId)
 
+	// the token metadata is imported first, so that it is set in the bank metadata of the denominations
+	for _, tokenMetadata := range state.TokenMetadata {
+		k.setTokenMetadata(ctx, tokenMetadata.Denom, tokenMetadata.Metadata)
+	}
+
 	for _, denom := range state.Denoms {
 		k.SetDenom(ctx, denom)
 		k.setDenomMetadata(ctx, denom)
@@ -27,16 +32,43 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	for _, forwardPacketState := range state.ForwardedPackets {
 		forwardKey := forwardPacketState.ForwardKey
 		k.setForwardedPacket(ctx, forwardKey.PortId, forwardKey.ChannelId, forwardKey.Sequence, forwardPacketState.Packet)
+		if forwardPacketState.Retries > 0 {
+			k.setForwardedPacketRetries(ctx, forwardKey.PortId, forwardKey.ChannelId, forwardKey.Sequence, forwardPacketState.Retries)
+		}
+		if forwardPacketState.ForwardedAt > 0 {
+			k.setForwardedPacketTime(ctx, forwardKey.PortId, forwardKey.ChannelId, forwardKey.Sequence, forwardPacketState.ForwardedAt)
+		}
+	}
+
+	for _, rateLimit := range state.RateLimits {
+		k.setRateLimit(ctx, rateLimit)
+	}
+
+	for _, pendingSend := range state.PendingRateLimitedSends {
+		k.setPendingRateLimitedSend(ctx, pendingSend)
+	}
+
+	for _, claim := range state.PendingClaims {
+		k.setPendingClaim(ctx, claim)
+	}
+
+	for _, returnedClaim := range state.ReturnedClaims {
+		k.setReturnedClaim(ctx, returnedClaim)
 	}
 }
 
 // ExportGenesis exports ibc-transfer module's portID and denom trace info into its genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		PortId:           k.GetPort(ctx),
-		Denoms:           k.GetAllDenoms(ctx),
-		Params:           k.GetParams(ctx),
-		TotalEscrowed:    k.GetAllTotalEscrowed(ctx),
-		ForwardedPackets: k.getAllForwardedPackets(ctx),
+		PortId:                  k.GetPort(ctx),
+		Denoms:                  k.GetAllDenoms(ctx),
+		Params:                  k.GetParams(ctx),
+		TotalEscrowed:           k.GetAllTotalEscrowed(ctx),
+		ForwardedPackets:        k.getAllForwardedPackets(ctx),
+		RateLimits:              k.GetAllRateLimits(ctx),
+		PendingRateLimitedSends: k.getAllPendingRateLimitedSends(ctx),
+		PendingClaims:           k.getAllPendingClaims(ctx),
+		ReturnedClaims:          k.getAllReturnedClaims(ctx),
+		TokenMetadata:           k.getAllTokenMetadata(ctx),
 	}
 }
@@ -51,9 +51,22 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		// go across '10' to test numerical order
 		for sequence := uint64(5); sequence <= 15; sequence++ {
 			packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, ibctesting.TransferPort, channelID, "", "", clienttypes.ZeroHeight(), 0)
-			forwardPackets = append(forwardPackets, types.ForwardedPacket{ForwardKey: channeltypes.NewPacketID(ibctesting.TransferPort, channelID, sequence), Packet: packet})
+			// every other packet was sent again after timing out
+			retries := sequence % 2
+			// packets forwarded before the time was recorded have no forward time
+			var forwardedAt uint64
+			if sequence%3 != 0 {
+				forwardedAt = sequence * 1000
+			}
+			forwardPackets = append(forwardPackets, types.ForwardedPacket{ForwardKey: channeltypes.NewPacketID(ibctesting.TransferPort, channelID, sequence), Packet: packet, Retries: retries, ForwardedAt: forwardedAt})
 
 			suite.chainA.GetSimApp().TransferKeeper.SetForwardedPacket(suite.chainA.GetContext(), ibctesting.TransferPort, channelID, sequence, packet)
+			if retries > 0 {
+				suite.chainA.GetSimApp().TransferKeeper.SetForwardedPacketRetries(suite.chainA.GetContext(), ibctesting.TransferPort, channelID, sequence, retries)
+			}
+			if forwardedAt > 0 {
+				suite.chainA.GetSimApp().TransferKeeper.SetForwardedPacketTime(suite.chainA.GetContext(), ibctesting.TransferPort, channelID, sequence, forwardedAt)
+			}
 		}
 	}
 
@@ -74,4 +87,34 @@ func (suite *KeeperTestSuite) TestGenesis() {
 
 	storedForwardedPackets := suite.chainA.GetSimApp().TransferKeeper.GetAllForwardedPackets(suite.chainA.GetContext())
 	suite.Require().Equal(storedForwardedPackets, forwardPackets)
+
+	// claims are imported and exported
+	claim := types.NewPendingClaim(
+		channeltypes.NewPacketID(ibctesting.TransferPort, "channel-1", 1),
+		suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(),
+		sdk.NewCoins(escrows[0]), 100, 200,
+	)
+	returnedClaim := types.NewReturnedClaim(channeltypes.NewPacketID(ibctesting.TransferPort, "channel-1", 2), claim)
+	genesis.PendingClaims = []types.PendingClaim{claim}
+	genesis.ReturnedClaims = []types.ReturnedClaim{returnedClaim}
+
+	suite.chainA.GetSimApp().TransferKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
+
+	exported := suite.chainA.GetSimApp().TransferKeeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().Equal([]types.PendingClaim{claim}, exported.PendingClaims)
+	suite.Require().Equal([]types.ReturnedClaim{returnedClaim}, exported.ReturnedClaims)
+
+	// token metadata is imported and exported, and set in the bank metadata of the denomination
+	tokenMetadata := types.NewDenomTokenMetadata(denoms[0].IBCDenom(), types.NewTokenMetadata("atom", "ATOM", 6, ""))
+	genesis.TokenMetadata = []types.DenomTokenMetadata{tokenMetadata}
+
+	suite.chainA.GetSimApp().TransferKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
+
+	exported = suite.chainA.GetSimApp().TransferKeeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().Equal([]types.DenomTokenMetadata{tokenMetadata}, exported.TokenMetadata)
+
+	metadata, found := suite.chainA.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainA.GetContext(), denoms[0].IBCDenom())
+	suite.Require().True(found)
+	suite.Require().Equal("atom", metadata.Display)
+	suite.Require().Equal("ATOM", metadata.Symbol)
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/ibc-go/v9/internal/validate"
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 )
 
 var (
@@ -152,3 +153,191 @@ func (k Keeper) TotalEscrowForDenom(ctx context.Context, req *types.QueryTotalEs
 		Amount: amount,
 	}, nil
 }
+
+// RateLimit implements the Query/RateLimit gRPC method
+func (k Keeper) RateLimit(ctx context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rateLimit, found := k.getCurrentRateLimit(ctx, req.ChannelId, req.Denom)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrRateLimitNotFound, "channel ID (%s) denom (%s)", req.ChannelId, req.Denom).Error(),
+		)
+	}
+
+	res := &types.QueryRateLimitResponse{RateLimit: rateLimit}
+	if remaining, capped := rateLimit.RemainingSend(); capped {
+		res.RemainingSend = &remaining
+	}
+	if remaining, capped := rateLimit.RemainingRecv(); capped {
+		res.RemainingRecv = &remaining
+	}
+
+	return res, nil
+}
+
+// RateLimits implements the Query/RateLimits gRPC method
+func (k Keeper) RateLimits(ctx context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var rateLimits []types.RateLimit
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.RateLimitKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var rateLimit types.RateLimit
+		if err := k.cdc.Unmarshal(value, &rateLimit); err != nil {
+			return err
+		}
+
+		rateLimits = append(rateLimits, rateLimit)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRateLimitsResponse{
+		RateLimits: rateLimits,
+		Pagination: pageRes,
+	}, nil
+}
+
+// PendingClaim implements the Query/PendingClaim gRPC method
+func (k Keeper) PendingClaim(ctx context.Context, req *types.QueryPendingClaimRequest) (*types.QueryPendingClaimResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claim, found := k.GetPendingClaim(ctx, req.PortId, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrClaimNotFound, "port ID (%s) channel ID (%s) sequence (%d)", req.PortId, req.ChannelId, req.Sequence).Error(),
+		)
+	}
+
+	return &types.QueryPendingClaimResponse{PendingClaim: claim}, nil
+}
+
+// PendingClaims implements the Query/PendingClaims gRPC method
+func (k Keeper) PendingClaims(ctx context.Context, req *types.QueryPendingClaimsRequest) (*types.QueryPendingClaimsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var receiver sdk.AccAddress
+	if req.Receiver != "" {
+		var err error
+		receiver, err = sdk.AccAddressFromBech32(req.Receiver)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	var claims []types.PendingClaim
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.PendingClaimKey)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var claim types.PendingClaim
+		if err := k.cdc.Unmarshal(value, &claim); err != nil {
+			return false, err
+		}
+
+		if receiver != nil {
+			claimReceiver, err := sdk.AccAddressFromBech32(claim.Receiver)
+			if err != nil || !claimReceiver.Equals(receiver) {
+				return false, nil
+			}
+		}
+
+		if accumulate {
+			claims = append(claims, claim)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingClaimsResponse{
+		PendingClaims: claims,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// ForwardedPacket implements the Query/ForwardedPacket gRPC method
+func (k Keeper) ForwardedPacket(ctx context.Context, req *types.QueryForwardedPacketRequest) (*types.QueryForwardedPacketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	key := types.PacketForwardKey(req.PortId, req.ChannelId, req.Sequence)
+	bz, err := store.Get(key)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if bz == nil {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrForwardedPacketNotFound, "port ID (%s) channel ID (%s) sequence (%d)", req.PortId, req.ChannelId, req.Sequence).Error(),
+		)
+	}
+
+	forwardedPacket, err := k.forwardedPacketInfo(ctx, k.forwardedPacketFromStore(ctx, key, bz))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryForwardedPacketResponse{ForwardedPacket: forwardedPacket}, nil
+}
+
+// ForwardedPackets implements the Query/ForwardedPackets gRPC method
+func (k Keeper) ForwardedPackets(ctx context.Context, req *types.QueryForwardedPacketsRequest) (*types.QueryForwardedPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var forwardedPackets []types.ForwardedPacketInfo
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ForwardedPacketKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		forwardedPacket, err := k.forwardedPacketInfo(ctx, k.forwardedPacketFromStore(ctx, append(types.ForwardedPacketKey, key...), value))
+		if err != nil {
+			return err
+		}
+
+		forwardedPackets = append(forwardedPackets, forwardedPacket)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryForwardedPacketsResponse{
+		ForwardedPackets: forwardedPackets,
+		Pagination:       pageRes,
+	}, nil
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 )
 
@@ -400,3 +402,381 @@ func (suite *KeeperTestSuite) TestTotalEscrowForDenom() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryRateLimit() {
+	var (
+		req              *types.QueryRateLimitRequest
+		expRemainingSend *sdkmath.Int
+		expRemainingRecv *sdkmath.Int
+		rateLimit        types.RateLimit
+
+		quota             = sdkmath.NewInt(100)
+		outflow           = sdkmath.NewInt(40)
+		expRemainingQuota = quota.Sub(outflow)
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: outflow capped",
+			func() {
+				expRemainingSend = &expRemainingQuota
+			},
+			nil,
+		},
+		{
+			"success: outflow and inflow capped",
+			func() {
+				rateLimit.Quota.MaxAmountRecv = quota
+				suite.chainA.GetSimApp().TransferKeeper.StoreRateLimit(suite.chainA.GetContext(), rateLimit)
+
+				expRemainingSend = &expRemainingQuota
+				expRemainingRecv = &quota
+			},
+			nil,
+		},
+		{
+			"success: expired window reports full quota",
+			func() {
+				rateLimit.Flow.WindowStartHeight = 0
+				rateLimit.Quota.DurationBlocks = 1
+				suite.chainA.GetSimApp().TransferKeeper.StoreRateLimit(suite.chainA.GetContext(), rateLimit)
+
+				expRemainingSend = &quota
+			},
+			nil,
+		},
+		{
+			"failure: rate limit not found",
+			func() {
+				req.Denom = "notfound"
+			},
+			errors.New("rate limit not found"),
+		},
+		{
+			"failure: invalid channel identifier",
+			func() {
+				req.ChannelId = ""
+			},
+			errors.New("identifier cannot be blank"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			expRemainingSend, expRemainingRecv = nil, nil
+			rateLimit = types.NewRateLimit(
+				ibctesting.FirstChannelID, sdk.DefaultBondDenom,
+				types.NewRateLimitQuota(0, 0, quota, sdkmath.ZeroInt(), 1000),
+				types.NewRateLimitFlow(sdkmath.ZeroInt(), uint64(suite.chainA.GetContext().BlockHeight())),
+			)
+			rateLimit.Flow.Outflow = outflow
+			suite.chainA.GetSimApp().TransferKeeper.StoreRateLimit(suite.chainA.GetContext(), rateLimit)
+
+			req = &types.QueryRateLimitRequest{
+				ChannelId: ibctesting.FirstChannelID,
+				Denom:     sdk.DefaultBondDenom,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.RateLimit(suite.chainA.GetContext(), req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRemainingSend, res.RemainingSend)
+				suite.Require().Equal(expRemainingRecv, res.RemainingRecv)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimits() {
+	suite.SetupTest() // reset
+
+	var expRateLimits []types.RateLimit
+	for _, denom := range []string{sdk.DefaultBondDenom, ibctesting.SecondaryDenom} {
+		rateLimit := types.NewRateLimit(
+			ibctesting.FirstChannelID, denom,
+			types.NewRateLimitQuota(0, 0, sdkmath.NewInt(100), sdkmath.ZeroInt(), 1000),
+			types.NewRateLimitFlow(sdkmath.ZeroInt(), 1),
+		)
+		suite.chainA.GetSimApp().TransferKeeper.StoreRateLimit(suite.chainA.GetContext(), rateLimit)
+		expRateLimits = append(expRateLimits, rateLimit)
+	}
+
+	res, err := suite.chainA.GetSimApp().TransferKeeper.RateLimits(suite.chainA.GetContext(), &types.QueryRateLimitsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(expRateLimits, res.RateLimits)
+}
+
+func (suite *KeeperTestSuite) TestQueryPendingClaim() {
+	var (
+		req   *types.QueryPendingClaimRequest
+		claim types.PendingClaim
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: pending claim not found",
+			func() {
+				req.Sequence++
+			},
+			errors.New("claim not found"),
+		},
+		{
+			"failure: invalid port identifier",
+			func() {
+				req.PortId = ""
+			},
+			errors.New("identifier cannot be blank"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			claim = types.NewPendingClaim(
+				channeltypes.NewPacketID(ibctesting.TransferPort, ibctesting.FirstChannelID, 1),
+				suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(),
+				sdk.NewCoins(ibctesting.TestCoin), 100, 200,
+			)
+			suite.chainA.GetSimApp().TransferKeeper.SetPendingClaim(suite.chainA.GetContext(), claim)
+
+			req = &types.QueryPendingClaimRequest{
+				PortId:    ibctesting.TransferPort,
+				ChannelId: ibctesting.FirstChannelID,
+				Sequence:  1,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.PendingClaim(suite.chainA.GetContext(), req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(claim, res.PendingClaim)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPendingClaims() {
+	suite.SetupTest() // reset
+
+	receiver := suite.chainA.SenderAccount.GetAddress().String()
+	otherReceiver := suite.chainB.SenderAccount.GetAddress().String()
+
+	var expClaims []types.PendingClaim
+	for sequence, claimReceiver := range []string{receiver, otherReceiver, receiver} {
+		claim := types.NewPendingClaim(
+			channeltypes.NewPacketID(ibctesting.TransferPort, ibctesting.FirstChannelID, uint64(sequence+1)),
+			suite.chainB.SenderAccount.GetAddress().String(), claimReceiver,
+			sdk.NewCoins(ibctesting.TestCoin), 100, 200,
+		)
+		suite.chainA.GetSimApp().TransferKeeper.SetPendingClaim(suite.chainA.GetContext(), claim)
+
+		if claimReceiver == receiver {
+			expClaims = append(expClaims, claim)
+		}
+	}
+
+	res, err := suite.chainA.GetSimApp().TransferKeeper.PendingClaims(suite.chainA.GetContext(), &types.QueryPendingClaimsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.PendingClaims, 3)
+
+	res, err = suite.chainA.GetSimApp().TransferKeeper.PendingClaims(suite.chainA.GetContext(), &types.QueryPendingClaimsRequest{Receiver: receiver})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expClaims, res.PendingClaims)
+
+	_, err = suite.chainA.GetSimApp().TransferKeeper.PendingClaims(suite.chainA.GetContext(), &types.QueryPendingClaimsRequest{Receiver: "invalid"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryForwardedPacket() {
+	var (
+		req                *types.QueryForwardedPacketRequest
+		packet             channeltypes.Packet
+		expForwardedPacket types.ForwardedPacketInfo
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: no forward time recorded",
+			func() {
+				req.Sequence = 2
+				suite.chainA.GetSimApp().TransferKeeper.SetForwardedPacket(suite.chainA.GetContext(), req.PortId, req.ChannelId, req.Sequence, packet)
+
+				expForwardedPacket.ForwardKey.Sequence = req.Sequence
+				expForwardedPacket.Retries = 0
+				expForwardedPacket.ForwardedAt = 0
+				expForwardedPacket.Age = 0
+			},
+			nil,
+		},
+		{
+			"success: sequence containing the key separator",
+			func() {
+				// the big endian encoding of 47 contains the '/' separator
+				req.Sequence = 47
+				suite.chainA.GetSimApp().TransferKeeper.SetForwardedPacket(suite.chainA.GetContext(), req.PortId, req.ChannelId, req.Sequence, packet)
+				suite.chainA.GetSimApp().TransferKeeper.SetForwardedPacketRetries(suite.chainA.GetContext(), req.PortId, req.ChannelId, req.Sequence, expForwardedPacket.Retries)
+				suite.chainA.GetSimApp().TransferKeeper.SetForwardedPacketTime(suite.chainA.GetContext(), req.PortId, req.ChannelId, req.Sequence, expForwardedPacket.ForwardedAt)
+
+				expForwardedPacket.ForwardKey.Sequence = req.Sequence
+			},
+			nil,
+		},
+		{
+			"failure: forwarded packet not found",
+			func() {
+				req.Sequence++
+			},
+			types.ErrForwardedPacketNotFound,
+		},
+		{
+			"failure: invalid port identifier",
+			func() {
+				req.PortId = ""
+			},
+			errors.New("identifier cannot be blank"),
+		},
+		{
+			"failure: invalid channel identifier",
+			func() {
+				req.ChannelId = ""
+			},
+			errors.New("identifier cannot be blank"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			// the packet received on chainA from chainB, whose tokens are forwarded on transfer/channel-1
+			data := types.NewFungibleTokenPacketDataV2(
+				[]types.Token{
+					{Denom: types.NewDenom(sdk.DefaultBondDenom), Amount: ibctesting.DefaultCoinAmount.String()},
+					{Denom: types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)), Amount: "50"},
+				},
+				suite.chainB.SenderAccount.GetAddress().String(), suite.chainC.SenderAccount.GetAddress().String(), "",
+				types.NewForwardingPacketData("", types.NewHop(ibctesting.TransferPort, "channel-1")),
+			)
+			packet = channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.ZeroHeight(), suite.chainA.GetTimeoutTimestamp())
+
+			forwardedAt := uint64(suite.chainA.GetContext().BlockTime().UnixNano()) - 100
+			suite.chainA.GetSimApp().TransferKeeper.SetForwardedPacket(suite.chainA.GetContext(), ibctesting.TransferPort, "channel-1", 1, packet)
+			suite.chainA.GetSimApp().TransferKeeper.SetForwardedPacketRetries(suite.chainA.GetContext(), ibctesting.TransferPort, "channel-1", 1, 2)
+			suite.chainA.GetSimApp().TransferKeeper.SetForwardedPacketTime(suite.chainA.GetContext(), ibctesting.TransferPort, "channel-1", 1, forwardedAt)
+
+			expForwardedPacket = types.ForwardedPacketInfo{
+				ForwardKey: channeltypes.NewPacketID(ibctesting.TransferPort, "channel-1", 1),
+				Packet:     packet,
+				Tokens: sdk.NewCoins(
+					// the token native to chainB is received as a voucher on chainA
+					sdk.NewCoin(types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)).IBCDenom(), ibctesting.DefaultCoinAmount),
+					// the token native to chainA is returned to its source
+					sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50)),
+				),
+				Retries:     2,
+				ForwardedAt: forwardedAt,
+				Age:         100,
+			}
+
+			req = &types.QueryForwardedPacketRequest{
+				PortId:    ibctesting.TransferPort,
+				ChannelId: "channel-1",
+				Sequence:  1,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.ForwardedPacket(suite.chainA.GetContext(), req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expForwardedPacket, res.ForwardedPacket)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryForwardedPackets() {
+	suite.SetupTest() // reset
+
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	res, err := suite.chainA.GetSimApp().TransferKeeper.ForwardedPackets(suite.chainA.GetContext(), &types.QueryForwardedPacketsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.ForwardedPackets)
+
+	data := types.NewFungibleTokenPacketDataV2(
+		[]types.Token{{Denom: types.NewDenom(sdk.DefaultBondDenom), Amount: ibctesting.DefaultCoinAmount.String()}},
+		suite.chainB.SenderAccount.GetAddress().String(), suite.chainC.SenderAccount.GetAddress().String(), "",
+		types.NewForwardingPacketData("", types.NewHop(ibctesting.TransferPort, "channel-1")),
+	)
+
+	var expForwardKeys []channeltypes.PacketId
+	for sequence := uint64(1); sequence <= 3; sequence++ {
+		packet := channeltypes.NewPacket(data.GetBytes(), sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.ZeroHeight(), suite.chainA.GetTimeoutTimestamp())
+		suite.chainA.GetSimApp().TransferKeeper.SetForwardedPacket(suite.chainA.GetContext(), ibctesting.TransferPort, "channel-1", sequence, packet)
+
+		expForwardKeys = append(expForwardKeys, channeltypes.NewPacketID(ibctesting.TransferPort, "channel-1", sequence))
+	}
+
+	res, err = suite.chainA.GetSimApp().TransferKeeper.ForwardedPackets(suite.chainA.GetContext(), &types.QueryForwardedPacketsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.ForwardedPackets, 3)
+
+	expTokens := sdk.NewCoins(sdk.NewCoin(types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)).IBCDenom(), ibctesting.DefaultCoinAmount))
+	for i, forwardedPacket := range res.ForwardedPackets {
+		suite.Require().Equal(expForwardKeys[i], forwardedPacket.ForwardKey)
+		suite.Require().Equal(expTokens, forwardedPacket.Tokens)
+		// no forward time was recorded for the packets
+		suite.Require().Zero(forwardedPacket.ForwardedAt)
+		suite.Require().Zero(forwardedPacket.Age)
+	}
+
+	res, err = suite.chainA.GetSimApp().TransferKeeper.ForwardedPackets(suite.chainA.GetContext(), &types.QueryForwardedPacketsRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.ForwardedPackets, 2)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+}
@@ -40,6 +40,9 @@ type Keeper struct {
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
+
+	// the validators of the top-level memo keys registered by middlewares and applications
+	memoKeyValidators map[string]types.MemoKeyValidator
 }
 
 // NewKeeper creates a new IBC transfer Keeper instance
@@ -63,14 +66,15 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:            cdc,
-		storeService:   storeService,
-		legacySubspace: legacySubspace,
-		ics4Wrapper:    ics4Wrapper,
-		channelKeeper:  channelKeeper,
-		authKeeper:     authKeeper,
-		bankKeeper:     bankKeeper,
-		authority:      authority,
+		cdc:               cdc,
+		storeService:      storeService,
+		legacySubspace:    legacySubspace,
+		ics4Wrapper:       ics4Wrapper,
+		channelKeeper:     channelKeeper,
+		authKeeper:        authKeeper,
+		bankKeeper:        bankKeeper,
+		authority:         authority,
+		memoKeyValidators: make(map[string]types.MemoKeyValidator),
 	}
 }
 
@@ -214,9 +218,91 @@ func (k Keeper) setDenomMetadata(ctx context.Context, denom types.Denom) {
 		Symbol:  strings.ToUpper(denom.Base),
 	}
 
+	// the token metadata recorded for the voucher describes how the token is displayed on its source chain
+	if tokenMetadata, found := k.GetTokenMetadata(ctx, denom.IBCDenom()); found {
+		if tokenMetadata.Display != denom.Base && tokenMetadata.Exponent > 0 {
+			metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{
+				Denom:    tokenMetadata.Display,
+				Exponent: tokenMetadata.Exponent,
+			})
+		}
+		metadata.Display = tokenMetadata.Display
+		metadata.Symbol = tokenMetadata.Symbol
+		metadata.URI = tokenMetadata.Uri
+	}
+
 	k.bankKeeper.SetDenomMetaData(ctx, metadata)
 }
 
+// tokenMetadataFromBank returns the token metadata to be sent along with a token native to this chain,
+// built from the bank metadata of the given denomination. It returns nil if the denomination has no
+// bank metadata or it cannot be described by valid token metadata.
+func (k Keeper) tokenMetadataFromBank(ctx context.Context, denom string) *types.TokenMetadata {
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return nil
+	}
+
+	var exponent uint32
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			exponent = unit.Exponent
+			break
+		}
+	}
+
+	tokenMetadata := types.NewTokenMetadata(metadata.Display, metadata.Symbol, exponent, metadata.URI)
+	if err := tokenMetadata.Validate(); err != nil {
+		return nil
+	}
+
+	return &tokenMetadata
+}
+
+// GetTokenMetadata returns the token metadata recorded for the given IBC voucher denomination.
+func (k Keeper) GetTokenMetadata(ctx context.Context, denom string) (types.TokenMetadata, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.TokenMetadataStoreKey(denom))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return types.TokenMetadata{}, false
+	}
+
+	var denomTokenMetadata types.DenomTokenMetadata
+	k.cdc.MustUnmarshal(bz, &denomTokenMetadata)
+
+	return denomTokenMetadata.Metadata, true
+}
+
+// setTokenMetadata records the token metadata for the given IBC voucher denomination.
+func (k Keeper) setTokenMetadata(ctx context.Context, denom string, metadata types.TokenMetadata) {
+	store := k.storeService.OpenKVStore(ctx)
+	denomTokenMetadata := types.NewDenomTokenMetadata(denom, metadata)
+	bz := k.cdc.MustMarshal(&denomTokenMetadata)
+	if err := store.Set(types.TokenMetadataStoreKey(denom), bz); err != nil {
+		panic(err)
+	}
+}
+
+// getAllTokenMetadata returns the token metadata recorded for all the IBC voucher denominations.
+func (k Keeper) getAllTokenMetadata(ctx context.Context) []types.DenomTokenMetadata {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.TokenMetadataKey)
+
+	var tokenMetadata []types.DenomTokenMetadata
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var denomTokenMetadata types.DenomTokenMetadata
+		k.cdc.MustUnmarshal(iterator.Value(), &denomTokenMetadata)
+
+		tokenMetadata = append(tokenMetadata, denomTokenMetadata)
+	}
+
+	return tokenMetadata
+}
+
 // GetTotalEscrowForDenom gets the total amount of source chain tokens that
 // are in escrow, keyed by the denomination.
 //
@@ -299,6 +385,265 @@ func (k Keeper) IterateTokensInEscrow(ctx context.Context, storeprefix []byte, c
 	}
 }
 
+// GetRateLimit returns the rate limit for the provided channel and denom.
+func (k Keeper) GetRateLimit(ctx context.Context, channelID, denom string) (types.RateLimit, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.RateLimitStoreKey(channelID, denom))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+
+	return rateLimit, true
+}
+
+// setRateLimit stores the rate limit keyed by its channel and denom.
+func (k Keeper) setRateLimit(ctx context.Context, rateLimit types.RateLimit) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&rateLimit)
+	if err := store.Set(types.RateLimitStoreKey(rateLimit.ChannelId, rateLimit.Denom), bz); err != nil {
+		panic(err)
+	}
+}
+
+// deleteRateLimit deletes the rate limit for the provided channel and denom.
+func (k Keeper) deleteRateLimit(ctx context.Context, channelID, denom string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.RateLimitStoreKey(channelID, denom)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllRateLimits returns all the rate limits stored in state.
+func (k Keeper) GetAllRateLimits(ctx context.Context) []types.RateLimit {
+	var rateLimits []types.RateLimit
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) bool {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+
+	return rateLimits
+}
+
+// IterateRateLimits iterates over the rate limits in the store and performs a callback function.
+func (k Keeper) IterateRateLimits(ctx context.Context, cb func(rateLimit types.RateLimit) bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.RateLimitKey)
+
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+
+		if cb(rateLimit) {
+			break
+		}
+	}
+}
+
+// setPendingRateLimitedSend stores an in-flight packet accounted for in a rate limit flow.
+func (k Keeper) setPendingRateLimitedSend(ctx context.Context, pendingSend types.PendingRateLimitedSend) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&pendingSend)
+	if err := store.Set(types.PendingRateLimitedSendStoreKey(pendingSend.ChannelId, pendingSend.Sequence, pendingSend.Denom), bz); err != nil {
+		panic(err)
+	}
+}
+
+// getPendingRateLimitedSend returns the in-flight packet accounted for in a rate limit flow.
+func (k Keeper) getPendingRateLimitedSend(ctx context.Context, channelID string, sequence uint64, denom string) (types.PendingRateLimitedSend, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PendingRateLimitedSendStoreKey(channelID, sequence, denom))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.PendingRateLimitedSend{}, false
+	}
+
+	var pendingSend types.PendingRateLimitedSend
+	k.cdc.MustUnmarshal(bz, &pendingSend)
+
+	return pendingSend, true
+}
+
+// deletePendingRateLimitedSend deletes the in-flight packet accounted for in a rate limit flow.
+func (k Keeper) deletePendingRateLimitedSend(ctx context.Context, channelID string, sequence uint64, denom string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.PendingRateLimitedSendStoreKey(channelID, sequence, denom)); err != nil {
+		panic(err)
+	}
+}
+
+// getAllPendingRateLimitedSends returns all the in-flight packets accounted for in a rate limit flow.
+func (k Keeper) getAllPendingRateLimitedSends(ctx context.Context) []types.PendingRateLimitedSend {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.PendingRateLimitedSendKey)
+
+	var pendingSends []types.PendingRateLimitedSend
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingSend types.PendingRateLimitedSend
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingSend)
+
+		pendingSends = append(pendingSends, pendingSend)
+	}
+
+	return pendingSends
+}
+
+// setPendingClaim stores the pending claim and queues it by deadline, unless returning
+// its tokens to the sender has already failed.
+func (k Keeper) setPendingClaim(ctx context.Context, claim types.PendingClaim) {
+	store := k.storeService.OpenKVStore(ctx)
+	packetID := claim.PacketId
+
+	bz := k.cdc.MustMarshal(&claim)
+	if err := store.Set(types.PendingClaimStoreKey(packetID.PortId, packetID.ChannelId, packetID.Sequence), bz); err != nil {
+		panic(err)
+	}
+
+	if claim.ReturnFailed {
+		return
+	}
+
+	bz = k.cdc.MustMarshal(&packetID)
+	if err := store.Set(types.ClaimDeadlineStoreKey(claim.Deadline, packetID.PortId, packetID.ChannelId, packetID.Sequence), bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetPendingClaim returns the pending claim of the claimable transfer received in the packet
+// with the given port ID, channel ID and sequence.
+func (k Keeper) GetPendingClaim(ctx context.Context, portID, channelID string, sequence uint64) (types.PendingClaim, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PendingClaimStoreKey(portID, channelID, sequence))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return types.PendingClaim{}, false
+	}
+
+	var claim types.PendingClaim
+	k.cdc.MustUnmarshal(bz, &claim)
+
+	return claim, true
+}
+
+// deletePendingClaim deletes the pending claim and removes it from the deadline queue.
+func (k Keeper) deletePendingClaim(ctx context.Context, claim types.PendingClaim) {
+	store := k.storeService.OpenKVStore(ctx)
+	packetID := claim.PacketId
+
+	if err := store.Delete(types.PendingClaimStoreKey(packetID.PortId, packetID.ChannelId, packetID.Sequence)); err != nil {
+		panic(err)
+	}
+
+	if err := store.Delete(types.ClaimDeadlineStoreKey(claim.Deadline, packetID.PortId, packetID.ChannelId, packetID.Sequence)); err != nil {
+		panic(err)
+	}
+}
+
+// getAllPendingClaims returns all the pending claims stored.
+func (k Keeper) getAllPendingClaims(ctx context.Context) []types.PendingClaim {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.PendingClaimKey)
+
+	var claims []types.PendingClaim
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var claim types.PendingClaim
+		k.cdc.MustUnmarshal(iterator.Value(), &claim)
+
+		claims = append(claims, claim)
+	}
+
+	return claims
+}
+
+// iterateExpiredClaims iterates over the pending claims whose deadline has passed at the given
+// block time, in order of deadline, and performs a callback function.
+func (k Keeper) iterateExpiredClaims(ctx context.Context, blockTime uint64, cb func(claim types.PendingClaim) bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.ClaimDeadlineKey)
+
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var packetID channeltypes.PacketId
+		k.cdc.MustUnmarshal(iterator.Value(), &packetID)
+
+		claim, found := k.GetPendingClaim(ctx, packetID.PortId, packetID.ChannelId, packetID.Sequence)
+		if !found {
+			panic(fmt.Errorf("pending claim not found for queued packet %s/%s/%d", packetID.PortId, packetID.ChannelId, packetID.Sequence))
+		}
+
+		if !claim.IsExpired(blockTime) || cb(claim) {
+			break
+		}
+	}
+}
+
+// setReturnedClaim stores the claim whose tokens are being returned to the sender.
+func (k Keeper) setReturnedClaim(ctx context.Context, returnedClaim types.ReturnedClaim) {
+	store := k.storeService.OpenKVStore(ctx)
+	packetID := returnedClaim.ReturnPacketId
+
+	bz := k.cdc.MustMarshal(&returnedClaim)
+	if err := store.Set(types.ReturnedClaimStoreKey(packetID.PortId, packetID.ChannelId, packetID.Sequence), bz); err != nil {
+		panic(err)
+	}
+}
+
+// getReturnedClaim returns the claim whose tokens are returned in the packet with the given
+// port ID, channel ID and sequence.
+func (k Keeper) getReturnedClaim(ctx context.Context, portID, channelID string, sequence uint64) (types.ReturnedClaim, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.ReturnedClaimStoreKey(portID, channelID, sequence))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return types.ReturnedClaim{}, false
+	}
+
+	var returnedClaim types.ReturnedClaim
+	k.cdc.MustUnmarshal(bz, &returnedClaim)
+
+	return returnedClaim, true
+}
+
+// deleteReturnedClaim deletes the claim whose tokens are returned in the packet with the given
+// port ID, channel ID and sequence.
+func (k Keeper) deleteReturnedClaim(ctx context.Context, portID, channelID string, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.ReturnedClaimStoreKey(portID, channelID, sequence)); err != nil {
+		panic(err)
+	}
+}
+
+// getAllReturnedClaims returns all the claims whose tokens are being returned to the sender.
+func (k Keeper) getAllReturnedClaims(ctx context.Context) []types.ReturnedClaim {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.ReturnedClaimKey)
+
+	var returnedClaims []types.ReturnedClaim
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var returnedClaim types.ReturnedClaim
+		k.cdc.MustUnmarshal(iterator.Value(), &returnedClaim)
+
+		returnedClaims = append(returnedClaims, returnedClaim)
+	}
+
+	return returnedClaims
+}
+
 // setForwardedPacket sets the forwarded packet in the store.
 func (k Keeper) setForwardedPacket(ctx context.Context, portID, channelID string, sequence uint64, packet channeltypes.Packet) {
 	store := k.storeService.OpenKVStore(ctx)
@@ -333,6 +678,58 @@ func (k Keeper) deleteForwardedPacket(ctx context.Context, portID, channelID str
 	if err := store.Delete(packetKey); err != nil {
 		panic(err)
 	}
+
+	if err := store.Delete(types.PacketForwardRetriesKey(portID, channelID, sequence)); err != nil {
+		panic(err)
+	}
+
+	if err := store.Delete(types.PacketForwardTimeKey(portID, channelID, sequence)); err != nil {
+		panic(err)
+	}
+}
+
+// setForwardedPacketTime sets the time in nanoseconds since unix epoch at which the forwarded packet was sent on the next hop.
+func (k Keeper) setForwardedPacketTime(ctx context.Context, portID, channelID string, sequence, forwardedAt uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.PacketForwardTimeKey(portID, channelID, sequence), sdk.Uint64ToBigEndian(forwardedAt)); err != nil {
+		panic(err)
+	}
+}
+
+// getForwardedPacketTime returns the time in nanoseconds since unix epoch at which the forwarded packet was sent on the next hop.
+func (k Keeper) getForwardedPacketTime(ctx context.Context, portID, channelID string, sequence uint64) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PacketForwardTimeKey(portID, channelID, sequence))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setForwardedPacketRetries sets the number of times the forwarded packet was sent again after timing out.
+func (k Keeper) setForwardedPacketRetries(ctx context.Context, portID, channelID string, sequence, retries uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.PacketForwardRetriesKey(portID, channelID, sequence), sdk.Uint64ToBigEndian(retries)); err != nil {
+		panic(err)
+	}
+}
+
+// getForwardedPacketRetries returns the number of times the forwarded packet was sent again after timing out.
+func (k Keeper) getForwardedPacketRetries(ctx context.Context, portID, channelID string, sequence uint64) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PacketForwardRetriesKey(portID, channelID, sequence))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
 }
 
 // getAllForwardedPackets gets all forward packets stored in state.
@@ -353,34 +750,42 @@ func (k Keeper) iterateForwardedPackets(ctx context.Context, cb func(packet type
 
 	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
 	for ; iterator.Valid(); iterator.Next() {
-		var forwardPacket types.ForwardedPacket
-		k.cdc.MustUnmarshal(iterator.Value(), &forwardPacket.Packet)
-
-		// Iterator key consists of types.ForwardedPacketKey/portID/channelID/sequence
-		parts := strings.Split(string(iterator.Key()), "/")
-		if len(parts) != 4 {
-			panic(fmt.Errorf("key path should always have 4 elements"))
-		}
-		if parts[0] != string(types.ForwardedPacketKey) {
-			panic(fmt.Errorf("key path does not start with expected prefix: %s", types.ForwardedPacketKey))
+		if cb(k.forwardedPacketFromStore(ctx, iterator.Key(), iterator.Value())) {
+			break
 		}
+	}
+}
 
-		portID, channelID := parts[1], parts[2]
-		if err := host.PortIdentifierValidator(portID); err != nil {
-			panic(fmt.Errorf("port identifier validation failed while parsing forward key path"))
-		}
-		if err := host.ChannelIdentifierValidator(channelID); err != nil {
-			panic(fmt.Errorf("channel identifier validation failed while parsing forward key path"))
-		}
+// forwardedPacketFromStore returns the forwarded packet stored under the given key and value.
+func (k Keeper) forwardedPacketFromStore(ctx context.Context, key, value []byte) types.ForwardedPacket {
+	var forwardPacket types.ForwardedPacket
+	k.cdc.MustUnmarshal(value, &forwardPacket.Packet)
 
-		forwardPacket.ForwardKey.Sequence = sdk.BigEndianToUint64([]byte(parts[3]))
-		forwardPacket.ForwardKey.ChannelId = channelID
-		forwardPacket.ForwardKey.PortId = portID
+	// Key consists of types.ForwardedPacketKey/portID/channelID/sequence, where the big endian
+	// encoded sequence may itself contain the separator
+	parts := strings.SplitN(string(key), "/", 4)
+	if len(parts) != 4 {
+		panic(fmt.Errorf("key path should always have 4 elements"))
+	}
+	if parts[0] != string(types.ForwardedPacketKey) {
+		panic(fmt.Errorf("key path does not start with expected prefix: %s", types.ForwardedPacketKey))
+	}
 
-		if cb(forwardPacket) {
-			break
-		}
+	portID, channelID := parts[1], parts[2]
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		panic(fmt.Errorf("port identifier validation failed while parsing forward key path"))
 	}
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		panic(fmt.Errorf("channel identifier validation failed while parsing forward key path"))
+	}
+
+	forwardPacket.ForwardKey.Sequence = sdk.BigEndianToUint64([]byte(parts[3]))
+	forwardPacket.ForwardKey.ChannelId = channelID
+	forwardPacket.ForwardKey.PortId = portID
+	forwardPacket.Retries = k.getForwardedPacketRetries(ctx, portID, channelID, forwardPacket.ForwardKey.Sequence)
+	forwardPacket.ForwardedAt = k.getForwardedPacketTime(ctx, portID, channelID, forwardPacket.ForwardKey.Sequence)
+
+	return forwardPacket
 }
 
 // IsBlockedAddr checks if the given address is allowed to send or receive tokens.
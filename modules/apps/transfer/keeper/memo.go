@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+)
+
+// RegisterMemoKey registers a top-level memo key owned by a middleware or application, together with the
+// validator of its value. A nil validator accepts any value for the key. When memo validation is enabled
+// in the params, the memos of the transfers sent and received by this chain may only contain registered keys.
+// It panics if the key is blank or has already been registered.
+func (k Keeper) RegisterMemoKey(key string, validator types.MemoKeyValidator) {
+	if strings.TrimSpace(key) == "" {
+		panic(errors.New("memo key cannot be blank"))
+	}
+
+	if _, found := k.memoKeyValidators[key]; found {
+		panic(fmt.Errorf("memo key %s has already been registered", key))
+	}
+
+	k.memoKeyValidators[key] = validator
+}
+
+// validateMemo validates the top-level keys of the memo against the registered memo keys if memo validation
+// is enabled. Memos that are not JSON objects are free-form and have no keys to validate, unless they are
+// malformed JSON objects.
+func (k Keeper) validateMemo(ctx context.Context, memo string) error {
+	if memo == "" || !k.GetParams(ctx).MemoValidationEnabled {
+		return nil
+	}
+
+	return types.ValidateMemoKeys(memo, k.memoKeyValidators)
+}
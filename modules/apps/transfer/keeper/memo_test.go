@@ -0,0 +1,261 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+// validateAmountMemo is a memo key validator that requires the value to be a json object containing an amount.
+func validateAmountMemo(value json.RawMessage) error {
+	var data struct {
+		Amount string `json:"amount"`
+	}
+	if err := json.Unmarshal(value, &data); err != nil {
+		return err
+	}
+
+	if data.Amount == "" {
+		return errors.New("amount cannot be empty")
+	}
+
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestRegisterMemoKey() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPanic bool
+	}{
+		{
+			"success",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.RegisterMemoKey("amount", validateAmountMemo)
+			},
+			false,
+		},
+		{
+			"success: nil validator",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.RegisterMemoKey("amount", nil)
+			},
+			false,
+		},
+		{
+			"failure: blank key",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.RegisterMemoKey(" ", validateAmountMemo)
+			},
+			true,
+		},
+		{
+			"failure: key already registered",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.RegisterMemoKey("amount", validateAmountMemo)
+				suite.chainA.GetSimApp().TransferKeeper.RegisterMemoKey("amount", nil)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			if tc.expPanic {
+				suite.Require().Panics(tc.malleate)
+			} else {
+				suite.Require().NotPanics(tc.malleate)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgTransferMemoValidation() {
+	var (
+		memo    string
+		enabled bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: registered key with valid value",
+			func() {},
+			nil,
+		},
+		{
+			"success: empty memo",
+			func() {
+				memo = ""
+			},
+			nil,
+		},
+		{
+			"success: free-form memo",
+			func() {
+				memo = "free-form memo"
+			},
+			nil,
+		},
+		{
+			"success: key with nil validator",
+			func() {
+				memo = `{"any": [1, 2, 3]}`
+			},
+			nil,
+		},
+		{
+			"success: memo validation disabled",
+			func() {
+				enabled = false
+				memo = `{"unknown": {}}`
+			},
+			nil,
+		},
+		{
+			"failure: malformed json object",
+			func() {
+				memo = `{"amount": `
+			},
+			types.ErrInvalidMemo,
+		},
+		{
+			"failure: unknown key",
+			func() {
+				memo = `{"amount": {"amount": "100"}, "unknown": {}}`
+			},
+			types.ErrInvalidMemo,
+		},
+		{
+			"failure: invalid value",
+			func() {
+				memo = `{"amount": {"amount": ""}}`
+			},
+			types.ErrInvalidMemo,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			memo = `{"amount": {"amount": "100"}}`
+			enabled = true
+
+			tc.malleate()
+
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			transferKeeper.RegisterMemoKey("amount", validateAmountMemo)
+			transferKeeper.RegisterMemoKey("any", nil)
+
+			params := transferKeeper.GetParams(suite.chainA.GetContext())
+			params.MemoValidationEnabled = enabled
+			transferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+				sdk.NewCoins(ibctesting.TestCoin), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0, memo, nil,
+			)
+
+			res, err := suite.chainA.SendMsgs(msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError, err.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketMemoValidation() {
+	var memo string
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: registered key with valid value",
+			func() {},
+			nil,
+		},
+		{
+			"success: free-form memo",
+			func() {
+				memo = "free-form memo"
+			},
+			nil,
+		},
+		{
+			"failure: unknown key",
+			func() {
+				memo = `{"unknown": {}}`
+			},
+			types.ErrInvalidMemo,
+		},
+		{
+			"failure: invalid value",
+			func() {
+				memo = `{"amount": "100"}`
+			},
+			types.ErrInvalidMemo,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			memo = `{"amount": {"amount": "100"}}`
+
+			tc.malleate()
+
+			transferKeeper := suite.chainB.GetSimApp().TransferKeeper
+			transferKeeper.RegisterMemoKey("amount", validateAmountMemo)
+
+			params := transferKeeper.GetParams(suite.chainB.GetContext())
+			params.MemoValidationEnabled = true
+			transferKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			data := types.NewFungibleTokenPacketDataV2(
+				[]types.Token{{Denom: types.NewDenom(sdk.DefaultBondDenom), Amount: defaultAmount.String()}},
+				suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), memo, ibctesting.EmptyForwardingPacketData,
+			)
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, suite.chainB.GetTimeoutHeight(), 0)
+
+			err := transferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
@@ -8,7 +8,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/internal/events"
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 )
 
@@ -37,6 +39,10 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to send funds", sender)
 	}
 
+	if err := k.validateMemo(ctx, msg.Memo); err != nil {
+		return nil, err
+	}
+
 	if msg.Forwarding.GetUnwind() {
 		msg, err = k.unwindHops(ctx, msg)
 		if err != nil {
@@ -46,7 +52,7 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 
 	sequence, err := k.sendTransfer(
 		ctx, msg.SourcePort, msg.SourceChannel, coins, sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp,
-		msg.Memo, msg.Forwarding.GetHops())
+		msg.Memo, msg.Forwarding, msg.ClaimTimeout, msg.IncludeDenomMetadata)
 	if err != nil {
 		return nil, err
 	}
@@ -56,6 +62,44 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 	return &types.MsgTransferResponse{Sequence: sequence}, nil
 }
 
+// MultiTransfer defines an rpc handler method for MsgMultiTransfer. Transfers tokens to multiple receivers in a single packet.
+func (k Keeper) MultiTransfer(goCtx context.Context, msg *types.MsgMultiTransfer) (*types.MsgMultiTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).SendEnabled {
+		return nil, types.ErrSendDisabled
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	coins := msg.GetCoins()
+
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, coins...); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrSendDisabled, err.Error())
+	}
+
+	if k.isBlockedAddr(sender) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to send funds", sender)
+	}
+
+	if err := k.validateMemo(ctx, msg.Memo); err != nil {
+		return nil, err
+	}
+
+	sequence, err := k.sendMultiTransfer(
+		ctx, msg.SourcePort, msg.SourceChannel, sender, msg.Outputs, msg.TimeoutHeight, msg.TimeoutTimestamp, msg.Memo, msg.IncludeDenomMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("IBC fungible token multi transfer", "tokens", coins, "sender", msg.Sender, "receivers", len(msg.Outputs))
+
+	return &types.MsgMultiTransferResponse{Sequence: sequence}, nil
+}
+
 // UpdateParams defines an rpc handler method for MsgUpdateParams. Updates the ibc-transfer module's parameters.
 func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != msg.Signer {
@@ -68,6 +112,100 @@ func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams)
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+// SetRateLimit defines an rpc handler method for MsgSetRateLimit. Adds a rate limit for a denomination
+// on a channel or updates the quota of an existing one, in which case the flow of the current window is kept.
+func (k Keeper) SetRateLimit(goCtx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.channelKeeper.HasChannel(ctx, k.GetPort(ctx), msg.ChannelId) {
+		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", k.GetPort(ctx), msg.ChannelId)
+	}
+
+	rateLimit, found := k.GetRateLimit(ctx, msg.ChannelId, msg.Denom)
+	if !found {
+		channelValue := k.bankKeeper.GetSupply(ctx, msg.Denom).Amount
+		rateLimit = types.NewRateLimit(msg.ChannelId, msg.Denom, msg.Quota, types.NewRateLimitFlow(channelValue, uint64(ctx.BlockHeight())))
+	}
+	rateLimit.Quota = msg.Quota
+
+	if rateLimit.Quota.UsesPercent() && rateLimit.Flow.ChannelValue.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRateLimit, "cannot set a percentage quota for denom %s with zero supply", msg.Denom)
+	}
+
+	k.setRateLimit(ctx, rateLimit)
+
+	return &types.MsgSetRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit defines an rpc handler method for MsgRemoveRateLimit. Removes the rate limit for a denomination on a channel.
+func (k Keeper) RemoveRateLimit(goCtx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetRateLimit(ctx, msg.ChannelId, msg.Denom); !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "channel ID (%s) denom (%s)", msg.ChannelId, msg.Denom)
+	}
+
+	k.deleteRateLimit(ctx, msg.ChannelId, msg.Denom)
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// UpdateDenomMetadata defines an rpc handler method for MsgUpdateDenomMetadata. Overrides the token metadata
+// recorded for an IBC voucher denomination and updates its bank metadata accordingly.
+func (k Keeper) UpdateDenomMetadata(goCtx context.Context, msg *types.MsgUpdateDenomMetadata) (*types.MsgUpdateDenomMetadataResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hexHash := msg.Denom[len(types.DenomPrefix+"/"):]
+	hash, err := types.ParseHexHash(hexHash)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidDenomForTransfer, err.Error())
+	}
+
+	denom, found := k.GetDenom(ctx, hash)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrDenomNotFound, hexHash)
+	}
+
+	k.setTokenMetadata(ctx, msg.Denom, msg.Metadata)
+	k.setDenomMetadata(ctx, denom)
+
+	events.EmitDenomMetadataEvent(ctx, msg.Denom, msg.Metadata)
+
+	return &types.MsgUpdateDenomMetadataResponse{}, nil
+}
+
+// ClaimTransfer defines an rpc handler method for MsgClaimTransfer. Sends the tokens of a
+// pending claim, held since the claimable transfer was received, to the receiver.
+func (k Keeper) ClaimTransfer(goCtx context.Context, msg *types.MsgClaimTransfer) (*types.MsgClaimTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	claim, err := k.claimTransfer(ctx, receiver, msg.PortId, msg.ChannelId, msg.Sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("IBC fungible token transfer claimed", "tokens", claim.Tokens, "sender", claim.Sender, "receiver", claim.Receiver)
+
+	return &types.MsgClaimTransferResponse{}, nil
+}
+
 // unwindHops unwinds the hops present in the tokens denomination and returns the message modified to reflect
 // the unwound path to take. It assumes that only a single token is present (as this is verified in ValidateBasic)
 // in the tokens list and ensures that the token is not native to the chain.
@@ -79,6 +217,10 @@ func (k Keeper) unwindHops(ctx sdk.Context, msg *types.MsgTransfer) (*types.MsgT
 
 	// Update message fields.
 	msg.SourcePort, msg.SourceChannel = unwindHops[0].PortId, unwindHops[0].ChannelId
+	if len(msg.Forwarding.HopTimeouts) > 0 {
+		// the unwinding hops use the timeout of the received packet
+		msg.Forwarding.HopTimeouts = append(make([]types.HopTimeout, len(unwindHops)-1), msg.Forwarding.HopTimeouts...)
+	}
 	msg.Forwarding.Hops = append(unwindHops[1:], msg.Forwarding.Hops...)
 	msg.Forwarding.Unwind = false
 
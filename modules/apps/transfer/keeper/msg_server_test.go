@@ -5,6 +5,8 @@ import (
 	"errors"
 	"strings"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
@@ -246,6 +248,221 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 	}
 }
 
+// TestSetRateLimit tests SetRateLimit rpc handler
+func (suite *KeeperTestSuite) TestSetRateLimit() {
+	var (
+		msg  *types.MsgSetRateLimit
+		path *ibctesting.Path
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: new rate limit",
+			func() {},
+			nil,
+		},
+		{
+			"success: update quota of existing rate limit",
+			func() {
+				_, err := suite.chainA.GetSimApp().TransferKeeper.SetRateLimit(suite.chainA.GetContext(), msg)
+				suite.Require().NoError(err)
+
+				msg.Quota.MaxAmountSend = msg.Quota.MaxAmountSend.AddRaw(1)
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer address",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				msg.ChannelId = ibctesting.InvalidID
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+		{
+			"failure: percentage quota with zero supply",
+			func() {
+				msg.Denom = "nosupply"
+				msg.Quota = types.NewRateLimitQuota(10, 0, sdkmath.ZeroInt(), sdkmath.ZeroInt(), 10)
+			},
+			types.ErrInvalidRateLimit,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			quota := types.NewRateLimitQuota(10, 0, ibctesting.DefaultCoinAmount, sdkmath.ZeroInt(), 100)
+			msg = types.NewMsgSetRateLimit(suite.chainA.GetSimApp().TransferKeeper.GetAuthority(), path.EndpointA.ChannelID, sdk.DefaultBondDenom, quota)
+
+			tc.malleate()
+
+			_, err := suite.chainA.GetSimApp().TransferKeeper.SetRateLimit(suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				rateLimit, found := suite.chainA.GetSimApp().TransferKeeper.GetRateLimit(suite.chainA.GetContext(), msg.ChannelId, msg.Denom)
+				suite.Require().True(found)
+				suite.Require().Equal(msg.Quota, rateLimit.Quota)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// TestRemoveRateLimit tests RemoveRateLimit rpc handler
+func (suite *KeeperTestSuite) TestRemoveRateLimit() {
+	var msg *types.MsgRemoveRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer address",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: rate limit not found",
+			func() {
+				msg.Denom = "notfound"
+			},
+			types.ErrRateLimitNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			quota := types.NewRateLimitQuota(0, 0, ibctesting.DefaultCoinAmount, sdkmath.ZeroInt(), 100)
+			_, err := suite.chainA.GetSimApp().TransferKeeper.SetRateLimit(suite.chainA.GetContext(), types.NewMsgSetRateLimit(
+				suite.chainA.GetSimApp().TransferKeeper.GetAuthority(), path.EndpointA.ChannelID, sdk.DefaultBondDenom, quota,
+			))
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgRemoveRateLimit(suite.chainA.GetSimApp().TransferKeeper.GetAuthority(), path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+
+			tc.malleate()
+
+			_, err = suite.chainA.GetSimApp().TransferKeeper.RemoveRateLimit(suite.chainA.GetContext(), msg)
+
+			_, found := suite.chainA.GetSimApp().TransferKeeper.GetRateLimit(suite.chainA.GetContext(), path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().True(found)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateDenomMetadata() {
+	var (
+		msg   *types.MsgUpdateDenomMetadata
+		denom types.Denom
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: new token metadata",
+			func() {},
+			nil,
+		},
+		{
+			"success: override recorded token metadata",
+			func() {
+				_, err := suite.chainA.GetSimApp().TransferKeeper.UpdateDenomMetadata(suite.chainA.GetContext(), types.NewMsgUpdateDenomMetadata(msg.Signer, msg.Denom, types.NewTokenMetadata("uatom", "UATOM", 0, "")))
+				suite.Require().NoError(err)
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer address",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: denom not found",
+			func() {
+				msg.Denom = types.NewDenom("uosmo", types.NewHop(ibctesting.TransferPort, ibctesting.FirstChannelID)).IBCDenom()
+			},
+			types.ErrDenomNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			denom = types.NewDenom("uatom", types.NewHop(ibctesting.TransferPort, ibctesting.FirstChannelID))
+			suite.chainA.GetSimApp().TransferKeeper.SetDenom(suite.chainA.GetContext(), denom)
+
+			msg = types.NewMsgUpdateDenomMetadata(suite.chainA.GetSimApp().TransferKeeper.GetAuthority(), denom.IBCDenom(), types.NewTokenMetadata("atom", "ATOM", 6, "https://cosmos.network"))
+
+			tc.malleate()
+
+			_, err := suite.chainA.GetSimApp().TransferKeeper.UpdateDenomMetadata(suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				tokenMetadata, found := suite.chainA.GetSimApp().TransferKeeper.GetTokenMetadata(suite.chainA.GetContext(), msg.Denom)
+				suite.Require().True(found)
+				suite.Require().Equal(msg.Metadata, tokenMetadata)
+
+				metadata, found := suite.chainA.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainA.GetContext(), msg.Denom)
+				suite.Require().True(found)
+				suite.Require().Equal("atom", metadata.Display)
+				suite.Require().Equal("ATOM", metadata.Symbol)
+				suite.Require().Equal("https://cosmos.network", metadata.URI)
+				suite.Require().Equal([]*banktypes.DenomUnit{{Denom: "uatom", Exponent: 0}, {Denom: "atom", Exponent: 6}}, metadata.DenomUnits)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestUnwindHops() {
 	var msg *types.MsgTransfer
 	var path *ibctesting.Path
@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/internal/events"
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/internal/telemetry"
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// sendMultiTransfer handles transfer sending logic for a transfer to multiple receivers. The coins of
+// all the outputs are escrowed or burned as in sendTransfer and sent in a single packet, which lists
+// the tokens to be credited to each of the receivers. Multi transfers are only supported with ics20-2.
+func (k Keeper) sendMultiTransfer(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	sender sdk.AccAddress,
+	outputs []types.MultiTransferOutput,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+	includeDenomMetadata bool,
+) (uint64, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "application version not found for source port: %s and source channel: %s", sourcePort, sourceChannel)
+	}
+
+	// the use of structured error acknowledgements does not change the packet data
+	appVersion, _ = types.ParseVersion(appVersion)
+
+	if appVersion != types.V2 {
+		return 0, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "cannot transfer coins to multiple receivers with %s", appVersion)
+	}
+
+	receivers := make([]types.ReceiverTokens, 0, len(outputs))
+	coins := sdk.NewCoins()
+	for _, output := range outputs {
+		tokens := make(types.Tokens, 0, len(output.Tokens))
+		for _, coin := range output.Tokens {
+			token, err := k.tokenFromCoin(ctx, coin)
+			if err != nil {
+				return 0, err
+			}
+
+			tokens = append(tokens, token)
+		}
+
+		receivers = append(receivers, types.NewReceiverTokens(output.Receiver, tokens))
+		coins = coins.Add(output.Tokens...)
+	}
+
+	tokens, rateLimitedSends, err := k.sendTokens(ctx, sourcePort, sourceChannel, coins, sender, includeDenomMetadata)
+	if err != nil {
+		return 0, err
+	}
+
+	packetData := types.NewFungibleTokenPacketDataV2(tokens, sender.String(), "", memo, types.ForwardingPacketData{})
+	packetData.Receivers = receivers
+
+	if err := packetData.ValidateBasic(); err != nil {
+		return 0, errorsmod.Wrapf(err, "failed to validate %s packet data", types.V2)
+	}
+
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData.GetBytes())
+	if err != nil {
+		return 0, err
+	}
+
+	k.setPendingRateLimitedSends(ctx, rateLimitedSends, sequence)
+
+	for _, receiver := range receivers {
+		events.EmitTransferEvent(ctx, sender.String(), receiver.Receiver, receiver.Tokens, memo, nil)
+	}
+
+	telemetry.ReportTransfer(sourcePort, sourceChannel, channel.Counterparty.PortId, channel.Counterparty.ChannelId, tokens)
+
+	return sequence, nil
+}
+
+// OnRecvMultiTransferPacket processes a cross chain fungible token transfer to multiple receivers.
+//
+// The tokens of each receiver are received as in OnRecvPacket. The tokens of a receiver that cannot
+// be received are not credited, and the failure is reported in the returned acknowledgement so that
+// the sending chain refunds them to the sender. If the tokens of none of the receivers can be received,
+// an error is returned and the whole transfer is refunded.
+func (k Keeper) OnRecvMultiTransferPacket(ctx context.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) (types.MultiTransferAcknowledgement, error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return types.MultiTransferAcknowledgement{}, errorsmod.Wrapf(err, "error validating ICS-20 transfer packet data")
+	}
+
+	if !data.IsMultiTransfer() {
+		return types.MultiTransferAcknowledgement{}, errorsmod.Wrap(types.ErrInvalidMultiTransfer, "packet data has no receivers")
+	}
+
+	if !k.GetParams(ctx).ReceiveEnabled {
+		return types.MultiTransferAcknowledgement{}, types.ErrReceiveDisabled
+	}
+
+	if err := k.validateMemo(ctx, data.Memo); err != nil {
+		return types.MultiTransferAcknowledgement{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var (
+		firstErr error
+		received bool
+		results  = make([]types.ReceiverResult, 0, len(data.Receivers))
+	)
+
+	for _, receiverTokens := range data.Receivers {
+		// the tokens of each receiver are received in a cached context, so that
+		// no state changes are persisted for a receiver whose tokens cannot be received
+		cacheCtx, writeFn := sdkCtx.CacheContext()
+		err := k.receiveMultiTransferTokens(cacheCtx, packet, receiverTokens)
+		if err == nil {
+			writeFn()
+			received = true
+		} else if firstErr == nil {
+			firstErr = err
+		}
+
+		results = append(results, types.NewReceiverResult(receiverTokens.Receiver, err))
+	}
+
+	if !received {
+		return types.MultiTransferAcknowledgement{}, errorsmod.Wrap(firstErr, "tokens could not be received by any of the receivers")
+	}
+
+	telemetry.ReportOnRecvPacket(packet, data.Tokens)
+
+	return types.NewMultiTransferAcknowledgement(results), nil
+}
+
+// receiveMultiTransferTokens receives the tokens of a single receiver of a multi transfer.
+func (k Keeper) receiveMultiTransferTokens(ctx context.Context, packet channeltypes.Packet, receiverTokens types.ReceiverTokens) error {
+	receiver, err := sdk.AccAddressFromBech32(receiverTokens.Receiver)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "failed to decode receiver address %s: %v", receiverTokens.Receiver, err)
+	}
+
+	if k.isBlockedAddr(receiver) {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
+	}
+
+	_, err = k.receiveTokens(ctx, packet, receiverTokens.Tokens, receiver)
+	return err
+}
+
+// refundFailedReceivers refunds to the sender the tokens of the receivers of a multi transfer
+// that could not be credited on the receiving chain, as reported in the acknowledgement result.
+func (k Keeper) refundFailedReceivers(ctx context.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2, result []byte) error {
+	ack, err := types.UnmarshalMultiTransferAcknowledgement(result)
+	if err != nil {
+		return err
+	}
+
+	if len(ack.Results) != len(data.Receivers) {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %d receiver results, got %d", len(data.Receivers), len(ack.Results))
+	}
+
+	var failedTokens []types.Token
+	for i, result := range ack.Results {
+		if !result.Success {
+			failedTokens = append(failedTokens, data.Receivers[i].Tokens...)
+		}
+	}
+
+	if len(failedTokens) == 0 {
+		return nil
+	}
+
+	// the tokens of the same denomination are refunded together
+	refundTokens, err := types.MergeTokens(failedTokens)
+	if err != nil {
+		return err
+	}
+
+	refundData := data
+	refundData.Tokens = refundTokens
+
+	return k.refundPacketTokens(ctx, packet, refundData)
+}
@@ -0,0 +1,235 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func (suite *KeeperTestSuite) TestMultiTransfer() {
+	var (
+		path    *ibctesting.Path
+		outputs []types.MultiTransferOutput
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: send disabled",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, true))
+			},
+			types.ErrSendDisabled,
+		},
+		{
+			"failure: channel version is ics20-1",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.Version = types.V1 })
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: insufficient funds",
+			func() {
+				outputs[0].Tokens = sdk.NewCoins(sdk.NewCoin("randomdenom", defaultAmount))
+			},
+			sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, defaultAmount)
+			outputs = []types.MultiTransferOutput{
+				types.NewMultiTransferOutput(suite.chainB.SenderAccounts[0].SenderAccount.GetAddress().String(), sdk.NewCoins(coin)),
+				types.NewMultiTransferOutput(suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String(), sdk.NewCoins(coin)),
+			}
+
+			tc.malleate()
+
+			msg := types.NewMsgMultiTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				suite.chainA.SenderAccount.GetAddress().String(),
+				outputs,
+				suite.chainB.GetTimeoutHeight(), 0,
+				"",
+			)
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.MultiTransfer(suite.chainA.GetContext(), msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(uint64(1), res.Sequence)
+
+				escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+				suite.Require().Equal(defaultAmount.MulRaw(2), escrowBalance.Amount)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvMultiTransferPacket() {
+	var (
+		path      *ibctesting.Path
+		receivers []string
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expReceived []bool
+	}{
+		{
+			"success: all receivers are credited",
+			func() {},
+			[]bool{true, true},
+		},
+		{
+			"partial success: invalid receiver address is refunded",
+			func() {
+				receivers[1] = "invalid"
+			},
+			[]bool{true, false},
+		},
+		{
+			"partial success: blocked receiver is refunded",
+			func() {
+				receivers[0] = suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(minttypes.ModuleName).String()
+			},
+			[]bool{false, true},
+		},
+		{
+			"failure: no receiver is credited",
+			func() {
+				receivers[0] = "invalid"
+				receivers[1] = "invalid"
+			},
+			[]bool{false, false},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			receivers = []string{
+				suite.chainB.SenderAccounts[0].SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String(),
+			}
+
+			tc.malleate()
+
+			senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+			amounts := []sdkmath.Int{defaultAmount, defaultAmount.MulRaw(2)}
+			outputs := make([]types.MultiTransferOutput, len(receivers))
+			for i, receiver := range receivers {
+				outputs[i] = types.NewMultiTransferOutput(receiver, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amounts[i])))
+			}
+
+			msg := types.NewMsgMultiTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				suite.chainA.SenderAccount.GetAddress().String(),
+				outputs,
+				suite.chainB.GetTimeoutHeight(), 0,
+				"",
+			)
+
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.UpdateClient()
+			suite.Require().NoError(err)
+
+			res, err = path.EndpointB.RecvPacketWithResult(packet)
+			suite.Require().NoError(err)
+
+			ackBz, err := ibctesting.ParseAckFromEvents(res.Events)
+			suite.Require().NoError(err)
+
+			var ack channeltypes.Acknowledgement
+			err = types.ModuleCdc.UnmarshalJSON(ackBz, &ack)
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.AcknowledgePacket(packet, ackBz)
+			suite.Require().NoError(err)
+
+			voucherDenom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)).IBCDenom()
+
+			anyReceived := false
+			refunded := sdkmath.ZeroInt()
+			for i, received := range tc.expReceived {
+				if received {
+					anyReceived = true
+					balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), sdk.MustAccAddressFromBech32(receivers[i]), voucherDenom)
+					suite.Require().Equal(amounts[i], balance.Amount)
+				} else {
+					refunded = refunded.Add(amounts[i])
+				}
+			}
+
+			if anyReceived {
+				suite.Require().True(ack.Success())
+
+				result, err := types.UnmarshalMultiTransferAcknowledgement(ack.GetResult())
+				suite.Require().NoError(err)
+				suite.Require().Len(result.Results, len(receivers))
+
+				for i, received := range tc.expReceived {
+					suite.Require().Equal(receivers[i], result.Results[i].Receiver)
+					suite.Require().Equal(received, result.Results[i].Success)
+				}
+			} else {
+				suite.Require().False(ack.Success())
+
+				totalVouchers := suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), voucherDenom)
+				suite.Require().True(totalVouchers.IsZero())
+			}
+
+			// the tokens of the receivers that could not be credited are refunded to the sender
+			balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+			suite.Require().True(senderBalance.Amount.Sub(amounts[0]).Sub(amounts[1]).Add(refunded).Equal(balance.Amount))
+
+			escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+			suite.Require().True(amounts[0].Add(amounts[1]).Sub(refunded).Equal(escrowBalance.Amount))
+		})
+	}
+}
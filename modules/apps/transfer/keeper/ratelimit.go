@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// sendRateLimited adds the coin to the outflow of the rate limit set for the channel and
+// denomination, if any, and returns an error if the outflow would exceed the quota for the
+// current window. The returned boolean indicates whether a rate limit was applied.
+func (k Keeper) sendRateLimited(ctx context.Context, channelID string, coin sdk.Coin) (types.RateLimit, bool, error) {
+	rateLimit, found := k.getCurrentRateLimit(ctx, channelID, coin.Denom)
+	if !found {
+		return types.RateLimit{}, false, nil
+	}
+
+	outflow := rateLimit.Flow.Outflow.Add(coin.Amount)
+	if limit, capped := rateLimit.SendThreshold(); capped && outflow.GT(limit) {
+		return types.RateLimit{}, false, errorsmod.Wrapf(
+			types.ErrRateLimitExceeded, "outflow of %s on channel %s would be %s, exceeding the quota of %s", coin.Denom, channelID, outflow, limit,
+		)
+	}
+
+	rateLimit.Flow.Outflow = outflow
+	k.setRateLimit(ctx, rateLimit)
+
+	return rateLimit, true, nil
+}
+
+// recvRateLimited adds the coin to the inflow of the rate limit set for the channel and
+// denomination, if any, and returns an error if the inflow would exceed the quota for the
+// current window.
+func (k Keeper) recvRateLimited(ctx context.Context, channelID string, coin sdk.Coin) error {
+	rateLimit, found := k.getCurrentRateLimit(ctx, channelID, coin.Denom)
+	if !found {
+		return nil
+	}
+
+	inflow := rateLimit.Flow.Inflow.Add(coin.Amount)
+	if limit, capped := rateLimit.RecvThreshold(); capped && inflow.GT(limit) {
+		return errorsmod.Wrapf(
+			types.ErrRateLimitExceeded, "inflow of %s on channel %s would be %s, exceeding the quota of %s", coin.Denom, channelID, inflow, limit,
+		)
+	}
+
+	rateLimit.Flow.Inflow = inflow
+	k.setRateLimit(ctx, rateLimit)
+
+	return nil
+}
+
+// revertRateLimitedSend removes the coin from the outflow of the rate limit it was accounted
+// for when the packet was sent. The outflow is only reverted if the packet was sent during
+// the current window, as the flow of previous windows has already been discarded.
+func (k Keeper) revertRateLimitedSend(ctx context.Context, channelID string, sequence uint64, coin sdk.Coin) {
+	pendingSend, found := k.getPendingRateLimitedSend(ctx, channelID, sequence, coin.Denom)
+	if !found {
+		return
+	}
+
+	k.deletePendingRateLimitedSend(ctx, channelID, sequence, coin.Denom)
+
+	rateLimit, found := k.GetRateLimit(ctx, channelID, coin.Denom)
+	if !found || rateLimit.Flow.WindowStartHeight != pendingSend.WindowStartHeight {
+		return
+	}
+
+	rateLimit.Flow.Outflow = sdkmath.MaxInt(rateLimit.Flow.Outflow.Sub(coin.Amount), sdkmath.ZeroInt())
+	k.setRateLimit(ctx, rateLimit)
+}
+
+// getCurrentRateLimit returns the rate limit for the channel and denomination, resetting
+// its flow if the window has expired at the current block height.
+func (k Keeper) getCurrentRateLimit(ctx context.Context, channelID, denom string) (types.RateLimit, bool) {
+	rateLimit, found := k.GetRateLimit(ctx, channelID, denom)
+	if !found {
+		return types.RateLimit{}, false
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	if rateLimit.IsWindowExpired(height) {
+		rateLimit.Flow = types.NewRateLimitFlow(k.bankKeeper.GetSupply(ctx, denom).Amount, height)
+	}
+
+	return rateLimit, true
+}
+
+// clearRateLimitedSends deletes the pending rate limited sends of a packet that has been
+// successfully acknowledged, as its outflow will never be reverted.
+func (k Keeper) clearRateLimitedSends(ctx context.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+	for _, token := range data.Tokens {
+		coin, err := token.ToCoin()
+		if err != nil {
+			return err
+		}
+
+		k.deletePendingRateLimitedSend(ctx, packet.GetSourceChannel(), packet.GetSequence(), coin.Denom)
+	}
+
+	return nil
+}
@@ -0,0 +1,302 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func (suite *KeeperTestSuite) TestSendTransferRateLimited() {
+	var (
+		path *ibctesting.Path
+		coin sdk.Coin
+	)
+
+	setRateLimit := func(quota types.RateLimitQuota) {
+		_, err := suite.chainA.GetSimApp().TransferKeeper.SetRateLimit(suite.chainA.GetContext(), types.NewMsgSetRateLimit(
+			suite.chainA.GetSimApp().TransferKeeper.GetAuthority(), path.EndpointA.ChannelID, sdk.DefaultBondDenom, quota,
+		))
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: outflow within absolute quota",
+			func() {},
+			nil,
+		},
+		{
+			"success: outflow equal to absolute quota",
+			func() {
+				coin = sdk.NewCoin(sdk.DefaultBondDenom, defaultAmount)
+			},
+			nil,
+		},
+		{
+			"success: no rate limit set",
+			func() {
+				_, err := suite.chainA.GetSimApp().TransferKeeper.RemoveRateLimit(suite.chainA.GetContext(), types.NewMsgRemoveRateLimit(
+					suite.chainA.GetSimApp().TransferKeeper.GetAuthority(), path.EndpointA.ChannelID, sdk.DefaultBondDenom,
+				))
+				suite.Require().NoError(err)
+
+				coin = sdk.NewCoin(sdk.DefaultBondDenom, defaultAmount.AddRaw(1))
+			},
+			nil,
+		},
+		{
+			"success: inflow quota does not apply to outflow",
+			func() {
+				setRateLimit(types.NewRateLimitQuota(0, 0, sdkmath.ZeroInt(), sdkmath.NewInt(1), 10))
+				coin = sdk.NewCoin(sdk.DefaultBondDenom, defaultAmount.AddRaw(1))
+			},
+			nil,
+		},
+		{
+			"success: expired window resets the flow",
+			func() {
+				setRateLimit(types.NewRateLimitQuota(0, 0, defaultAmount, sdkmath.ZeroInt(), 1))
+
+				rateLimit, found := suite.chainA.GetSimApp().TransferKeeper.GetRateLimit(suite.chainA.GetContext(), path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+				suite.Require().True(found)
+
+				rateLimit.Flow.Outflow = defaultAmount
+				rateLimit.Flow.WindowStartHeight--
+				suite.chainA.GetSimApp().TransferKeeper.StoreRateLimit(suite.chainA.GetContext(), rateLimit)
+			},
+			nil,
+		},
+		{
+			"failure: outflow exceeds absolute quota",
+			func() {
+				coin = sdk.NewCoin(sdk.DefaultBondDenom, defaultAmount.AddRaw(1))
+			},
+			types.ErrRateLimitExceeded,
+		},
+		{
+			"failure: outflow exceeds absolute quota together with previous outflow",
+			func() {
+				rateLimit, found := suite.chainA.GetSimApp().TransferKeeper.GetRateLimit(suite.chainA.GetContext(), path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+				suite.Require().True(found)
+
+				rateLimit.Flow.Outflow = defaultAmount
+				suite.chainA.GetSimApp().TransferKeeper.StoreRateLimit(suite.chainA.GetContext(), rateLimit)
+			},
+			types.ErrRateLimitExceeded,
+		},
+		{
+			"failure: outflow exceeds percentage quota",
+			func() {
+				setRateLimit(types.NewRateLimitQuota(1, 0, sdkmath.ZeroInt(), sdkmath.ZeroInt(), 10))
+
+				supply := suite.chainA.GetSimApp().BankKeeper.GetSupply(suite.chainA.GetContext(), sdk.DefaultBondDenom).Amount
+				coin = sdk.NewCoin(sdk.DefaultBondDenom, supply.QuoRaw(100).AddRaw(1))
+			},
+			types.ErrRateLimitExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			setRateLimit(types.NewRateLimitQuota(0, 0, defaultAmount, sdkmath.ZeroInt(), 10))
+			coin = sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1))
+
+			tc.malleate()
+
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				sdk.NewCoins(coin),
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0,
+				"",
+				nil,
+			)
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(suite.chainA.GetContext(), msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				rateLimit, found := suite.chainA.GetSimApp().TransferKeeper.GetRateLimit(suite.chainA.GetContext(), path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+				if found {
+					suite.Require().Equal(coin.Amount, rateLimit.Flow.Outflow)
+
+					pendingSend, found := suite.chainA.GetSimApp().TransferKeeper.GetPendingRateLimitedSend(suite.chainA.GetContext(), path.EndpointA.ChannelID, res.Sequence, sdk.DefaultBondDenom)
+					suite.Require().True(found)
+					suite.Require().Equal(rateLimit.Flow.WindowStartHeight, pendingSend.WindowStartHeight)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundRevertsRateLimitedSend() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expOutflow sdkmath.Int
+	}{
+		{
+			"outflow is reverted",
+			func() {},
+			sdkmath.ZeroInt(),
+		},
+		{
+			"outflow is not reverted if the window has rolled over",
+			func() {
+				rateLimit, found := suite.chainA.GetSimApp().TransferKeeper.GetRateLimit(suite.chainA.GetContext(), path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+				suite.Require().True(found)
+
+				rateLimit.Flow.WindowStartHeight++
+				suite.chainA.GetSimApp().TransferKeeper.StoreRateLimit(suite.chainA.GetContext(), rateLimit)
+			},
+			defaultAmount,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			quota := types.NewRateLimitQuota(0, 0, defaultAmount, sdkmath.ZeroInt(), 1000)
+			_, err := suite.chainA.GetSimApp().TransferKeeper.SetRateLimit(suite.chainA.GetContext(), types.NewMsgSetRateLimit(
+				suite.chainA.GetSimApp().TransferKeeper.GetAuthority(), path.EndpointA.ChannelID, sdk.DefaultBondDenom, quota,
+			))
+			suite.Require().NoError(err)
+
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, defaultAmount)
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				sdk.NewCoins(coin),
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0,
+				"",
+				nil,
+			)
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(suite.chainA.GetContext(), msg)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			data := types.NewFungibleTokenPacketDataV2(
+				[]types.Token{{Denom: types.NewDenom(sdk.DefaultBondDenom), Amount: defaultAmount.String()}},
+				suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "", ibctesting.EmptyForwardingPacketData,
+			)
+			packet := channeltypes.NewPacket(data.GetBytes(), res.Sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+			err = suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
+			suite.Require().NoError(err)
+
+			rateLimit, found := suite.chainA.GetSimApp().TransferKeeper.GetRateLimit(suite.chainA.GetContext(), path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expOutflow, rateLimit.Flow.Outflow)
+
+			_, found = suite.chainA.GetSimApp().TransferKeeper.GetPendingRateLimitedSend(suite.chainA.GetContext(), path.EndpointA.ChannelID, res.Sequence, sdk.DefaultBondDenom)
+			suite.Require().False(found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketRateLimited() {
+	var (
+		path  *ibctesting.Path
+		quota types.RateLimitQuota
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: inflow within quota",
+			func() {},
+			nil,
+		},
+		{
+			"success: outflow quota does not apply to inflow",
+			func() {
+				quota = types.NewRateLimitQuota(0, 0, sdkmath.NewInt(1), sdkmath.ZeroInt(), 10)
+			},
+			nil,
+		},
+		{
+			"failure: inflow exceeds quota",
+			func() {
+				quota.MaxAmountRecv = defaultAmount.SubRaw(1)
+			},
+			types.ErrRateLimitExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			quota = types.NewRateLimitQuota(0, 0, sdkmath.ZeroInt(), defaultAmount, 10)
+
+			tc.malleate()
+
+			denom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+			suite.chainB.GetSimApp().TransferKeeper.StoreRateLimit(suite.chainB.GetContext(), types.NewRateLimit(
+				path.EndpointB.ChannelID, denom.IBCDenom(), quota, types.NewRateLimitFlow(sdkmath.ZeroInt(), uint64(suite.chainB.GetContext().BlockHeight())),
+			))
+
+			data := types.NewFungibleTokenPacketDataV2(
+				[]types.Token{{Denom: types.NewDenom(sdk.DefaultBondDenom), Amount: defaultAmount.String()}},
+				suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "", ibctesting.EmptyForwardingPacketData,
+			)
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, suite.chainB.GetTimeoutHeight(), 0)
+
+			err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+
+			rateLimit, found := suite.chainB.GetSimApp().TransferKeeper.GetRateLimit(suite.chainB.GetContext(), path.EndpointB.ChannelID, denom.IBCDenom())
+			suite.Require().True(found)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(defaultAmount, rateLimit.Flow.Inflow)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().True(rateLimit.Flow.Inflow.IsZero(), fmt.Sprintf("expected no inflow, got %s", rateLimit.Flow.Inflow))
+			}
+		})
+	}
+}
@@ -61,7 +61,9 @@ func (k Keeper) sendTransfer(
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
 	memo string,
-	hops []types.Hop,
+	forwarding *types.Forwarding,
+	claimTimeout uint64,
+	includeDenomMetadata bool,
 ) (uint64, error) {
 	channel, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
 	if !found {
@@ -73,6 +75,9 @@ func (k Keeper) sendTransfer(
 		return 0, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "application version not found for source port: %s and source channel: %s", sourcePort, sourceChannel)
 	}
 
+	// the use of structured error acknowledgements does not change the packet data
+	appVersion, _ = types.ParseVersion(appVersion)
+
 	if appVersion == types.V1 {
 		// ics20-1 only supports a single coin, so if that is the current version, we must only process a single coin.
 		if len(coins) > 1 {
@@ -80,9 +85,14 @@ func (k Keeper) sendTransfer(
 		}
 
 		// ics20-1 does not support forwarding, so if that is the current version, we must reject the transfer.
-		if len(hops) > 0 {
+		if len(forwarding.GetHops()) > 0 {
 			return 0, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "cannot forward coins with %s", types.V1)
 		}
+
+		// ics20-1 does not support claimable transfers, so if that is the current version, we must reject the transfer.
+		if claimTimeout > 0 {
+			return 0, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "cannot send claimable transfer with %s", types.V1)
+		}
 	}
 
 	destinationPort := channel.Counterparty.PortId
@@ -91,7 +101,46 @@ func (k Keeper) sendTransfer(
 	// begin createOutgoingPacket logic
 	// See spec for this logic: https://github.com/cosmos/ibc/tree/master/spec/app/ics-020-fungible-token-transfer#packet-relay
 
-	tokens := make([]types.Token, 0, len(coins))
+	tokens, rateLimitedSends, err := k.sendTokens(ctx, sourcePort, sourceChannel, coins, sender, includeDenomMetadata)
+	if err != nil {
+		return 0, err
+	}
+
+	packetDataBytes, err := createPacketDataBytesFromVersion(appVersion, sender.String(), receiver, memo, tokens, forwarding, claimTimeout)
+	if err != nil {
+		return 0, err
+	}
+
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetDataBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	k.setPendingRateLimitedSends(ctx, rateLimitedSends, sequence)
+
+	events.EmitTransferEvent(ctx, sender.String(), receiver, tokens, memo, forwarding.GetHops())
+
+	telemetry.ReportTransfer(sourcePort, sourceChannel, destinationPort, destinationChannel, tokens)
+
+	return sequence, nil
+}
+
+// sendTokens escrows or burns the coins of the sender to be sent on the source port and channel,
+// according to whether the sender chain is acting as the source or the sink zone of each of them.
+// It returns the tokens to be set in the packet data and the rate limited sends to be stored
+// once the packet has been sent. The bank metadata of native tokens is only set in the returned
+// tokens if includeDenomMetadata is true.
+func (k Keeper) sendTokens(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	coins sdk.Coins,
+	sender sdk.AccAddress,
+	includeDenomMetadata bool,
+) (types.Tokens, []types.PendingRateLimitedSend, error) {
+	params := k.GetParams(ctx)
+	tokens := make(types.Tokens, 0, len(coins))
+	var rateLimitedSends []types.PendingRateLimitedSend
 
 	for _, coin := range coins {
 		// Using types.UnboundedSpendLimit allows us to send the entire balance of a given denom.
@@ -101,7 +150,26 @@ func (k Keeper) sendTransfer(
 
 		token, err := k.tokenFromCoin(ctx, coin)
 		if err != nil {
-			return 0, err
+			return nil, nil, err
+		}
+
+		// if requested by the sender, the display metadata of tokens native to this chain is sent along,
+		// so that the receiving chain can record it
+		if includeDenomMetadata && token.Denom.IsNative() {
+			token.Metadata = k.tokenMetadataFromBank(ctx, coin.Denom)
+		}
+
+		if !params.IsSendAllowed(sourceChannel, token.Denom) {
+			return nil, nil, errorsmod.Wrapf(types.ErrDenomNotAllowed, "%s cannot be sent on channel %s", token.Denom.Path(), sourceChannel)
+		}
+
+		rateLimit, isRateLimited, err := k.sendRateLimited(ctx, sourceChannel, coin)
+		if err != nil {
+			return nil, nil, err
+		}
+		if isRateLimited {
+			// the packet sequence is set once the packet has been sent
+			rateLimitedSends = append(rateLimitedSends, types.NewPendingRateLimitedSend(sourceChannel, coin.Denom, 0, rateLimit.Flow.WindowStartHeight))
 		}
 
 		// NOTE: SendTransfer simply sends the denomination as it exists on its own
@@ -115,7 +183,7 @@ func (k Keeper) sendTransfer(
 			if err := k.bankKeeper.SendCoinsFromAccountToModule(
 				ctx, sender, types.ModuleName, sdk.NewCoins(coin),
 			); err != nil {
-				return 0, err
+				return nil, nil, err
 			}
 
 			if err := k.bankKeeper.BurnCoins(
@@ -130,28 +198,22 @@ func (k Keeper) sendTransfer(
 			// obtain the escrow address for the source channel end
 			escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
 			if err := k.escrowCoin(ctx, sender, escrowAddress, coin); err != nil {
-				return 0, err
+				return nil, nil, err
 			}
 		}
 
 		tokens = append(tokens, token)
 	}
 
-	packetDataBytes, err := createPacketDataBytesFromVersion(appVersion, sender.String(), receiver, memo, tokens, hops)
-	if err != nil {
-		return 0, err
-	}
+	return tokens, rateLimitedSends, nil
+}
 
-	sequence, err := k.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetDataBytes)
-	if err != nil {
-		return 0, err
+// setPendingRateLimitedSends stores the rate limited sends of the packet sent with the given sequence.
+func (k Keeper) setPendingRateLimitedSends(ctx context.Context, rateLimitedSends []types.PendingRateLimitedSend, sequence uint64) {
+	for _, pendingSend := range rateLimitedSends {
+		pendingSend.Sequence = sequence
+		k.setPendingRateLimitedSend(ctx, pendingSend)
 	}
-
-	events.EmitTransferEvent(ctx, sender.String(), receiver, tokens, memo, hops)
-
-	telemetry.ReportTransfer(sourcePort, sourceChannel, destinationPort, destinationChannel, tokens)
-
-	return sequence, nil
 }
 
 // OnRecvPacket processes a cross chain fungible token transfer.
@@ -173,6 +235,10 @@ func (k Keeper) OnRecvPacket(ctx context.Context, packet channeltypes.Packet, da
 		return types.ErrReceiveDisabled
 	}
 
+	if err := k.validateMemo(ctx, data.Memo); err != nil {
+		return err
+	}
+
 	receiver, err := k.getReceiverFromPacketData(data)
 	if err != nil {
 		return err
@@ -182,12 +248,47 @@ func (k Keeper) OnRecvPacket(ctx context.Context, packet channeltypes.Packet, da
 		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
 	}
 
-	receivedCoins := make(sdk.Coins, 0, len(data.Tokens))
-	for _, token := range data.Tokens {
+	// the tokens of a claimable transfer are held by the module account until the receiver claims them
+	recipient := receiver
+	if data.IsClaimable() {
+		recipient = k.authKeeper.GetModuleAddress(types.ModuleName)
+	}
+
+	receivedCoins, err := k.receiveTokens(ctx, packet, data.Tokens, recipient)
+	if err != nil {
+		return err
+	}
+
+	if data.HasForwarding() {
+		// we are now sending from the forward escrow address to the final receiver address.
+		if _, err := k.forwardPacket(ctx, data, packet, receivedCoins); err != nil {
+			return err
+		}
+	}
+
+	if data.IsClaimable() {
+		k.holdClaimableTransfer(ctx, packet, data, receivedCoins)
+	}
+
+	telemetry.ReportOnRecvPacket(packet, data.Tokens)
+
+	// The ibc_module.go module will return the proper ack.
+	return nil
+}
+
+// receiveTokens unescrows or mints the tokens received in the packet and sends them to the
+// recipient, according to whether the sender chain is acting as the source or the sink zone
+// of each of them. It returns the coins received.
+func (k Keeper) receiveTokens(ctx context.Context, packet channeltypes.Packet, tokens types.Tokens, recipient sdk.AccAddress) (sdk.Coins, error) {
+	params := k.GetParams(ctx)
+	moduleAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
+
+	receivedCoins := make(sdk.Coins, 0, len(tokens))
+	for _, token := range tokens {
 		// parse the transfer amount
 		transferAmount, ok := sdkmath.NewIntFromString(token.Amount)
 		if !ok {
-			return errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount: %s", token.Amount)
+			return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount: %s", token.Amount)
 		}
 
 		// This is the prefix that would have been prefixed to the denomination
@@ -203,11 +304,19 @@ func (k Keeper) OnRecvPacket(ctx context.Context, packet channeltypes.Packet, da
 			// remove prefix added by sender chain
 			token.Denom.Trace = token.Denom.Trace[1:]
 
+			if !params.IsReceiveAllowed(packet.GetDestChannel(), token.Denom) {
+				return nil, errorsmod.Wrapf(types.ErrDenomNotAllowed, "%s cannot be received on channel %s", token.Denom.Path(), packet.GetDestChannel())
+			}
+
 			coin := sdk.NewCoin(token.Denom.IBCDenom(), transferAmount)
 
+			if err := k.recvRateLimited(ctx, packet.GetDestChannel(), coin); err != nil {
+				return nil, err
+			}
+
 			escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
-			if err := k.unescrowCoin(ctx, escrowAddress, receiver, coin); err != nil {
-				return err
+			if err := k.unescrowCoin(ctx, escrowAddress, recipient, coin); err != nil {
+				return nil, err
 			}
 
 			// Appending token. The new denom has been computed
@@ -219,12 +328,23 @@ func (k Keeper) OnRecvPacket(ctx context.Context, packet channeltypes.Packet, da
 			trace := []types.Hop{types.NewHop(packet.DestinationPort, packet.DestinationChannel)}
 			token.Denom.Trace = append(trace, token.Denom.Trace...)
 
+			if !params.IsReceiveAllowed(packet.GetDestChannel(), token.Denom) {
+				return nil, errorsmod.Wrapf(types.ErrDenomNotAllowed, "%s cannot be received on channel %s", token.Denom.Path(), packet.GetDestChannel())
+			}
+
 			if !k.HasDenom(ctx, token.Denom.Hash()) {
 				k.SetDenom(ctx, token.Denom)
 			}
 
 			voucherDenom := token.Denom.IBCDenom()
-			if !k.bankKeeper.HasDenomMetaData(ctx, voucherDenom) {
+			if _, found := k.GetTokenMetadata(ctx, voucherDenom); token.Metadata != nil && !found {
+				// the token metadata of the source chain is only recorded on first receipt, after
+				// which it can only be overridden by governance
+				k.setTokenMetadata(ctx, voucherDenom, *token.Metadata)
+				k.setDenomMetadata(ctx, token.Denom)
+
+				events.EmitDenomMetadataEvent(ctx, voucherDenom, *token.Metadata)
+			} else if !k.bankKeeper.HasDenomMetaData(ctx, voucherDenom) {
 				k.setDenomMetadata(ctx, token.Denom)
 			}
 
@@ -232,36 +352,31 @@ func (k Keeper) OnRecvPacket(ctx context.Context, packet channeltypes.Packet, da
 
 			voucher := sdk.NewCoin(voucherDenom, transferAmount)
 
+			if err := k.recvRateLimited(ctx, packet.GetDestChannel(), voucher); err != nil {
+				return nil, err
+			}
+
 			// mint new tokens if the source of the transfer is the same chain
 			if err := k.bankKeeper.MintCoins(
 				ctx, types.ModuleName, sdk.NewCoins(voucher),
 			); err != nil {
-				return errorsmod.Wrap(err, "failed to mint IBC tokens")
+				return nil, errorsmod.Wrap(err, "failed to mint IBC tokens")
 			}
 
 			// send to receiver
-			moduleAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
-			if err := k.bankKeeper.SendCoins(
-				ctx, moduleAddr, receiver, sdk.NewCoins(voucher),
-			); err != nil {
-				return errorsmod.Wrapf(err, "failed to send coins to receiver %s", receiver.String())
+			if !recipient.Equals(moduleAddr) {
+				if err := k.bankKeeper.SendCoins(
+					ctx, moduleAddr, recipient, sdk.NewCoins(voucher),
+				); err != nil {
+					return nil, errorsmod.Wrapf(err, "failed to send coins to receiver %s", recipient.String())
+				}
 			}
 
 			receivedCoins = append(receivedCoins, voucher)
 		}
 	}
 
-	if data.HasForwarding() {
-		// we are now sending from the forward escrow address to the final receiver address.
-		if err := k.forwardPacket(ctx, data, packet, receivedCoins); err != nil {
-			return err
-		}
-	}
-
-	telemetry.ReportOnRecvPacket(packet, data.Tokens)
-
-	// The ibc_module.go module will return the proper ack.
-	return nil
+	return receivedCoins, nil
 }
 
 // OnAcknowledgementPacket responds to the success or failure of a packet acknowledgment
@@ -278,6 +393,20 @@ func (k Keeper) OnAcknowledgementPacket(ctx context.Context, packet channeltypes
 
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Result:
+		// the tokens of the receivers of a multi transfer that could not be credited are refunded
+		if data.IsMultiTransfer() {
+			if err := k.refundFailedReceivers(ctx, packet, data, ack.GetResult()); err != nil {
+				return err
+			}
+		}
+
+		if err := k.clearRateLimitedSends(ctx, packet, data); err != nil {
+			return err
+		}
+
+		// the tokens of a returned claim have been received by the sender
+		k.deleteReturnedClaim(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
 		if isForwarded {
 			// Write a successful async ack for the forwardedPacket
 			forwardAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
@@ -292,6 +421,10 @@ func (k Keeper) OnAcknowledgementPacket(ctx context.Context, packet channeltypes
 		if err := k.refundPacketTokens(ctx, packet, data); err != nil {
 			return err
 		}
+
+		// the refunded tokens of a returned claim can be claimed again by the receiver
+		k.restoreReturnedClaim(ctx, packet)
+
 		if isForwarded {
 			// the forwarded packet has failed, thus the funds have been refunded to the intermediate address.
 			// we must revert the changes that came from successfully receiving the tokens on our chain
@@ -315,15 +448,27 @@ func (k Keeper) OnAcknowledgementPacket(ctx context.Context, packet channeltypes
 // If no forwarding occurs, it refunds the tokens to the sender.
 //
 // If forwarding is used and the chain acted as a middle hop on a multihop transfer, after refunding
-// the tokens to the sender, the tokens of the forwarded packet that were received are in turn
-// either refunded or burned.
+// the tokens to the sender, the tokens are sent again on the same hop if the forwarding packet data
+// allows further retries and the channel is still open. Otherwise, the tokens of the forwarded packet
+// that were received are in turn either refunded or burned.
+//
+// On ORDERED channels a timeout closes the channel, after which the tokens of the remaining in-flight
+// packets are refunded in the same way when their timeout on close is processed.
 func (k Keeper) OnTimeoutPacket(ctx context.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
 	if err := k.refundPacketTokens(ctx, packet, data); err != nil {
 		return err
 	}
 
+	// the refunded tokens of a returned claim can be claimed again by the receiver
+	k.restoreReturnedClaim(ctx, packet)
+
 	forwardedPacket, isForwarded := k.getForwardedPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
 	if isForwarded {
+		// the refunded tokens are sent again on the same hop if retries are left
+		if k.retryForwardedPacket(ctx, forwardedPacket, packet, data) {
+			return nil
+		}
+
 		if err := k.revertForwardedPacket(ctx, forwardedPacket, data); err != nil {
 			return err
 		}
@@ -360,6 +505,8 @@ func (k Keeper) refundPacketTokens(ctx context.Context, packet channeltypes.Pack
 			return err
 		}
 
+		k.revertRateLimitedSend(ctx, packet.GetSourceChannel(), packet.GetSequence(), coin)
+
 		// if the token we must refund is prefixed by the source port and channel
 		// then the tokens were burnt when the packet was sent and we must mint new tokens
 		if token.Denom.HasPrefix(packet.GetSourcePort(), packet.GetSourceChannel()) {
@@ -448,7 +595,7 @@ func (k Keeper) tokenFromCoin(ctx sdk.Context, coin sdk.Coin) (types.Token, erro
 }
 
 // createPacketDataBytesFromVersion creates the packet data bytes to be sent based on the application version.
-func createPacketDataBytesFromVersion(appVersion, sender, receiver, memo string, tokens types.Tokens, hops []types.Hop) ([]byte, error) {
+func createPacketDataBytesFromVersion(appVersion, sender, receiver, memo string, tokens types.Tokens, forwarding *types.Forwarding, claimTimeout uint64) ([]byte, error) {
 	switch appVersion {
 	case types.V1:
 		// Sanity check, tokens must always be of length 1 if using app version V1.
@@ -456,6 +603,11 @@ func createPacketDataBytesFromVersion(appVersion, sender, receiver, memo string,
 			return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "cannot transfer multiple coins with %s", types.V1)
 		}
 
+		// Sanity check, claimable transfers are not supported with app version V1.
+		if claimTimeout > 0 {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "cannot send claimable transfer with %s", types.V1)
+		}
+
 		token := tokens[0]
 		packetData := types.NewFungibleTokenPacketData(token.Denom.Path(), token.Amount, sender, receiver, memo)
 
@@ -467,12 +619,15 @@ func createPacketDataBytesFromVersion(appVersion, sender, receiver, memo string,
 	case types.V2:
 		// If forwarding is needed, move memo to forwarding packet data and set packet.Memo to empty string.
 		var forwardingPacketData types.ForwardingPacketData
-		if len(hops) > 0 {
-			forwardingPacketData = types.NewForwardingPacketData(memo, hops...)
+		if len(forwarding.GetHops()) > 0 {
+			forwardingPacketData = types.NewForwardingPacketData(memo, forwarding.Hops...)
+			forwardingPacketData.HopTimeouts = forwarding.HopTimeouts
+			forwardingPacketData.MaxRetries = forwarding.MaxRetries
 			memo = ""
 		}
 
 		packetData := types.NewFungibleTokenPacketDataV2(tokens, sender, receiver, memo, forwardingPacketData)
+		packetData.ClaimTimeout = claimTimeout
 
 		if err := packetData.ValidateBasic(); err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to validate %s packet data", types.V2)
@@ -124,6 +124,18 @@ func (suite *ForwardingTestSuite) TestStoredForwardedPacketAndEscrowAfterFirstHo
 	// denom path: transfer/channel-0
 	denom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID))
 	suite.assertAmountOnChain(suite.chainB, escrow, amount, denom.IBCDenom())
+
+	// the in-flight forward can be queried on B
+	res, err := suite.chainB.GetSimApp().TransferKeeper.ForwardedPacket(suite.chainB.GetContext(), &types.QueryForwardedPacketRequest{
+		PortId:    pathBtoC.EndpointA.ChannelConfig.PortID,
+		ChannelId: pathBtoC.EndpointA.ChannelID,
+		Sequence:  packet.Sequence,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(packet, res.ForwardedPacket.Packet)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom.IBCDenom(), amount)), res.ForwardedPacket.Tokens)
+	suite.Require().NotZero(res.ForwardedPacket.ForwardedAt)
+	suite.Require().Equal(uint64(suite.chainB.GetContext().BlockTime().UnixNano())-res.ForwardedPacket.ForwardedAt, res.ForwardedPacket.Age)
 }
 
 // TestSuccessfulForward tests a successful transfer from A to C through B.
@@ -1157,6 +1169,158 @@ func (suite *ForwardingTestSuite) TestOnTimeoutPacketForwarding() {
 	suite.assertAmountOnChain(suite.chainA, balance, originalABalance.Amount, coin.Denom)
 }
 
+// TestOnTimeoutPacketForwardingRetry tests that a packet that times out on a forwarding hop is sent again
+// using the hop timeout until the maximum number of retries is reached, after which the tokens are refunded.
+func (suite *ForwardingTestSuite) TestOnTimeoutPacketForwardingRetry() {
+	pathAtoB, pathBtoC := suite.setupForwardingPaths()
+
+	amount := sdkmath.NewInt(100)
+	coin := ibctesting.TestCoin
+	sender := suite.chainA.SenderAccounts[0].SenderAccount
+	receiver := suite.chainC.SenderAccounts[0].SenderAccount
+
+	denomAB := types.NewDenom(coin.Denom, types.NewHop(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID))
+
+	timeoutTimestamp := uint64(suite.chainA.GetContext().BlockTime().Add(time.Minute * 5).UnixNano())
+	forwarding := types.NewForwarding(false, types.NewHop(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID)).
+		WithHopTimeouts(types.NewRelativeHopTimeout(uint64(time.Hour))).
+		WithMaxRetries(1)
+
+	transferMsg := types.NewMsgTransfer(
+		pathAtoB.EndpointA.ChannelConfig.PortID,
+		pathAtoB.EndpointA.ChannelID,
+		sdk.NewCoins(coin),
+		sender.GetAddress().String(),
+		receiver.GetAddress().String(),
+		clienttypes.ZeroHeight(),
+		timeoutTimestamp,
+		"",
+		forwarding,
+	)
+
+	result, err := suite.chainA.SendMsgs(transferMsg)
+	suite.Require().NoError(err) // message committed
+
+	packetFromAtoB, err := ibctesting.ParsePacketFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	err = pathAtoB.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	result, err = pathAtoB.EndpointB.RecvPacketWithResult(packetFromAtoB)
+	suite.Require().NoError(err)
+
+	packetFromBtoC, err := ibctesting.ParsePacketFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	// the packet sent on the hop uses the relative hop timeout instead of the timeout of the received packet
+	suite.Require().Greater(packetFromBtoC.TimeoutTimestamp, timeoutTimestamp)
+
+	cbs, ok := suite.chainB.App.GetIBCKeeper().PortKeeper.Route(pathBtoC.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(ok)
+
+	// the first timeout sends the packet again on the same hop
+	err = cbs.OnTimeoutPacket(suite.chainB.GetContext(), pathBtoC.EndpointA.GetChannel().Version, packetFromBtoC, nil)
+	suite.Require().NoError(err)
+
+	_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID, packetFromAtoB.Sequence)
+	suite.Require().False(found, "chainB should not have written an ack")
+
+	_, found = suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacket(suite.chainB.GetContext(), packetFromBtoC.SourcePort, packetFromBtoC.SourceChannel, packetFromBtoC.Sequence)
+	suite.Require().False(found, "timed out forwarded packet should have been deleted")
+
+	retriedPacket := packetFromBtoC
+	retriedPacket.Sequence++
+
+	forwardedPacket, found := suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacket(suite.chainB.GetContext(), retriedPacket.SourcePort, retriedPacket.SourceChannel, retriedPacket.Sequence)
+	suite.Require().True(found, "retried packet should have been stored as forwarded packet")
+	suite.Require().Equal(packetFromAtoB, forwardedPacket)
+	suite.Require().Equal(uint64(1), suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacketRetries(suite.chainB.GetContext(), retriedPacket.SourcePort, retriedPacket.SourceChannel, retriedPacket.Sequence))
+
+	suite.assertAmountOnChain(suite.chainB, escrow, amount, denomAB.IBCDenom())
+
+	// the second timeout exceeds the maximum number of retries and writes the timeout ack
+	err = cbs.OnTimeoutPacket(suite.chainB.GetContext(), pathBtoC.EndpointA.GetChannel().Version, retriedPacket, nil)
+	suite.Require().NoError(err)
+
+	storedAck, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID, packetFromAtoB.Sequence)
+	suite.Require().True(found, "chainB does not have an ack")
+
+	ack := internaltypes.NewForwardTimeoutAcknowledgement(retriedPacket)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(ack.Acknowledgement()), storedAck)
+
+	_, found = suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacket(suite.chainB.GetContext(), retriedPacket.SourcePort, retriedPacket.SourceChannel, retriedPacket.Sequence)
+	suite.Require().False(found)
+	suite.Require().Zero(suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacketRetries(suite.chainB.GetContext(), retriedPacket.SourcePort, retriedPacket.SourceChannel, retriedPacket.Sequence))
+
+	suite.assertAmountOnChain(suite.chainB, escrow, sdkmath.ZeroInt(), denomAB.IBCDenom())
+}
+
+// TestOnTimeoutPacketForwardingNoRetryOnClosedChannel tests that a packet that times out on a forwarding
+// hop is not sent again if the channel of the hop has been closed, which is the case for ORDERED channels.
+func (suite *ForwardingTestSuite) TestOnTimeoutPacketForwardingNoRetryOnClosedChannel() {
+	pathAtoB, pathBtoC := suite.setupForwardingPaths()
+
+	coin := ibctesting.TestCoin
+	sender := suite.chainA.SenderAccounts[0].SenderAccount
+	receiver := suite.chainC.SenderAccounts[0].SenderAccount
+
+	denomAB := types.NewDenom(coin.Denom, types.NewHop(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID))
+
+	forwarding := types.NewForwarding(false, types.NewHop(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID)).
+		WithHopTimeouts(types.NewRelativeHopTimeout(uint64(time.Hour))).
+		WithMaxRetries(1)
+
+	transferMsg := types.NewMsgTransfer(
+		pathAtoB.EndpointA.ChannelConfig.PortID,
+		pathAtoB.EndpointA.ChannelID,
+		sdk.NewCoins(coin),
+		sender.GetAddress().String(),
+		receiver.GetAddress().String(),
+		clienttypes.ZeroHeight(),
+		suite.chainA.GetTimeoutTimestamp(),
+		"",
+		forwarding,
+	)
+
+	result, err := suite.chainA.SendMsgs(transferMsg)
+	suite.Require().NoError(err) // message committed
+
+	packetFromAtoB, err := ibctesting.ParsePacketFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	err = pathAtoB.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	result, err = pathAtoB.EndpointB.RecvPacketWithResult(packetFromAtoB)
+	suite.Require().NoError(err)
+
+	packetFromBtoC, err := ibctesting.ParsePacketFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	// a timeout on an ORDERED channel closes the channel before the application callback is executed
+	err = pathBtoC.EndpointA.SetChannelState(channeltypes.CLOSED)
+	suite.Require().NoError(err)
+
+	cbs, ok := suite.chainB.App.GetIBCKeeper().PortKeeper.Route(pathBtoC.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(ok)
+
+	err = cbs.OnTimeoutPacket(suite.chainB.GetContext(), pathBtoC.EndpointA.GetChannel().Version, packetFromBtoC, nil)
+	suite.Require().NoError(err)
+
+	// the retries left are not used and the timeout ack is written
+	storedAck, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID, packetFromAtoB.Sequence)
+	suite.Require().True(found, "chainB does not have an ack")
+
+	ack := internaltypes.NewForwardTimeoutAcknowledgement(packetFromBtoC)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(ack.Acknowledgement()), storedAck)
+
+	_, found = suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacket(suite.chainB.GetContext(), packetFromBtoC.SourcePort, packetFromBtoC.SourceChannel, packetFromBtoC.Sequence)
+	suite.Require().False(found)
+
+	suite.assertAmountOnChain(suite.chainB, escrow, sdkmath.ZeroInt(), denomAB.IBCDenom())
+}
+
 // TestForwardingWithMoreThanOneHop tests the scenario in which we
 // forward with more than one forwarding hop.
 func (suite *ForwardingTestSuite) TestForwardingWithMoreThanOneHop() {
@@ -101,6 +101,17 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 			},
 			nil,
 		},
+		{
+			"successful transfer of native token with ics20-1 and structured errors",
+			func() {
+				coins = sdk.NewCoins(coins[0])
+
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) {
+					channel.Version = channeltypes.NewStructuredErrorsVersion(types.V1)
+				})
+			},
+			nil,
+		},
 		{
 			"successful transfer with empty forwarding hops and ics20-1",
 			func() {
@@ -123,6 +134,62 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 			},
 			nil,
 		},
+		{
+			"successful transfer with send policy on another channel",
+			func() {
+				params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+				params.SendPolicies = []types.DenomPolicy{types.NewDenomPolicy(ibctesting.InvalidID, types.DENYLIST, sdk.DefaultBondDenom)}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			nil,
+		},
+		{
+			"successful transfer of IBC token in send allowlist by path",
+			func() {
+				denom := types.NewDenom(ibctesting.TestCoin.Denom, types.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				coins = sdk.NewCoins(sdk.NewCoin(denom.IBCDenom(), ibctesting.TestCoin.Amount))
+				expEscrowAmounts = []sdkmath.Int{zeroAmount}
+
+				params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+				params.SendPolicies = []types.DenomPolicy{types.NewDenomPolicy(path.EndpointA.ChannelID, types.ALLOWLIST, denom.Path())}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			nil,
+		},
+		{
+			"failure: native token denied by send policy",
+			func() {
+				coins = sdk.NewCoins(ibctesting.SecondaryTestCoin)
+
+				params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+				params.SendPolicies = []types.DenomPolicy{types.NewDenomPolicy("", types.DENYLIST, ibctesting.SecondaryDenom)}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			types.ErrDenomNotAllowed,
+		},
+		{
+			"failure: IBC token denied by send policy by hash",
+			func() {
+				denom := types.NewDenom(ibctesting.TestCoin.Denom, types.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				coins = sdk.NewCoins(sdk.NewCoin(denom.IBCDenom(), ibctesting.TestCoin.Amount))
+
+				params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+				params.SendPolicies = []types.DenomPolicy{types.NewDenomPolicy(path.EndpointA.ChannelID, types.DENYLIST, denom.Hash().String())}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			types.ErrDenomNotAllowed,
+		},
+		{
+			"failure: native token not in send allowlist",
+			func() {
+				coins = sdk.NewCoins(ibctesting.SecondaryTestCoin)
+
+				params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+				params.SendPolicies = []types.DenomPolicy{types.NewDenomPolicy(path.EndpointA.ChannelID, types.ALLOWLIST, sdk.DefaultBondDenom)}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			types.ErrDenomNotAllowed,
+		},
 		{
 			"failure: source channel not found",
 			func() {
@@ -323,7 +390,10 @@ func (suite *KeeperTestSuite) TestSendTransferSetsTotalEscrowAmountForSourceIBCT
 // loop since setup is intensive for all cases. The malleate function allows
 // for testing invalid cases.
 func (suite *KeeperTestSuite) TestOnRecvPacket_ReceiverIsNotSource() {
-	var packetData types.FungibleTokenPacketDataV2
+	var (
+		packetData types.FungibleTokenPacketDataV2
+		path       *ibctesting.Path
+	)
 
 	testCases := []struct {
 		msg      string
@@ -373,6 +443,26 @@ func (suite *KeeperTestSuite) TestOnRecvPacket_ReceiverIsNotSource() {
 			},
 			types.ErrReceiveDisabled,
 		},
+		{
+			"failure: denom denied by receive policy",
+			func() {
+				params := suite.chainB.GetSimApp().TransferKeeper.GetParams(suite.chainB.GetContext())
+				params.ReceivePolicies = []types.DenomPolicy{types.NewDenomPolicy(path.EndpointB.ChannelID, types.DENYLIST, sdk.DefaultBondDenom)}
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			types.ErrDenomNotAllowed,
+		},
+		{
+			"failure: denom not in receive allowlist",
+			func() {
+				denom := types.NewDenom(ibctesting.SecondaryDenom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+
+				params := suite.chainB.GetSimApp().TransferKeeper.GetParams(suite.chainB.GetContext())
+				params.ReceivePolicies = []types.DenomPolicy{types.NewDenomPolicy("", types.ALLOWLIST, denom.IBCDenom())}
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			types.ErrDenomNotAllowed,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -381,7 +471,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket_ReceiverIsNotSource() {
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
 
-			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
 			path.Setup()
 
 			receiver := suite.chainB.SenderAccount.GetAddress().String() // must be explicitly changed in malleate
@@ -1085,6 +1175,77 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacketSetsTotalEscrowAmountForSourceI
 	suite.Require().Equal(zeroAmount, totalEscrowChainB.Amount)
 }
 
+func (suite *KeeperTestSuite) TestOnTimeoutPacketOrderedChannel() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+	path.Setup()
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+	// send two packets which time out on the next block of chain B
+	timeoutHeight := clienttypes.NewHeight(1, uint64(suite.chainB.GetContext().BlockHeight())+1)
+	packets := make([]channeltypes.Packet, 2)
+	for i := range packets {
+		msg := types.NewMsgTransfer(
+			path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+			sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, defaultAmount)), sender.String(),
+			suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, "", nil,
+		)
+
+		res, err := suite.chainA.SendMsgs(msg)
+		suite.Require().NoError(err)
+
+		packets[i], err = ibctesting.ParsePacketFromEvents(res.Events)
+		suite.Require().NoError(err)
+	}
+
+	escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+	suite.Require().Equal(defaultAmount.MulRaw(2), escrowBalance.Amount)
+
+	suite.coordinator.CommitNBlocks(suite.chainB, 2)
+	err := path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	// the timeout of the first packet refunds its tokens and closes the channel
+	err = path.EndpointA.TimeoutPacket(packets[0])
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.CLOSED, path.EndpointA.GetChannel().State)
+
+	escrowBalance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+	suite.Require().Equal(defaultAmount, escrowBalance.Amount)
+
+	// no more tokens can be sent on the closed channel
+	msg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, defaultAmount)), sender.String(),
+		suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "", nil,
+	)
+	_, err = suite.chainA.SendMsgs(msg)
+	suite.Require().ErrorContains(err, channeltypes.ErrInvalidChannelState.Error())
+
+	// once the counterparty channel end is closed, the tokens of the remaining packet are refunded
+	// by its timeout on close
+	err = path.EndpointB.SetChannelState(channeltypes.CLOSED)
+	suite.Require().NoError(err)
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.TimeoutOnClose(packets[1])
+	suite.Require().NoError(err)
+
+	escrowBalance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+	suite.Require().True(escrowBalance.Amount.IsZero())
+
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+	suite.Require().True(senderBalance.Amount.Equal(balance.Amount))
+
+	totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom)
+	suite.Require().True(totalEscrow.Amount.IsZero())
+}
+
 func (suite *KeeperTestSuite) TestPacketForwardsCompatibility() {
 	// We are testing a scenario where a packet in the future has a new populated
 	// field called "new_field". And this packet is being sent to this module which
@@ -1315,3 +1476,109 @@ func (suite *KeeperTestSuite) assertEscrowEqual(chain *ibctesting.TestChain, coi
 		suite.Require().Equal(expectedAmounts[i], amount.Amount)
 	}
 }
+
+func (suite *KeeperTestSuite) TestTokenMetadataRelay() {
+	suite.SetupTest() // reset
+
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	// bank metadata of the token native to chain A
+	suite.chainA.GetSimApp().BankKeeper.SetDenomMetaData(suite.chainA.GetContext(), banktypes.Metadata{
+		Name: "Stake",
+		Base: sdk.DefaultBondDenom,
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: sdk.DefaultBondDenom, Exponent: 0},
+			{Denom: "kstake", Exponent: 3},
+		},
+		Display: "kstake",
+		Symbol:  "STK",
+		URI:     "https://stake.example",
+	})
+
+	sendAndRelay := func(includeDenomMetadata bool) types.FungibleTokenPacketDataV2 {
+		msg := types.NewMsgTransfer(
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			sdk.NewCoins(ibctesting.TestCoin),
+			suite.chainA.SenderAccount.GetAddress().String(),
+			suite.chainB.SenderAccount.GetAddress().String(),
+			suite.chainB.GetTimeoutHeight(), 0, "",
+			nil,
+		)
+		msg.IncludeDenomMetadata = includeDenomMetadata
+		result, err := suite.chainA.SendMsgs(msg)
+		suite.Require().NoError(err)
+
+		packet, err := ibctesting.ParsePacketFromEvents(result.Events)
+		suite.Require().NoError(err)
+
+		data, err := types.UnmarshalPacketData(packet.GetData(), types.V2)
+		suite.Require().NoError(err)
+
+		err = path.RelayPacket(packet)
+		suite.Require().NoError(err)
+
+		return data
+	}
+
+	// the token metadata of chain A is not sent along by default
+	data := sendAndRelay(false)
+	suite.Require().Nil(data.Tokens[0].Metadata)
+
+	voucherDenom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+	_, found := suite.chainB.GetSimApp().TransferKeeper.GetTokenMetadata(suite.chainB.GetContext(), voucherDenom.IBCDenom())
+	suite.Require().False(found)
+
+	// the token metadata of chain A is sent along with the native token if requested by the sender
+	data = sendAndRelay(true)
+	expTokenMetadata := types.NewTokenMetadata("kstake", "STK", 3, "https://stake.example")
+	suite.Require().Equal(&expTokenMetadata, data.Tokens[0].Metadata)
+
+	// chain B records it and enriches the bank metadata of the voucher
+	tokenMetadata, found := suite.chainB.GetSimApp().TransferKeeper.GetTokenMetadata(suite.chainB.GetContext(), voucherDenom.IBCDenom())
+	suite.Require().True(found)
+	suite.Require().Equal(expTokenMetadata, tokenMetadata)
+
+	metadata, found := suite.chainB.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), voucherDenom.IBCDenom())
+	suite.Require().True(found)
+	suite.Require().Equal(voucherDenom.IBCDenom(), metadata.Base)
+	suite.Require().Equal("kstake", metadata.Display)
+	suite.Require().Equal("STK", metadata.Symbol)
+	suite.Require().Equal("https://stake.example", metadata.URI)
+	suite.Require().Equal([]*banktypes.DenomUnit{{Denom: sdk.DefaultBondDenom, Exponent: 0}, {Denom: "kstake", Exponent: 3}}, metadata.DenomUnits)
+
+	// the token metadata is only recorded on first receipt
+	metadata, found = suite.chainA.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainA.GetContext(), sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	metadata.Symbol = "CHANGED"
+	suite.chainA.GetSimApp().BankKeeper.SetDenomMetaData(suite.chainA.GetContext(), metadata)
+
+	data = sendAndRelay(true)
+	suite.Require().Equal("CHANGED", data.Tokens[0].Metadata.Symbol)
+
+	tokenMetadata, found = suite.chainB.GetSimApp().TransferKeeper.GetTokenMetadata(suite.chainB.GetContext(), voucherDenom.IBCDenom())
+	suite.Require().True(found)
+	suite.Require().Equal(expTokenMetadata, tokenMetadata)
+
+	// the vouchers sent back to chain A carry no token metadata
+	msg := types.NewMsgTransfer(
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		sdk.NewCoins(sdk.NewCoin(voucherDenom.IBCDenom(), ibctesting.TestCoin.Amount)),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainA.GetTimeoutHeight(), 0, "",
+		nil,
+	)
+	msg.IncludeDenomMetadata = true
+	result, err := suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	data, err = types.UnmarshalPacketData(packet.GetData(), types.V2)
+	suite.Require().NoError(err)
+	suite.Require().Nil(data.Tokens[0].Metadata)
+}
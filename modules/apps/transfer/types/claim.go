@@ -0,0 +1,95 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// ClaimReturnTimeout is the relative timeout of the packets returning the tokens of
+// expired claims to their sender.
+const ClaimReturnTimeout = time.Hour
+
+// MaxExpiredClaimsPerBlock is the maximum number of expired claims whose tokens are returned
+// to their sender in a single block. Remaining expired claims are returned in the next blocks.
+const MaxExpiredClaimsPerBlock = 100
+
+// NewPendingClaim creates a new PendingClaim instance.
+func NewPendingClaim(packetID channeltypes.PacketId, sender, receiver string, tokens sdk.Coins, claimTimeout, deadline uint64) PendingClaim {
+	return PendingClaim{
+		PacketId:     packetID,
+		Sender:       sender,
+		Receiver:     receiver,
+		Tokens:       tokens,
+		ClaimTimeout: claimTimeout,
+		Deadline:     deadline,
+	}
+}
+
+// Validate performs a basic validation of the PendingClaim fields.
+func (c PendingClaim) Validate() error {
+	if err := host.PortIdentifierValidator(c.PacketId.PortId); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(c.PacketId.ChannelId); err != nil {
+		return err
+	}
+	if c.PacketId.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidClaim, "packet sequence cannot be 0")
+	}
+
+	if c.Sender == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "sender address cannot be blank")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Receiver); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if c.Tokens.Empty() {
+		return errorsmod.Wrap(ErrInvalidClaim, "tokens cannot be empty")
+	}
+	if err := c.Tokens.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidClaim, err.Error())
+	}
+
+	if c.ClaimTimeout == 0 || c.Deadline == 0 {
+		return errorsmod.Wrap(ErrInvalidClaim, "claim timeout and deadline must be greater than 0")
+	}
+
+	return nil
+}
+
+// IsExpired returns true if the tokens can no longer be claimed at the given block time.
+// A claim whose return to the sender failed never expires.
+func (c PendingClaim) IsExpired(blockTime uint64) bool {
+	return !c.ReturnFailed && blockTime >= c.Deadline
+}
+
+// NewReturnedClaim creates a new ReturnedClaim instance.
+func NewReturnedClaim(returnPacketID channeltypes.PacketId, claim PendingClaim) ReturnedClaim {
+	return ReturnedClaim{
+		ReturnPacketId: returnPacketID,
+		Claim:          claim,
+	}
+}
+
+// Validate performs a basic validation of the ReturnedClaim fields.
+func (r ReturnedClaim) Validate() error {
+	if err := host.PortIdentifierValidator(r.ReturnPacketId.PortId); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(r.ReturnPacketId.ChannelId); err != nil {
+		return err
+	}
+	if r.ReturnPacketId.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidClaim, "return packet sequence cannot be 0")
+	}
+
+	return r.Claim.Validate()
+}
@@ -0,0 +1,856 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/transfer/v1/claim.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingClaim defines the tokens of a claimable transfer received by this chain that are
+// held by the transfer module until the receiver claims them. If they are not claimed before
+// the deadline, they are returned to the sender on the channel they were received on.
+type PendingClaim struct {
+	// the identifiers of the received packet
+	PacketId types.PacketId `protobuf:"bytes,1,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
+	// the sender address on the counterparty chain
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// the receiver address allowed to claim the tokens
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// the tokens held by the transfer module
+	Tokens github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=tokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens"`
+	// the claim timeout in nanoseconds set by the sender
+	ClaimTimeout uint64 `protobuf:"varint,5,opt,name=claim_timeout,json=claimTimeout,proto3" json:"claim_timeout,omitempty"`
+	// the timestamp in nanoseconds since unix epoch after which the tokens are returned to the sender
+	Deadline uint64 `protobuf:"varint,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// set if returning the tokens to the sender failed, in which case the tokens are held until
+	// the receiver claims them and no further return is attempted
+	ReturnFailed bool `protobuf:"varint,7,opt,name=return_failed,json=returnFailed,proto3" json:"return_failed,omitempty"`
+}
+
+func (m *PendingClaim) Reset()         { *m = PendingClaim{} }
+func (m *PendingClaim) String() string { return proto.CompactTextString(m) }
+func (*PendingClaim) ProtoMessage()    {}
+func (*PendingClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99ca926bd40d6c47, []int{0}
+}
+func (m *PendingClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingClaim.Merge(m, src)
+}
+func (m *PendingClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingClaim proto.InternalMessageInfo
+
+func (m *PendingClaim) GetPacketId() types.PacketId {
+	if m != nil {
+		return m.PacketId
+	}
+	return types.PacketId{}
+}
+
+func (m *PendingClaim) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *PendingClaim) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *PendingClaim) GetTokens() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *PendingClaim) GetClaimTimeout() uint64 {
+	if m != nil {
+		return m.ClaimTimeout
+	}
+	return 0
+}
+
+func (m *PendingClaim) GetDeadline() uint64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *PendingClaim) GetReturnFailed() bool {
+	if m != nil {
+		return m.ReturnFailed
+	}
+	return false
+}
+
+// ReturnedClaim defines a claim whose tokens are being returned to the sender in the packet
+// identified by return_packet_id. If the packet fails, the claim becomes pending again.
+type ReturnedClaim struct {
+	// the identifiers of the packet returning the tokens to the sender
+	ReturnPacketId types.PacketId `protobuf:"bytes,1,opt,name=return_packet_id,json=returnPacketId,proto3" json:"return_packet_id"`
+	// the claim whose tokens are returned
+	Claim PendingClaim `protobuf:"bytes,2,opt,name=claim,proto3" json:"claim"`
+}
+
+func (m *ReturnedClaim) Reset()         { *m = ReturnedClaim{} }
+func (m *ReturnedClaim) String() string { return proto.CompactTextString(m) }
+func (*ReturnedClaim) ProtoMessage()    {}
+func (*ReturnedClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99ca926bd40d6c47, []int{1}
+}
+func (m *ReturnedClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReturnedClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReturnedClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReturnedClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReturnedClaim.Merge(m, src)
+}
+func (m *ReturnedClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReturnedClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReturnedClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReturnedClaim proto.InternalMessageInfo
+
+func (m *ReturnedClaim) GetReturnPacketId() types.PacketId {
+	if m != nil {
+		return m.ReturnPacketId
+	}
+	return types.PacketId{}
+}
+
+func (m *ReturnedClaim) GetClaim() PendingClaim {
+	if m != nil {
+		return m.Claim
+	}
+	return PendingClaim{}
+}
+
+func init() {
+	proto.RegisterType((*PendingClaim)(nil), "ibc.applications.transfer.v1.PendingClaim")
+	proto.RegisterType((*ReturnedClaim)(nil), "ibc.applications.transfer.v1.ReturnedClaim")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/transfer/v1/claim.proto", fileDescriptor_99ca926bd40d6c47)
+}
+
+var fileDescriptor_99ca926bd40d6c47 = []byte{
+	// 462 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x4d, 0x8b, 0xd3, 0x40,
+	0x18, 0x6e, 0xf6, 0xa3, 0x76, 0xa7, 0x5d, 0x91, 0x20, 0x12, 0x8b, 0x66, 0xe3, 0x7a, 0x09, 0xc2,
+	0xce, 0x98, 0x7a, 0x10, 0x6f, 0xd2, 0x85, 0x05, 0x0f, 0xc2, 0x1a, 0x3c, 0x79, 0x29, 0x93, 0x99,
+	0x77, 0xb3, 0x43, 0x93, 0x99, 0x90, 0x99, 0x06, 0xfc, 0x17, 0xfe, 0x0a, 0x0f, 0xfe, 0x92, 0xbd,
+	0xb9, 0x47, 0x4f, 0x2a, 0xed, 0x1f, 0x91, 0x99, 0x49, 0x97, 0x82, 0xe0, 0xc1, 0x53, 0xe6, 0x7d,
+	0xde, 0xe7, 0x79, 0x3f, 0x9e, 0xbc, 0x28, 0x15, 0x05, 0x23, 0xb4, 0x69, 0x2a, 0xc1, 0xa8, 0x11,
+	0x4a, 0x6a, 0x62, 0x5a, 0x2a, 0xf5, 0x15, 0xb4, 0xa4, 0xcb, 0x08, 0xab, 0xa8, 0xa8, 0x71, 0xd3,
+	0x2a, 0xa3, 0xc2, 0x27, 0xa2, 0x60, 0x78, 0x97, 0x89, 0xb7, 0x4c, 0xdc, 0x65, 0xd3, 0x87, 0xa5,
+	0x2a, 0x95, 0x23, 0x12, 0xfb, 0xf2, 0x9a, 0x69, 0xcc, 0x94, 0xae, 0x95, 0x26, 0x05, 0xd5, 0x40,
+	0xba, 0xac, 0x00, 0x43, 0x33, 0xc2, 0x94, 0x90, 0x7d, 0xfe, 0x99, 0xed, 0xce, 0x54, 0x0b, 0x84,
+	0x5d, 0x53, 0x29, 0xa1, 0x72, 0x4d, 0xfd, 0xd3, 0x53, 0x4e, 0xbf, 0xef, 0xa1, 0xc9, 0x25, 0x48,
+	0x2e, 0x64, 0x79, 0x6e, 0xa7, 0x09, 0xdf, 0xa2, 0xa3, 0x86, 0xb2, 0x25, 0x98, 0x85, 0xe0, 0x51,
+	0x90, 0x04, 0xe9, 0x78, 0xf6, 0x14, 0xdb, 0xd9, 0x6c, 0x1d, 0xbc, 0x15, 0x77, 0x19, 0xbe, 0x74,
+	0xac, 0x77, 0x7c, 0x7e, 0x70, 0xf3, 0xf3, 0x64, 0x90, 0x8f, 0x9a, 0x3e, 0x0e, 0x1f, 0xa1, 0xa1,
+	0x06, 0xc9, 0xa1, 0x8d, 0xf6, 0x92, 0x20, 0x3d, 0xca, 0xfb, 0x28, 0x9c, 0xa2, 0x51, 0x0b, 0x0c,
+	0x44, 0x07, 0x6d, 0xb4, 0xef, 0x32, 0x77, 0x71, 0xc8, 0xd0, 0xd0, 0xa8, 0x25, 0x48, 0x1d, 0x1d,
+	0x24, 0xfb, 0xe9, 0x78, 0xf6, 0x18, 0xfb, 0xd5, 0xb0, 0x5d, 0x0d, 0xf7, 0xab, 0xe1, 0x73, 0x25,
+	0xe4, 0xfc, 0xa5, 0x6d, 0xf7, 0xed, 0xd7, 0x49, 0x5a, 0x0a, 0x73, 0xbd, 0x2a, 0x30, 0x53, 0x35,
+	0xe9, 0x7d, 0xf0, 0x9f, 0x33, 0xcd, 0x97, 0xc4, 0x7c, 0x6e, 0x40, 0x3b, 0x81, 0xce, 0xfb, 0xd2,
+	0xe1, 0x73, 0x74, 0xec, 0x1c, 0x5f, 0x18, 0x51, 0x83, 0x5a, 0x99, 0xe8, 0x30, 0x09, 0xd2, 0x83,
+	0x7c, 0xe2, 0xc0, 0x8f, 0x1e, 0xb3, 0x53, 0x72, 0xa0, 0xbc, 0x12, 0x12, 0xa2, 0xa1, 0xcb, 0xdf,
+	0xc5, 0xb6, 0x40, 0x0b, 0x66, 0xd5, 0xca, 0xc5, 0x15, 0x15, 0x15, 0xf0, 0xe8, 0x5e, 0x12, 0xa4,
+	0xa3, 0x7c, 0xe2, 0xc1, 0x0b, 0x87, 0x9d, 0x7e, 0x0d, 0xd0, 0x71, 0xee, 0x00, 0xe0, 0xde, 0xd2,
+	0xf7, 0xe8, 0x41, 0x2f, 0xfb, 0x2f, 0x67, 0xef, 0x7b, 0xf1, 0x16, 0x0d, 0x2f, 0xd0, 0xa1, 0x9b,
+	0xd8, 0xd9, 0x3b, 0x9e, 0xbd, 0xc0, 0xff, 0xba, 0x1c, 0xbc, 0xfb, 0x73, 0xfb, 0x82, 0x5e, 0x3e,
+	0xff, 0x70, 0xb3, 0x8e, 0x83, 0xdb, 0x75, 0x1c, 0xfc, 0x5e, 0xc7, 0xc1, 0x97, 0x4d, 0x3c, 0xb8,
+	0xdd, 0xc4, 0x83, 0x1f, 0x9b, 0x78, 0xf0, 0xe9, 0xf5, 0xdf, 0xd6, 0x8a, 0x82, 0x9d, 0x95, 0x8a,
+	0x74, 0x6f, 0x48, 0xad, 0xf8, 0xaa, 0x02, 0x6d, 0xaf, 0x7a, 0xe7, 0x9a, 0x9d, 0xdf, 0xc5, 0xd0,
+	0x1d, 0xd5, 0xab, 0x3f, 0x03, 0x00, 0x1b, 0x52, 0x26, 0xd8, 0xf7, 0x02, 0x00, 0x00,
+}
+
+func (m *PendingClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReturnFailed {
+		i--
+		if m.ReturnFailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ClaimTimeout != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.ClaimTimeout))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClaim(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClaim(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReturnedClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReturnedClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReturnedClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Claim.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClaim(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ReturnPacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClaim(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintClaim(dAtA []byte, offset int, v uint64) int {
+	offset -= sovClaim(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovClaim(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovClaim(uint64(l))
+		}
+	}
+	if m.ClaimTimeout != 0 {
+		n += 1 + sovClaim(uint64(m.ClaimTimeout))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovClaim(uint64(m.Deadline))
+	}
+	if m.ReturnFailed {
+		n += 2
+	}
+	return n
+}
+
+func (m *ReturnedClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReturnPacketId.Size()
+	n += 1 + l + sovClaim(uint64(l))
+	l = m.Claim.Size()
+	n += 1 + l + sovClaim(uint64(l))
+	return n
+}
+
+func sovClaim(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClaim(x uint64) (n int) {
+	return sovClaim(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaim
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, types1.Coin{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimTimeout", wireType)
+			}
+			m.ClaimTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnFailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReturnFailed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaim(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReturnedClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaim
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReturnedClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReturnedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnPacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReturnPacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaim(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipClaim(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowClaim
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthClaim
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupClaim
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthClaim
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthClaim        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowClaim          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupClaim = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,83 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func TestPendingClaimValidate(t *testing.T) {
+	packetID := channeltypes.NewPacketID(validPort, validChannel, 1)
+	tokens := sdk.NewCoins(ibctesting.TestCoin)
+
+	testCases := []struct {
+		name   string
+		claim  types.PendingClaim
+		expErr error
+	}{
+		{"success", types.NewPendingClaim(packetID, sender, receiver, tokens, 100, 200), nil},
+		{"failure: invalid port", types.NewPendingClaim(channeltypes.NewPacketID(invalidPort, validChannel, 1), sender, receiver, tokens, 100, 200), host.ErrInvalidID},
+		{"failure: invalid channel", types.NewPendingClaim(channeltypes.NewPacketID(validPort, invalidChannel, 1), sender, receiver, tokens, 100, 200), host.ErrInvalidID},
+		{"failure: zero sequence", types.NewPendingClaim(channeltypes.NewPacketID(validPort, validChannel, 0), sender, receiver, tokens, 100, 200), types.ErrInvalidClaim},
+		{"failure: empty sender", types.NewPendingClaim(packetID, "", receiver, tokens, 100, 200), ibcerrors.ErrInvalidAddress},
+		{"failure: invalid receiver", types.NewPendingClaim(packetID, sender, invalidAddress, tokens, 100, 200), ibcerrors.ErrInvalidAddress},
+		{"failure: empty tokens", types.NewPendingClaim(packetID, sender, receiver, sdk.NewCoins(), 100, 200), types.ErrInvalidClaim},
+		{"failure: invalid tokens", types.NewPendingClaim(packetID, sender, receiver, sdk.Coins{sdk.Coin{Denom: "0atom", Amount: ibctesting.TestCoin.Amount}}, 100, 200), types.ErrInvalidClaim},
+		{"failure: zero claim timeout", types.NewPendingClaim(packetID, sender, receiver, tokens, 0, 200), types.ErrInvalidClaim},
+		{"failure: zero deadline", types.NewPendingClaim(packetID, sender, receiver, tokens, 100, 0), types.ErrInvalidClaim},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.claim.Validate()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestPendingClaimIsExpired(t *testing.T) {
+	claim := types.NewPendingClaim(channeltypes.NewPacketID(validPort, validChannel, 1), sender, receiver, sdk.NewCoins(ibctesting.TestCoin), 100, 200)
+
+	require.False(t, claim.IsExpired(199))
+	require.True(t, claim.IsExpired(200))
+	require.True(t, claim.IsExpired(201))
+}
+
+func TestReturnedClaimValidate(t *testing.T) {
+	claim := types.NewPendingClaim(channeltypes.NewPacketID(validPort, validChannel, 1), sender, receiver, sdk.NewCoins(ibctesting.TestCoin), 100, 200)
+
+	testCases := []struct {
+		name          string
+		returnedClaim types.ReturnedClaim
+		expErr        error
+	}{
+		{"success", types.NewReturnedClaim(channeltypes.NewPacketID(validPort, validChannel, 2), claim), nil},
+		{"failure: invalid port", types.NewReturnedClaim(channeltypes.NewPacketID(invalidPort, validChannel, 2), claim), host.ErrInvalidID},
+		{"failure: invalid channel", types.NewReturnedClaim(channeltypes.NewPacketID(validPort, invalidChannel, 2), claim), host.ErrInvalidID},
+		{"failure: zero sequence", types.NewReturnedClaim(channeltypes.NewPacketID(validPort, validChannel, 0), claim), types.ErrInvalidClaim},
+		{"failure: invalid claim", types.NewReturnedClaim(channeltypes.NewPacketID(validPort, validChannel, 2), types.PendingClaim{}), host.ErrInvalidID},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.returnedClaim.Validate()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
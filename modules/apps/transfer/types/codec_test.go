@@ -44,6 +44,26 @@ func (suite *TypesTestSuite) TestCodecTypeRegistration() {
 			sdk.MsgTypeURL(&types.MsgUpdateParams{}),
 			nil,
 		},
+		{
+			"success: MsgSetRateLimit",
+			sdk.MsgTypeURL(&types.MsgSetRateLimit{}),
+			nil,
+		},
+		{
+			"success: MsgRemoveRateLimit",
+			sdk.MsgTypeURL(&types.MsgRemoveRateLimit{}),
+			nil,
+		},
+		{
+			"success: MsgMultiTransfer",
+			sdk.MsgTypeURL(&types.MsgMultiTransfer{}),
+			nil,
+		},
+		{
+			"success: MsgClaimTransfer",
+			sdk.MsgTypeURL(&types.MsgClaimTransfer{}),
+			nil,
+		},
 		{
 			"success: TransferAuthorization",
 			sdk.MsgTypeURL(&types.TransferAuthorization{}),
@@ -19,4 +19,15 @@ var (
 	ErrInvalidForwarding       = errorsmod.Register(ModuleName, 12, "invalid token forwarding")
 	ErrForwardedPacketTimedOut = errorsmod.Register(ModuleName, 13, "forwarded packet timed out")
 	ErrForwardedPacketFailed   = errorsmod.Register(ModuleName, 14, "forwarded packet failed")
+	ErrInvalidRateLimit        = errorsmod.Register(ModuleName, 15, "invalid rate limit")
+	ErrRateLimitNotFound       = errorsmod.Register(ModuleName, 16, "rate limit not found")
+	ErrRateLimitExceeded       = errorsmod.Register(ModuleName, 17, "rate limit exceeded")
+	ErrInvalidDenomPolicy      = errorsmod.Register(ModuleName, 18, "invalid denomination policy")
+	ErrDenomNotAllowed         = errorsmod.Register(ModuleName, 19, "denomination not allowed")
+	ErrInvalidClaim            = errorsmod.Register(ModuleName, 20, "invalid claim")
+	ErrClaimNotFound           = errorsmod.Register(ModuleName, 21, "claim not found")
+	ErrClaimExpired            = errorsmod.Register(ModuleName, 22, "claim expired")
+	ErrInvalidMultiTransfer    = errorsmod.Register(ModuleName, 23, "invalid multi transfer")
+	ErrForwardedPacketNotFound = errorsmod.Register(ModuleName, 24, "forwarded packet not found")
+	ErrInvalidTokenMetadata    = errorsmod.Register(ModuleName, 25, "invalid token metadata")
 )
@@ -2,11 +2,14 @@ package types
 
 // IBC transfer events
 const (
-	EventTypeTimeout      = "timeout"
-	EventTypePacket       = "fungible_token_packet"
-	EventTypeTransfer     = "ibc_transfer"
-	EventTypeChannelClose = "channel_closed"
-	EventTypeDenom        = "denomination"
+	EventTypeTimeout       = "timeout"
+	EventTypePacket        = "fungible_token_packet"
+	EventTypeTransfer      = "ibc_transfer"
+	EventTypeChannelClose  = "channel_closed"
+	EventTypeDenom         = "denomination"
+	EventTypeClaim         = "claim_transfer"
+	EventTypeReturnClaim   = "return_claim"
+	EventTypeDenomMetadata = "denomination_metadata"
 
 	AttributeKeySender         = "sender"
 	AttributeKeyReceiver       = "receiver"
@@ -18,6 +21,14 @@ const (
 	AttributeKeyAckSuccess     = "success"
 	AttributeKeyAck            = "acknowledgement"
 	AttributeKeyAckError       = "error"
+	AttributeKeyAckCodespace   = "error_codespace"
+	AttributeKeyAckCode        = "error_code"
+	AttributeKeyAckReason      = "error_reason"
 	AttributeKeyMemo           = "memo"
 	AttributeKeyForwardingHops = "forwarding_hops"
+	AttributeKeyPortID         = "port_id"
+	AttributeKeyChannelID      = "channel_id"
+	AttributeKeySequence       = "sequence"
+	AttributeKeyReturnSequence = "return_sequence"
+	AttributeKeyTokenMetadata  = "token_metadata"
 )
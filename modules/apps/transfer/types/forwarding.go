@@ -8,7 +8,10 @@ import (
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 )
 
-const MaximumNumberOfForwardingHops = 8 // denotes the maximum number of forwarding hops allowed
+const (
+	MaximumNumberOfForwardingHops    = 8  // denotes the maximum number of forwarding hops allowed
+	MaximumNumberOfForwardingRetries = 10 // denotes the maximum number of times a timed out forwarded packet may be sent again
+)
 
 // NewForwarding creates a new Forwarding instance given an unwind value and a variable number of hops.
 func NewForwarding(unwind bool, hops ...Hop) *Forwarding {
@@ -24,9 +27,25 @@ func (f Forwarding) Validate() error {
 		return errorsmod.Wrapf(ErrInvalidForwarding, "invalid hops in forwarding")
 	}
 
+	if err := validateHopTimeouts(f.GetHops(), f.HopTimeouts, f.MaxRetries); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// WithHopTimeouts sets the timeouts used when forwarding on each of the hops and returns the Forwarding.
+func (f *Forwarding) WithHopTimeouts(hopTimeouts ...HopTimeout) *Forwarding {
+	f.HopTimeouts = hopTimeouts
+	return f
+}
+
+// WithMaxRetries sets the number of times a packet that timed out on a hop is sent again and returns the Forwarding.
+func (f *Forwarding) WithMaxRetries(maxRetries uint64) *Forwarding {
+	f.MaxRetries = maxRetries
+	return f
+}
+
 // NewForwardingPacketData creates a new ForwardingPacketData instance given a memo and a variable number of hops.
 func NewForwardingPacketData(destinationMemo string, hops ...Hop) ForwardingPacketData {
 	return ForwardingPacketData{
@@ -49,9 +68,50 @@ func (fpd ForwardingPacketData) Validate() error {
 		return errorsmod.Wrap(ErrInvalidForwarding, "memo specified when forwarding packet data hops is empty")
 	}
 
+	if err := validateHopTimeouts(fpd.Hops, fpd.HopTimeouts, fpd.MaxRetries); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewRelativeHopTimeout creates a HopTimeout with a timeout duration relative to the block time of the forwarding chain.
+func NewRelativeHopTimeout(relativeTimeout uint64) HopTimeout {
+	return HopTimeout{RelativeTimeout: relativeTimeout}
+}
+
+// NewAbsoluteHopTimeout creates a HopTimeout with an absolute timeout timestamp.
+func NewAbsoluteHopTimeout(timeoutTimestamp uint64) HopTimeout {
+	return HopTimeout{TimeoutTimestamp: timeoutTimestamp}
+}
+
+// Validate performs a basic validation of the HopTimeout fields.
+func (t HopTimeout) Validate() error {
+	if t.RelativeTimeout != 0 && t.TimeoutTimestamp != 0 {
+		return errorsmod.Wrap(ErrInvalidPacketTimeout, "relative and absolute hop timeouts cannot both be set")
+	}
+
 	return nil
 }
 
+// IsEmpty returns true if neither a relative nor an absolute timeout is set.
+func (t HopTimeout) IsEmpty() bool {
+	return t.RelativeTimeout == 0 && t.TimeoutTimestamp == 0
+}
+
+// TimeoutTimestampAt returns the timeout timestamp of the packet sent on the hop at the given block time,
+// falling back to defaultTimeoutTimestamp if no timeout is set.
+func (t HopTimeout) TimeoutTimestampAt(blockTime, defaultTimeoutTimestamp uint64) uint64 {
+	switch {
+	case t.RelativeTimeout != 0:
+		return blockTime + t.RelativeTimeout
+	case t.TimeoutTimestamp != 0:
+		return t.TimeoutTimestamp
+	default:
+		return defaultTimeoutTimestamp
+	}
+}
+
 // NewHop creates a Hop with the given port ID and channel ID.
 func NewHop(portID, channelID string) Hop {
 	return Hop{portID, channelID}
@@ -91,3 +151,36 @@ func validateHops(hops []Hop) error {
 
 	return nil
 }
+
+// validateHopTimeouts performs a basic validation of the hop timeouts and maximum number of retries.
+// If hop timeouts are set, there must be exactly one per hop. Retries are only allowed if every hop has
+// a relative timeout, since an absolute or inherited timeout has already expired when a packet is sent again.
+func validateHopTimeouts(hops []Hop, hopTimeouts []HopTimeout, maxRetries uint64) error {
+	if len(hopTimeouts) != 0 && len(hopTimeouts) != len(hops) {
+		return errorsmod.Wrapf(ErrInvalidForwarding, "number of hop timeouts (%d) must match number of hops (%d)", len(hopTimeouts), len(hops))
+	}
+
+	for _, hopTimeout := range hopTimeouts {
+		if err := hopTimeout.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if maxRetries > MaximumNumberOfForwardingRetries {
+		return errorsmod.Wrapf(ErrInvalidForwarding, "number of retries cannot exceed %d", MaximumNumberOfForwardingRetries)
+	}
+
+	if maxRetries > 0 {
+		if len(hopTimeouts) == 0 {
+			return errorsmod.Wrap(ErrInvalidForwarding, "relative hop timeouts must be set when retries are enabled")
+		}
+
+		for i, hopTimeout := range hopTimeouts {
+			if hopTimeout.RelativeTimeout == 0 {
+				return errorsmod.Wrapf(ErrInvalidForwarding, "relative timeout must be set for hop %d when retries are enabled", i)
+			}
+		}
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -91,6 +92,47 @@ func TestForwarding_Validate(t *testing.T) {
 			types.NewForwarding(true, types.NewHop(types.PortID, types.PortID)),
 			nil,
 		},
+		{
+			"valid forwarding with hop timeouts and retries",
+			types.NewForwarding(false, validHop, validHop).WithHopTimeouts(
+				types.NewRelativeHopTimeout(uint64(time.Hour)), types.NewRelativeHopTimeout(uint64(time.Minute)),
+			).WithMaxRetries(types.MaximumNumberOfForwardingRetries),
+			nil,
+		},
+		{
+			"valid forwarding with absolute hop timeouts and no retries",
+			types.NewForwarding(false, validHop, validHop).WithHopTimeouts(
+				types.NewRelativeHopTimeout(uint64(time.Hour)), types.NewAbsoluteHopTimeout(0),
+			),
+			nil,
+		},
+		{
+			"invalid forwarding with fewer hop timeouts than hops",
+			types.NewForwarding(false, validHop, validHop).WithHopTimeouts(types.NewRelativeHopTimeout(uint64(time.Hour))),
+			types.ErrInvalidForwarding,
+		},
+		{
+			"invalid forwarding with relative and absolute hop timeouts",
+			types.NewForwarding(false, validHop).WithHopTimeouts(types.HopTimeout{RelativeTimeout: 1, TimeoutTimestamp: 1}),
+			types.ErrInvalidPacketTimeout,
+		},
+		{
+			"invalid forwarding with too many retries",
+			types.NewForwarding(false, validHop).WithMaxRetries(types.MaximumNumberOfForwardingRetries + 1),
+			types.ErrInvalidForwarding,
+		},
+		{
+			"invalid forwarding with retries and no hop timeouts",
+			types.NewForwarding(false, validHop).WithMaxRetries(1),
+			types.ErrInvalidForwarding,
+		},
+		{
+			"invalid forwarding with retries and absolute hop timeout",
+			types.NewForwarding(false, validHop, validHop).WithHopTimeouts(
+				types.NewRelativeHopTimeout(uint64(time.Hour)), types.NewAbsoluteHopTimeout(uint64(time.Hour)),
+			).WithMaxRetries(1),
+			types.ErrInvalidForwarding,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -154,6 +196,34 @@ func TestForwardingPacketData_Validate(t *testing.T) {
 			types.NewForwardingPacketData("memo"),
 			types.ErrInvalidForwarding,
 		},
+		{
+			"valid forwarding with hop timeouts and retries",
+			types.ForwardingPacketData{
+				Hops:        []types.Hop{validHop},
+				HopTimeouts: []types.HopTimeout{types.NewRelativeHopTimeout(1)},
+				MaxRetries:  1,
+			},
+			nil,
+		},
+		{
+			"invalid forwarding with retries and absolute hop timeout",
+			types.ForwardingPacketData{
+				Hops:        []types.Hop{validHop},
+				HopTimeouts: []types.HopTimeout{types.NewAbsoluteHopTimeout(1)},
+				MaxRetries:  1,
+			},
+			types.ErrInvalidForwarding,
+		},
+		{
+			"invalid forwarding with hop timeouts and no hops",
+			types.ForwardingPacketData{HopTimeouts: []types.HopTimeout{types.NewAbsoluteHopTimeout(1)}},
+			types.ErrInvalidForwarding,
+		},
+		{
+			"invalid forwarding with too many retries",
+			types.ForwardingPacketData{Hops: []types.Hop{validHop}, MaxRetries: types.MaximumNumberOfForwardingRetries + 1},
+			types.ErrInvalidForwarding,
+		},
 		{
 			"invalid forwarding with too short hop port ID",
 			types.NewForwardingPacketData(
@@ -285,3 +355,9 @@ func generateHops(n int) []types.Hop {
 	}
 	return hops
 }
+
+func TestHopTimeout_TimeoutTimestampAt(t *testing.T) {
+	require.Equal(t, uint64(15), types.NewRelativeHopTimeout(5).TimeoutTimestampAt(10, 100))
+	require.Equal(t, uint64(5), types.NewAbsoluteHopTimeout(5).TimeoutTimestampAt(10, 100))
+	require.Equal(t, uint64(100), types.HopTimeout{}.TimeoutTimestampAt(10, 100))
+}
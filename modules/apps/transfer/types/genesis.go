@@ -1,6 +1,8 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
@@ -35,5 +37,55 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Denoms.Validate(); err != nil {
 		return err
 	}
-	return gs.TotalEscrowed.Validate() // will fail if there are duplicates for any denom
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := gs.TotalEscrowed.Validate(); err != nil { // will fail if there are duplicates for any denom
+		return err
+	}
+
+	seenRateLimits := make(map[string]bool)
+	for _, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+
+		key := string(RateLimitStoreKey(rateLimit.ChannelId, rateLimit.Denom))
+		if seenRateLimits[key] {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "duplicate rate limit for channel %s and denom %s", rateLimit.ChannelId, rateLimit.Denom)
+		}
+		seenRateLimits[key] = true
+	}
+
+	for _, pendingSend := range gs.PendingRateLimitedSends {
+		if err := pendingSend.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, claim := range gs.PendingClaims {
+		if err := claim.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, returnedClaim := range gs.ReturnedClaims {
+		if err := returnedClaim.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seenTokenMetadata := make(map[string]bool)
+	for _, tokenMetadata := range gs.TokenMetadata {
+		if err := tokenMetadata.Validate(); err != nil {
+			return err
+		}
+
+		if seenTokenMetadata[tokenMetadata.Denom] {
+			return errorsmod.Wrapf(ErrInvalidTokenMetadata, "duplicate token metadata for denom %s", tokenMetadata.Denom)
+		}
+		seenTokenMetadata[tokenMetadata.Denom] = true
+	}
+
+	return nil
 }
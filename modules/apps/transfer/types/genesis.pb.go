@@ -37,6 +37,16 @@ type GenesisState struct {
 	// forwarded_packets contains the forwarded packets stored as part of the
 	// packet forwarding lifecycle
 	ForwardedPackets []ForwardedPacket `protobuf:"bytes,5,rep,name=forwarded_packets,json=forwardedPackets,proto3" json:"forwarded_packets"`
+	// rate_limits contains the rate limits enforced on the transfer channels
+	RateLimits []RateLimit `protobuf:"bytes,6,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	// pending_rate_limited_sends contains the in-flight packets that were accounted for in a rate limit flow
+	PendingRateLimitedSends []PendingRateLimitedSend `protobuf:"bytes,7,rep,name=pending_rate_limited_sends,json=pendingRateLimitedSends,proto3" json:"pending_rate_limited_sends"`
+	// pending_claims contains the claimable transfers held by the transfer module
+	PendingClaims []PendingClaim `protobuf:"bytes,8,rep,name=pending_claims,json=pendingClaims,proto3" json:"pending_claims"`
+	// returned_claims contains the claims whose tokens are being returned to the sender
+	ReturnedClaims []ReturnedClaim `protobuf:"bytes,9,rep,name=returned_claims,json=returnedClaims,proto3" json:"returned_claims"`
+	// token_metadata contains the token metadata recorded for the IBC voucher denominations
+	TokenMetadata []DenomTokenMetadata `protobuf:"bytes,10,rep,name=token_metadata,json=tokenMetadata,proto3" json:"token_metadata"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -107,10 +117,49 @@ func (m *GenesisState) GetForwardedPackets() []ForwardedPacket {
 	return nil
 }
 
+func (m *GenesisState) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingRateLimitedSends() []PendingRateLimitedSend {
+	if m != nil {
+		return m.PendingRateLimitedSends
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingClaims() []PendingClaim {
+	if m != nil {
+		return m.PendingClaims
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReturnedClaims() []ReturnedClaim {
+	if m != nil {
+		return m.ReturnedClaims
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenMetadata() []DenomTokenMetadata {
+	if m != nil {
+		return m.TokenMetadata
+	}
+	return nil
+}
+
 // ForwardedPacket defines the genesis type necessary to retrieve and store forwarded packets.
 type ForwardedPacket struct {
 	ForwardKey types1.PacketId `protobuf:"bytes,1,opt,name=forward_key,json=forwardKey,proto3" json:"forward_key"`
 	Packet     types1.Packet   `protobuf:"bytes,2,opt,name=packet,proto3" json:"packet"`
+	// number of times the packet was sent again on the next hop after timing out
+	Retries uint64 `protobuf:"varint,3,opt,name=retries,proto3" json:"retries,omitempty"`
+	// the timestamp in nanoseconds since unix epoch at which the tokens were sent on the next hop
+	ForwardedAt uint64 `protobuf:"varint,4,opt,name=forwarded_at,json=forwardedAt,proto3" json:"forwarded_at,omitempty"`
 }
 
 func (m *ForwardedPacket) Reset()         { *m = ForwardedPacket{} }
@@ -160,6 +209,20 @@ func (m *ForwardedPacket) GetPacket() types1.Packet {
 	return types1.Packet{}
 }
 
+func (m *ForwardedPacket) GetRetries() uint64 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+func (m *ForwardedPacket) GetForwardedAt() uint64 {
+	if m != nil {
+		return m.ForwardedAt
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.transfer.v2.GenesisState")
 	proto.RegisterType((*ForwardedPacket)(nil), "ibc.applications.transfer.v2.ForwardedPacket")
@@ -170,38 +233,50 @@ func init() {
 }
 
 var fileDescriptor_62efebb47a9093ed = []byte{
-	// 485 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x9b, 0x6d, 0x04, 0xe1, 0xc2, 0x80, 0x08, 0x89, 0x30, 0x20, 0x2b, 0x83, 0x43, 0x04,
-	0xaa, 0x4d, 0xc3, 0x01, 0xed, 0x5a, 0x06, 0x68, 0xda, 0x65, 0x94, 0x1b, 0x97, 0xe2, 0xd8, 0x6e,
-	0x66, 0xb5, 0xc9, 0x8b, 0x6c, 0xaf, 0x53, 0xbf, 0x04, 0x42, 0x7c, 0x0c, 0x3e, 0xc9, 0x8e, 0x3b,
-	0x72, 0x02, 0xd4, 0x7e, 0x11, 0x64, 0xc7, 0x85, 0x01, 0x52, 0x4e, 0x7d, 0xcf, 0xfd, 0xff, 0xff,
-	0xcf, 0xfe, 0xe5, 0xa1, 0xa7, 0x32, 0x67, 0x84, 0xd6, 0xf5, 0x4c, 0x32, 0x6a, 0x24, 0x54, 0x9a,
-	0x18, 0x45, 0x2b, 0x3d, 0x11, 0x8a, 0xcc, 0x33, 0x52, 0x88, 0x4a, 0x68, 0xa9, 0x71, 0xad, 0xc0,
-	0x40, 0xf4, 0x40, 0xe6, 0x0c, 0x5f, 0xd6, 0xe2, 0xb5, 0x16, 0xcf, 0xb3, 0x9d, 0x67, 0x2d, 0x49,
-	0x83, 0xdf, 0x75, 0x13, 0xb5, 0x93, 0xb6, 0x8e, 0x35, 0x30, 0x15, 0x95, 0x57, 0x3e, 0xb2, 0x4a,
-	0x06, 0x4a, 0x10, 0x76, 0x42, 0xab, 0x4a, 0xcc, 0x6c, 0x9a, 0x2f, 0xbd, 0x24, 0x61, 0xa0, 0x4b,
-	0xd0, 0x24, 0xa7, 0x5a, 0x90, 0xf9, 0x20, 0x17, 0x86, 0x0e, 0x08, 0x03, 0xb9, 0x8e, 0xb8, 0x53,
-	0x40, 0x01, 0xae, 0x24, 0xb6, 0x6a, 0x4e, 0xf7, 0x3e, 0x6d, 0xa2, 0xeb, 0x6f, 0x9b, 0xf7, 0xbd,
-	0x37, 0xd4, 0x88, 0xe8, 0x2e, 0xba, 0x5a, 0x83, 0x32, 0x63, 0xc9, 0xe3, 0xa0, 0x17, 0xa4, 0xd7,
-	0x46, 0xa1, 0x6d, 0x0f, 0x79, 0x74, 0x84, 0x42, 0x2e, 0x2a, 0x28, 0x75, 0xbc, 0xd1, 0xdb, 0x4c,
-	0xbb, 0xd9, 0x63, 0xdc, 0x06, 0x02, 0x1f, 0x58, 0xed, 0x70, 0xfb, 0xfc, 0xfb, 0x6e, 0xe7, 0xeb,
-	0x8f, 0xdd, 0xd0, 0xb5, 0x7a, 0xe4, 0x23, 0xa2, 0x21, 0x0a, 0x6b, 0xaa, 0x68, 0xa9, 0xe3, 0xcd,
-	0x5e, 0x90, 0x76, 0xb3, 0x27, 0x6d, 0x61, 0x03, 0x7c, 0xec, 0xb4, 0xc3, 0x2d, 0x9b, 0x36, 0xf2,
-	0xce, 0x48, 0xa1, 0x6d, 0x03, 0x86, 0xce, 0xc6, 0x42, 0x33, 0x05, 0x67, 0x82, 0xc7, 0x5b, 0xee,
-	0x62, 0xf7, 0x70, 0x43, 0x02, 0x5b, 0x12, 0xd8, 0x93, 0xc0, 0xaf, 0x40, 0x56, 0xc3, 0xe7, 0xfe,
-	0x3a, 0x69, 0x21, 0xcd, 0xc9, 0x69, 0x8e, 0x19, 0x94, 0xc4, 0x63, 0x6b, 0x7e, 0xfa, 0x9a, 0x4f,
-	0x89, 0x59, 0xd4, 0x42, 0x3b, 0x83, 0x1e, 0xdd, 0x70, 0x23, 0x5e, 0xfb, 0x09, 0xd1, 0x47, 0x74,
-	0x7b, 0x02, 0xea, 0x8c, 0x2a, 0x2e, 0xf8, 0xb8, 0xa6, 0x6c, 0x2a, 0x8c, 0x8e, 0xaf, 0xb8, 0xb1,
-	0xfd, 0x76, 0x1e, 0x6f, 0xd6, 0xb6, 0x63, 0xe7, 0xf2, 0x6f, 0xb9, 0x35, 0xf9, 0xfb, 0x58, 0xef,
-	0x7d, 0x09, 0xd0, 0xcd, 0x7f, 0xb4, 0xd1, 0x01, 0xea, 0x7a, 0xdd, 0x78, 0x2a, 0x16, 0xee, 0xbb,
-	0x74, 0xb3, 0x87, 0x6e, 0x9e, 0xdd, 0x09, 0xbc, 0x5e, 0x04, 0x47, 0xca, 0x3a, 0x0e, 0xb9, 0xcf,
-	0x47, 0xde, 0x77, 0x24, 0x16, 0xd1, 0xbe, 0x65, 0x6e, 0xff, 0x8d, 0x37, 0x5c, 0xc0, 0xfd, 0x96,
-	0x80, 0x3f, 0xa8, 0x5d, 0xf7, 0xee, 0x7c, 0x99, 0x04, 0x17, 0xcb, 0x24, 0xf8, 0xb9, 0x4c, 0x82,
-	0xcf, 0xab, 0xa4, 0x73, 0xb1, 0x4a, 0x3a, 0xdf, 0x56, 0x49, 0xe7, 0xc3, 0xcb, 0xff, 0x49, 0xca,
-	0x9c, 0xf5, 0x0b, 0x20, 0xf3, 0x7d, 0x52, 0x02, 0x3f, 0x9d, 0x09, 0x6d, 0x57, 0xfc, 0xd2, 0x6a,
-	0x3b, 0xbc, 0x79, 0xe8, 0xf6, 0xef, 0xc5, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfb, 0x97, 0x02,
-	0x93, 0x7b, 0x03, 0x00, 0x00,
+	// 678 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xdd, 0x4e, 0x14, 0x3f,
+	0x14, 0xdf, 0x85, 0xfd, 0x2f, 0x7f, 0xba, 0xb0, 0xe8, 0xc4, 0x84, 0x11, 0x75, 0xf9, 0xd0, 0xc4,
+	0x0d, 0xc8, 0x94, 0x5d, 0x4d, 0x0c, 0x97, 0x2e, 0xa8, 0x21, 0xa8, 0xc1, 0xc5, 0xc4, 0x84, 0xc4,
+	0x8c, 0x9d, 0xe9, 0x61, 0x69, 0x76, 0xa7, 0x9d, 0xb4, 0x65, 0x09, 0x6f, 0xe1, 0x73, 0xf8, 0x24,
+	0xdc, 0xc9, 0xa5, 0x57, 0x6a, 0xe0, 0xd2, 0x97, 0x30, 0xed, 0x74, 0x60, 0xfd, 0xc8, 0xc0, 0xd5,
+	0xb4, 0xa7, 0xbf, 0x8f, 0xce, 0x2f, 0xe7, 0x14, 0x2d, 0xb3, 0x28, 0xc6, 0x24, 0x4d, 0x07, 0x2c,
+	0x26, 0x9a, 0x09, 0xae, 0xb0, 0x96, 0x84, 0xab, 0x7d, 0x90, 0x78, 0xd8, 0xc6, 0x3d, 0xe0, 0xa0,
+	0x98, 0x0a, 0x52, 0x29, 0xb4, 0xf0, 0xee, 0xb2, 0x28, 0x0e, 0x46, 0xb1, 0x41, 0x8e, 0x0d, 0x86,
+	0xed, 0xb9, 0x95, 0x02, 0xa5, 0xd6, 0xc5, 0x3a, 0x93, 0x9a, 0x7b, 0x54, 0x08, 0x96, 0x44, 0xc3,
+	0x80, 0x25, 0x4c, 0x3b, 0x74, 0xb3, 0x10, 0x1d, 0x0f, 0x08, 0x4b, 0xae, 0x81, 0x6c, 0x63, 0x2d,
+	0xfa, 0xc0, 0x1d, 0x72, 0xd1, 0x20, 0x63, 0x21, 0x01, 0xc7, 0x07, 0x84, 0x73, 0x18, 0x58, 0xa9,
+	0x6c, 0xe9, 0x20, 0x8d, 0x58, 0xa8, 0x44, 0x28, 0x1c, 0x11, 0x05, 0x78, 0xd8, 0x8a, 0x40, 0x93,
+	0x16, 0x8e, 0x05, 0xcb, 0x25, 0x6e, 0xf5, 0x44, 0x4f, 0xd8, 0x25, 0x36, 0xab, 0xac, 0xba, 0xf4,
+	0xb3, 0x8a, 0xa6, 0x5e, 0x66, 0xb9, 0xed, 0x6a, 0xa2, 0xc1, 0x9b, 0x45, 0x13, 0xa9, 0x90, 0x3a,
+	0x64, 0xd4, 0x2f, 0x2f, 0x94, 0x9b, 0x93, 0xdd, 0xaa, 0xd9, 0x6e, 0x51, 0x6f, 0x1b, 0x55, 0x29,
+	0x70, 0x91, 0x28, 0x7f, 0x6c, 0x61, 0xbc, 0x59, 0x6b, 0xdf, 0x0f, 0x8a, 0x02, 0x0e, 0x36, 0x0d,
+	0xb6, 0x53, 0x3f, 0xf9, 0x36, 0x5f, 0xfa, 0xfc, 0x7d, 0xbe, 0x6a, 0xb7, 0xaa, 0xeb, 0x24, 0xbc,
+	0x0e, 0xaa, 0xa6, 0x44, 0x92, 0x44, 0xf9, 0xe3, 0x0b, 0xe5, 0x66, 0xad, 0xfd, 0xa0, 0x48, 0xac,
+	0x15, 0xec, 0x58, 0x6c, 0xa7, 0x62, 0xd4, 0xba, 0x8e, 0xe9, 0x49, 0x54, 0xd7, 0x42, 0x93, 0x41,
+	0x08, 0x2a, 0x96, 0xe2, 0x08, 0xa8, 0x5f, 0xb1, 0x17, 0xbb, 0x1d, 0x64, 0x49, 0x04, 0x26, 0x89,
+	0xc0, 0x25, 0x11, 0x6c, 0x08, 0xc6, 0x3b, 0x6b, 0xee, 0x3a, 0xcd, 0x1e, 0xd3, 0x07, 0x87, 0x51,
+	0x10, 0x8b, 0x04, 0xbb, 0xd8, 0xb2, 0xcf, 0xaa, 0xa2, 0x7d, 0xac, 0x8f, 0x53, 0x50, 0x96, 0xa0,
+	0xba, 0xd3, 0xd6, 0xe2, 0xb9, 0x73, 0xf0, 0x3e, 0xa2, 0x9b, 0xfb, 0x42, 0x1e, 0x11, 0x49, 0x81,
+	0x86, 0x29, 0x89, 0xfb, 0xa0, 0x95, 0xff, 0x9f, 0xb5, 0x5d, 0x2d, 0xce, 0xe3, 0x45, 0x4e, 0xdb,
+	0xb1, 0x2c, 0xf7, 0x2f, 0x37, 0xf6, 0x7f, 0x2f, 0x2b, 0xef, 0x0d, 0xaa, 0x99, 0x86, 0x0a, 0x6d,
+	0x47, 0x29, 0xbf, 0x6a, 0xb5, 0x1f, 0x16, 0xc7, 0xd3, 0x25, 0x1a, 0x5e, 0x19, 0xbc, 0x53, 0x45,
+	0x32, 0x2f, 0x28, 0xef, 0x08, 0xcd, 0xa5, 0xc0, 0x29, 0xe3, 0xbd, 0xf0, 0x52, 0x17, 0x68, 0xa8,
+	0x80, 0x53, 0xe5, 0x4f, 0x58, 0xf9, 0x27, 0x57, 0xa4, 0x9f, 0xf1, 0x2f, 0x5c, 0x80, 0xee, 0x02,
+	0xa7, 0xce, 0x6b, 0x36, 0xfd, 0xe7, 0xa9, 0xf2, 0xde, 0xa3, 0x7a, 0x6e, 0x6c, 0x7b, 0x5e, 0xf9,
+	0xff, 0x5b, 0xb3, 0xe5, 0x6b, 0x99, 0x6d, 0x18, 0x8a, 0xb3, 0x98, 0x4e, 0x47, 0x6a, 0xca, 0xdb,
+	0x43, 0x33, 0x12, 0xf4, 0xa1, 0xe4, 0x40, 0x73, 0xe5, 0x49, 0xab, 0xbc, 0x72, 0x45, 0x4a, 0x8e,
+	0x34, 0x2a, 0x5d, 0x97, 0xa3, 0x45, 0xe5, 0x7d, 0x30, 0x3d, 0xd5, 0x07, 0x1e, 0x26, 0xa0, 0x09,
+	0x25, 0x9a, 0xf8, 0xc8, 0x4a, 0xaf, 0x5d, 0xa3, 0xd9, 0xdf, 0x19, 0xe2, 0x6b, 0xc7, 0xcb, 0xaf,
+	0xae, 0x47, 0x8b, 0x4b, 0x5f, 0xca, 0x68, 0xe6, 0x8f, 0x46, 0xf0, 0x36, 0x51, 0xcd, 0x35, 0x41,
+	0xd8, 0x87, 0x63, 0x3b, 0x74, 0xb5, 0xf6, 0x3d, 0xeb, 0x67, 0x06, 0x3e, 0xc8, 0xa7, 0xdc, 0x8e,
+	0x81, 0x61, 0x6c, 0xe5, 0xd1, 0x23, 0xc7, 0xdb, 0x86, 0x63, 0x6f, 0xdd, 0x0c, 0x94, 0x39, 0xf5,
+	0xc7, 0xac, 0xc0, 0x9d, 0x02, 0x81, 0xcb, 0x39, 0xb2, 0x17, 0xf0, 0xd1, 0x84, 0x04, 0x2d, 0x19,
+	0x64, 0xc3, 0x58, 0xe9, 0xe6, 0x5b, 0x6f, 0x11, 0x4d, 0x5d, 0x76, 0x3b, 0xd1, 0x7e, 0xc5, 0x1e,
+	0xd7, 0x2e, 0x6a, 0xcf, 0x74, 0xe7, 0xed, 0xc9, 0x59, 0xa3, 0x7c, 0x7a, 0xd6, 0x28, 0xff, 0x38,
+	0x6b, 0x94, 0x3f, 0x9d, 0x37, 0x4a, 0xa7, 0xe7, 0x8d, 0xd2, 0xd7, 0xf3, 0x46, 0x69, 0xef, 0xe9,
+	0xdf, 0x33, 0xc6, 0xa2, 0x78, 0xb5, 0x27, 0xf0, 0x70, 0x1d, 0x27, 0x82, 0x1e, 0x0e, 0x40, 0x99,
+	0xc7, 0x6f, 0xe4, 0xd1, 0xb3, 0x83, 0x17, 0x55, 0xed, 0xcb, 0xf4, 0xf8, 0xd7, 0x00, 0x05, 0x57,
+	0x88, 0x80, 0xed, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -224,6 +299,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TokenMetadata) > 0 {
+		for iNdEx := len(m.TokenMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ReturnedClaims) > 0 {
+		for iNdEx := len(m.ReturnedClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReturnedClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PendingClaims) > 0 {
+		for iNdEx := len(m.PendingClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PendingRateLimitedSends) > 0 {
+		for iNdEx := len(m.PendingRateLimitedSends) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRateLimitedSends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.ForwardedPackets) > 0 {
 		for iNdEx := len(m.ForwardedPackets) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -306,6 +451,16 @@ func (m *ForwardedPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ForwardedAt != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ForwardedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Retries != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -370,6 +525,36 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingRateLimitedSends) > 0 {
+		for _, e := range m.PendingRateLimitedSends {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingClaims) > 0 {
+		for _, e := range m.PendingClaims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReturnedClaims) > 0 {
+		for _, e := range m.ReturnedClaims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenMetadata) > 0 {
+		for _, e := range m.TokenMetadata {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -383,6 +568,12 @@ func (m *ForwardedPacket) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.Packet.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.Retries != 0 {
+		n += 1 + sovGenesis(uint64(m.Retries))
+	}
+	if m.ForwardedAt != 0 {
+		n += 1 + sovGenesis(uint64(m.ForwardedAt))
+	}
 	return n
 }
 
@@ -588,6 +779,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRateLimitedSends", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRateLimitedSends = append(m.PendingRateLimitedSends, PendingRateLimitedSend{})
+			if err := m.PendingRateLimitedSends[len(m.PendingRateLimitedSends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingClaims = append(m.PendingClaims, PendingClaim{})
+			if err := m.PendingClaims[len(m.PendingClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnedClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnedClaims = append(m.ReturnedClaims, ReturnedClaim{})
+			if err := m.ReturnedClaims[len(m.ReturnedClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenMetadata = append(m.TokenMetadata, DenomTokenMetadata{})
+			if err := m.TokenMetadata[len(m.TokenMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -704,6 +1065,44 @@ func (m *ForwardedPacket) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedAt", wireType)
+			}
+			m.ForwardedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,11 +5,27 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 )
 
 func TestValidateGenesis(t *testing.T) {
+	rateLimit := types.NewRateLimit(
+		"channel-0", "stake",
+		types.NewRateLimitQuota(10, 10, sdkmath.ZeroInt(), sdkmath.ZeroInt(), 100),
+		types.NewRateLimitFlow(sdkmath.NewInt(1000), 1),
+	)
+
+	claim := types.NewPendingClaim(channeltypes.NewPacketID("transfer", "channel-0", 1), sender, receiver, sdk.NewCoins(ibctesting.TestCoin), 100, 200)
+
+	tokenMetadata := types.NewDenomTokenMetadata(types.NewDenom("uatom", types.NewHop("transfer", "channel-0")).IBCDenom(), types.NewTokenMetadata("atom", "ATOM", 6, ""))
+
 	testCases := []struct {
 		name     string
 		genState *types.GenesisState
@@ -34,6 +50,92 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			host.ErrInvalidID,
 		},
+		{
+			"valid genesis with rate limits",
+			&types.GenesisState{
+				PortId:     "portidone",
+				RateLimits: []types.RateLimit{rateLimit},
+				PendingRateLimitedSends: []types.PendingRateLimitedSend{
+					types.NewPendingRateLimitedSend(rateLimit.ChannelId, rateLimit.Denom, 1, 1),
+				},
+			},
+			nil,
+		},
+		{
+			"invalid rate limit",
+			&types.GenesisState{
+				PortId:     "portidone",
+				RateLimits: []types.RateLimit{types.NewRateLimit("channel-0", "stake", types.RateLimitQuota{}, rateLimit.Flow)},
+			},
+			types.ErrInvalidRateLimit,
+		},
+		{
+			"duplicate rate limits",
+			&types.GenesisState{
+				PortId:     "portidone",
+				RateLimits: []types.RateLimit{rateLimit, rateLimit},
+			},
+			types.ErrInvalidRateLimit,
+		},
+		{
+			"invalid pending rate limited send",
+			&types.GenesisState{
+				PortId: "portidone",
+				PendingRateLimitedSends: []types.PendingRateLimitedSend{
+					types.NewPendingRateLimitedSend(rateLimit.ChannelId, rateLimit.Denom, 0, 1),
+				},
+			},
+			types.ErrInvalidRateLimit,
+		},
+		{
+			"valid genesis with claims",
+			&types.GenesisState{
+				PortId:         "portidone",
+				PendingClaims:  []types.PendingClaim{claim},
+				ReturnedClaims: []types.ReturnedClaim{types.NewReturnedClaim(channeltypes.NewPacketID("transfer", "channel-0", 2), claim)},
+			},
+			nil,
+		},
+		{
+			"invalid pending claim",
+			&types.GenesisState{
+				PortId:        "portidone",
+				PendingClaims: []types.PendingClaim{types.NewPendingClaim(claim.PacketId, sender, receiver, claim.Tokens, 0, 200)},
+			},
+			types.ErrInvalidClaim,
+		},
+		{
+			"invalid returned claim",
+			&types.GenesisState{
+				PortId:         "portidone",
+				ReturnedClaims: []types.ReturnedClaim{types.NewReturnedClaim(channeltypes.NewPacketID("transfer", "channel-0", 0), claim)},
+			},
+			types.ErrInvalidClaim,
+		},
+		{
+			"valid token metadata",
+			&types.GenesisState{
+				PortId:        "portidone",
+				TokenMetadata: []types.DenomTokenMetadata{tokenMetadata},
+			},
+			nil,
+		},
+		{
+			"invalid token metadata",
+			&types.GenesisState{
+				PortId:        "portidone",
+				TokenMetadata: []types.DenomTokenMetadata{types.NewDenomTokenMetadata("uatom", tokenMetadata.Metadata)},
+			},
+			types.ErrInvalidDenomForTransfer,
+		},
+		{
+			"duplicate token metadata",
+			&types.GenesisState{
+				PortId:        "portidone",
+				TokenMetadata: []types.DenomTokenMetadata{tokenMetadata, tokenMetadata},
+			},
+			types.ErrInvalidTokenMetadata,
+		},
 	}
 
 	for _, tc := range testCases {
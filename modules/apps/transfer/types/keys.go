@@ -55,6 +55,22 @@ var (
 	DenomKey = []byte{0x03}
 	// ForwardedPacketKey defines the key to store the forwarded packet in store
 	ForwardedPacketKey = []byte{0x04}
+	// RateLimitKey defines the key to store the rate limits in store
+	RateLimitKey = []byte{0x05}
+	// PendingRateLimitedSendKey defines the key to store the in-flight packets accounted for in a rate limit flow
+	PendingRateLimitedSendKey = []byte{0x06}
+	// ForwardedPacketRetriesKey defines the key to store the number of times a forwarded packet was sent again
+	ForwardedPacketRetriesKey = []byte{0x07}
+	// PendingClaimKey defines the key to store the claimable transfers held by the module
+	PendingClaimKey = []byte{0x08}
+	// ClaimDeadlineKey defines the key to store the queue of pending claims ordered by deadline
+	ClaimDeadlineKey = []byte{0x09}
+	// ReturnedClaimKey defines the key to store the claims whose tokens are being returned to the sender
+	ReturnedClaimKey = []byte{0x0a}
+	// ForwardedPacketTimeKey defines the key to store the time at which a forwarded packet was sent on the next hop
+	ForwardedPacketTimeKey = []byte{0x0b}
+	// TokenMetadataKey defines the key to store the token metadata recorded for IBC voucher denominations
+	TokenMetadataKey = []byte{0x0c}
 
 	// SupportedVersions defines all versions that are supported by the module
 	SupportedVersions = []string{V2, V1}
@@ -87,3 +103,51 @@ func TotalEscrowForDenomKey(denom string) []byte {
 func PacketForwardKey(portID, channelID string, sequence uint64) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s/%s", ForwardedPacketKey, portID, channelID, sdk.Uint64ToBigEndian(sequence)))
 }
+
+// PacketForwardRetriesKey returns the store key under which the number of retries of the forwarded
+// packet is stored for the provided portID, channelID, and packet sequence.
+func PacketForwardRetriesKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s", ForwardedPacketRetriesKey, portID, channelID, sdk.Uint64ToBigEndian(sequence)))
+}
+
+// PacketForwardTimeKey returns the store key under which the time at which the forwarded packet
+// was sent on the next hop is stored for the provided portID, channelID, and packet sequence.
+func PacketForwardTimeKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s", ForwardedPacketTimeKey, portID, channelID, sdk.Uint64ToBigEndian(sequence)))
+}
+
+// PendingClaimStoreKey returns the store key under which the pending claim of the claimable
+// transfer received in the packet with the provided portID, channelID, and sequence is stored.
+func PendingClaimStoreKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s", PendingClaimKey, portID, channelID, sdk.Uint64ToBigEndian(sequence)))
+}
+
+// ClaimDeadlineStoreKey returns the store key under which the pending claim with the provided deadline
+// is queued. The big endian encoding of the deadline orders the queue by deadline.
+func ClaimDeadlineStoreKey(deadline uint64, portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s/%s", ClaimDeadlineKey, sdk.Uint64ToBigEndian(deadline), portID, channelID, sdk.Uint64ToBigEndian(sequence)))
+}
+
+// ReturnedClaimStoreKey returns the store key under which the returned claim is stored for the
+// packet returning the tokens with the provided portID, channelID, and sequence.
+func ReturnedClaimStoreKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s", ReturnedClaimKey, portID, channelID, sdk.Uint64ToBigEndian(sequence)))
+}
+
+// TokenMetadataStoreKey returns the store key under which the token metadata recorded for the
+// provided IBC voucher denomination is stored.
+func TokenMetadataStoreKey(denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", TokenMetadataKey, denom))
+}
+
+// RateLimitStoreKey returns the store key under which the rate limit is stored
+// for the provided channelID and denom.
+func RateLimitStoreKey(channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", RateLimitKey, channelID, denom))
+}
+
+// PendingRateLimitedSendStoreKey returns the store key under which the pending rate limited
+// send is stored for the provided channelID, packet sequence and denom.
+func PendingRateLimitedSendStoreKey(channelID string, sequence uint64, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s", PendingRateLimitedSendKey, channelID, sdk.Uint64ToBigEndian(sequence), denom))
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// MemoKeyValidator validates the value of a top-level memo key owned by a middleware or application.
+// The value is passed as the raw JSON of the key in the memo.
+type MemoKeyValidator func(value json.RawMessage) error
+
+// ValidateMemoKeys validates the top-level keys of the memo against the given memo key validators. Every key
+// must have an entry in validators, and its value must be accepted by the validator unless the validator is nil.
+// Memos that are not JSON objects are free-form and have no keys to validate, unless they are malformed JSON objects.
+func ValidateMemoKeys(memo string, validators map[string]MemoKeyValidator) error {
+	if memo == "" {
+		return nil
+	}
+
+	var memoKeys map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &memoKeys); err != nil {
+		if !strings.HasPrefix(strings.TrimSpace(memo), "{") {
+			return nil
+		}
+
+		return errorsmod.Wrapf(ErrInvalidMemo, "memo is not a valid JSON object: %v", err)
+	}
+
+	// keys are validated in a deterministic order, so that the same error is returned on every node
+	keys := make([]string, 0, len(memoKeys))
+	for key := range memoKeys {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		validator, found := validators[key]
+		if !found {
+			return errorsmod.Wrapf(ErrInvalidMemo, "unknown memo key %s", key)
+		}
+
+		if validator == nil {
+			continue
+		}
+
+		if err := validator(memoKeys[key]); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMemo, "invalid value for memo key %s: %v", key, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+)
+
+// validateAmountMemo is a memo key validator that requires the value to be a json object containing an amount.
+func validateAmountMemo(value json.RawMessage) error {
+	var data struct {
+		Amount string `json:"amount"`
+	}
+	if err := json.Unmarshal(value, &data); err != nil {
+		return err
+	}
+
+	if data.Amount == "" {
+		return errors.New("amount cannot be empty")
+	}
+
+	return nil
+}
+
+func TestValidateMemoKeys(t *testing.T) {
+	validators := map[string]types.MemoKeyValidator{
+		"amount": validateAmountMemo,
+		"any":    nil,
+	}
+
+	testCases := []struct {
+		name     string
+		memo     string
+		expError error
+	}{
+		{"success: registered key with valid value", `{"amount": {"amount": "100"}}`, nil},
+		{"success: key with nil validator", `{"any": [1, 2, 3]}`, nil},
+		{"success: empty memo", "", nil},
+		{"success: free-form memo", "free-form memo", nil},
+		{"failure: unknown key", `{"amount": {"amount": "100"}, "unknown": {}}`, types.ErrInvalidMemo},
+		{"failure: invalid value", `{"amount": {"amount": ""}}`, types.ErrInvalidMemo},
+		{"failure: malformed json object", `{"amount": `, types.ErrInvalidMemo},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateMemoKeys(tc.memo, validators)
+
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
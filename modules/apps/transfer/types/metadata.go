@@ -0,0 +1,81 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	MaximumMetadataSymbolLength = 64  // maximum length of the symbol of the token metadata in bytes (value chosen arbitrarily)
+	MaximumMetadataURILength    = 512 // maximum length of the URI of the token metadata in bytes (value chosen arbitrarily)
+	MaximumMetadataExponent     = 36  // maximum exponent of the display unit of the token metadata (value chosen arbitrarily)
+)
+
+// NewTokenMetadata creates a new TokenMetadata instance
+func NewTokenMetadata(display, symbol string, exponent uint32, uri string) TokenMetadata {
+	return TokenMetadata{
+		Display:  display,
+		Symbol:   symbol,
+		Exponent: exponent,
+		Uri:      uri,
+	}
+}
+
+// Validate performs a basic validation of the TokenMetadata fields.
+func (m TokenMetadata) Validate() error {
+	if err := sdk.ValidateDenom(m.Display); err != nil {
+		return errorsmod.Wrapf(ErrInvalidTokenMetadata, "invalid display denomination: %v", err)
+	}
+
+	if strings.TrimSpace(m.Symbol) == "" {
+		return errorsmod.Wrap(ErrInvalidTokenMetadata, "symbol cannot be blank")
+	}
+
+	if len(m.Symbol) > MaximumMetadataSymbolLength {
+		return errorsmod.Wrapf(ErrInvalidTokenMetadata, "symbol must not exceed %d bytes", MaximumMetadataSymbolLength)
+	}
+
+	if m.Exponent > MaximumMetadataExponent {
+		return errorsmod.Wrapf(ErrInvalidTokenMetadata, "exponent must not exceed %d", MaximumMetadataExponent)
+	}
+
+	if len(m.Uri) > MaximumMetadataURILength {
+		return errorsmod.Wrapf(ErrInvalidTokenMetadata, "URI must not exceed %d bytes", MaximumMetadataURILength)
+	}
+
+	return nil
+}
+
+// NewDenomTokenMetadata creates a new DenomTokenMetadata instance
+func NewDenomTokenMetadata(denom string, metadata TokenMetadata) DenomTokenMetadata {
+	return DenomTokenMetadata{
+		Denom:    denom,
+		Metadata: metadata,
+	}
+}
+
+// Validate performs a basic validation of the DenomTokenMetadata fields.
+func (m DenomTokenMetadata) Validate() error {
+	if err := ValidateVoucherDenom(m.Denom); err != nil {
+		return err
+	}
+
+	return m.Metadata.Validate()
+}
+
+// ValidateVoucherDenom validates that the given denomination is an IBC voucher denomination
+// of the format 'ibc/{hash(trace + "/" + baseDenom)}'.
+func ValidateVoucherDenom(denom string) error {
+	if !strings.HasPrefix(denom, DenomPrefix+"/") {
+		return errorsmod.Wrapf(ErrInvalidDenomForTransfer, "denomination %s is not an IBC voucher denomination", denom)
+	}
+
+	if err := validateIBCDenom(denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenomForTransfer, err.Error())
+	}
+
+	return nil
+}
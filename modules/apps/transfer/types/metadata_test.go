@@ -0,0 +1,113 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+)
+
+func TestTokenMetadataValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		metadata types.TokenMetadata
+		expError error
+	}{
+		{
+			"success",
+			types.NewTokenMetadata("atom", "ATOM", 6, "https://cosmos.network"),
+			nil,
+		},
+		{
+			"success: display unit is the base denomination",
+			types.NewTokenMetadata("uatom", "ATOM", 0, ""),
+			nil,
+		},
+		{
+			"failure: invalid display denomination",
+			types.NewTokenMetadata("a", "ATOM", 6, ""),
+			types.ErrInvalidTokenMetadata,
+		},
+		{
+			"failure: blank symbol",
+			types.NewTokenMetadata("atom", " ", 6, ""),
+			types.ErrInvalidTokenMetadata,
+		},
+		{
+			"failure: symbol too long",
+			types.NewTokenMetadata("atom", strings.Repeat("A", types.MaximumMetadataSymbolLength+1), 6, ""),
+			types.ErrInvalidTokenMetadata,
+		},
+		{
+			"failure: exponent too large",
+			types.NewTokenMetadata("atom", "ATOM", types.MaximumMetadataExponent+1, ""),
+			types.ErrInvalidTokenMetadata,
+		},
+		{
+			"failure: URI too long",
+			types.NewTokenMetadata("atom", "ATOM", 6, strings.Repeat("a", types.MaximumMetadataURILength+1)),
+			types.ErrInvalidTokenMetadata,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.metadata.Validate()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestDenomTokenMetadataValidate(t *testing.T) {
+	voucherDenom := types.NewDenom("uatom", types.NewHop("transfer", "channel-0")).IBCDenom()
+	metadata := types.NewTokenMetadata("atom", "ATOM", 6, "")
+
+	testCases := []struct {
+		name     string
+		denom    string
+		metadata types.TokenMetadata
+		expError error
+	}{
+		{
+			"success",
+			voucherDenom,
+			metadata,
+			nil,
+		},
+		{
+			"failure: denomination is not an IBC voucher",
+			"uatom",
+			metadata,
+			types.ErrInvalidDenomForTransfer,
+		},
+		{
+			"failure: invalid hash",
+			"ibc/invalid",
+			metadata,
+			types.ErrInvalidDenomForTransfer,
+		},
+		{
+			"failure: invalid metadata",
+			voucherDenom,
+			types.TokenMetadata{},
+			types.ErrInvalidTokenMetadata,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.NewDenomTokenMetadata(tc.denom, tc.metadata).Validate()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
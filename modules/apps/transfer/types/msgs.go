@@ -16,13 +16,24 @@ const (
 	MaximumReceiverLength = 2048  // maximum length of the receiver address in bytes (value chosen arbitrarily)
 	MaximumMemoLength     = 32768 // maximum length of the memo in bytes (value chosen arbitrarily)
 	MaximumTokensLength   = 100   // maximum number of tokens that can be transferred in a single message (value chosen arbitrarily)
+	MaximumOutputsLength  = 100   // maximum number of receivers of a multi transfer (value chosen arbitrarily)
 )
 
 var (
 	_ sdk.Msg              = (*MsgUpdateParams)(nil)
 	_ sdk.Msg              = (*MsgTransfer)(nil)
+	_ sdk.Msg              = (*MsgSetRateLimit)(nil)
+	_ sdk.Msg              = (*MsgRemoveRateLimit)(nil)
+	_ sdk.Msg              = (*MsgClaimTransfer)(nil)
+	_ sdk.Msg              = (*MsgMultiTransfer)(nil)
+	_ sdk.Msg              = (*MsgUpdateDenomMetadata)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 	_ sdk.HasValidateBasic = (*MsgTransfer)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetRateLimit)(nil)
+	_ sdk.HasValidateBasic = (*MsgRemoveRateLimit)(nil)
+	_ sdk.HasValidateBasic = (*MsgClaimTransfer)(nil)
+	_ sdk.HasValidateBasic = (*MsgMultiTransfer)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateDenomMetadata)(nil)
 )
 
 // NewMsgUpdateParams creates a new MsgUpdateParams instance
@@ -40,6 +51,111 @@ func (msg MsgUpdateParams) ValidateBasic() error {
 		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
 	}
 
+	return msg.Params.Validate()
+}
+
+// NewMsgSetRateLimit creates a new MsgSetRateLimit instance
+func NewMsgSetRateLimit(signer, channelID, denom string, quota RateLimitQuota) *MsgSetRateLimit {
+	return &MsgSetRateLimit{
+		Signer:    signer,
+		ChannelId: channelID,
+		Denom:     denom,
+		Quota:     quota,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSetRateLimit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid channel ID %s", msg.ChannelId)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+
+	return msg.Quota.ValidateBasic()
+}
+
+// NewMsgRemoveRateLimit creates a new MsgRemoveRateLimit instance
+func NewMsgRemoveRateLimit(signer, channelID, denom string) *MsgRemoveRateLimit {
+	return &MsgRemoveRateLimit{
+		Signer:    signer,
+		ChannelId: channelID,
+		Denom:     denom,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRemoveRateLimit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid channel ID %s", msg.ChannelId)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgUpdateDenomMetadata creates a new MsgUpdateDenomMetadata instance
+func NewMsgUpdateDenomMetadata(signer, denom string, metadata TokenMetadata) *MsgUpdateDenomMetadata {
+	return &MsgUpdateDenomMetadata{
+		Signer:   signer,
+		Denom:    denom,
+		Metadata: metadata,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateDenomMetadata) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return NewDenomTokenMetadata(msg.Denom, msg.Metadata).Validate()
+}
+
+// NewMsgClaimTransfer creates a new MsgClaimTransfer instance
+func NewMsgClaimTransfer(receiver, portID, channelID string, sequence uint64) *MsgClaimTransfer {
+	return &MsgClaimTransfer{
+		Receiver:  receiver,
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequence:  sequence,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgClaimTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	if msg.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidClaim, "packet sequence cannot be 0")
+	}
+
 	return nil
 }
 
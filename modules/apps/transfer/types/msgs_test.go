@@ -81,7 +81,7 @@ func TestMsgTransferValidation(t *testing.T) {
 		{"multidenom: invalid ibc denom", types.NewMsgTransfer(validPort, validChannel, coins.Add(invalidIBCCoins...), sender, receiver, clienttypes.ZeroHeight(), 100, "", nil), ibcerrors.ErrInvalidCoins},
 		{"multidenom: zero coins", types.NewMsgTransfer(validPort, validChannel, zeroCoins, sender, receiver, clienttypes.ZeroHeight(), 100, "", nil), ibcerrors.ErrInvalidCoins},
 		{"multidenom: too many coins", types.NewMsgTransfer(validPort, validChannel, make([]sdk.Coin, types.MaximumTokensLength+1), sender, receiver, clienttypes.ZeroHeight(), 100, "", nil), ibcerrors.ErrInvalidCoins},
-		{"multidenom: both token and tokens are set", &types.MsgTransfer{validPort, validChannel, coin, sender, receiver, clienttypes.ZeroHeight(), 100, "", coins, nil}, ibcerrors.ErrInvalidCoins},
+		{"multidenom: both token and tokens are set", &types.MsgTransfer{validPort, validChannel, coin, sender, receiver, clienttypes.ZeroHeight(), 100, "", coins, nil, 0, false}, ibcerrors.ErrInvalidCoins},
 		{"timeout height must be zero if forwarding path hops is not empty", types.NewMsgTransfer(validPort, validChannel, coins, sender, receiver, timeoutHeight, 100, "memo", types.NewForwarding(false, validHop)), types.ErrInvalidPacketTimeout},
 		{"invalid forwarding info port", types.NewMsgTransfer(validPort, validChannel, coins, sender, receiver, clienttypes.ZeroHeight(), 100, "", types.NewForwarding(false, types.NewHop(invalidPort, validChannel))), types.ErrInvalidForwarding},
 		{"invalid forwarding info channel", types.NewMsgTransfer(validPort, validChannel, coins, sender, receiver, clienttypes.ZeroHeight(), 100, "", types.NewForwarding(false, types.NewHop(validPort, invalidChannel))), types.ErrInvalidForwarding},
@@ -127,6 +127,11 @@ func TestMsgUpdateParamsValidateBasic(t *testing.T) {
 		{"success: valid signer and valid params", types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.DefaultParams()), nil},
 		{"failure: invalid signer with valid params", types.NewMsgUpdateParams(invalidAddress, types.DefaultParams()), ibcerrors.ErrInvalidAddress},
 		{"failure: empty signer with valid params", types.NewMsgUpdateParams(emptyAddr, types.DefaultParams()), ibcerrors.ErrInvalidAddress},
+		{
+			"failure: valid signer with invalid denom policy",
+			types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.Params{SendPolicies: []types.DenomPolicy{types.NewDenomPolicy("", types.UNSPECIFIED, "uatom")}}),
+			types.ErrInvalidDenomPolicy,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -173,3 +178,110 @@ func TestMsgUpdateParamsGetSigners(t *testing.T) {
 		})
 	}
 }
+
+// TestMsgSetRateLimitValidation tests ValidateBasic for MsgSetRateLimit
+func TestMsgSetRateLimitValidation(t *testing.T) {
+	quota := types.NewRateLimitQuota(10, 10, sdkmath.ZeroInt(), sdkmath.ZeroInt(), 100)
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgSetRateLimit
+		expError error
+	}{
+		{"success: valid msg", types.NewMsgSetRateLimit(sender, validChannel, coin.Denom, quota), nil},
+		{"failure: invalid signer", types.NewMsgSetRateLimit(invalidAddress, validChannel, coin.Denom, quota), ibcerrors.ErrInvalidAddress},
+		{"failure: invalid channel", types.NewMsgSetRateLimit(sender, invalidChannel, coin.Denom, quota), host.ErrInvalidID},
+		{"failure: invalid denom", types.NewMsgSetRateLimit(sender, validChannel, "0atom", quota), types.ErrInvalidRateLimit},
+		{"failure: invalid quota", types.NewMsgSetRateLimit(sender, validChannel, coin.Denom, types.RateLimitQuota{}), types.ErrInvalidRateLimit},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestMsgRemoveRateLimitValidation tests ValidateBasic for MsgRemoveRateLimit
+func TestMsgRemoveRateLimitValidation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		msg      *types.MsgRemoveRateLimit
+		expError error
+	}{
+		{"success: valid msg", types.NewMsgRemoveRateLimit(sender, validChannel, coin.Denom), nil},
+		{"failure: invalid signer", types.NewMsgRemoveRateLimit(invalidAddress, validChannel, coin.Denom), ibcerrors.ErrInvalidAddress},
+		{"failure: invalid channel", types.NewMsgRemoveRateLimit(sender, invalidChannel, coin.Denom), host.ErrInvalidID},
+		{"failure: invalid denom", types.NewMsgRemoveRateLimit(sender, validChannel, "0atom"), types.ErrInvalidRateLimit},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestMsgUpdateDenomMetadataValidation tests ValidateBasic for MsgUpdateDenomMetadata
+func TestMsgUpdateDenomMetadataValidation(t *testing.T) {
+	voucherDenom := types.NewDenom("uatom", types.NewHop(validPort, validChannel)).IBCDenom()
+	metadata := types.NewTokenMetadata("atom", "ATOM", 6, "")
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgUpdateDenomMetadata
+		expError error
+	}{
+		{"success: valid msg", types.NewMsgUpdateDenomMetadata(sender, voucherDenom, metadata), nil},
+		{"failure: invalid signer", types.NewMsgUpdateDenomMetadata(invalidAddress, voucherDenom, metadata), ibcerrors.ErrInvalidAddress},
+		{"failure: denom is not an IBC voucher", types.NewMsgUpdateDenomMetadata(sender, "uatom", metadata), types.ErrInvalidDenomForTransfer},
+		{"failure: invalid metadata", types.NewMsgUpdateDenomMetadata(sender, voucherDenom, types.TokenMetadata{}), types.ErrInvalidTokenMetadata},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestMsgClaimTransferValidation tests ValidateBasic for MsgClaimTransfer
+func TestMsgClaimTransferValidation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		msg      *types.MsgClaimTransfer
+		expError error
+	}{
+		{"success: valid msg", types.NewMsgClaimTransfer(receiver, validPort, validChannel, 1), nil},
+		{"failure: invalid receiver", types.NewMsgClaimTransfer(invalidAddress, validPort, validChannel, 1), ibcerrors.ErrInvalidAddress},
+		{"failure: invalid port", types.NewMsgClaimTransfer(receiver, invalidPort, validChannel, 1), host.ErrInvalidID},
+		{"failure: invalid channel", types.NewMsgClaimTransfer(receiver, validPort, invalidChannel, 1), host.ErrInvalidID},
+		{"failure: zero sequence", types.NewMsgClaimTransfer(receiver, validPort, validChannel, 0), types.ErrInvalidClaim},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
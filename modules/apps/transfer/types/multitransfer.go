@@ -0,0 +1,290 @@
+package types
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// NewMsgMultiTransfer creates a new MsgMultiTransfer instance
+func NewMsgMultiTransfer(
+	sourcePort, sourceChannel string,
+	sender string, outputs []MultiTransferOutput,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+	memo string,
+) *MsgMultiTransfer {
+	return &MsgMultiTransfer{
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		Sender:           sender,
+		Outputs:          outputs,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgMultiTransfer fields.
+// NOTE: timeout height or timestamp values can be 0 to disable the timeout.
+// NOTE: The recipient addresses format is not validated as the format defined by
+// the chain is not known to IBC.
+func (msg MsgMultiTransfer) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
+		return errorsmod.Wrapf(err, "invalid source port ID %s", msg.SourcePort)
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return errorsmod.Wrapf(err, "invalid source channel ID %s", msg.SourceChannel)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if len(msg.Outputs) == 0 {
+		return errorsmod.Wrap(ErrInvalidMultiTransfer, "outputs cannot be empty")
+	}
+	if len(msg.Outputs) > MaximumOutputsLength {
+		return errorsmod.Wrapf(ErrInvalidMultiTransfer, "number of outputs must not exceed %d", MaximumOutputsLength)
+	}
+
+	for _, output := range msg.Outputs {
+		if err := output.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if len(msg.GetCoins()) > MaximumTokensLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "number of tokens must not exceed %d", MaximumTokensLength)
+	}
+
+	if len(msg.Memo) > MaximumMemoLength {
+		return errorsmod.Wrapf(ErrInvalidMemo, "memo must not exceed %d bytes", MaximumMemoLength)
+	}
+
+	return nil
+}
+
+// GetCoins returns the total amount of tokens which will be transferred to all the receivers.
+func (msg MsgMultiTransfer) GetCoins() sdk.Coins {
+	coins := sdk.NewCoins()
+	for _, output := range msg.Outputs {
+		coins = coins.Add(output.Tokens...)
+	}
+
+	return coins
+}
+
+// NewMultiTransferOutput creates a new MultiTransferOutput instance
+func NewMultiTransferOutput(receiver string, tokens sdk.Coins) MultiTransferOutput {
+	return MultiTransferOutput{
+		Receiver: receiver,
+		Tokens:   tokens,
+	}
+}
+
+// Validate performs a basic validation of the MultiTransferOutput fields.
+func (o MultiTransferOutput) Validate() error {
+	if strings.TrimSpace(o.Receiver) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "missing recipient address")
+	}
+	if len(o.Receiver) > MaximumReceiverLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "recipient address must not exceed %d bytes", MaximumReceiverLength)
+	}
+
+	if o.Tokens.Empty() {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "tokens of receiver %s cannot be empty", o.Receiver)
+	}
+	if err := o.Tokens.Validate(); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "%s: %s", err.Error(), o.Tokens)
+	}
+
+	for _, coin := range o.Tokens {
+		if err := validateIBCCoin(coin); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "%s: %s", err.Error(), coin.String())
+		}
+
+		// the whole balance cannot be split between receivers
+		if coin.Amount.Equal(UnboundedSpendLimit()) {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "cannot transfer the whole balance of %s in a multi transfer", coin.Denom)
+		}
+	}
+
+	return nil
+}
+
+// NewReceiverTokens creates a new ReceiverTokens instance
+func NewReceiverTokens(receiver string, tokens []Token) ReceiverTokens {
+	return ReceiverTokens{
+		Receiver: receiver,
+		Tokens:   tokens,
+	}
+}
+
+// Validate performs a basic validation of the ReceiverTokens fields.
+func (rt ReceiverTokens) Validate() error {
+	if strings.TrimSpace(rt.Receiver) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "receiver address cannot be blank")
+	}
+	if len(rt.Receiver) > MaximumReceiverLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "receiver address must not exceed %d bytes", MaximumReceiverLength)
+	}
+
+	if len(rt.Tokens) == 0 {
+		return errorsmod.Wrapf(ErrInvalidAmount, "tokens of receiver %s cannot be empty", rt.Receiver)
+	}
+
+	for _, token := range rt.Tokens {
+		if err := token.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateReceivers ensures the receivers of a multi transfer are valid and are
+// transferred exactly the tokens of the packet.
+func (ftpd FungibleTokenPacketDataV2) validateReceivers() error {
+	if ftpd.Receiver != "" {
+		return errorsmod.Wrap(ErrInvalidMultiTransfer, "receiver must be empty if receivers are set")
+	}
+
+	if len(ftpd.Receivers) > MaximumOutputsLength {
+		return errorsmod.Wrapf(ErrInvalidMultiTransfer, "number of receivers must not exceed %d", MaximumOutputsLength)
+	}
+
+	if ftpd.HasForwarding() {
+		return errorsmod.Wrap(ErrInvalidMultiTransfer, "cannot forward tokens to multiple receivers")
+	}
+
+	if ftpd.ClaimTimeout > 0 {
+		return errorsmod.Wrap(ErrInvalidMultiTransfer, "cannot transfer claimable tokens to multiple receivers")
+	}
+
+	var receiverTokens []Token
+	for _, receiver := range ftpd.Receivers {
+		if err := receiver.Validate(); err != nil {
+			return err
+		}
+
+		receiverTokens = append(receiverTokens, receiver.Tokens...)
+	}
+
+	total, err := MergeTokens(receiverTokens)
+	if err != nil {
+		return err
+	}
+
+	sent, err := MergeTokens(ftpd.Tokens)
+	if err != nil {
+		return err
+	}
+
+	if !total.equal(sent) {
+		return errorsmod.Wrapf(ErrInvalidMultiTransfer, "tokens of the receivers %s do not add up to the tokens transferred %s", total, sent)
+	}
+
+	return nil
+}
+
+// MergeTokens returns the tokens with the amounts of the tokens of the same
+// denomination added together, in the order of their first occurrence.
+func MergeTokens(tokens []Token) (Tokens, error) {
+	merged := make(Tokens, 0, len(tokens))
+	indexes := make(map[string]int)
+
+	for _, token := range tokens {
+		amount, ok := sdkmath.NewIntFromString(token.Amount)
+		if !ok {
+			return nil, errorsmod.Wrapf(ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", token.Amount)
+		}
+
+		path := token.Denom.Path()
+		index, found := indexes[path]
+		if !found {
+			indexes[path] = len(merged)
+			merged = append(merged, token)
+			continue
+		}
+
+		total, _ := sdkmath.NewIntFromString(merged[index].Amount)
+		merged[index].Amount = total.Add(amount).String()
+	}
+
+	return merged, nil
+}
+
+// equal returns true if both merged token lists contain the same amounts of the same denominations.
+func (t Tokens) equal(other Tokens) bool {
+	if len(t) != len(other) {
+		return false
+	}
+
+	amounts := make(map[string]string, len(t))
+	for _, token := range t {
+		amounts[token.Denom.Path()] = token.Amount
+	}
+
+	for _, token := range other {
+		if amount, found := amounts[token.Denom.Path()]; !found || amount != token.Amount {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NewMultiTransferAcknowledgement creates a new MultiTransferAcknowledgement instance
+func NewMultiTransferAcknowledgement(results []ReceiverResult) MultiTransferAcknowledgement {
+	return MultiTransferAcknowledgement{
+		Results: results,
+	}
+}
+
+// NewReceiverResult creates a new ReceiverResult for the receiver. The result is successful
+// if err is nil, otherwise the error is recorded in the same deterministic format as error
+// acknowledgements.
+func NewReceiverResult(receiver string, err error) ReceiverResult {
+	if err == nil {
+		return ReceiverResult{Receiver: receiver, Success: true}
+	}
+
+	ack := channeltypes.NewErrorAcknowledgement(err)
+	return ReceiverResult{
+		Receiver: receiver,
+		Error:    ack.GetError(),
+	}
+}
+
+// GetBytes is a helper for serialising a MultiTransferAcknowledgement. It uses protobuf to serialise
+// the acknowledgement and panics on failure.
+func (ack MultiTransferAcknowledgement) GetBytes() []byte {
+	bz, err := proto.Marshal(&ack)
+	if err != nil {
+		panic(errors.New("cannot marshal MultiTransferAcknowledgement into bytes"))
+	}
+
+	return bz
+}
+
+// UnmarshalMultiTransferAcknowledgement unmarshals the result of the successful acknowledgement
+// of a multi transfer packet into a MultiTransferAcknowledgement.
+func UnmarshalMultiTransferAcknowledgement(bz []byte) (MultiTransferAcknowledgement, error) {
+	var ack MultiTransferAcknowledgement
+	if err := proto.Unmarshal(bz, &ack); err != nil {
+		return MultiTransferAcknowledgement{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal multi transfer acknowledgement: %s", err.Error())
+	}
+
+	return ack, nil
+}
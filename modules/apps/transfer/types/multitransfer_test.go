@@ -0,0 +1,212 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func TestMsgMultiTransferValidation(t *testing.T) {
+	outputs := []types.MultiTransferOutput{
+		types.NewMultiTransferOutput(receiver, coins),
+		types.NewMultiTransferOutput(sender, ibcCoins),
+	}
+
+	tooManyOutputs := make([]types.MultiTransferOutput, types.MaximumOutputsLength+1)
+	for i := range tooManyOutputs {
+		tooManyOutputs[i] = types.NewMultiTransferOutput(receiver, coins)
+	}
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgMultiTransfer
+		expErr error
+	}{
+		{"success", types.NewMsgMultiTransfer(validPort, validChannel, sender, outputs, timeoutHeight, 0, ""), nil},
+		{"success: same receiver in multiple outputs", types.NewMsgMultiTransfer(validPort, validChannel, sender, []types.MultiTransferOutput{outputs[0], outputs[0]}, timeoutHeight, 0, ""), nil},
+		{"failure: invalid port", types.NewMsgMultiTransfer(invalidPort, validChannel, sender, outputs, timeoutHeight, 0, ""), host.ErrInvalidID},
+		{"failure: invalid channel", types.NewMsgMultiTransfer(validPort, invalidChannel, sender, outputs, timeoutHeight, 0, ""), host.ErrInvalidID},
+		{"failure: invalid sender", types.NewMsgMultiTransfer(validPort, validChannel, invalidAddress, outputs, timeoutHeight, 0, ""), ibcerrors.ErrInvalidAddress},
+		{"failure: empty outputs", types.NewMsgMultiTransfer(validPort, validChannel, sender, nil, timeoutHeight, 0, ""), types.ErrInvalidMultiTransfer},
+		{"failure: too many outputs", types.NewMsgMultiTransfer(validPort, validChannel, sender, tooManyOutputs, timeoutHeight, 0, ""), types.ErrInvalidMultiTransfer},
+		{"failure: blank receiver", types.NewMsgMultiTransfer(validPort, validChannel, sender, []types.MultiTransferOutput{types.NewMultiTransferOutput("  ", coins)}, timeoutHeight, 0, ""), ibcerrors.ErrInvalidAddress},
+		{"failure: empty tokens", types.NewMsgMultiTransfer(validPort, validChannel, sender, []types.MultiTransferOutput{types.NewMultiTransferOutput(receiver, sdk.NewCoins())}, timeoutHeight, 0, ""), ibcerrors.ErrInvalidCoins},
+		{"failure: zero tokens", types.NewMsgMultiTransfer(validPort, validChannel, sender, []types.MultiTransferOutput{types.NewMultiTransferOutput(receiver, zeroCoins)}, timeoutHeight, 0, ""), ibcerrors.ErrInvalidCoins},
+		{"failure: invalid ibc denom", types.NewMsgMultiTransfer(validPort, validChannel, sender, []types.MultiTransferOutput{types.NewMultiTransferOutput(receiver, invalidIBCCoins)}, timeoutHeight, 0, ""), ibcerrors.ErrInvalidCoins},
+		{"failure: unbounded spend limit", types.NewMsgMultiTransfer(validPort, validChannel, sender, []types.MultiTransferOutput{types.NewMultiTransferOutput(receiver, sdk.NewCoins(sdk.NewCoin(coin.Denom, types.UnboundedSpendLimit())))}, timeoutHeight, 0, ""), ibcerrors.ErrInvalidCoins},
+		{"failure: memo too long", types.NewMsgMultiTransfer(validPort, validChannel, sender, outputs, timeoutHeight, 0, ibctesting.GenerateString(types.MaximumMemoLength+1)), types.ErrInvalidMemo},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestMsgMultiTransferGetCoins(t *testing.T) {
+	msg := types.NewMsgMultiTransfer(validPort, validChannel, sender, []types.MultiTransferOutput{
+		types.NewMultiTransferOutput(receiver, coins),
+		types.NewMultiTransferOutput(sender, coins.Add(ibcCoins...)),
+	}, timeoutHeight, 0, "")
+
+	require.Equal(t, coins.Add(coins...).Add(ibcCoins...), msg.GetCoins())
+}
+
+func TestFungibleTokenPacketDataV2MultiTransferValidateBasic(t *testing.T) {
+	atom := types.Token{Denom: types.NewDenom("atom"), Amount: amount}
+	osmo := types.Token{Denom: types.NewDenom("uosmo", types.NewHop("transfer", "channel-1")), Amount: amount}
+
+	var packetData types.FungibleTokenPacketDataV2
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: tokens of the same denomination split between receivers",
+			func() {
+				packetData.Tokens = []types.Token{{Denom: atom.Denom, Amount: "200"}, osmo}
+				packetData.Receivers = append(packetData.Receivers, types.NewReceiverTokens(sender, []types.Token{atom}))
+			},
+			nil,
+		},
+		{
+			"failure: receiver is set",
+			func() {
+				packetData.Receiver = receiver
+			},
+			types.ErrInvalidMultiTransfer,
+		},
+		{
+			"failure: forwarding is set",
+			func() {
+				packetData.Forwarding = types.NewForwardingPacketData("", validHop)
+			},
+			types.ErrInvalidMultiTransfer,
+		},
+		{
+			"failure: claim timeout is set",
+			func() {
+				packetData.ClaimTimeout = 100
+			},
+			types.ErrInvalidMultiTransfer,
+		},
+		{
+			"failure: blank receiver address",
+			func() {
+				packetData.Receivers[0].Receiver = ""
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: receiver without tokens",
+			func() {
+				packetData.Receivers[0].Tokens = nil
+				packetData.Tokens = []types.Token{osmo}
+			},
+			types.ErrInvalidAmount,
+		},
+		{
+			"failure: tokens of the receivers exceed the tokens transferred",
+			func() {
+				packetData.Receivers = append(packetData.Receivers, types.NewReceiverTokens(sender, []types.Token{atom}))
+			},
+			types.ErrInvalidMultiTransfer,
+		},
+		{
+			"failure: tokens of the receivers are less than the tokens transferred",
+			func() {
+				packetData.Receivers = packetData.Receivers[:1]
+			},
+			types.ErrInvalidMultiTransfer,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			packetData = types.NewFungibleTokenPacketDataV2([]types.Token{atom, osmo}, sender, "", "", types.ForwardingPacketData{})
+			packetData.Receivers = []types.ReceiverTokens{
+				types.NewReceiverTokens(receiver, []types.Token{atom}),
+				types.NewReceiverTokens(sender, []types.Token{osmo}),
+			}
+
+			tc.malleate()
+
+			require.True(t, packetData.IsMultiTransfer())
+
+			err := packetData.ValidateBasic()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestMergeTokens(t *testing.T) {
+	atom := types.NewDenom("atom")
+	osmo := types.NewDenom("uosmo", types.NewHop("transfer", "channel-1"))
+
+	large, ok := sdkmath.NewIntFromString(largeAmount)
+	require.True(t, ok)
+
+	merged, err := types.MergeTokens([]types.Token{
+		{Denom: osmo, Amount: "1"},
+		{Denom: atom, Amount: "2"},
+		{Denom: osmo, Amount: largeAmount},
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.Tokens{
+		{Denom: osmo, Amount: large.AddRaw(1).String()},
+		{Denom: atom, Amount: "2"},
+	}, merged)
+
+	_, err = types.MergeTokens([]types.Token{{Denom: atom, Amount: "invalid"}})
+	require.ErrorIs(t, err, types.ErrInvalidAmount)
+}
+
+func TestMultiTransferAcknowledgement(t *testing.T) {
+	ack := types.NewMultiTransferAcknowledgement([]types.ReceiverResult{
+		types.NewReceiverResult(receiver, nil),
+		types.NewReceiverResult(sender, errors.New("non-deterministic error")),
+		types.NewReceiverResult(invalidAddress, ibcerrors.ErrInvalidAddress),
+	})
+
+	require.True(t, ack.Results[0].Success)
+	require.Empty(t, ack.Results[0].Error)
+	require.False(t, ack.Results[1].Success)
+	// the error is recorded in the same format as an error acknowledgement
+	require.Equal(t, "ABCI code: 1: error handling packet: see events for details", ack.Results[1].Error)
+	require.False(t, ack.Results[2].Success)
+	require.Contains(t, ack.Results[2].Error, "ABCI code: 5")
+
+	unmarshalled, err := types.UnmarshalMultiTransferAcknowledgement(ack.GetBytes())
+	require.NoError(t, err)
+	require.Equal(t, ack, unmarshalled)
+
+	_, err = types.UnmarshalMultiTransferAcknowledgement([]byte("invalid"))
+	require.ErrorIs(t, err, ibcerrors.ErrInvalidType)
+}
@@ -128,7 +128,7 @@ func (ftpd FungibleTokenPacketDataV2) ValidateBasic() error {
 		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "sender address cannot be blank")
 	}
 
-	if strings.TrimSpace(ftpd.Receiver) == "" {
+	if !ftpd.IsMultiTransfer() && strings.TrimSpace(ftpd.Receiver) == "" {
 		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "receiver address cannot be blank")
 	}
 
@@ -155,6 +155,10 @@ func (ftpd FungibleTokenPacketDataV2) ValidateBasic() error {
 		return errorsmod.Wrapf(ErrInvalidMemo, "memo must be empty if forwarding path hops is not empty: %s, %s", ftpd.Memo, ftpd.Forwarding.Hops)
 	}
 
+	if ftpd.IsMultiTransfer() {
+		return ftpd.validateReceivers()
+	}
+
 	return nil
 }
 
@@ -207,10 +211,23 @@ func (ftpd FungibleTokenPacketDataV2) HasForwarding() bool {
 	return len(ftpd.Forwarding.Hops) > 0
 }
 
+// IsMultiTransfer determines if the tokens are transferred to multiple receivers.
+func (ftpd FungibleTokenPacketDataV2) IsMultiTransfer() bool {
+	return len(ftpd.Receivers) > 0
+}
+
+// IsClaimable determines if the received tokens should be held until the receiver claims them.
+// Claimable transfers are only held on the final destination of the tokens.
+func (ftpd FungibleTokenPacketDataV2) IsClaimable() bool {
+	return ftpd.ClaimTimeout > 0 && !ftpd.HasForwarding()
+}
+
 // UnmarshalPacketData attempts to unmarshal the provided packet data bytes into a FungibleTokenPacketDataV2.
-// The version of ics20 should be provided and should be either ics20-1 or ics20-2.
+// The version of ics20 should be provided and should be either ics20-1 or ics20-2, optionally
+// negotiating the use of structured error acknowledgements.
 func UnmarshalPacketData(bz []byte, ics20Version string) (FungibleTokenPacketDataV2, error) {
-	switch ics20Version {
+	version, _ := ParseVersion(ics20Version)
+	switch version {
 	case V1:
 		var datav1 FungibleTokenPacketData
 		if err := json.Unmarshal(bz, &datav1); err != nil {
@@ -121,6 +121,13 @@ type FungibleTokenPacketDataV2 struct {
 	Memo string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
 	// optional forwarding information
 	Forwarding ForwardingPacketData `protobuf:"bytes,5,opt,name=forwarding,proto3" json:"forwarding"`
+	// optional claim timeout in nanoseconds. If set, the tokens received on the final destination are
+	// held by the transfer module until the receiver claims them, and returned to the sender if they
+	// are not claimed within the claim timeout.
+	ClaimTimeout uint64 `protobuf:"varint,6,opt,name=claim_timeout,json=claimTimeout,proto3" json:"claim_timeout,omitempty"`
+	// optional receivers of a multi transfer, each credited with its own tokens. If set, the
+	// receiver must be empty and the tokens must be the sum of the tokens of all the receivers.
+	Receivers []ReceiverTokens `protobuf:"bytes,7,rep,name=receivers,proto3" json:"receivers"`
 }
 
 func (m *FungibleTokenPacketDataV2) Reset()         { *m = FungibleTokenPacketDataV2{} }
@@ -191,6 +198,186 @@ func (m *FungibleTokenPacketDataV2) GetForwarding() ForwardingPacketData {
 	return ForwardingPacketData{}
 }
 
+func (m *FungibleTokenPacketDataV2) GetClaimTimeout() uint64 {
+	if m != nil {
+		return m.ClaimTimeout
+	}
+	return 0
+}
+
+func (m *FungibleTokenPacketDataV2) GetReceivers() []ReceiverTokens {
+	if m != nil {
+		return m.Receivers
+	}
+	return nil
+}
+
+// ReceiverTokens defines a receiver of a multi transfer and the tokens transferred to it.
+type ReceiverTokens struct {
+	// the recipient address on the destination chain
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// the tokens to be transferred to the receiver
+	Tokens []Token `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens"`
+}
+
+func (m *ReceiverTokens) Reset()         { *m = ReceiverTokens{} }
+func (m *ReceiverTokens) String() string { return proto.CompactTextString(m) }
+func (*ReceiverTokens) ProtoMessage()    {}
+func (*ReceiverTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_653ca2ce9a5ca313, []int{2}
+}
+func (m *ReceiverTokens) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiverTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReceiverTokens.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReceiverTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiverTokens.Merge(m, src)
+}
+func (m *ReceiverTokens) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiverTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiverTokens.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiverTokens proto.InternalMessageInfo
+
+func (m *ReceiverTokens) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *ReceiverTokens) GetTokens() []Token {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+// MultiTransferAcknowledgement defines the result of a multi transfer written in the successful
+// acknowledgement of the packet, reporting for each receiver whether it was credited.
+type MultiTransferAcknowledgement struct {
+	// the results, in the same order as the receivers of the packet
+	Results []ReceiverResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *MultiTransferAcknowledgement) Reset()         { *m = MultiTransferAcknowledgement{} }
+func (m *MultiTransferAcknowledgement) String() string { return proto.CompactTextString(m) }
+func (*MultiTransferAcknowledgement) ProtoMessage()    {}
+func (*MultiTransferAcknowledgement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_653ca2ce9a5ca313, []int{3}
+}
+func (m *MultiTransferAcknowledgement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiTransferAcknowledgement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiTransferAcknowledgement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiTransferAcknowledgement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiTransferAcknowledgement.Merge(m, src)
+}
+func (m *MultiTransferAcknowledgement) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiTransferAcknowledgement) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiTransferAcknowledgement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiTransferAcknowledgement proto.InternalMessageInfo
+
+func (m *MultiTransferAcknowledgement) GetResults() []ReceiverResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// ReceiverResult defines the result of crediting a receiver of a multi transfer.
+type ReceiverResult struct {
+	// the recipient address on the destination chain
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// whether the tokens were credited to the receiver
+	Success bool `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// the error that caused the tokens not to be credited, if any
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ReceiverResult) Reset()         { *m = ReceiverResult{} }
+func (m *ReceiverResult) String() string { return proto.CompactTextString(m) }
+func (*ReceiverResult) ProtoMessage()    {}
+func (*ReceiverResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_653ca2ce9a5ca313, []int{4}
+}
+func (m *ReceiverResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiverResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReceiverResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReceiverResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiverResult.Merge(m, src)
+}
+func (m *ReceiverResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiverResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiverResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiverResult proto.InternalMessageInfo
+
+func (m *ReceiverResult) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *ReceiverResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *ReceiverResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // ForwardingPacketData defines a list of port ID, channel ID pairs determining the path
 // through which a packet must be forwarded, and the destination memo string to be used in the
 // final destination of the tokens.
@@ -199,13 +386,18 @@ type ForwardingPacketData struct {
 	DestinationMemo string `protobuf:"bytes,1,opt,name=destination_memo,json=destinationMemo,proto3" json:"destination_memo,omitempty"`
 	// optional intermediate path through which packet will be forwarded.
 	Hops []Hop `protobuf:"bytes,2,rep,name=hops,proto3" json:"hops"`
+	// optional timeouts used when forwarding the packet on each of the hops.
+	HopTimeouts []HopTimeout `protobuf:"bytes,3,rep,name=hop_timeouts,json=hopTimeouts,proto3" json:"hop_timeouts"`
+	// optional number of times a packet that timed out on a hop is sent again
+	// before the tokens are refunded.
+	MaxRetries uint64 `protobuf:"varint,4,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
 }
 
 func (m *ForwardingPacketData) Reset()         { *m = ForwardingPacketData{} }
 func (m *ForwardingPacketData) String() string { return proto.CompactTextString(m) }
 func (*ForwardingPacketData) ProtoMessage()    {}
 func (*ForwardingPacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_653ca2ce9a5ca313, []int{2}
+	return fileDescriptor_653ca2ce9a5ca313, []int{5}
 }
 func (m *ForwardingPacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -248,9 +440,26 @@ func (m *ForwardingPacketData) GetHops() []Hop {
 	return nil
 }
 
+func (m *ForwardingPacketData) GetHopTimeouts() []HopTimeout {
+	if m != nil {
+		return m.HopTimeouts
+	}
+	return nil
+}
+
+func (m *ForwardingPacketData) GetMaxRetries() uint64 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*FungibleTokenPacketData)(nil), "ibc.applications.transfer.v2.FungibleTokenPacketData")
 	proto.RegisterType((*FungibleTokenPacketDataV2)(nil), "ibc.applications.transfer.v2.FungibleTokenPacketDataV2")
+	proto.RegisterType((*ReceiverTokens)(nil), "ibc.applications.transfer.v2.ReceiverTokens")
+	proto.RegisterType((*MultiTransferAcknowledgement)(nil), "ibc.applications.transfer.v2.MultiTransferAcknowledgement")
+	proto.RegisterType((*ReceiverResult)(nil), "ibc.applications.transfer.v2.ReceiverResult")
 	proto.RegisterType((*ForwardingPacketData)(nil), "ibc.applications.transfer.v2.ForwardingPacketData")
 }
 
@@ -259,34 +468,45 @@ func init() {
 }
 
 var fileDescriptor_653ca2ce9a5ca313 = []byte{
-	// 418 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x93, 0x34, 0x5b, 0x74, 0xf6, 0xa0, 0x0c, 0x45, 0x63, 0x91, 0xb8, 0xd6, 0x4b, 0x17,
-	0x71, 0x86, 0x8d, 0x07, 0x11, 0x4f, 0x2e, 0xb2, 0x78, 0x11, 0x74, 0x11, 0x11, 0x2f, 0x32, 0x99,
-	0xcc, 0x66, 0x87, 0x36, 0xf3, 0xc2, 0xcc, 0x24, 0xe2, 0x45, 0xfc, 0x06, 0xfa, 0xb1, 0x7a, 0xec,
-	0xd1, 0x93, 0x48, 0xfb, 0x45, 0x24, 0x93, 0xd8, 0xe6, 0x60, 0x73, 0x7b, 0xef, 0x9f, 0xff, 0xfb,
-	0xf3, 0x9b, 0x97, 0x87, 0x4e, 0x65, 0xca, 0x29, 0x2b, 0xcb, 0xa5, 0xe4, 0xcc, 0x4a, 0x50, 0x86,
-	0x5a, 0xcd, 0x94, 0xb9, 0x12, 0x9a, 0xd6, 0x09, 0x2d, 0x19, 0x5f, 0x08, 0x4b, 0x4a, 0x0d, 0x16,
-	0xf0, 0x7d, 0x99, 0x72, 0xd2, 0xb7, 0x92, 0x7f, 0x56, 0x52, 0x27, 0xd3, 0xf9, 0x60, 0x90, 0x85,
-	0x85, 0x50, 0x6d, 0xce, 0x74, 0x92, 0x43, 0x0e, 0xae, 0xa4, 0x4d, 0xd5, 0xa9, 0x8f, 0x07, 0xe6,
-	0xcf, 0x76, 0x75, 0x6b, 0x9e, 0xfd, 0xf0, 0xd1, 0xdd, 0x8b, 0x4a, 0xe5, 0x32, 0x5d, 0x8a, 0xf7,
-	0x4d, 0xf4, 0x5b, 0x07, 0xfa, 0x8a, 0x59, 0x86, 0x27, 0xe8, 0x28, 0x13, 0x0a, 0x8a, 0xc8, 0x3f,
-	0xf1, 0xe7, 0x37, 0x2f, 0xdb, 0x06, 0xdf, 0x41, 0x63, 0x56, 0x40, 0xa5, 0x6c, 0x14, 0x38, 0xb9,
-	0xeb, 0x1a, 0xdd, 0x08, 0x95, 0x09, 0x1d, 0x8d, 0x5a, 0xbd, 0xed, 0xf0, 0x14, 0xdd, 0xd0, 0x82,
-	0x0b, 0x59, 0x0b, 0x1d, 0x85, 0xee, 0xcb, 0xae, 0xc7, 0x18, 0x85, 0x85, 0x28, 0x20, 0x3a, 0x72,
-	0xba, 0xab, 0x67, 0xdf, 0x03, 0x74, 0xef, 0x00, 0xd1, 0x87, 0x04, 0xbf, 0x44, 0x63, 0xb7, 0x01,
-	0x13, 0xf9, 0x27, 0xa3, 0xf9, 0x71, 0xf2, 0x88, 0x0c, 0xed, 0x92, 0xb8, 0x80, 0xf3, 0x70, 0xf5,
-	0xfb, 0x81, 0x77, 0xd9, 0x0d, 0xf6, 0x40, 0x83, 0x83, 0xa0, 0xa3, 0x03, 0xa0, 0xe1, 0x1e, 0x14,
-	0x7f, 0x44, 0xe8, 0x0a, 0xf4, 0x17, 0xa6, 0x33, 0xa9, 0x72, 0xf7, 0x84, 0xe3, 0x24, 0x19, 0xc6,
-	0xb9, 0xd8, 0xf9, 0xf7, 0x8f, 0xea, 0xe8, 0x7a, 0x59, 0xb3, 0x6f, 0x68, 0xf2, 0x3f, 0x27, 0x3e,
-	0x45, 0xb7, 0x33, 0x61, 0xac, 0x54, 0x2e, 0xfa, 0xb3, 0x23, 0x6a, 0xff, 0xcd, 0xad, 0x9e, 0xfe,
-	0xa6, 0x81, 0x7b, 0x81, 0xc2, 0x6b, 0x28, 0x4d, 0x14, 0xb8, 0x2d, 0x3d, 0x1c, 0xc2, 0x3a, 0x23,
-	0xaf, 0xa1, 0xec, 0x28, 0xdc, 0xd0, 0xf9, 0xbb, 0xd5, 0x26, 0xf6, 0xd7, 0x9b, 0xd8, 0xff, 0xb3,
-	0x89, 0xfd, 0x9f, 0xdb, 0xd8, 0x5b, 0x6f, 0x63, 0xef, 0xd7, 0x36, 0xf6, 0x3e, 0x3d, 0xcb, 0xa5,
-	0xbd, 0xae, 0x52, 0xc2, 0xa1, 0xa0, 0x1c, 0x4c, 0x01, 0x86, 0xca, 0x94, 0x3f, 0xc9, 0x81, 0xd6,
-	0xcf, 0x69, 0x01, 0x59, 0xb5, 0x14, 0xa6, 0xb9, 0xbd, 0xde, 0xcd, 0xd9, 0xaf, 0xa5, 0x30, 0xe9,
-	0xd8, 0x9d, 0xdb, 0xd3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x73, 0xe7, 0x36, 0x26, 0x03,
-	0x00, 0x00,
+	// 598 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0x6e, 0xda, 0xac, 0xdb, 0xdc, 0xfd, 0xf6, 0x43, 0xd6, 0x04, 0xa1, 0x9a, 0xb2, 0x92, 0x5d,
+	0x3a, 0x01, 0x89, 0x16, 0x0e, 0x08, 0x71, 0xda, 0x84, 0x26, 0x0e, 0x4c, 0xda, 0xa2, 0x0a, 0x21,
+	0x84, 0x54, 0x25, 0x8e, 0x97, 0x5a, 0x8d, 0xe3, 0xc8, 0x76, 0xba, 0xf1, 0x29, 0xe0, 0x63, 0xed,
+	0xb8, 0x23, 0x27, 0x84, 0xda, 0x6f, 0xc0, 0x85, 0x2b, 0x8a, 0x93, 0xf4, 0x0f, 0xa2, 0x01, 0x71,
+	0xf3, 0xfb, 0xe4, 0xf1, 0xf3, 0x3e, 0xef, 0x9f, 0x18, 0x1c, 0x91, 0x00, 0x39, 0x7e, 0x9a, 0xc6,
+	0x04, 0xf9, 0x92, 0xb0, 0x44, 0x38, 0x92, 0xfb, 0x89, 0xb8, 0xc2, 0xdc, 0x99, 0xb8, 0x4e, 0xea,
+	0xa3, 0x31, 0x96, 0x76, 0xca, 0x99, 0x64, 0x70, 0x9f, 0x04, 0xc8, 0x5e, 0xa6, 0xda, 0x15, 0xd5,
+	0x9e, 0xb8, 0xdd, 0x7e, 0xad, 0x90, 0x64, 0x63, 0x9c, 0x14, 0x3a, 0xdd, 0xbd, 0x88, 0x45, 0x4c,
+	0x1d, 0x9d, 0xfc, 0x54, 0xa2, 0x8f, 0x6b, 0xee, 0x1f, 0xcf, 0xcf, 0x05, 0xd9, 0xfa, 0xa4, 0x81,
+	0x07, 0x67, 0x59, 0x12, 0x91, 0x20, 0xc6, 0x83, 0x5c, 0xfa, 0x42, 0x19, 0x7d, 0xe5, 0x4b, 0x1f,
+	0xee, 0x81, 0x8d, 0x10, 0x27, 0x8c, 0x1a, 0x5a, 0x4f, 0xeb, 0x6f, 0x7b, 0x45, 0x00, 0xef, 0x83,
+	0xb6, 0x4f, 0x59, 0x96, 0x48, 0xa3, 0xa9, 0xe0, 0x32, 0xca, 0x71, 0x81, 0x93, 0x10, 0x73, 0xa3,
+	0x55, 0xe0, 0x45, 0x04, 0xbb, 0x60, 0x8b, 0x63, 0x84, 0xc9, 0x04, 0x73, 0x43, 0x57, 0x5f, 0xe6,
+	0x31, 0x84, 0x40, 0xa7, 0x98, 0x32, 0x63, 0x43, 0xe1, 0xea, 0x6c, 0xfd, 0x68, 0x82, 0x87, 0x6b,
+	0x1c, 0xbd, 0x75, 0xe1, 0x09, 0x68, 0xab, 0x0e, 0x08, 0x43, 0xeb, 0xb5, 0xfa, 0x1d, 0xf7, 0xd0,
+	0xae, 0xeb, 0xa5, 0xad, 0x04, 0x4e, 0xf5, 0xdb, 0xaf, 0x07, 0x0d, 0xaf, 0xbc, 0xb8, 0x64, 0xb4,
+	0xb9, 0xd6, 0x68, 0x6b, 0x8d, 0x51, 0x7d, 0x61, 0x14, 0xbe, 0x03, 0xe0, 0x8a, 0xf1, 0x6b, 0x9f,
+	0x87, 0x24, 0x89, 0x54, 0x09, 0x1d, 0xd7, 0xad, 0xb7, 0x73, 0x36, 0xe7, 0x2f, 0x8a, 0x2a, 0xdd,
+	0x2d, 0x69, 0xc1, 0x43, 0xf0, 0x1f, 0x8a, 0x7d, 0x42, 0x87, 0x92, 0x50, 0xcc, 0x32, 0x69, 0xb4,
+	0x7b, 0x5a, 0x5f, 0xf7, 0x76, 0x14, 0x38, 0x28, 0x30, 0x78, 0x01, 0xb6, 0x2b, 0x7b, 0xc2, 0xd8,
+	0x54, 0xcd, 0x78, 0x52, 0x9f, 0xdd, 0x2b, 0xe9, 0xaa, 0x29, 0xa2, 0xcc, 0xbb, 0x10, 0xb1, 0x18,
+	0xd8, 0x5d, 0xa5, 0xac, 0xb4, 0x44, 0xfb, 0xa5, 0x25, 0x8b, 0x49, 0x34, 0xff, 0x71, 0x12, 0x56,
+	0x0c, 0xf6, 0xcf, 0xb3, 0x58, 0x92, 0x41, 0xc9, 0x3b, 0x41, 0xe3, 0x84, 0x5d, 0xc7, 0x38, 0x8c,
+	0x30, 0xc5, 0x89, 0x84, 0x6f, 0xc0, 0x26, 0xc7, 0x22, 0x8b, 0x65, 0x35, 0xed, 0xbf, 0x2c, 0xd0,
+	0x53, 0x97, 0xca, 0x64, 0x95, 0x84, 0xf5, 0x01, 0xec, 0xae, 0x12, 0x6a, 0xcb, 0x33, 0xc0, 0xa6,
+	0xc8, 0x10, 0xc2, 0x42, 0xa8, 0x35, 0xd9, 0xf2, 0xaa, 0x30, 0xff, 0x2d, 0x30, 0xe7, 0xac, 0x5a,
+	0x92, 0x22, 0xb0, 0xbe, 0x6b, 0x60, 0xef, 0x77, 0xe3, 0x85, 0x47, 0xe0, 0x5e, 0x88, 0x85, 0x24,
+	0x89, 0x32, 0x3c, 0x54, 0x6b, 0x54, 0x24, 0xfb, 0x7f, 0x09, 0x3f, 0xcf, 0x37, 0xea, 0x25, 0xd0,
+	0x47, 0x2c, 0xad, 0x1a, 0xfa, 0xa8, 0xae, 0xd8, 0x63, 0xfb, 0x35, 0x4b, 0xcb, 0x0a, 0xd5, 0x25,
+	0x78, 0x09, 0x76, 0x46, 0x2c, 0xad, 0x56, 0x46, 0x18, 0x2d, 0x25, 0xd2, 0xff, 0xa3, 0x48, 0xb9,
+	0x4f, 0xa5, 0x56, 0x67, 0x34, 0x47, 0x04, 0x3c, 0x00, 0x1d, 0xea, 0xdf, 0x0c, 0x39, 0x96, 0x9c,
+	0x60, 0xa1, 0x96, 0x5f, 0xf7, 0x00, 0xf5, 0x6f, 0xbc, 0x02, 0x39, 0xbd, 0xbc, 0x9d, 0x9a, 0xda,
+	0xdd, 0xd4, 0xd4, 0xbe, 0x4d, 0x4d, 0xed, 0xf3, 0xcc, 0x6c, 0xdc, 0xcd, 0xcc, 0xc6, 0x97, 0x99,
+	0xd9, 0x78, 0xff, 0x3c, 0x22, 0x72, 0x94, 0x05, 0x36, 0x62, 0xd4, 0x41, 0x4c, 0x50, 0x26, 0x1c,
+	0x12, 0xa0, 0xa7, 0x11, 0x73, 0x26, 0x2f, 0x1c, 0xca, 0xc2, 0x2c, 0xc6, 0x22, 0x7f, 0xa4, 0x96,
+	0x1e, 0x27, 0xf9, 0x31, 0xc5, 0x22, 0x68, 0xab, 0x77, 0xe9, 0xd9, 0xcf, 0x01, 0x00, 0x84, 0x71,
+	0x73, 0xe1, 0x4f, 0x05, 0x00, 0x00,
 }
 
 func (m *FungibleTokenPacketData) Marshal() (dAtA []byte, err error) {
@@ -367,6 +587,25 @@ func (m *FungibleTokenPacketDataV2) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.Receivers) > 0 {
+		for iNdEx := len(m.Receivers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ClaimTimeout != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ClaimTimeout))
+		i--
+		dAtA[i] = 0x30
+	}
 	{
 		size, err := m.Forwarding.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -415,6 +654,134 @@ func (m *FungibleTokenPacketDataV2) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *ReceiverTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiverTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiverTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiTransferAcknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiTransferAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiTransferAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiverResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiverResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiverResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ForwardingPacketData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -435,6 +802,25 @@ func (m *ForwardingPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxRetries != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.HopTimeouts) > 0 {
+		for iNdEx := len(m.HopTimeouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HopTimeouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Hops) > 0 {
 		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -525,25 +911,97 @@ func (m *FungibleTokenPacketDataV2) Size() (n int) {
 	}
 	l = m.Forwarding.Size()
 	n += 1 + l + sovPacket(uint64(l))
+	if m.ClaimTimeout != 0 {
+		n += 1 + sovPacket(uint64(m.ClaimTimeout))
+	}
+	if len(m.Receivers) > 0 {
+		for _, e := range m.Receivers {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *ForwardingPacketData) Size() (n int) {
+func (m *ReceiverTokens) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DestinationMemo)
+	l = len(m.Receiver)
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
-	if len(m.Hops) > 0 {
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiTransferAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReceiverResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *ForwardingPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DestinationMemo)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if len(m.Hops) > 0 {
 		for _, e := range m.Hops {
 			l = e.Size()
 			n += 1 + l + sovPacket(uint64(l))
 		}
 	}
+	if len(m.HopTimeouts) > 0 {
+		for _, e := range m.HopTimeouts {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovPacket(uint64(m.MaxRetries))
+	}
 	return n
 }
 
@@ -612,13 +1070,354 @@ func (m *FungibleTokenPacketData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FungibleTokenPacketDataV2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FungibleTokenPacketDataV2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FungibleTokenPacketDataV2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, Token{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forwarding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Forwarding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimTimeout", wireType)
 			}
-			var stringLen uint64
+			m.ClaimTimeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -628,29 +1427,16 @@ func (m *FungibleTokenPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ClaimTimeout |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPacket
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPacket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receivers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -660,25 +1446,77 @@ func (m *FungibleTokenPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPacket
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPacket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Receivers = append(m.Receivers, ReceiverTokens{})
+			if err := m.Receivers[len(m.Receivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReceiverTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReceiverTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReceiverTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
@@ -710,11 +1548,11 @@ func (m *FungibleTokenPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -724,23 +1562,25 @@ func (m *FungibleTokenPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPacket
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPacket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
+			m.Tokens = append(m.Tokens, Token{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -763,7 +1603,7 @@ func (m *FungibleTokenPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FungibleTokenPacketDataV2) Unmarshal(dAtA []byte) error {
+func (m *MultiTransferAcknowledgement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -786,15 +1626,15 @@ func (m *FungibleTokenPacketDataV2) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FungibleTokenPacketDataV2: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiTransferAcknowledgement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FungibleTokenPacketDataV2: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiTransferAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -821,44 +1661,62 @@ func (m *FungibleTokenPacketDataV2) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, Token{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, ReceiverResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPacket
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPacket
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPacket
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReceiverResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReceiverResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReceiverResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
@@ -890,11 +1748,11 @@ func (m *FungibleTokenPacketDataV2) Unmarshal(dAtA []byte) error {
 			}
 			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -904,29 +1762,17 @@ func (m *FungibleTokenPacketDataV2) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPacket
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPacket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			m.Success = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Forwarding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -936,24 +1782,23 @@ func (m *FungibleTokenPacketDataV2) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPacket
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPacket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Forwarding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1071,6 +1916,59 @@ func (m *ForwardingPacketData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HopTimeouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HopTimeouts = append(m.HopTimeouts, HopTimeout{})
+			if err := m.HopTimeouts[len(m.HopTimeouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
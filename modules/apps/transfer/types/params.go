@@ -1,5 +1,13 @@
 package types
 
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
 const (
 	// DefaultSendEnabled enabled
 	DefaultSendEnabled = true
@@ -19,3 +27,101 @@ func NewParams(enableSend, enableReceive bool) Params {
 func DefaultParams() Params {
 	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled)
 }
+
+// Validate performs basic validation of the transfer module parameters.
+func (p Params) Validate() error {
+	for _, policy := range p.SendPolicies {
+		if err := policy.Validate(); err != nil {
+			return errorsmod.Wrap(err, "invalid send policy")
+		}
+	}
+
+	for _, policy := range p.ReceivePolicies {
+		if err := policy.Validate(); err != nil {
+			return errorsmod.Wrap(err, "invalid receive policy")
+		}
+	}
+
+	return nil
+}
+
+// IsSendAllowed returns true if the send policies allow the denomination to be sent on the channel.
+func (p Params) IsSendAllowed(channelID string, denom Denom) bool {
+	return isDenomAllowed(p.SendPolicies, channelID, denom)
+}
+
+// IsReceiveAllowed returns true if the receive policies allow the denomination to be received on the channel.
+func (p Params) IsReceiveAllowed(channelID string, denom Denom) bool {
+	return isDenomAllowed(p.ReceivePolicies, channelID, denom)
+}
+
+// NewDenomPolicy creates a new DenomPolicy instance. An empty channel ID applies the policy to all channels.
+func NewDenomPolicy(channelID string, policyType DenomPolicyType, denoms ...string) DenomPolicy {
+	return DenomPolicy{
+		ChannelId:  channelID,
+		PolicyType: policyType,
+		Denoms:     denoms,
+	}
+}
+
+// Validate performs basic validation of the denomination policy.
+func (p DenomPolicy) Validate() error {
+	if p.ChannelId != "" {
+		if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+			return err
+		}
+	}
+
+	if p.PolicyType != ALLOWLIST && p.PolicyType != DENYLIST {
+		return errorsmod.Wrapf(ErrInvalidDenomPolicy, "invalid policy type %s", p.PolicyType)
+	}
+
+	seen := make(map[string]bool)
+	for _, denom := range p.Denoms {
+		if strings.TrimSpace(denom) == "" {
+			return errorsmod.Wrap(ErrInvalidDenomPolicy, "denomination cannot be blank")
+		}
+
+		if seen[denom] {
+			return errorsmod.Wrapf(ErrInvalidDenomPolicy, "duplicate denomination %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+// AppliesTo returns true if the policy applies to transfers on the channel.
+func (p DenomPolicy) AppliesTo(channelID string) bool {
+	return p.ChannelId == "" || p.ChannelId == channelID
+}
+
+// Matches returns true if any of the denominations of the policy matches the base denomination,
+// the full path or the hash of the given denomination.
+func (p DenomPolicy) Matches(denom Denom) bool {
+	hash := denom.Hash().String()
+	for _, entry := range p.Denoms {
+		if entry == denom.Base || entry == denom.Path() || entry == denom.IBCDenom() ||
+			strings.EqualFold(strings.TrimPrefix(entry, DenomPrefix+"/"), hash) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isDenomAllowed returns true if none of the policies applying to the channel denies the denomination,
+// that is, the denomination is not matched by any denylist and is matched by every allowlist.
+func isDenomAllowed(policies []DenomPolicy, channelID string, denom Denom) bool {
+	for _, policy := range policies {
+		if !policy.AppliesTo(channelID) {
+			continue
+		}
+
+		if matches := policy.Matches(denom); matches != (policy.PolicyType == ALLOWLIST) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		params   types.Params
+		expError error
+	}{
+		{"success: default params", types.DefaultParams(), nil},
+		{
+			"success: valid policies",
+			types.Params{
+				SendPolicies:    []types.DenomPolicy{types.NewDenomPolicy("", types.DENYLIST, "uatom")},
+				ReceivePolicies: []types.DenomPolicy{types.NewDenomPolicy("channel-0", types.ALLOWLIST, "transfer/channel-0/uatom")},
+			},
+			nil,
+		},
+		{
+			"failure: invalid channel",
+			types.Params{SendPolicies: []types.DenomPolicy{types.NewDenomPolicy("(invalid)", types.DENYLIST, "uatom")}},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: unspecified policy type",
+			types.Params{ReceivePolicies: []types.DenomPolicy{types.NewDenomPolicy("", types.UNSPECIFIED, "uatom")}},
+			types.ErrInvalidDenomPolicy,
+		},
+		{
+			"failure: blank denom",
+			types.Params{SendPolicies: []types.DenomPolicy{types.NewDenomPolicy("", types.DENYLIST, " ")}},
+			types.ErrInvalidDenomPolicy,
+		},
+		{
+			"failure: duplicate denom",
+			types.Params{SendPolicies: []types.DenomPolicy{types.NewDenomPolicy("", types.DENYLIST, "uatom", "uatom")}},
+			types.ErrInvalidDenomPolicy,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestParamsIsSendAllowed(t *testing.T) {
+	denom := types.NewDenom("uatom", types.NewHop(types.PortID, "channel-0"))
+
+	testCases := []struct {
+		name       string
+		policies   []types.DenomPolicy
+		expAllowed bool
+	}{
+		{"no policies", nil, true},
+		{"denied by base denom", []types.DenomPolicy{types.NewDenomPolicy("", types.DENYLIST, "uatom")}, false},
+		{"denied by path", []types.DenomPolicy{types.NewDenomPolicy("", types.DENYLIST, denom.Path())}, false},
+		{"denied by ibc denom", []types.DenomPolicy{types.NewDenomPolicy("", types.DENYLIST, denom.IBCDenom())}, false},
+		{"denied by hash", []types.DenomPolicy{types.NewDenomPolicy("", types.DENYLIST, denom.Hash().String())}, false},
+		{"denylist of another denom", []types.DenomPolicy{types.NewDenomPolicy("", types.DENYLIST, "uosmo")}, true},
+		{"denylist of another channel", []types.DenomPolicy{types.NewDenomPolicy("channel-1", types.DENYLIST, "uatom")}, true},
+		{"in allowlist", []types.DenomPolicy{types.NewDenomPolicy("channel-7", types.ALLOWLIST, "uosmo", denom.Path())}, true},
+		{"not in allowlist", []types.DenomPolicy{types.NewDenomPolicy("channel-7", types.ALLOWLIST, "uosmo")}, false},
+		{
+			"in allowlist but denied by denylist",
+			[]types.DenomPolicy{
+				types.NewDenomPolicy("", types.ALLOWLIST, "uatom"),
+				types.NewDenomPolicy("channel-7", types.DENYLIST, denom.IBCDenom()),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.Params{SendPolicies: tc.policies}
+			require.Equal(t, tc.expAllowed, params.IsSendAllowed("channel-7", denom))
+			require.True(t, params.IsReceiveAllowed("channel-7", denom))
+		})
+	}
+}
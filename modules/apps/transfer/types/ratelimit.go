@@ -0,0 +1,201 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
+// MaxRateLimitPercent is the maximum value a percentage based quota may take.
+const MaxRateLimitPercent = 100
+
+// NewRateLimitQuota creates a new RateLimitQuota instance.
+func NewRateLimitQuota(maxPercentSend, maxPercentRecv uint64, maxAmountSend, maxAmountRecv sdkmath.Int, durationBlocks uint64) RateLimitQuota {
+	return RateLimitQuota{
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		MaxAmountSend:  maxAmountSend,
+		MaxAmountRecv:  maxAmountRecv,
+		DurationBlocks: durationBlocks,
+	}
+}
+
+// ValidateBasic performs a basic validation of the RateLimitQuota fields.
+func (q RateLimitQuota) ValidateBasic() error {
+	if q.MaxPercentSend > MaxRateLimitPercent || q.MaxPercentRecv > MaxRateLimitPercent {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "percentage quotas cannot be greater than %d", MaxRateLimitPercent)
+	}
+
+	if q.MaxAmountSend.IsNil() || q.MaxAmountRecv.IsNil() {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "absolute quotas cannot be nil")
+	}
+
+	if q.MaxAmountSend.IsNegative() || q.MaxAmountRecv.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "absolute quotas cannot be negative")
+	}
+
+	if q.DurationBlocks == 0 {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "duration in blocks must be greater than zero")
+	}
+
+	if !q.IsSendCapped() && !q.IsRecvCapped() {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "at least one quota must be set")
+	}
+
+	return nil
+}
+
+// IsSendCapped returns true if the quota limits the outflow.
+func (q RateLimitQuota) IsSendCapped() bool {
+	return q.MaxPercentSend != 0 || q.MaxAmountSend.IsPositive()
+}
+
+// IsRecvCapped returns true if the quota limits the inflow.
+func (q RateLimitQuota) IsRecvCapped() bool {
+	return q.MaxPercentRecv != 0 || q.MaxAmountRecv.IsPositive()
+}
+
+// UsesPercent returns true if any of the quotas is stated as a percentage of the channel value.
+func (q RateLimitQuota) UsesPercent() bool {
+	return q.MaxPercentSend != 0 || q.MaxPercentRecv != 0
+}
+
+// NewRateLimitFlow creates a new RateLimitFlow with no inflow and outflow, starting at the provided height.
+func NewRateLimitFlow(channelValue sdkmath.Int, windowStartHeight uint64) RateLimitFlow {
+	return RateLimitFlow{
+		Inflow:            sdkmath.ZeroInt(),
+		Outflow:           sdkmath.ZeroInt(),
+		ChannelValue:      channelValue,
+		WindowStartHeight: windowStartHeight,
+	}
+}
+
+// NewRateLimit creates a new RateLimit instance.
+func NewRateLimit(channelID, denom string, quota RateLimitQuota, flow RateLimitFlow) RateLimit {
+	return RateLimit{
+		ChannelId: channelID,
+		Denom:     denom,
+		Quota:     quota,
+		Flow:      flow,
+	}
+}
+
+// Validate performs a basic validation of the RateLimit fields.
+func (rl RateLimit) Validate() error {
+	if err := host.ChannelIdentifierValidator(rl.ChannelId); err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(rl.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+
+	if err := rl.Quota.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if rl.Flow.Inflow.IsNil() || rl.Flow.Outflow.IsNil() || rl.Flow.ChannelValue.IsNil() {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "flow amounts cannot be nil")
+	}
+
+	if rl.Flow.Inflow.IsNegative() || rl.Flow.Outflow.IsNegative() || rl.Flow.ChannelValue.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "flow amounts cannot be negative")
+	}
+
+	return nil
+}
+
+// IsWindowExpired returns true if the current window has elapsed at the provided height.
+func (rl RateLimit) IsWindowExpired(height uint64) bool {
+	return height >= rl.Flow.WindowStartHeight+rl.Quota.DurationBlocks
+}
+
+// SendThreshold returns the maximum outflow allowed in the current window. The boolean
+// returned is false if the outflow is not capped. If both a percentage and an absolute
+// quota are set, the lowest of the two is used.
+func (rl RateLimit) SendThreshold() (sdkmath.Int, bool) {
+	return threshold(rl.Quota.MaxPercentSend, rl.Quota.MaxAmountSend, rl.Flow.ChannelValue)
+}
+
+// RecvThreshold returns the maximum inflow allowed in the current window. The boolean
+// returned is false if the inflow is not capped. If both a percentage and an absolute
+// quota are set, the lowest of the two is used.
+func (rl RateLimit) RecvThreshold() (sdkmath.Int, bool) {
+	return threshold(rl.Quota.MaxPercentRecv, rl.Quota.MaxAmountRecv, rl.Flow.ChannelValue)
+}
+
+// RemainingSend returns the amount that may still be sent in the current window. The
+// boolean returned is false if the outflow is not capped.
+func (rl RateLimit) RemainingSend() (sdkmath.Int, bool) {
+	return remaining(rl.SendThreshold, rl.Flow.Outflow)
+}
+
+// RemainingRecv returns the amount that may still be received in the current window. The
+// boolean returned is false if the inflow is not capped.
+func (rl RateLimit) RemainingRecv() (sdkmath.Int, bool) {
+	return remaining(rl.RecvThreshold, rl.Flow.Inflow)
+}
+
+// NewPendingRateLimitedSend creates a new PendingRateLimitedSend instance.
+func NewPendingRateLimitedSend(channelID, denom string, sequence, windowStartHeight uint64) PendingRateLimitedSend {
+	return PendingRateLimitedSend{
+		ChannelId:         channelID,
+		Denom:             denom,
+		Sequence:          sequence,
+		WindowStartHeight: windowStartHeight,
+	}
+}
+
+// Validate performs a basic validation of the PendingRateLimitedSend fields.
+func (p PendingRateLimitedSend) Validate() error {
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+
+	if p.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "packet sequence cannot be zero")
+	}
+
+	return nil
+}
+
+// threshold computes the effective cap given a percentage quota, an absolute quota and the channel value.
+func threshold(percent uint64, amount, channelValue sdkmath.Int) (sdkmath.Int, bool) {
+	var (
+		limit  sdkmath.Int
+		capped bool
+	)
+
+	if percent != 0 {
+		limit = channelValue.Mul(sdkmath.NewIntFromUint64(percent)).Quo(sdkmath.NewInt(MaxRateLimitPercent))
+		capped = true
+	}
+
+	if amount.IsPositive() && (!capped || amount.LT(limit)) {
+		limit = amount
+		capped = true
+	}
+
+	return limit, capped
+}
+
+// remaining computes the quota left given a threshold function and the current flow.
+func remaining(thresholdFn func() (sdkmath.Int, bool), flow sdkmath.Int) (sdkmath.Int, bool) {
+	limit, capped := thresholdFn()
+	if !capped {
+		return sdkmath.Int{}, false
+	}
+
+	if flow.GTE(limit) {
+		return sdkmath.ZeroInt(), true
+	}
+
+	return limit.Sub(flow), true
+}
@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+)
+
+func TestRateLimitQuotaValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		quota  types.RateLimitQuota
+		expErr error
+	}{
+		{"success: percentage quotas", types.NewRateLimitQuota(10, 20, sdkmath.ZeroInt(), sdkmath.ZeroInt(), 100), nil},
+		{"success: absolute quotas", types.NewRateLimitQuota(0, 0, sdkmath.NewInt(10), sdkmath.NewInt(20), 100), nil},
+		{"success: outflow only", types.NewRateLimitQuota(0, 0, sdkmath.NewInt(10), sdkmath.ZeroInt(), 100), nil},
+		{"failure: percentage greater than 100", types.NewRateLimitQuota(101, 0, sdkmath.ZeroInt(), sdkmath.ZeroInt(), 100), types.ErrInvalidRateLimit},
+		{"failure: nil amount", types.NewRateLimitQuota(10, 0, sdkmath.Int{}, sdkmath.ZeroInt(), 100), types.ErrInvalidRateLimit},
+		{"failure: negative amount", types.NewRateLimitQuota(0, 0, sdkmath.NewInt(-1), sdkmath.NewInt(10), 100), types.ErrInvalidRateLimit},
+		{"failure: zero duration", types.NewRateLimitQuota(10, 0, sdkmath.ZeroInt(), sdkmath.ZeroInt(), 0), types.ErrInvalidRateLimit},
+		{"failure: no quota set", types.NewRateLimitQuota(0, 0, sdkmath.ZeroInt(), sdkmath.ZeroInt(), 100), types.ErrInvalidRateLimit},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.quota.ValidateBasic()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestRateLimitThresholds(t *testing.T) {
+	channelValue := sdkmath.NewInt(1000)
+
+	testCases := []struct {
+		name         string
+		quota        types.RateLimitQuota
+		expCapped    bool
+		expThreshold sdkmath.Int
+	}{
+		{"percentage quota", types.NewRateLimitQuota(10, 0, sdkmath.ZeroInt(), sdkmath.ZeroInt(), 100), true, sdkmath.NewInt(100)},
+		{"absolute quota", types.NewRateLimitQuota(0, 0, sdkmath.NewInt(50), sdkmath.ZeroInt(), 100), true, sdkmath.NewInt(50)},
+		{"lowest of percentage and absolute quotas", types.NewRateLimitQuota(10, 0, sdkmath.NewInt(150), sdkmath.ZeroInt(), 100), true, sdkmath.NewInt(100)},
+		{"not capped", types.NewRateLimitQuota(0, 10, sdkmath.ZeroInt(), sdkmath.ZeroInt(), 100), false, sdkmath.Int{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rateLimit := types.NewRateLimit("channel-0", "stake", tc.quota, types.NewRateLimitFlow(channelValue, 1))
+
+			threshold, capped := rateLimit.SendThreshold()
+			require.Equal(t, tc.expCapped, capped)
+			if tc.expCapped {
+				require.Equal(t, tc.expThreshold, threshold)
+
+				rateLimit.Flow.Outflow = tc.expThreshold.AddRaw(1)
+				remaining, _ := rateLimit.RemainingSend()
+				require.True(t, remaining.IsZero())
+			}
+		})
+	}
+}
@@ -30,6 +30,17 @@ func (t Token) Validate() error {
 		return errorsmod.Wrapf(ErrInvalidAmount, "amount must be strictly positive: got %d", amount)
 	}
 
+	if t.Metadata != nil {
+		// only the source chain of a token can describe it
+		if !t.Denom.IsNative() {
+			return errorsmod.Wrapf(ErrInvalidTokenMetadata, "metadata cannot be set for token %s that is not native to the sending chain", t.Denom.Path())
+		}
+
+		if err := t.Metadata.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -29,6 +29,8 @@ type Token struct {
 	Denom Denom `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom"`
 	// the token amount to be transferred
 	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// optional display metadata of the token, which can only be set by the source chain of the token
+	Metadata *TokenMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *Token) Reset()         { *m = Token{} }
@@ -78,6 +80,143 @@ func (m *Token) GetAmount() string {
 	return ""
 }
 
+func (m *Token) GetMetadata() *TokenMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// TokenMetadata defines the display metadata of a token on its source chain. The chain receiving
+// the token records it in the bank metadata of the IBC voucher denomination.
+type TokenMetadata struct {
+	// the denomination unit displayed to users (e.g. atom)
+	Display string `protobuf:"bytes,1,opt,name=display,proto3" json:"display,omitempty"`
+	// the ticker symbol of the token (e.g. ATOM)
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// the exponent of the display unit with respect to the base denomination (i.e. one display
+	// unit is 10^exponent base units)
+	Exponent uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	// optional URI to a document with additional information about the token
+	Uri string `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (m *TokenMetadata) Reset()         { *m = TokenMetadata{} }
+func (m *TokenMetadata) String() string { return proto.CompactTextString(m) }
+func (*TokenMetadata) ProtoMessage()    {}
+func (*TokenMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_732b93aa1330663e, []int{1}
+}
+func (m *TokenMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenMetadata.Merge(m, src)
+}
+func (m *TokenMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenMetadata proto.InternalMessageInfo
+
+func (m *TokenMetadata) GetDisplay() string {
+	if m != nil {
+		return m.Display
+	}
+	return ""
+}
+
+func (m *TokenMetadata) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenMetadata) GetExponent() uint32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
+func (m *TokenMetadata) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+// DenomTokenMetadata defines the token metadata recorded for an IBC voucher denomination.
+type DenomTokenMetadata struct {
+	// the IBC voucher denomination (i.e. ibc/{hash})
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the token metadata recorded for the denomination
+	Metadata TokenMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *DenomTokenMetadata) Reset()         { *m = DenomTokenMetadata{} }
+func (m *DenomTokenMetadata) String() string { return proto.CompactTextString(m) }
+func (*DenomTokenMetadata) ProtoMessage()    {}
+func (*DenomTokenMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_732b93aa1330663e, []int{2}
+}
+func (m *DenomTokenMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTokenMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTokenMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTokenMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTokenMetadata.Merge(m, src)
+}
+func (m *DenomTokenMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTokenMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTokenMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTokenMetadata proto.InternalMessageInfo
+
+func (m *DenomTokenMetadata) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomTokenMetadata) GetMetadata() TokenMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return TokenMetadata{}
+}
+
 // Denom holds the base denom of a Token and a trace of the chains it was sent through.
 type Denom struct {
 	// the base token denomination
@@ -90,7 +229,7 @@ func (m *Denom) Reset()         { *m = Denom{} }
 func (m *Denom) String() string { return proto.CompactTextString(m) }
 func (*Denom) ProtoMessage()    {}
 func (*Denom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_732b93aa1330663e, []int{1}
+	return fileDescriptor_732b93aa1330663e, []int{3}
 }
 func (m *Denom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -135,6 +274,8 @@ func (m *Denom) GetTrace() []Hop {
 
 func init() {
 	proto.RegisterType((*Token)(nil), "ibc.applications.transfer.v2.Token")
+	proto.RegisterType((*TokenMetadata)(nil), "ibc.applications.transfer.v2.TokenMetadata")
+	proto.RegisterType((*DenomTokenMetadata)(nil), "ibc.applications.transfer.v2.DenomTokenMetadata")
 	proto.RegisterType((*Denom)(nil), "ibc.applications.transfer.v2.Denom")
 }
 
@@ -143,25 +284,32 @@ func init() {
 }
 
 var fileDescriptor_732b93aa1330663e = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x41, 0x4b, 0xc3, 0x30,
-	0x18, 0x86, 0x1b, 0xb7, 0x0e, 0x96, 0xdd, 0x82, 0xc8, 0x18, 0x12, 0xe7, 0xbc, 0x14, 0xc4, 0x84,
-	0xd5, 0x83, 0x78, 0x10, 0x61, 0x78, 0xf0, 0xea, 0xf0, 0xb4, 0x93, 0x49, 0x16, 0x6b, 0x70, 0xed,
-	0x57, 0x9a, 0x6c, 0xe0, 0xbf, 0xf0, 0x67, 0xed, 0xb8, 0xa3, 0x27, 0x91, 0xf6, 0x8f, 0x48, 0x53,
-	0x27, 0x3b, 0xf5, 0xf6, 0x26, 0x3c, 0xdf, 0xfb, 0xc0, 0x8b, 0x23, 0x23, 0x15, 0x17, 0x79, 0xbe,
-	0x32, 0x4a, 0x38, 0x03, 0x99, 0xe5, 0xae, 0x10, 0x99, 0x7d, 0xd5, 0x05, 0xdf, 0xc4, 0xdc, 0xc1,
-	0xbb, 0xce, 0x58, 0x5e, 0x80, 0x03, 0x72, 0x6a, 0xa4, 0x62, 0x87, 0x24, 0xdb, 0x93, 0x6c, 0x13,
-	0x8f, 0x2e, 0x5b, 0x7a, 0xa6, 0xff, 0xb9, 0xa9, 0x1a, 0x1d, 0x27, 0x90, 0x80, 0x8f, 0xbc, 0x4e,
-	0xcd, 0xef, 0xe4, 0x05, 0x87, 0xcf, 0xb5, 0x8f, 0xdc, 0xe3, 0x70, 0xa9, 0x33, 0x48, 0x87, 0x68,
-	0x8c, 0xa2, 0x41, 0x7c, 0xc1, 0xda, 0xcc, 0xec, 0xa1, 0x46, 0x67, 0xdd, 0xed, 0xf7, 0x59, 0x30,
-	0x6f, 0xee, 0xc8, 0x09, 0xee, 0x89, 0x14, 0xd6, 0x99, 0x1b, 0x1e, 0x8d, 0x51, 0xd4, 0x9f, 0xff,
-	0xbd, 0x26, 0x0b, 0x1c, 0x7a, 0x9a, 0x10, 0xdc, 0x95, 0xc2, 0x6a, 0x2f, 0xe8, 0xcf, 0x7d, 0x26,
-	0x77, 0x38, 0x74, 0x85, 0x50, 0x7a, 0xd8, 0x19, 0x77, 0xa2, 0x41, 0x7c, 0xde, 0x66, 0x9d, 0xb2,
-	0x47, 0xc8, 0xf7, 0x4e, 0x7f, 0x35, 0x7b, 0xda, 0x96, 0x14, 0xed, 0x4a, 0x8a, 0x7e, 0x4a, 0x8a,
-	0x3e, 0x2b, 0x1a, 0xec, 0x2a, 0x1a, 0x7c, 0x55, 0x34, 0x58, 0xdc, 0x24, 0xc6, 0xbd, 0xad, 0x25,
-	0x53, 0x90, 0x72, 0x05, 0x36, 0x05, 0xcb, 0x8d, 0x54, 0x57, 0x09, 0xf0, 0xcd, 0x2d, 0x4f, 0x61,
-	0xb9, 0x5e, 0x69, 0x5b, 0x4f, 0x77, 0x30, 0x99, 0xfb, 0xc8, 0xb5, 0x95, 0x3d, 0xbf, 0xcb, 0xf5,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd9, 0x51, 0x5a, 0x6f, 0xa4, 0x01, 0x00, 0x00,
+	// 392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xc1, 0xaa, 0xd3, 0x40,
+	0x14, 0x4d, 0x5e, 0x9a, 0xe7, 0xeb, 0x3c, 0x1e, 0xc8, 0x50, 0x24, 0x14, 0x89, 0xb5, 0x6e, 0x02,
+	0xc5, 0x19, 0x1a, 0x17, 0xe2, 0x42, 0x84, 0x22, 0xe8, 0xa6, 0x0b, 0x83, 0xab, 0xee, 0x26, 0xc9,
+	0x18, 0x07, 0x93, 0xdc, 0x90, 0x99, 0x14, 0xf3, 0x17, 0xfe, 0x86, 0x7f, 0xd2, 0x65, 0x97, 0xae,
+	0x44, 0xda, 0x1f, 0x91, 0x4c, 0x92, 0x9a, 0x6e, 0x02, 0xee, 0xce, 0x4d, 0xee, 0xb9, 0xe7, 0x9c,
+	0x3b, 0x17, 0x79, 0x22, 0x8c, 0x28, 0x2b, 0x8a, 0x54, 0x44, 0x4c, 0x09, 0xc8, 0x25, 0x55, 0x25,
+	0xcb, 0xe5, 0x17, 0x5e, 0xd2, 0xbd, 0x4f, 0x15, 0x7c, 0xe3, 0x39, 0x29, 0x4a, 0x50, 0x80, 0x9f,
+	0x8a, 0x30, 0x22, 0xc3, 0x4e, 0xd2, 0x77, 0x92, 0xbd, 0x3f, 0x5f, 0x8d, 0xcc, 0x59, 0x5f, 0x70,
+	0x3b, 0x6a, 0x3e, 0x4b, 0x20, 0x01, 0x0d, 0x69, 0x83, 0xda, 0xaf, 0xcb, 0x9f, 0x26, 0xb2, 0x3f,
+	0x37, 0x82, 0xf8, 0x1d, 0xb2, 0x63, 0x9e, 0x43, 0xe6, 0x98, 0x0b, 0xd3, 0xbb, 0xf7, 0x5f, 0x90,
+	0x31, 0x69, 0xf2, 0xbe, 0x69, 0xdd, 0x4c, 0x0e, 0xbf, 0x9f, 0x19, 0x41, 0xcb, 0xc3, 0x4f, 0xd0,
+	0x2d, 0xcb, 0xa0, 0xca, 0x95, 0x73, 0xb3, 0x30, 0xbd, 0x69, 0xd0, 0x55, 0xf8, 0x03, 0xba, 0xcb,
+	0xb8, 0x62, 0x31, 0x53, 0xcc, 0xb1, 0xf4, 0xec, 0xd5, 0xf8, 0x6c, 0xed, 0x67, 0xdb, 0x51, 0x82,
+	0x0b, 0x79, 0x09, 0xe8, 0xe1, 0xea, 0x17, 0x76, 0xd0, 0xa3, 0x58, 0xc8, 0x22, 0x65, 0xb5, 0x36,
+	0x3d, 0x0d, 0xfa, 0xb2, 0xf1, 0x22, 0xeb, 0x2c, 0x84, 0xb4, 0xf7, 0xd2, 0x56, 0x78, 0x8e, 0xee,
+	0xf8, 0xf7, 0x02, 0x72, 0x9e, 0x2b, 0xed, 0xe5, 0x21, 0xb8, 0xd4, 0xf8, 0x31, 0xb2, 0xaa, 0x52,
+	0x38, 0x13, 0x4d, 0x68, 0xe0, 0xb2, 0x46, 0x58, 0xe7, 0xbc, 0x56, 0x9d, 0x0d, 0x17, 0x35, 0xed,
+	0xd3, 0x6f, 0x07, 0x29, 0x6f, 0xfe, 0x3b, 0x65, 0xb7, 0xc9, 0x7f, 0x59, 0x77, 0xc8, 0xd6, 0xd2,
+	0x18, 0xa3, 0x49, 0xc8, 0x24, 0xef, 0xc4, 0x34, 0xc6, 0x6f, 0x91, 0xad, 0x4a, 0x16, 0x71, 0xc7,
+	0x5a, 0x58, 0xde, 0xbd, 0xff, 0x7c, 0x4c, 0x68, 0x4d, 0x3e, 0x42, 0xd1, 0x3f, 0x94, 0x66, 0x6d,
+	0x3e, 0x1d, 0x4e, 0xae, 0x79, 0x3c, 0xb9, 0xe6, 0x9f, 0x93, 0x6b, 0xfe, 0x38, 0xbb, 0xc6, 0xf1,
+	0xec, 0x1a, 0xbf, 0xce, 0xae, 0xb1, 0x7b, 0x9d, 0x08, 0xf5, 0xb5, 0x0a, 0x49, 0x04, 0x19, 0x8d,
+	0x40, 0x66, 0x20, 0xa9, 0x08, 0xa3, 0x97, 0x09, 0xd0, 0xfd, 0x1b, 0x9a, 0x41, 0x5c, 0xa5, 0x5c,
+	0x36, 0x07, 0x37, 0x38, 0x34, 0x55, 0x17, 0x5c, 0x86, 0xb7, 0xfa, 0x9a, 0x5e, 0xfd, 0x1d, 0x00,
+	0xa7, 0xee, 0x0e, 0x7d, 0xda, 0x02, 0x00, 0x00,
 }
 
 func (m *Token) Marshal() (dAtA []byte, err error) {
@@ -184,6 +332,18 @@ func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Amount) > 0 {
 		i -= len(m.Amount)
 		copy(dAtA[i:], m.Amount)
@@ -204,6 +364,95 @@ func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Exponent != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Display) > 0 {
+		i -= len(m.Display)
+		copy(dAtA[i:], m.Display)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Display)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomTokenMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTokenMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTokenMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Denom) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -271,6 +520,49 @@ func (m *Token) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovToken(uint64(l))
 	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Display)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovToken(uint64(m.Exponent))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomTokenMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovToken(uint64(l))
 	return n
 }
 
@@ -393,6 +685,322 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			}
 			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &TokenMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Display = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomTokenMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTokenMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTokenMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipToken(dAtA[iNdEx:])
@@ -139,6 +139,33 @@ func TestValidate(t *testing.T) {
 			},
 			fmt.Errorf("invalid token denom: invalid trace: invalid hop source port ID : identifier cannot be blank: invalid identifier"),
 		},
+		{
+			"success: native token with metadata",
+			Token{
+				Denom:    NewDenom("uatom"),
+				Amount:   amount,
+				Metadata: &TokenMetadata{Display: "atom", Symbol: "ATOM", Exponent: 6},
+			},
+			nil,
+		},
+		{
+			"failure: metadata on token not native to the sending chain",
+			Token{
+				Denom:    NewDenom("uatom", NewHop("transfer", "channel-1")),
+				Amount:   amount,
+				Metadata: &TokenMetadata{Display: "atom", Symbol: "ATOM", Exponent: 6},
+			},
+			ErrInvalidTokenMetadata,
+		},
+		{
+			"failure: invalid metadata",
+			Token{
+				Denom:    NewDenom("uatom"),
+				Amount:   amount,
+				Metadata: &TokenMetadata{Display: "atom"},
+			},
+			ErrInvalidTokenMetadata,
+		},
 	}
 
 	for _, tc := range testCases {
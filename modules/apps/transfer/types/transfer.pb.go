@@ -23,10 +23,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DenomPolicyType defines whether a denomination policy allows or denies the
+// listed denominations.
+type DenomPolicyType int32
+
+const (
+	// zero-value for the denomination policy type
+	UNSPECIFIED DenomPolicyType = 0
+	// only the listed denominations may be transferred
+	ALLOWLIST DenomPolicyType = 1
+	// the listed denominations may not be transferred
+	DENYLIST DenomPolicyType = 2
+)
+
+var DenomPolicyType_name = map[int32]string{
+	0: "DENOM_POLICY_TYPE_UNSPECIFIED",
+	1: "DENOM_POLICY_TYPE_ALLOWLIST",
+	2: "DENOM_POLICY_TYPE_DENYLIST",
+}
+
+var DenomPolicyType_value = map[string]int32{
+	"DENOM_POLICY_TYPE_UNSPECIFIED": 0,
+	"DENOM_POLICY_TYPE_ALLOWLIST":   1,
+	"DENOM_POLICY_TYPE_DENYLIST":    2,
+}
+
+func (x DenomPolicyType) String() string {
+	return proto.EnumName(DenomPolicyType_name, int32(x))
+}
+
+func (DenomPolicyType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{0}
+}
+
 // Params defines the set of IBC transfer parameters.
-// NOTE: To prevent a single token from being transferred, set the
-// TransfersEnabled parameter to true and then set the bank module's SendEnabled
-// parameter for the denomination to false.
+// NOTE: To prevent a single token from being transferred, add it to a denylist
+// in send_policies or receive_policies.
 type Params struct {
 	// send_enabled enables or disables all cross-chain token transfers from this
 	// chain.
@@ -34,6 +66,13 @@ type Params struct {
 	// receive_enabled enables or disables all cross-chain token transfers to this
 	// chain.
 	ReceiveEnabled bool `protobuf:"varint,2,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty"`
+	// send_policies restricts the denominations that may be sent from this chain.
+	SendPolicies []DenomPolicy `protobuf:"bytes,3,rep,name=send_policies,json=sendPolicies,proto3" json:"send_policies"`
+	// receive_policies restricts the denominations that may be received by this chain.
+	ReceivePolicies []DenomPolicy `protobuf:"bytes,4,rep,name=receive_policies,json=receivePolicies,proto3" json:"receive_policies"`
+	// memo_validation_enabled enables the validation of the memos of the transfers sent and received by
+	// this chain against the memo keys registered in the transfer keeper.
+	MemoValidationEnabled bool `protobuf:"varint,5,opt,name=memo_validation_enabled,json=memoValidationEnabled,proto3" json:"memo_validation_enabled,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -83,6 +122,95 @@ func (m *Params) GetReceiveEnabled() bool {
 	return false
 }
 
+func (m *Params) GetSendPolicies() []DenomPolicy {
+	if m != nil {
+		return m.SendPolicies
+	}
+	return nil
+}
+
+func (m *Params) GetReceivePolicies() []DenomPolicy {
+	if m != nil {
+		return m.ReceivePolicies
+	}
+	return nil
+}
+
+func (m *Params) GetMemoValidationEnabled() bool {
+	if m != nil {
+		return m.MemoValidationEnabled
+	}
+	return false
+}
+
+// DenomPolicy defines an allowlist or denylist of denominations applied to the
+// transfers of a channel, or of every channel if channel_id is empty. Each entry
+// of denoms is matched against the base denomination, the full path
+// (e.g. transfer/channel-0/uatom) or the hash (with or without the ibc/ prefix)
+// of the transferred denomination as represented on this chain.
+type DenomPolicy struct {
+	// the channel the policy applies to, all channels if empty
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// whether denoms is an allowlist or a denylist
+	PolicyType DenomPolicyType `protobuf:"varint,2,opt,name=policy_type,json=policyType,proto3,enum=ibc.applications.transfer.v1.DenomPolicyType" json:"policy_type,omitempty"`
+	// the denominations matched by the policy
+	Denoms []string `protobuf:"bytes,3,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *DenomPolicy) Reset()         { *m = DenomPolicy{} }
+func (m *DenomPolicy) String() string { return proto.CompactTextString(m) }
+func (*DenomPolicy) ProtoMessage()    {}
+func (*DenomPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{1}
+}
+func (m *DenomPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPolicy.Merge(m, src)
+}
+func (m *DenomPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPolicy proto.InternalMessageInfo
+
+func (m *DenomPolicy) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *DenomPolicy) GetPolicyType() DenomPolicyType {
+	if m != nil {
+		return m.PolicyType
+	}
+	return UNSPECIFIED
+}
+
+func (m *DenomPolicy) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
 // Forwarding defines a list of port ID, channel ID pairs determining the path
 // through which a packet must be forwarded, and an unwind boolean indicating if
 // the coin should be unwinded to its native chain before forwarding.
@@ -91,13 +219,19 @@ type Forwarding struct {
 	Unwind bool `protobuf:"varint,1,opt,name=unwind,proto3" json:"unwind,omitempty"`
 	// optional intermediate path through which packet will be forwarded
 	Hops []Hop `protobuf:"bytes,2,rep,name=hops,proto3" json:"hops"`
+	// optional timeouts used when forwarding the packet on each of the hops, if
+	// set there must be one per hop
+	HopTimeouts []HopTimeout `protobuf:"bytes,3,rep,name=hop_timeouts,json=hopTimeouts,proto3" json:"hop_timeouts"`
+	// optional number of times a packet that timed out on a hop is sent again
+	// before the tokens are refunded
+	MaxRetries uint64 `protobuf:"varint,4,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
 }
 
 func (m *Forwarding) Reset()         { *m = Forwarding{} }
 func (m *Forwarding) String() string { return proto.CompactTextString(m) }
 func (*Forwarding) ProtoMessage()    {}
 func (*Forwarding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5041673e96e97901, []int{1}
+	return fileDescriptor_5041673e96e97901, []int{2}
 }
 func (m *Forwarding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -140,6 +274,20 @@ func (m *Forwarding) GetHops() []Hop {
 	return nil
 }
 
+func (m *Forwarding) GetHopTimeouts() []HopTimeout {
+	if m != nil {
+		return m.HopTimeouts
+	}
+	return nil
+}
+
+func (m *Forwarding) GetMaxRetries() uint64 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
 // Hop defines a port ID, channel ID pair specifying where tokens must be forwarded
 // next in a multihop transfer.
 type Hop struct {
@@ -150,7 +298,7 @@ type Hop struct {
 func (m *Hop) Reset()      { *m = Hop{} }
 func (*Hop) ProtoMessage() {}
 func (*Hop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5041673e96e97901, []int{2}
+	return fileDescriptor_5041673e96e97901, []int{3}
 }
 func (m *Hop) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -193,10 +341,70 @@ func (m *Hop) GetChannelId() string {
 	return ""
 }
 
+// HopTimeout defines the timeout of the packet sent on a forwarding hop. At most
+// one of the relative or absolute timeouts may be set. If none is set, the timeout
+// timestamp of the packet received by the forwarding chain is used.
+type HopTimeout struct {
+	// timeout duration in nanoseconds added to the block time of the forwarding chain
+	RelativeTimeout uint64 `protobuf:"varint,1,opt,name=relative_timeout,json=relativeTimeout,proto3" json:"relative_timeout,omitempty"`
+	// absolute timeout timestamp in nanoseconds since unix epoch
+	TimeoutTimestamp uint64 `protobuf:"varint,2,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+}
+
+func (m *HopTimeout) Reset()         { *m = HopTimeout{} }
+func (m *HopTimeout) String() string { return proto.CompactTextString(m) }
+func (*HopTimeout) ProtoMessage()    {}
+func (*HopTimeout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{4}
+}
+func (m *HopTimeout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HopTimeout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HopTimeout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HopTimeout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HopTimeout.Merge(m, src)
+}
+func (m *HopTimeout) XXX_Size() int {
+	return m.Size()
+}
+func (m *HopTimeout) XXX_DiscardUnknown() {
+	xxx_messageInfo_HopTimeout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HopTimeout proto.InternalMessageInfo
+
+func (m *HopTimeout) GetRelativeTimeout() uint64 {
+	if m != nil {
+		return m.RelativeTimeout
+	}
+	return 0
+}
+
+func (m *HopTimeout) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("ibc.applications.transfer.v1.DenomPolicyType", DenomPolicyType_name, DenomPolicyType_value)
 	proto.RegisterType((*Params)(nil), "ibc.applications.transfer.v1.Params")
+	proto.RegisterType((*DenomPolicy)(nil), "ibc.applications.transfer.v1.DenomPolicy")
 	proto.RegisterType((*Forwarding)(nil), "ibc.applications.transfer.v1.Forwarding")
 	proto.RegisterType((*Hop)(nil), "ibc.applications.transfer.v1.Hop")
+	proto.RegisterType((*HopTimeout)(nil), "ibc.applications.transfer.v1.HopTimeout")
 }
 
 func init() {
@@ -204,28 +412,48 @@ func init() {
 }
 
 var fileDescriptor_5041673e96e97901 = []byte{
-	// 332 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xcf, 0x4a, 0xc3, 0x40,
-	0x10, 0x87, 0x93, 0xb6, 0x44, 0xbb, 0x15, 0x85, 0x45, 0xb4, 0x88, 0xa6, 0x7f, 0x2e, 0x16, 0xc4,
-	0x2c, 0xd5, 0x83, 0xa8, 0xb7, 0x8a, 0xd2, 0xde, 0xb4, 0x78, 0xf2, 0x52, 0x36, 0x9b, 0x35, 0x5d,
-	0x48, 0x76, 0x96, 0xdd, 0x34, 0xc5, 0xb7, 0xf0, 0xe8, 0xd1, 0xc7, 0xe9, 0xb1, 0x47, 0x4f, 0x22,
-	0xed, 0x8b, 0x48, 0xd2, 0x58, 0x7a, 0xf2, 0x36, 0xf3, 0xcd, 0x37, 0xc3, 0xb2, 0x3f, 0x74, 0x26,
-	0x7c, 0x46, 0xa8, 0x52, 0x91, 0x60, 0x34, 0x11, 0x20, 0x0d, 0x49, 0x34, 0x95, 0xe6, 0x95, 0x6b,
-	0x92, 0x76, 0xd7, 0xb5, 0xa7, 0x34, 0x24, 0x80, 0x8f, 0x85, 0xcf, 0xbc, 0x4d, 0xd9, 0x5b, 0x0b,
-	0x69, 0xf7, 0x68, 0x3f, 0x84, 0x10, 0x72, 0x91, 0x64, 0xd5, 0x6a, 0xa7, 0xfd, 0x8c, 0x9c, 0x47,
-	0xaa, 0x69, 0x6c, 0x70, 0x0b, 0xed, 0x18, 0x2e, 0x83, 0x11, 0x97, 0xd4, 0x8f, 0x78, 0x50, 0xb7,
-	0x9b, 0x76, 0x67, 0x7b, 0x58, 0xcb, 0xd8, 0xfd, 0x0a, 0xe1, 0x53, 0xb4, 0xa7, 0x39, 0xe3, 0x22,
-	0xe5, 0x6b, 0xab, 0x94, 0x5b, 0xbb, 0x05, 0x2e, 0xc4, 0x36, 0x45, 0xe8, 0x01, 0xf4, 0x94, 0xea,
-	0x40, 0xc8, 0x10, 0x1f, 0x20, 0x67, 0x22, 0xa7, 0x42, 0xfe, 0xdd, 0x2c, 0x3a, 0x7c, 0x8b, 0x2a,
-	0x63, 0x50, 0xa6, 0x5e, 0x6a, 0x96, 0x3b, 0xb5, 0x8b, 0x96, 0xf7, 0xdf, 0xf3, 0xbd, 0x3e, 0xa8,
-	0x5e, 0x65, 0xf6, 0xdd, 0xb0, 0x86, 0xf9, 0x52, 0xfb, 0x0e, 0x95, 0xfb, 0xa0, 0xf0, 0x21, 0xda,
-	0x52, 0xa0, 0x93, 0x91, 0x58, 0x1d, 0xaf, 0x0e, 0x9d, 0xac, 0x1d, 0x04, 0xf8, 0x04, 0x21, 0x36,
-	0xa6, 0x52, 0xf2, 0x28, 0x9b, 0x95, 0xf2, 0x59, 0xb5, 0x20, 0x83, 0xe0, 0xa6, 0xf2, 0xf1, 0xd9,
-	0xb0, 0x7a, 0x4f, 0xb3, 0x85, 0x6b, 0xcf, 0x17, 0xae, 0xfd, 0xb3, 0x70, 0xed, 0xf7, 0xa5, 0x6b,
-	0xcd, 0x97, 0xae, 0xf5, 0xb5, 0x74, 0xad, 0x97, 0xab, 0x50, 0x24, 0xe3, 0x89, 0xef, 0x31, 0x88,
-	0x09, 0x03, 0x13, 0x83, 0x21, 0xc2, 0x67, 0xe7, 0x21, 0x90, 0xf4, 0x9a, 0xc4, 0x10, 0x4c, 0x22,
-	0x6e, 0xb2, 0x60, 0x36, 0x02, 0x49, 0xde, 0x14, 0x37, 0xbe, 0x93, 0xff, 0xeb, 0xe5, 0x6f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x58, 0xc2, 0xeb, 0x86, 0xba, 0x01, 0x00, 0x00,
+	// 652 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x4f, 0xdb, 0x4a,
+	0x14, 0xb5, 0x83, 0x5f, 0x1e, 0xb9, 0x06, 0x92, 0x37, 0x7a, 0xef, 0x81, 0xd2, 0x62, 0x42, 0x36,
+	0x0d, 0xa5, 0xd8, 0x82, 0x4a, 0xad, 0xda, 0xae, 0x0a, 0x09, 0x22, 0x52, 0x1a, 0x82, 0x49, 0x5b,
+	0xc1, 0xc6, 0x72, 0xec, 0x69, 0x32, 0x92, 0xed, 0x19, 0xd9, 0x4e, 0x20, 0xff, 0xa0, 0x62, 0xd5,
+	0x45, 0x17, 0xdd, 0x20, 0x55, 0xea, 0xaa, 0x7f, 0xa2, 0x6b, 0x96, 0x6c, 0x2a, 0x75, 0x55, 0x55,
+	0xf0, 0x47, 0x2a, 0x8f, 0x3f, 0x88, 0x40, 0xa2, 0x55, 0x77, 0x73, 0xcf, 0x3d, 0xf7, 0xcc, 0xbd,
+	0xe7, 0x8e, 0x06, 0x56, 0x49, 0xcf, 0xd2, 0x4c, 0xc6, 0x1c, 0x62, 0x99, 0x21, 0xa1, 0x5e, 0xa0,
+	0x85, 0xbe, 0xe9, 0x05, 0x6f, 0xb0, 0xaf, 0x8d, 0xd6, 0xb3, 0xb3, 0xca, 0x7c, 0x1a, 0x52, 0x74,
+	0x97, 0xf4, 0x2c, 0x75, 0x92, 0xac, 0x66, 0x84, 0xd1, 0x7a, 0xf9, 0xdf, 0x3e, 0xed, 0x53, 0x4e,
+	0xd4, 0xa2, 0x53, 0x5c, 0x53, 0xfd, 0x92, 0x83, 0x7c, 0xc7, 0xf4, 0x4d, 0x37, 0x40, 0xcb, 0x30,
+	0x13, 0x60, 0xcf, 0x36, 0xb0, 0x67, 0xf6, 0x1c, 0x6c, 0x2f, 0x88, 0x15, 0xb1, 0x36, 0xad, 0xcb,
+	0x11, 0xd6, 0x88, 0x21, 0x74, 0x0f, 0x8a, 0x3e, 0xb6, 0x30, 0x19, 0xe1, 0x8c, 0x95, 0xe3, 0xac,
+	0xb9, 0x04, 0x4e, 0x89, 0x5d, 0x98, 0xe5, 0x5a, 0x8c, 0x3a, 0xc4, 0x22, 0x38, 0x58, 0x98, 0xaa,
+	0x4c, 0xd5, 0xe4, 0x8d, 0x15, 0xf5, 0xb6, 0x16, 0xd5, 0x3a, 0xf6, 0xa8, 0xdb, 0x89, 0x4a, 0xc6,
+	0x9b, 0xd2, 0xd9, 0xf7, 0x25, 0x41, 0xe7, 0x1d, 0x75, 0x12, 0x11, 0x74, 0x08, 0xa5, 0xf4, 0xfa,
+	0x4c, 0x58, 0xfa, 0x33, 0xe1, 0x74, 0x8e, 0x4c, 0xfb, 0x11, 0xcc, 0xbb, 0xd8, 0xa5, 0xc6, 0xc8,
+	0x74, 0x88, 0xcd, 0x25, 0xb2, 0x11, 0xff, 0xe2, 0x23, 0xfe, 0x17, 0xa5, 0x5f, 0x65, 0xd9, 0x64,
+	0xd2, 0xea, 0x7b, 0x11, 0xe4, 0x09, 0x79, 0xb4, 0x08, 0x60, 0x0d, 0x4c, 0xcf, 0xc3, 0x8e, 0x41,
+	0x62, 0x0f, 0x0b, 0x7a, 0x21, 0x41, 0x9a, 0x36, 0x6a, 0x83, 0xcc, 0x5b, 0x1f, 0x1b, 0xe1, 0x98,
+	0x61, 0xee, 0xde, 0xdc, 0xc6, 0xda, 0x6f, 0x77, 0xdf, 0x1d, 0x33, 0xac, 0x03, 0xcb, 0xce, 0xe8,
+	0x7f, 0xc8, 0xdb, 0x51, 0x3a, 0x76, 0xb8, 0xa0, 0x27, 0x51, 0xf5, 0xab, 0x08, 0xb0, 0x4d, 0xfd,
+	0x23, 0xd3, 0xb7, 0x89, 0xd7, 0x8f, 0x68, 0x43, 0xef, 0x88, 0x78, 0xe9, 0x56, 0x93, 0x08, 0x3d,
+	0x03, 0x69, 0x40, 0x59, 0xb0, 0x90, 0xe3, 0x2e, 0x2e, 0xdf, 0xde, 0xc7, 0x0e, 0x65, 0x89, 0x7b,
+	0xbc, 0x08, 0xed, 0xc1, 0xcc, 0x80, 0x32, 0x23, 0x24, 0x2e, 0xa6, 0xc3, 0x30, 0xdd, 0x71, 0xed,
+	0x97, 0x22, 0xdd, 0xb8, 0x20, 0xd1, 0x92, 0x07, 0x19, 0x12, 0xa0, 0x25, 0x90, 0x5d, 0xf3, 0xd8,
+	0xf0, 0x71, 0xe8, 0xc7, 0xcb, 0x15, 0x6b, 0x92, 0x0e, 0xae, 0x79, 0xac, 0xc7, 0x48, 0x75, 0x0b,
+	0xa6, 0x76, 0x28, 0x43, 0xf3, 0xf0, 0x37, 0xa3, 0x7e, 0x78, 0x65, 0x71, 0x3e, 0x0a, 0x9b, 0xf6,
+	0x35, 0xfb, 0x73, 0xd7, 0xec, 0x7f, 0x2a, 0x7d, 0xf8, 0xb8, 0x24, 0x54, 0x6d, 0x80, 0xab, 0x36,
+	0xd0, 0x4a, 0xf4, 0xaa, 0x1c, 0x33, 0x8c, 0x9e, 0x55, 0x32, 0x0b, 0x17, 0x95, 0xf4, 0x62, 0x8a,
+	0xa7, 0xd4, 0x55, 0xf8, 0x27, 0x61, 0x70, 0x66, 0x10, 0x9a, 0x2e, 0xe3, 0x97, 0x48, 0x7a, 0x29,
+	0x49, 0x74, 0x53, 0xfc, 0xfe, 0x67, 0x11, 0x8a, 0xd7, 0x56, 0x87, 0x36, 0x60, 0xb1, 0xde, 0x68,
+	0xef, 0xbe, 0x30, 0x3a, 0xbb, 0xad, 0xe6, 0xd6, 0x81, 0xd1, 0x3d, 0xe8, 0x34, 0x8c, 0x97, 0xed,
+	0xfd, 0x4e, 0x63, 0xab, 0xb9, 0xdd, 0x6c, 0xd4, 0x4b, 0x42, 0xb9, 0x78, 0x72, 0x5a, 0x91, 0x27,
+	0x20, 0xa4, 0xc2, 0x9d, 0x9b, 0x35, 0xcf, 0x5b, 0xad, 0xdd, 0xd7, 0xad, 0xe6, 0x7e, 0xb7, 0x24,
+	0x96, 0x67, 0x4f, 0x4e, 0x2b, 0x85, 0x0c, 0x40, 0x0f, 0xa0, 0x7c, 0x93, 0x5f, 0x6f, 0xb4, 0x0f,
+	0x38, 0x3d, 0x57, 0x9e, 0x39, 0x39, 0xad, 0x4c, 0xa7, 0x71, 0x59, 0x7a, 0xfb, 0x49, 0x11, 0x36,
+	0xf7, 0xce, 0x2e, 0x14, 0xf1, 0xfc, 0x42, 0x11, 0x7f, 0x5c, 0x28, 0xe2, 0xbb, 0x4b, 0x45, 0x38,
+	0xbf, 0x54, 0x84, 0x6f, 0x97, 0x8a, 0x70, 0xf8, 0xb8, 0x4f, 0xc2, 0xc1, 0xb0, 0xa7, 0x5a, 0xd4,
+	0xd5, 0x2c, 0x1a, 0xb8, 0x34, 0xd0, 0x48, 0xcf, 0x5a, 0xeb, 0x53, 0x6d, 0xf4, 0x44, 0x73, 0xa9,
+	0x3d, 0x74, 0x70, 0x10, 0xfd, 0x50, 0x13, 0x3f, 0x53, 0xf4, 0xb2, 0x83, 0x5e, 0x9e, 0x7f, 0x30,
+	0x0f, 0x7f, 0x0e, 0x00, 0x92, 0x07, 0x50, 0x17, 0xc3, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -248,6 +476,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MemoValidationEnabled {
+		i--
+		if m.MemoValidationEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ReceivePolicies) > 0 {
+		for iNdEx := len(m.ReceivePolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceivePolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SendPolicies) > 0 {
+		for iNdEx := len(m.SendPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.ReceiveEnabled {
 		i--
 		if m.ReceiveEnabled {
@@ -271,6 +537,50 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTransfer(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PolicyType != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.PolicyType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Forwarding) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -291,6 +601,25 @@ func (m *Forwarding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxRetries != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.HopTimeouts) > 0 {
+		for iNdEx := len(m.HopTimeouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HopTimeouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Hops) > 0 {
 		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -355,6 +684,39 @@ func (m *Hop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HopTimeout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HopTimeout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HopTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RelativeTimeout != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.RelativeTimeout))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTransfer(v)
 	base := offset
@@ -378,6 +740,43 @@ func (m *Params) Size() (n int) {
 	if m.ReceiveEnabled {
 		n += 2
 	}
+	if len(m.SendPolicies) > 0 {
+		for _, e := range m.SendPolicies {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	if len(m.ReceivePolicies) > 0 {
+		for _, e := range m.ReceivePolicies {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	if m.MemoValidationEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *DenomPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.PolicyType != 0 {
+		n += 1 + sovTransfer(uint64(m.PolicyType))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -396,6 +795,15 @@ func (m *Forwarding) Size() (n int) {
 			n += 1 + l + sovTransfer(uint64(l))
 		}
 	}
+	if len(m.HopTimeouts) > 0 {
+		for _, e := range m.HopTimeouts {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovTransfer(uint64(m.MaxRetries))
+	}
 	return n
 }
 
@@ -416,6 +824,21 @@ func (m *Hop) Size() (n int) {
 	return n
 }
 
+func (m *HopTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelativeTimeout != 0 {
+		n += 1 + sovTransfer(uint64(m.RelativeTimeout))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTransfer(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
 func sovTransfer(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -491,17 +914,238 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ReceiveEnabled = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTransfer(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTransfer
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendPolicies", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendPolicies = append(m.SendPolicies, DenomPolicy{})
+			if err := m.SendPolicies[len(m.SendPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivePolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceivePolicies = append(m.ReceivePolicies, DenomPolicy{})
+			if err := m.ReceivePolicies[len(m.ReceivePolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoValidationEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MemoValidationEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyType", wireType)
+			}
+			m.PolicyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PolicyType |= DenomPolicyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -595,6 +1239,59 @@ func (m *Forwarding) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HopTimeouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HopTimeouts = append(m.HopTimeouts, HopTimeout{})
+			if err := m.HopTimeouts[len(m.HopTimeouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransfer(dAtA[iNdEx:])
@@ -730,6 +1427,94 @@ func (m *Hop) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HopTimeout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HopTimeout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HopTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelativeTimeout", wireType)
+			}
+			m.RelativeTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelativeTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTransfer(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
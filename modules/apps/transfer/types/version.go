@@ -0,0 +1,19 @@
+package types
+
+import (
+	"slices"
+
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// ParseVersion returns the ICS-20 version of a channel version and whether the use of
+// structured error acknowledgements has been negotiated on the channel.
+func ParseVersion(channelVersion string) (string, bool) {
+	return channeltypes.ParseStructuredErrorsVersion(channelVersion)
+}
+
+// IsSupportedVersion returns true if the ICS-20 version of the channel version is supported.
+func IsSupportedVersion(channelVersion string) bool {
+	version, _ := ParseVersion(channelVersion)
+	return slices.Contains(SupportedVersions, version)
+}
@@ -0,0 +1,38 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+func TestParseVersion(t *testing.T) {
+	testCases := []struct {
+		name                string
+		channelVersion      string
+		expVersion          string
+		expStructuredErrors bool
+		expSupported        bool
+	}{
+		{"ics20-1", types.V1, types.V1, false, true},
+		{"ics20-2", types.V2, types.V2, false, true},
+		{"ics20-1 with structured errors", channeltypes.NewStructuredErrorsVersion(types.V1), types.V1, true, true},
+		{"ics20-2 with structured errors", channeltypes.NewStructuredErrorsVersion(types.V2), types.V2, true, true},
+		{"empty version", "", "", false, false},
+		{"unsupported version", "ics20-3", "ics20-3", false, false},
+		{"unsupported version with structured errors", channeltypes.NewStructuredErrorsVersion("ics20-3"), "ics20-3", true, false},
+		{"structured errors suffix only", channeltypes.StructuredErrorsVersionSuffix, "", true, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			version, structuredErrors := types.ParseVersion(tc.channelVersion)
+			require.Equal(t, tc.expVersion, version)
+			require.Equal(t, tc.expStructuredErrors, structuredErrors)
+			require.Equal(t, tc.expSupported, types.IsSupportedVersion(tc.channelVersion))
+		})
+	}
+}
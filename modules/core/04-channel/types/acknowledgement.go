@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
@@ -12,6 +13,10 @@ const (
 	// ackErrorString defines a string constant included in error acknowledgements
 	// NOTE: Changing this const is state machine breaking as acknowledgements are written into state.
 	ackErrorString = "error handling packet: see events for details"
+
+	// StructuredErrorsVersionSuffix is appended to an application version to negotiate the use of
+	// structured error acknowledgements on a channel (e.g. ics20-2+structured-errors).
+	StructuredErrorsVersionSuffix = "+structured-errors"
 )
 
 // NewResultAcknowledgement returns a new instance of Acknowledgement using an Acknowledgement_Result
@@ -60,6 +65,64 @@ func NewErrorAcknowledgement(err error) Acknowledgement {
 	}
 }
 
+// NewStructuredErrorAcknowledgement returns a new instance of Acknowledgement using an Acknowledgement_Error
+// type in the Response field, whose error is the JSON encoded ErrorAcknowledgement carrying the ABCI codespace
+// and code of the error and the reason chosen by the application.
+// NOTE: As with NewErrorAcknowledgementWithCodespace, changing the codespace of an error, or the reason an application
+// chooses for it, is a consensus breaking change. Applications must only use structured error acknowledgements on channels
+// whose version negotiated their use, so that the sending application is able to decode them.
+func NewStructuredErrorAcknowledgement(err error, reason string) Acknowledgement {
+	codespace, code, _ := errorsmod.ABCIInfo(err, false) // discard non-deterministic log value
+
+	errorAck := ErrorAcknowledgement{
+		Codespace: codespace,
+		Code:      code,
+		Reason:    reason,
+	}
+
+	return Acknowledgement{
+		Response: &Acknowledgement_Error{
+			Error: string(SubModuleCdc.MustMarshalJSON(&errorAck)),
+		},
+	}
+}
+
+// NewStructuredErrorsVersion returns the application version which negotiates the use of structured
+// error acknowledgements on a channel for the provided version.
+func NewStructuredErrorsVersion(version string) string {
+	return version + StructuredErrorsVersionSuffix
+}
+
+// ParseStructuredErrorsVersion returns the application version without the structured errors suffix
+// and whether the use of structured error acknowledgements is negotiated by the provided version.
+func ParseStructuredErrorsVersion(version string) (string, bool) {
+	return strings.CutSuffix(version, StructuredErrorsVersionSuffix)
+}
+
+// ErrorReason returns the description of the registered error wrapped by err. The description is part of the
+// error registration and is therefore deterministic, unlike the error string which may include wrapped messages.
+// A generic reason is returned if err does not wrap a registered error.
+func ErrorReason(err error) string {
+	var registeredErr *errorsmod.Error
+	if !errors.As(err, &registeredErr) {
+		return ackErrorString
+	}
+
+	return registeredErr.Error()
+}
+
+// ValidateBasic performs a basic validation of the structured error of an error acknowledgement.
+func (ea ErrorAcknowledgement) ValidateBasic() error {
+	if strings.TrimSpace(ea.Codespace) == "" {
+		return errorsmod.Wrap(ErrInvalidAcknowledgement, "error acknowledgement codespace cannot be empty")
+	}
+	if ea.Code == 0 {
+		return errorsmod.Wrap(ErrInvalidAcknowledgement, "error acknowledgement code cannot be zero")
+	}
+
+	return nil
+}
+
 // ValidateBasic performs a basic validation of the acknowledgement
 func (ack Acknowledgement) ValidateBasic() error {
 	switch resp := ack.Response.(type) {
@@ -90,3 +153,23 @@ func (ack Acknowledgement) Success() bool {
 func (ack Acknowledgement) Acknowledgement() []byte {
 	return SubModuleCdc.MustMarshalJSON(&ack)
 }
+
+// StructuredError returns the structured error of an acknowledgement created using NewStructuredErrorAcknowledgement.
+// It returns false if the acknowledgement is successful or if its error is not a valid structured error.
+func (ack Acknowledgement) StructuredError() (ErrorAcknowledgement, bool) {
+	resp, ok := ack.Response.(*Acknowledgement_Error)
+	if !ok {
+		return ErrorAcknowledgement{}, false
+	}
+
+	var errorAck ErrorAcknowledgement
+	if err := SubModuleCdc.UnmarshalJSON([]byte(resp.Error), &errorAck); err != nil {
+		return ErrorAcknowledgement{}, false
+	}
+
+	if err := errorAck.ValidateBasic(); err != nil {
+		return ErrorAcknowledgement{}, false
+	}
+
+	return errorAck, true
+}
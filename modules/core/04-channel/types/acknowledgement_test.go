@@ -173,3 +173,68 @@ func (suite TypesTestSuite) TestAcknowledgementWithCodespace() { //nolint:govet
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestStructuredErrorAcknowledgement() {
+	testCases := []struct {
+		name        string
+		ack         types.Acknowledgement
+		expBytes    []byte
+		expErrorAck types.ErrorAcknowledgement
+		expFound    bool
+	}{
+		{
+			"valid structured error ack",
+			types.NewStructuredErrorAcknowledgement(errorsmod.Wrap(ibcerrors.ErrInsufficientFunds, "non-deterministic message"), types.ErrorReason(ibcerrors.ErrInsufficientFunds)),
+			[]byte(`{"error":"{\"codespace\":\"ibc\",\"code\":3,\"reason\":\"insufficient funds\"}"}`),
+			types.ErrorAcknowledgement{Codespace: "ibc", Code: 3, Reason: "insufficient funds"},
+			true,
+		},
+		{
+			"unknown error",
+			types.NewStructuredErrorAcknowledgement(fmt.Errorf("unknown error"), types.ErrorReason(fmt.Errorf("unknown error"))),
+			[]byte(`{"error":"{\"codespace\":\"undefined\",\"code\":1,\"reason\":\"error handling packet: see events for details\"}"}`),
+			types.ErrorAcknowledgement{Codespace: "undefined", Code: 1, Reason: "error handling packet: see events for details"},
+			true,
+		},
+		{
+			"legacy error ack",
+			types.NewErrorAcknowledgement(ibcerrors.ErrInsufficientFunds),
+			[]byte(`{"error":"ABCI code: 3: error handling packet: see events for details"}`),
+			types.ErrorAcknowledgement{},
+			false,
+		},
+		{
+			"successful ack",
+			types.NewResultAcknowledgement([]byte("success")),
+			[]byte(`{"result":"c3VjY2Vzcw=="}`),
+			types.ErrorAcknowledgement{},
+			false,
+		},
+		{
+			"nil error",
+			types.NewStructuredErrorAcknowledgement(nil, ""),
+			[]byte(`{"error":"{\"codespace\":\"\",\"code\":0,\"reason\":\"\"}"}`),
+			types.ErrorAcknowledgement{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(tc.expBytes, tc.ack.Acknowledgement())
+
+			errorAck, found := tc.ack.StructuredError()
+			suite.Require().Equal(tc.expFound, found)
+			suite.Require().Equal(tc.expErrorAck, errorAck)
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestErrorReason() {
+	// the reason does not depend on the wrapped messages
+	suite.Require().Equal("out of gas", types.ErrorReason(errorsmod.Wrap(ibcerrors.ErrOutOfGas, "error string 1")))
+	suite.Require().Equal("out of gas", types.ErrorReason(errorsmod.Wrap(ibcerrors.ErrOutOfGas, "error string 2")))
+	suite.Require().Equal("error handling packet: see events for details", types.ErrorReason(fmt.Errorf("error")))
+}
@@ -500,6 +500,73 @@ func (*Acknowledgement) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// ErrorAcknowledgement defines the structured error of an error acknowledgement.
+// Applications which negotiate its use on a channel encode it as JSON in the error
+// field of the Acknowledgement, so that the sending application and off-chain tooling
+// can identify the error which caused the packet to fail.
+type ErrorAcknowledgement struct {
+	// the codespace of the error
+	Codespace string `protobuf:"bytes,1,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// the ABCI code of the error
+	Code uint32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	// the deterministic reason chosen by the application
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ErrorAcknowledgement) Reset()         { *m = ErrorAcknowledgement{} }
+func (m *ErrorAcknowledgement) String() string { return proto.CompactTextString(m) }
+func (*ErrorAcknowledgement) ProtoMessage()    {}
+func (*ErrorAcknowledgement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{7}
+}
+func (m *ErrorAcknowledgement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ErrorAcknowledgement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ErrorAcknowledgement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ErrorAcknowledgement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorAcknowledgement.Merge(m, src)
+}
+func (m *ErrorAcknowledgement) XXX_Size() int {
+	return m.Size()
+}
+func (m *ErrorAcknowledgement) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorAcknowledgement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorAcknowledgement proto.InternalMessageInfo
+
+func (m *ErrorAcknowledgement) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+func (m *ErrorAcknowledgement) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ErrorAcknowledgement) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 // Timeout defines an execution deadline structure for 04-channel handlers.
 // This includes packet lifecycle handlers as well as the upgrade handshake handlers.
 // A valid Timeout contains either one or both of a timestamp and block height (sequence).
@@ -514,7 +581,7 @@ func (m *Timeout) Reset()         { *m = Timeout{} }
 func (m *Timeout) String() string { return proto.CompactTextString(m) }
 func (*Timeout) ProtoMessage()    {}
 func (*Timeout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3a07336710636a0, []int{7}
+	return fileDescriptor_c3a07336710636a0, []int{8}
 }
 func (m *Timeout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -567,7 +634,7 @@ func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3a07336710636a0, []int{8}
+	return fileDescriptor_c3a07336710636a0, []int{9}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -613,6 +680,7 @@ func init() {
 	proto.RegisterType((*PacketState)(nil), "ibc.core.channel.v1.PacketState")
 	proto.RegisterType((*PacketId)(nil), "ibc.core.channel.v1.PacketId")
 	proto.RegisterType((*Acknowledgement)(nil), "ibc.core.channel.v1.Acknowledgement")
+	proto.RegisterType((*ErrorAcknowledgement)(nil), "ibc.core.channel.v1.ErrorAcknowledgement")
 	proto.RegisterType((*Timeout)(nil), "ibc.core.channel.v1.Timeout")
 	proto.RegisterType((*Params)(nil), "ibc.core.channel.v1.Params")
 }
@@ -620,66 +688,68 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/channel/v1/channel.proto", fileDescriptor_c3a07336710636a0) }
 
 var fileDescriptor_c3a07336710636a0 = []byte{
-	// 937 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6f, 0xe2, 0xc6,
-	0x1b, 0xc6, 0xc4, 0xfc, 0x7b, 0x93, 0x80, 0x33, 0xf9, 0xfd, 0x52, 0xcb, 0x4a, 0xc1, 0x8b, 0x5a,
-	0x95, 0x4d, 0xb5, 0xb0, 0xd9, 0x56, 0x55, 0xb7, 0xb7, 0x04, 0xbc, 0x8b, 0xb5, 0x14, 0x90, 0x81,
-	0x43, 0xf7, 0x82, 0x8c, 0x3d, 0x05, 0x6b, 0xc1, 0x43, 0xed, 0x81, 0xd5, 0xaa, 0xe7, 0x4a, 0x2b,
-	0x4e, 0xfd, 0x02, 0x48, 0x95, 0xfa, 0x15, 0xfa, 0x21, 0xf6, 0xb8, 0xc7, 0x3d, 0x55, 0x55, 0xf2,
-	0x1d, 0x7a, 0xae, 0x3c, 0x33, 0x0e, 0x10, 0x45, 0x51, 0x55, 0xa9, 0xb7, 0x9e, 0x98, 0xf7, 0x79,
-	0x9f, 0xf7, 0x7d, 0xde, 0x3f, 0xc3, 0xc8, 0xf0, 0xc0, 0x1b, 0x39, 0x35, 0x87, 0x04, 0xb8, 0xe6,
-	0x4c, 0x6c, 0xdf, 0xc7, 0xd3, 0xda, 0xf2, 0x3c, 0x3e, 0x56, 0xe7, 0x01, 0xa1, 0x04, 0x1d, 0x7b,
-	0x23, 0xa7, 0x1a, 0x51, 0xaa, 0x31, 0xbe, 0x3c, 0xd7, 0xfe, 0x37, 0x26, 0x63, 0xc2, 0xfc, 0xb5,
-	0xe8, 0xc4, 0xa9, 0x5a, 0x69, 0x93, 0x6d, 0xea, 0x61, 0x9f, 0xb2, 0x64, 0xec, 0xc4, 0x09, 0xe5,
-	0xdf, 0x92, 0x90, 0xa9, 0xf3, 0x2c, 0xe8, 0x31, 0xa4, 0x42, 0x6a, 0x53, 0xac, 0x4a, 0xba, 0x54,
-	0xc9, 0x3f, 0xd1, 0xaa, 0x77, 0xe8, 0x54, 0x7b, 0x11, 0xc3, 0xe2, 0x44, 0xf4, 0x15, 0x64, 0x49,
-	0xe0, 0xe2, 0xc0, 0xf3, 0xc7, 0x6a, 0xf2, 0x9e, 0xa0, 0x4e, 0x44, 0xb2, 0x6e, 0xb8, 0xe8, 0x05,
-	0x1c, 0x38, 0x64, 0xe1, 0x53, 0x1c, 0xcc, 0xed, 0x80, 0xbe, 0x51, 0xf7, 0x74, 0xa9, 0xb2, 0xff,
-	0xe4, 0xc1, 0x9d, 0xb1, 0xf5, 0x2d, 0xe2, 0xa5, 0xfc, 0xee, 0xf7, 0x52, 0xc2, 0xda, 0x09, 0x46,
-	0x9f, 0x41, 0xc1, 0x21, 0xbe, 0x8f, 0x1d, 0xea, 0x11, 0x7f, 0x38, 0x21, 0xf3, 0x50, 0x95, 0xf5,
-	0xbd, 0x4a, 0xce, 0xca, 0x6f, 0xe0, 0x26, 0x99, 0x87, 0x48, 0x85, 0xcc, 0x12, 0x07, 0xa1, 0x47,
-	0x7c, 0x35, 0xa5, 0x4b, 0x95, 0x9c, 0x15, 0x9b, 0xe8, 0x21, 0x28, 0x8b, 0xf9, 0x38, 0xb0, 0x5d,
-	0x3c, 0x0c, 0xf1, 0x0f, 0x0b, 0xec, 0x3b, 0x58, 0x4d, 0xeb, 0x52, 0x45, 0xb6, 0x0a, 0x02, 0xef,
-	0x09, 0xf8, 0x1b, 0xf9, 0xed, 0x2f, 0xa5, 0x44, 0xf9, 0xcf, 0x24, 0x1c, 0x99, 0x2e, 0xf6, 0xa9,
-	0xf7, 0xbd, 0x87, 0xdd, 0xff, 0x06, 0xf8, 0x11, 0x64, 0xe6, 0x24, 0xa0, 0x43, 0xcf, 0x65, 0x73,
-	0xcb, 0x59, 0xe9, 0xc8, 0x34, 0x5d, 0xf4, 0x31, 0x80, 0x28, 0x25, 0xf2, 0x65, 0x98, 0x2f, 0x27,
-	0x10, 0xd3, 0xbd, 0x73, 0xf0, 0xd9, 0xfb, 0x06, 0xdf, 0x82, 0x83, 0xed, 0x7e, 0xb6, 0x85, 0xa5,
-	0x7b, 0x84, 0x93, 0xb7, 0x84, 0x45, 0xb6, 0x0f, 0x49, 0x48, 0x77, 0x6d, 0xe7, 0x15, 0xa6, 0x48,
-	0x83, 0xec, 0x4d, 0x05, 0x12, 0xab, 0xe0, 0xc6, 0x46, 0x25, 0xd8, 0x0f, 0xc9, 0x22, 0x70, 0xf0,
-	0x30, 0x4a, 0x2e, 0x92, 0x01, 0x87, 0xba, 0x24, 0xa0, 0xe8, 0x53, 0xc8, 0x0b, 0x82, 0x50, 0x60,
-	0x0b, 0xc9, 0x59, 0x87, 0x1c, 0x8d, 0xef, 0xc7, 0x43, 0x50, 0x5c, 0x1c, 0x52, 0xcf, 0xb7, 0xd9,
-	0xa4, 0x59, 0x32, 0x99, 0x11, 0x0b, 0x5b, 0x38, 0xcb, 0x58, 0x83, 0xe3, 0x6d, 0x6a, 0x9c, 0x96,
-	0x8f, 0x1d, 0x6d, 0xb9, 0xe2, 0xdc, 0x08, 0x64, 0xd7, 0xa6, 0x36, 0x1b, 0xff, 0x81, 0xc5, 0xce,
-	0xe8, 0x39, 0xe4, 0xa9, 0x37, 0xc3, 0x64, 0x41, 0x87, 0x13, 0xec, 0x8d, 0x27, 0x94, 0x2d, 0x60,
-	0x7f, 0xe7, 0x8e, 0xf1, 0xc7, 0x60, 0x79, 0x5e, 0x6d, 0x32, 0x86, 0xb8, 0x20, 0x87, 0x22, 0x8e,
-	0x83, 0xe8, 0x73, 0x38, 0x8a, 0x13, 0x45, 0xbf, 0x21, 0xb5, 0x67, 0x73, 0xb1, 0x27, 0x45, 0x38,
-	0xfa, 0x31, 0x2e, 0x46, 0xfb, 0x23, 0xec, 0xf3, 0xc9, 0xb2, 0xfb, 0xfe, 0x4f, 0xf7, 0xb4, 0xb3,
-	0x96, 0xbd, 0x5b, 0x6b, 0x89, 0x5b, 0x96, 0x37, 0x2d, 0x0b, 0x71, 0x17, 0xb2, 0x5c, 0xdc, 0x74,
-	0xff, 0x0d, 0x65, 0xa1, 0xd2, 0x81, 0xc2, 0x85, 0xf3, 0xca, 0x27, 0xaf, 0xa7, 0xd8, 0x1d, 0xe3,
-	0x19, 0xf6, 0x29, 0x52, 0x21, 0x1d, 0xe0, 0x70, 0x31, 0xa5, 0xea, 0xff, 0xa3, 0xa2, 0x9a, 0x09,
-	0x4b, 0xd8, 0xe8, 0x04, 0x52, 0x38, 0x08, 0x48, 0xa0, 0x9e, 0x44, 0x42, 0xcd, 0x84, 0xc5, 0xcd,
-	0x4b, 0x80, 0x6c, 0x80, 0xc3, 0x39, 0xf1, 0x43, 0x5c, 0xb6, 0x21, 0xd3, 0xe7, 0xd3, 0x44, 0x5f,
-	0x43, 0x5a, 0xac, 0x4c, 0xfa, 0x9b, 0x2b, 0x13, 0x7c, 0x74, 0x0a, 0xb9, 0xcd, 0x8e, 0x92, 0xac,
-	0xf0, 0x0d, 0x50, 0x1e, 0x44, 0x17, 0x3e, 0xb0, 0x67, 0x21, 0x7a, 0x01, 0xf1, 0x5f, 0x6c, 0x28,
-	0x56, 0x28, 0xa4, 0x4e, 0xef, 0x7c, 0x45, 0x44, 0x61, 0x42, 0x2c, 0x2f, 0x42, 0x05, 0x7a, 0xf6,
-	0x53, 0x12, 0x52, 0x3d, 0xf1, 0xa2, 0x95, 0x7a, 0xfd, 0x8b, 0xbe, 0x31, 0x1c, 0xb4, 0xcd, 0xb6,
-	0xd9, 0x37, 0x2f, 0x5a, 0xe6, 0x4b, 0xa3, 0x31, 0x1c, 0xb4, 0x7b, 0x5d, 0xa3, 0x6e, 0x3e, 0x33,
-	0x8d, 0x86, 0x92, 0xd0, 0x8e, 0x56, 0x6b, 0xfd, 0x70, 0x87, 0x80, 0x54, 0x00, 0x1e, 0x17, 0x81,
-	0x8a, 0xa4, 0x65, 0x57, 0x6b, 0x5d, 0x8e, 0xce, 0xa8, 0x08, 0x87, 0xdc, 0xd3, 0xb7, 0xbe, 0xeb,
-	0x74, 0x8d, 0xb6, 0x92, 0xd4, 0xf6, 0x57, 0x6b, 0x3d, 0x23, 0xcc, 0x4d, 0x24, 0x73, 0xee, 0xf1,
-	0x48, 0xe6, 0x39, 0x85, 0x03, 0xee, 0xa9, 0xb7, 0x3a, 0x3d, 0xa3, 0xa1, 0xc8, 0x1a, 0xac, 0xd6,
-	0x7a, 0x9a, 0x5b, 0x48, 0x87, 0x3c, 0xf7, 0x3e, 0x6b, 0x0d, 0x7a, 0x4d, 0xb3, 0xfd, 0x5c, 0x49,
-	0x69, 0x07, 0xab, 0xb5, 0x9e, 0x8d, 0x6d, 0x74, 0x06, 0xc7, 0x5b, 0x8c, 0x7a, 0xe7, 0xdb, 0x6e,
-	0xcb, 0xe8, 0x1b, 0x4a, 0x9a, 0xd7, 0xbf, 0x03, 0x6a, 0xf2, 0xdb, 0x5f, 0x8b, 0x89, 0xb3, 0xd7,
-	0x90, 0x62, 0x4f, 0x35, 0xfa, 0x04, 0x4e, 0x3a, 0x56, 0xc3, 0xb0, 0x86, 0xed, 0x4e, 0xdb, 0xb8,
-	0xd5, 0x3d, 0x2b, 0x30, 0xc2, 0x51, 0x19, 0x0a, 0x9c, 0x35, 0x68, 0xb3, 0x5f, 0xa3, 0xa1, 0x48,
-	0xda, 0xe1, 0x6a, 0xad, 0xe7, 0x6e, 0x80, 0xa8, 0x7d, 0xce, 0x89, 0x19, 0xa2, 0x7d, 0x61, 0x72,
-	0xe1, 0xcb, 0xde, 0xbb, 0xab, 0xa2, 0xf4, 0xfe, 0xaa, 0x28, 0xfd, 0x71, 0x55, 0x94, 0x7e, 0xbe,
-	0x2e, 0x26, 0xde, 0x5f, 0x17, 0x13, 0x1f, 0xae, 0x8b, 0x89, 0x97, 0x4f, 0xc7, 0x1e, 0x9d, 0x2c,
-	0x46, 0x55, 0x87, 0xcc, 0x6a, 0x0e, 0x09, 0x67, 0x24, 0xac, 0x79, 0x23, 0xe7, 0xd1, 0x98, 0xd4,
-	0x96, 0x4f, 0x6b, 0x33, 0xe2, 0x2e, 0xa6, 0x38, 0xe4, 0x9f, 0x08, 0x8f, 0xbf, 0x7c, 0x14, 0x7f,
-	0x73, 0xd0, 0x37, 0x73, 0x1c, 0x8e, 0xd2, 0xec, 0x1b, 0xe1, 0x8b, 0xbf, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x39, 0xda, 0x3d, 0x84, 0x94, 0x08, 0x00, 0x00,
+	// 973 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x65, 0xea, 0x6f, 0x6c, 0xc9, 0xf4, 0x3a, 0x75, 0x09, 0xc2, 0x95, 0x19, 0xa1, 0x45,
+	0x1d, 0x17, 0x91, 0xe2, 0xb4, 0x28, 0x9a, 0xde, 0xfc, 0xc3, 0xc4, 0x44, 0x5c, 0xc9, 0xa0, 0xa4,
+	0x43, 0x73, 0x51, 0x29, 0x72, 0x2b, 0x11, 0x91, 0xb8, 0x2c, 0xb9, 0x52, 0x10, 0xf4, 0x5c, 0x20,
+	0xd0, 0xa9, 0x2f, 0x20, 0xa0, 0x40, 0x5f, 0xa1, 0x0f, 0x91, 0x63, 0x8e, 0x39, 0x15, 0x85, 0xfd,
+	0x0e, 0x3d, 0x17, 0xfb, 0x43, 0x4b, 0x32, 0x0c, 0xa3, 0x28, 0xd0, 0x5b, 0x4e, 0xda, 0xf9, 0xe6,
+	0x9b, 0xf9, 0x66, 0x67, 0x86, 0x14, 0xe1, 0x7e, 0xd0, 0xf7, 0x1a, 0x1e, 0x89, 0x71, 0xc3, 0x1b,
+	0xba, 0x61, 0x88, 0x47, 0x8d, 0xe9, 0x61, 0x7a, 0xac, 0x47, 0x31, 0xa1, 0x04, 0x6d, 0x07, 0x7d,
+	0xaf, 0xce, 0x28, 0xf5, 0x14, 0x9f, 0x1e, 0x1a, 0xf7, 0x06, 0x64, 0x40, 0xb8, 0xbf, 0xc1, 0x4e,
+	0x82, 0x6a, 0xec, 0x2d, 0xb2, 0x8d, 0x02, 0x1c, 0x52, 0x9e, 0x8c, 0x9f, 0x04, 0xa1, 0xf6, 0x47,
+	0x16, 0x0a, 0x27, 0x22, 0x0b, 0x7a, 0x04, 0xb9, 0x84, 0xba, 0x14, 0xeb, 0x8a, 0xa9, 0xec, 0x57,
+	0x1e, 0x1b, 0xf5, 0x5b, 0x74, 0xea, 0x6d, 0xc6, 0x70, 0x04, 0x11, 0x7d, 0x0d, 0x45, 0x12, 0xfb,
+	0x38, 0x0e, 0xc2, 0x81, 0x9e, 0xbd, 0x23, 0xa8, 0xc5, 0x48, 0xce, 0x35, 0x17, 0x3d, 0x87, 0x0d,
+	0x8f, 0x4c, 0x42, 0x8a, 0xe3, 0xc8, 0x8d, 0xe9, 0x6b, 0x7d, 0xcd, 0x54, 0xf6, 0xd7, 0x1f, 0xdf,
+	0xbf, 0x35, 0xf6, 0x64, 0x89, 0x78, 0xac, 0xbe, 0xfd, 0x73, 0x2f, 0xe3, 0xac, 0x04, 0xa3, 0xcf,
+	0x61, 0xd3, 0x23, 0x61, 0x88, 0x3d, 0x1a, 0x90, 0xb0, 0x37, 0x24, 0x51, 0xa2, 0xab, 0xe6, 0xda,
+	0x7e, 0xc9, 0xa9, 0x2c, 0xe0, 0x33, 0x12, 0x25, 0x48, 0x87, 0xc2, 0x14, 0xc7, 0x49, 0x40, 0x42,
+	0x3d, 0x67, 0x2a, 0xfb, 0x25, 0x27, 0x35, 0xd1, 0x03, 0xd0, 0x26, 0xd1, 0x20, 0x76, 0x7d, 0xdc,
+	0x4b, 0xf0, 0x4f, 0x13, 0x1c, 0x7a, 0x58, 0xcf, 0x9b, 0xca, 0xbe, 0xea, 0x6c, 0x4a, 0xbc, 0x2d,
+	0xe1, 0x6f, 0xd5, 0x37, 0xbf, 0xed, 0x65, 0x6a, 0x7f, 0x67, 0x61, 0xcb, 0xf6, 0x71, 0x48, 0x83,
+	0x1f, 0x03, 0xec, 0x7f, 0x68, 0xe0, 0xc7, 0x50, 0x88, 0x48, 0x4c, 0x7b, 0x81, 0xcf, 0xfb, 0x56,
+	0x72, 0xf2, 0xcc, 0xb4, 0x7d, 0xf4, 0x09, 0x80, 0x2c, 0x85, 0xf9, 0x0a, 0xdc, 0x57, 0x92, 0x88,
+	0xed, 0xdf, 0xda, 0xf8, 0xe2, 0x5d, 0x8d, 0x3f, 0x87, 0x8d, 0xe5, 0xfb, 0x2c, 0x0b, 0x2b, 0x77,
+	0x08, 0x67, 0x6f, 0x08, 0xcb, 0x6c, 0xef, 0xb3, 0x90, 0xbf, 0x70, 0xbd, 0x97, 0x98, 0x22, 0x03,
+	0x8a, 0xd7, 0x15, 0x28, 0xbc, 0x82, 0x6b, 0x1b, 0xed, 0xc1, 0x7a, 0x42, 0x26, 0xb1, 0x87, 0x7b,
+	0x2c, 0xb9, 0x4c, 0x06, 0x02, 0xba, 0x20, 0x31, 0x45, 0x9f, 0x41, 0x45, 0x12, 0xa4, 0x02, 0x1f,
+	0x48, 0xc9, 0x29, 0x0b, 0x34, 0xdd, 0x8f, 0x07, 0xa0, 0xf9, 0x38, 0xa1, 0x41, 0xe8, 0xf2, 0x4e,
+	0xf3, 0x64, 0x2a, 0x27, 0x6e, 0x2e, 0xe1, 0x3c, 0x63, 0x03, 0xb6, 0x97, 0xa9, 0x69, 0x5a, 0xd1,
+	0x76, 0xb4, 0xe4, 0x4a, 0x73, 0x23, 0x50, 0x7d, 0x97, 0xba, 0xbc, 0xfd, 0x1b, 0x0e, 0x3f, 0xa3,
+	0x67, 0x50, 0xa1, 0xc1, 0x18, 0x93, 0x09, 0xed, 0x0d, 0x71, 0x30, 0x18, 0x52, 0x3e, 0x80, 0xf5,
+	0x95, 0x1d, 0x13, 0x2f, 0x83, 0xe9, 0x61, 0xfd, 0x8c, 0x33, 0xe4, 0x82, 0x94, 0x65, 0x9c, 0x00,
+	0xd1, 0x17, 0xb0, 0x95, 0x26, 0x62, 0xbf, 0x09, 0x75, 0xc7, 0x91, 0x9c, 0x93, 0x26, 0x1d, 0x9d,
+	0x14, 0x97, 0xad, 0xfd, 0x19, 0xd6, 0x45, 0x67, 0xf9, 0xbe, 0xff, 0xd7, 0x39, 0xad, 0x8c, 0x65,
+	0xed, 0xc6, 0x58, 0xd2, 0x2b, 0xab, 0x8b, 0x2b, 0x4b, 0x71, 0x1f, 0x8a, 0x42, 0xdc, 0xf6, 0xff,
+	0x0f, 0x65, 0xa9, 0xd2, 0x82, 0xcd, 0x23, 0xef, 0x65, 0x48, 0x5e, 0x8d, 0xb0, 0x3f, 0xc0, 0x63,
+	0x1c, 0x52, 0xa4, 0x43, 0x3e, 0xc6, 0xc9, 0x64, 0x44, 0xf5, 0x8f, 0x58, 0x51, 0x67, 0x19, 0x47,
+	0xda, 0x68, 0x07, 0x72, 0x38, 0x8e, 0x49, 0xac, 0xef, 0x30, 0xa1, 0xb3, 0x8c, 0x23, 0xcc, 0x63,
+	0x80, 0x62, 0x8c, 0x93, 0x88, 0x84, 0x09, 0xae, 0xfd, 0x00, 0xf7, 0x2c, 0x06, 0xde, 0xcc, 0xba,
+	0x0b, 0x25, 0x8f, 0xf8, 0x38, 0x89, 0x5c, 0xb9, 0x9c, 0x25, 0x67, 0x01, 0xb0, 0x36, 0x30, 0x83,
+	0xdf, 0xa0, 0xec, 0xf0, 0x33, 0xda, 0x61, 0x75, 0xb8, 0x09, 0x09, 0xe5, 0x22, 0x4a, 0xab, 0xe6,
+	0x42, 0xa1, 0x23, 0xe6, 0x85, 0xbe, 0x81, 0xbc, 0x5c, 0x0a, 0xe5, 0x5f, 0x2e, 0x85, 0xe4, 0xb3,
+	0x72, 0x16, 0x5b, 0x90, 0xe5, 0xad, 0x59, 0x00, 0xb5, 0x2e, 0x7b, 0xa4, 0x62, 0x77, 0x9c, 0xa0,
+	0xe7, 0x90, 0x3e, 0xc4, 0x3d, 0xb9, 0x24, 0x52, 0x6a, 0xf7, 0xd6, 0xf7, 0x94, 0x2c, 0x4c, 0x8a,
+	0x55, 0x64, 0xa8, 0x44, 0x0f, 0x7e, 0xc9, 0x42, 0xae, 0x2d, 0xdf, 0x99, 0x7b, 0xed, 0xce, 0x51,
+	0xc7, 0xea, 0x75, 0x9b, 0x76, 0xd3, 0xee, 0xd8, 0x47, 0xe7, 0xf6, 0x0b, 0xeb, 0xb4, 0xd7, 0x6d,
+	0xb6, 0x2f, 0xac, 0x13, 0xfb, 0xa9, 0x6d, 0x9d, 0x6a, 0x19, 0x63, 0x6b, 0x36, 0x37, 0xcb, 0x2b,
+	0x04, 0xa4, 0x03, 0x88, 0x38, 0x06, 0x6a, 0x8a, 0x51, 0x9c, 0xcd, 0x4d, 0x95, 0x9d, 0x51, 0x15,
+	0xca, 0xc2, 0xd3, 0x71, 0xbe, 0x6f, 0x5d, 0x58, 0x4d, 0x2d, 0x6b, 0xac, 0xcf, 0xe6, 0x66, 0x41,
+	0x9a, 0x8b, 0x48, 0xee, 0x5c, 0x13, 0x91, 0xdc, 0xb3, 0x0b, 0x1b, 0xc2, 0x73, 0x72, 0xde, 0x6a,
+	0x5b, 0xa7, 0x9a, 0x6a, 0xc0, 0x6c, 0x6e, 0xe6, 0x85, 0x85, 0x4c, 0xa8, 0x08, 0xef, 0xd3, 0xf3,
+	0x6e, 0xfb, 0xcc, 0x6e, 0x3e, 0xd3, 0x72, 0xc6, 0xc6, 0x6c, 0x6e, 0x16, 0x53, 0x1b, 0x1d, 0xc0,
+	0xf6, 0x12, 0xe3, 0xa4, 0xf5, 0xdd, 0xc5, 0xb9, 0xd5, 0xb1, 0xb4, 0xbc, 0xa8, 0x7f, 0x05, 0x34,
+	0xd4, 0x37, 0xbf, 0x57, 0x33, 0x07, 0xaf, 0x20, 0xc7, 0xff, 0x0c, 0xd0, 0xa7, 0xb0, 0xd3, 0x72,
+	0x4e, 0x2d, 0xa7, 0xd7, 0x6c, 0x35, 0xad, 0x1b, 0xb7, 0xe7, 0x05, 0x32, 0x1c, 0xd5, 0x60, 0x53,
+	0xb0, 0xba, 0x4d, 0xfe, 0x6b, 0x9d, 0x6a, 0x8a, 0x51, 0x9e, 0xcd, 0xcd, 0xd2, 0x35, 0xc0, 0xae,
+	0x2f, 0x38, 0x29, 0x43, 0x5e, 0x5f, 0x9a, 0x42, 0xf8, 0xb8, 0xfd, 0xf6, 0xb2, 0xaa, 0xbc, 0xbb,
+	0xac, 0x2a, 0x7f, 0x5d, 0x56, 0x95, 0x5f, 0xaf, 0xaa, 0x99, 0x77, 0x57, 0xd5, 0xcc, 0xfb, 0xab,
+	0x6a, 0xe6, 0xc5, 0x93, 0x41, 0x40, 0x87, 0x93, 0x7e, 0xdd, 0x23, 0xe3, 0x86, 0x47, 0x92, 0x31,
+	0x49, 0x1a, 0x41, 0xdf, 0x7b, 0x38, 0x20, 0x8d, 0xe9, 0x93, 0xc6, 0x98, 0xf8, 0x93, 0x11, 0x4e,
+	0xc4, 0x47, 0xc8, 0xa3, 0xaf, 0x1e, 0xa6, 0x5f, 0x35, 0xf4, 0x75, 0x84, 0x93, 0x7e, 0x9e, 0x7f,
+	0x85, 0x7c, 0xf9, 0xcf, 0x00, 0x51, 0xad, 0x29, 0xbe, 0xf6, 0x08, 0x00, 0x00,
 }
 
 func (m *Channel) Marshal() (dAtA []byte, err error) {
@@ -1096,6 +1166,48 @@ func (m *Acknowledgement_Error) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	dAtA[i] = 0xb2
 	return len(dAtA) - i, nil
 }
+func (m *ErrorAcknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErrorAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ErrorAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Code != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Timeout) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1378,6 +1490,26 @@ func (m *Acknowledgement_Error) Size() (n int) {
 	n += 2 + l + sovChannel(uint64(l))
 	return n
 }
+func (m *ErrorAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovChannel(uint64(m.Code))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	return n
+}
+
 func (m *Timeout) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2693,6 +2825,139 @@ func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ErrorAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErrorAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErrorAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Timeout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
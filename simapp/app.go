@@ -379,6 +379,7 @@ func NewSimApp(
 		app.IBCKeeper.ChannelKeeper, // may be replaced with IBC middleware
 		app.IBCKeeper.ChannelKeeper,
 		app.AccountKeeper, app.BankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	// ICA Controller keeper
@@ -399,6 +400,12 @@ func NewSimApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// register the encoding formats which may be negotiated for interchain accounts in addition to protobuf and proto3 JSON
+	app.ICAControllerKeeper.RegisterEncoding(icatypes.EncodingCBOR, icatypes.NewCBOREncoder())
+	app.ICAControllerKeeper.RegisterEncoding(icatypes.EncodingAminoJSON, icatypes.NewAminoJSONEncoder(legacyAmino))
+	app.ICAHostKeeper.RegisterEncoding(icatypes.EncodingCBOR, icatypes.NewCBOREncoder())
+	app.ICAHostKeeper.RegisterEncoding(icatypes.EncodingAminoJSON, icatypes.NewAminoJSONEncoder(legacyAmino))
+
 	// Create IBC Router
 	ibcRouter := porttypes.NewRouter()
 
@@ -414,6 +421,13 @@ func NewSimApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// the callback keys of the transfer memo are deliberately accepted without validation: this app does not wire
+	// the callbacks middleware, whose memo validator lives in a separate go module, so the keys are only relayed to
+	// counterparty chains, whose callbacks middleware validates their value. Apps wiring the callbacks middleware
+	// should register ibccallbackstypes.ValidateCallbackMemo instead, as the callbacks simapp does.
+	app.TransferKeeper.RegisterMemoKey("src_callback", nil)
+	app.TransferKeeper.RegisterMemoKey("dest_callback", nil)
+
 	// Create Transfer Stack
 	// SendPacket, since it is originating from the application to core IBC:
 	// transferKeeper.SendPacket -> fee.SendPacket -> channel.SendPacket
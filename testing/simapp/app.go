@@ -353,6 +353,7 @@ func NewSimApp(
 		app.IBCKeeper.ChannelKeeper, // may be replaced with IBC middleware
 		app.IBCKeeper.ChannelKeeper,
 		app.AccountKeeper, app.BankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	// ICA Controller keeper
@@ -373,6 +374,12 @@ func NewSimApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// register the encoding formats which may be negotiated for interchain accounts in addition to protobuf and proto3 JSON
+	app.ICAControllerKeeper.RegisterEncoding(icatypes.EncodingCBOR, icatypes.NewCBOREncoder())
+	app.ICAControllerKeeper.RegisterEncoding(icatypes.EncodingAminoJSON, icatypes.NewAminoJSONEncoder(legacyAmino))
+	app.ICAHostKeeper.RegisterEncoding(icatypes.EncodingCBOR, icatypes.NewCBOREncoder())
+	app.ICAHostKeeper.RegisterEncoding(icatypes.EncodingAminoJSON, icatypes.NewAminoJSONEncoder(legacyAmino))
+
 	// Create IBC Router
 	ibcRouter := porttypes.NewRouter()
 
@@ -388,6 +395,13 @@ func NewSimApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// the callback keys of the transfer memo are deliberately accepted without validation: this app does not wire
+	// the callbacks middleware, whose memo validator lives in a separate go module, so the keys are only relayed to
+	// counterparty chains, whose callbacks middleware validates their value. Apps wiring the callbacks middleware
+	// should register ibccallbackstypes.ValidateCallbackMemo instead, as the callbacks simapp does.
+	app.TransferKeeper.RegisterMemoKey("src_callback", nil)
+	app.TransferKeeper.RegisterMemoKey("dest_callback", nil)
+
 	// Mock Module Stack
 
 	// Mock Module setup for testing IBC and also acts as the interchain accounts authentication module